@@ -5,6 +5,7 @@ import (
 
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	qbtcante "github.com/btcq-org/qbtc/x/qbtc/ante"
 	"github.com/btcq-org/qbtc/x/qbtc/ebifrost"
 	"github.com/btcq-org/qbtc/x/qbtc/keeper"
 	"github.com/cosmos/cosmos-sdk/client"
@@ -19,6 +20,7 @@ import (
 type HandlerOptions struct {
 	ante.HandlerOptions
 	QbtcKeeper            *keeper.Keeper
+	ClaimAccountKeeper    qbtcante.AccountKeeper
 	WasmConfig            *wasmtypes.WasmConfig
 	TXCounterStoreService corestoretypes.KVStoreService
 }
@@ -44,6 +46,12 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 	if options.TXCounterStoreService == nil {
 		return nil, errors.New("tx counter store service is required for ante builder")
 	}
+	if options.QbtcKeeper == nil {
+		return nil, errors.New("qbtc keeper is required for ante builder")
+	}
+	if options.ClaimAccountKeeper == nil {
+		return nil, errors.New("claim account keeper is required for ante builder")
+	}
 
 	anteDecorators := []sdk.AnteDecorator{
 		// ebifrost injected tx decorator must be the first decorator to avoid handled messages to be submitted
@@ -55,6 +63,8 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		ante.NewTxTimeoutHeightDecorator(),
 		ante.NewValidateMemoDecorator(options.AccountKeeper),
 		ante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
+		// creates the account of first-time claimers, must run before fee deduction and signature checks
+		qbtcante.NewClaimAccountDecorator(options.ClaimAccountKeeper, options.QbtcKeeper),
 		ante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.TxFeeChecker),
 		ante.NewSetPubKeyDecorator(options.AccountKeeper), // SetPubKeyDecorator must be called before all signature verification decorators
 		ante.NewValidateSigCountDecorator(options.AccountKeeper),
@@ -81,6 +91,7 @@ func (app *App) setAnteHandler(txConfig client.TxConfig) {
 				SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
 			},
 			QbtcKeeper:            app.QbtcKeeper,
+			ClaimAccountKeeper:    app.AuthKeeper,
 			WasmConfig:            &wasmConfig,
 			TXCounterStoreService: runtime.NewKVStoreService(app.GetKey(wasmtypes.StoreKey)), // TX counter uses main store, not transient
 		},
@@ -0,0 +1,265 @@
+package app
+
+import (
+	"compress/gzip"
+	"encoding/hex"
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/stretchr/testify/require"
+
+	"github.com/btcq-org/qbtc/x/qbtc/keeper"
+	qbtctestutil "github.com/btcq-org/qbtc/x/qbtc/testutil"
+	"github.com/btcq-org/qbtc/x/qbtc/types"
+	"github.com/btcq-org/qbtc/x/qbtc/zk"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+)
+
+const (
+	ClaimTestChainID = "qbtc-claim-test"
+
+	// claimTestHeight is the Bitcoin height of the hand-crafted block
+	claimTestHeight = 900000
+)
+
+// TestClaimPipeline runs a claim through the whole module:
+// 1. Report a Bitcoin block that pays a P2PKH and a P2WPKH output to one key
+// 2. Check the UTXOs indexed from the block
+// 3. Register the verifying key through governance
+// 4. Prove ownership of the key and submit ClaimWithProof
+// 5. Check the mint and that a repeated claim is a no-op
+func TestClaimPipeline(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping claim integration test in short mode")
+	}
+	s := setupClaimTestApp(t)
+	msgServer := keeper.NewMsgServerImpl(s.App.QbtcKeeper)
+
+	btcKey, _ := btcec.PrivKeyFromBytes([]byte("qbtc claim pipeline test key 001"))
+	addressHash, err := zk.PrivateKeyToAddressHash(btcKey)
+	require.NoError(t, err)
+	p2pkhAddress, err := zk.Hash160ToP2PKHAddress(addressHash)
+	require.NoError(t, err)
+	p2wpkh, err := btcutil.NewAddressWitnessPubKeyHash(addressHash[:], &chaincfg.MainNetParams)
+	require.NoError(t, err)
+
+	// The block spends a known UTXO into one output of each type. Amounts are
+	// exact in binary floating point, like the ones bitcoind reports.
+	const spentTxID = "aa11000000000000000000000000000000000000000000000000000000000001"
+	spent := types.UTXO{
+		Txid:           spentTxID,
+		Vout:           0,
+		Amount:         200000000,
+		EntitledAmount: 200000000,
+		ScriptPubKey: &types.ScriptPubKeyResult{
+			Hex:     "76a914000000000000000000000000000000000000000088ac",
+			Type:    "pubkeyhash",
+			Address: "1111111111111111111114oLvT2",
+		},
+	}
+	require.NoError(t, s.App.QbtcKeeper.Utxoes.Set(s.Ctx, spent.GetKey(), spent))
+
+	block := btcjson.GetBlockVerboseTxResult{
+		Height: claimTestHeight,
+		Tx: []btcjson.TxRawResult{
+			{
+				Vin: []btcjson.Vin{{Coinbase: "03a0bb0d"}},
+				Vout: []btcjson.Vout{{
+					Value: 3.375,
+					N:     0,
+					ScriptPubKey: btcjson.ScriptPubKeyResult{
+						Hex:     "76a914111111111111111111111111111111111111111188ac",
+						Type:    "pubkeyhash",
+						Address: "12ZEw5Hcv1hTb6YUQJ69y1V7uhcoDz92PH",
+					},
+				}},
+			},
+			{
+				Vin: []btcjson.Vin{{Txid: spentTxID, Vout: 0}},
+				Vout: []btcjson.Vout{
+					{
+						Value: 1.5,
+						N:     0,
+						ScriptPubKey: btcjson.ScriptPubKeyResult{
+							Hex:     "76a914" + hex.EncodeToString(addressHash[:]) + "88ac",
+							Type:    "pubkeyhash",
+							Address: p2pkhAddress,
+						},
+					},
+					{
+						Value: 0.25,
+						N:     1,
+						ScriptPubKey: btcjson.ScriptPubKeyResult{
+							Hex:     "0014" + hex.EncodeToString(addressHash[:]),
+							Type:    "witness_v0_keyhash",
+							Address: p2wpkh.EncodeAddress(),
+						},
+					},
+				},
+			},
+		},
+	}
+	s.reportBlock(t, msgServer, &block)
+	coinbaseTxID, claimTxID := block.Tx[0].Txid, block.Tx[1].Txid
+
+	// The spent UTXO is gone, the new outputs carry their full value and the
+	// coinbase has the 0.25 BTC fee removed
+	has, err := s.App.QbtcKeeper.Utxoes.Has(s.Ctx, spent.GetKey())
+	require.NoError(t, err)
+	require.False(t, has)
+	for vout, want := range []uint64{150000000, 25000000} {
+		utxo, err := s.App.QbtcKeeper.Utxoes.Get(s.Ctx, fmt.Sprintf("%s-%d", claimTxID, vout))
+		require.NoError(t, err)
+		require.Equal(t, want, utxo.Amount)
+		require.Equal(t, want, utxo.EntitledAmount)
+	}
+	coinbase, err := s.App.QbtcKeeper.Utxoes.Get(s.Ctx, coinbaseTxID+"-0")
+	require.NoError(t, err)
+	require.Equal(t, uint64(312500000), coinbase.EntitledAmount)
+	height, err := s.App.QbtcKeeper.GetLastProcessedBlock(s.Ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(claimTestHeight), height)
+
+	// Register the verifying key the way a chain upgrade would
+	setup, err := zk.SetupWithOptions(zk.TestSetupOptions())
+	require.NoError(t, err)
+	vkBytes, err := zk.SerializeVerifyingKey(setup.VerifyingKey)
+	require.NoError(t, err)
+	_, err = msgServer.ReplaceVerifyingKey(s.Ctx, types.NewMsgReplaceVerifyingKey(s.App.QbtcKeeper.GetAuthority(), vkBytes))
+	require.NoError(t, err)
+
+	// Prove ownership of the key for the claimer, with the default message
+	// version, which releases both output types of the key
+	claimer := s.Accounts[0].Address
+	proof, params := qbtctestutil.GenerateClaimProof(t, zk.ProverFromSetup(setup), btcKey, claimer.String(), ClaimTestChainID)
+
+	msg := &types.MsgClaimWithProof{
+		Claimer: claimer.String(),
+		Utxos: []types.UTXORef{
+			{Txid: claimTxID, Vout: 0},
+			{Txid: claimTxID, Vout: 1},
+		},
+		Proof:           hex.EncodeToString(proof),
+		MessageHash:     hex.EncodeToString(params.MessageHash[:]),
+		AddressHash:     hex.EncodeToString(addressHash[:]),
+		QbtcAddressHash: hex.EncodeToString(params.QBTCAddressHash[:]),
+	}
+	balanceBefore := s.App.BankKeeper.GetBalance(s.Ctx, claimer, sdk.DefaultBondDenom)
+	resp, err := msgServer.ClaimWithProof(s.Ctx, msg)
+	require.NoError(t, err)
+	require.Equal(t, uint32(2), resp.UtxosClaimed)
+	require.Equal(t, uint64(175000000), resp.TotalAmountClaimed)
+
+	balanceAfter := s.App.BankKeeper.GetBalance(s.Ctx, claimer, sdk.DefaultBondDenom)
+	require.Equal(t, int64(175000000), balanceAfter.Amount.Sub(balanceBefore.Amount).Int64())
+	for vout := range msg.Utxos {
+		utxo, err := s.App.QbtcKeeper.Utxoes.Get(s.Ctx, fmt.Sprintf("%s-%d", claimTxID, vout))
+		require.NoError(t, err)
+		require.Zero(t, utxo.EntitledAmount)
+	}
+
+	// Broadcasting the same claim again mints nothing
+	resp, err = msgServer.ClaimWithProof(s.Ctx, msg)
+	require.NoError(t, err)
+	require.Equal(t, uint32(2), resp.UtxosAlreadyClaimed)
+	require.Zero(t, resp.TotalAmountClaimed)
+	require.Equal(t, balanceAfter, s.App.BankKeeper.GetBalance(s.Ctx, claimer, sdk.DefaultBondDenom))
+}
+
+// claimTestSetup holds the test app and context along with the simulation
+// accounts, whose consensus keys back the genesis validators.
+type claimTestSetup struct {
+	App      *App
+	Ctx      sdk.Context
+	Accounts []simtypes.Account
+}
+
+// setupClaimTestApp creates a test app with a bonded validator set.
+func setupClaimTestApp(t *testing.T) claimTestSetup {
+	t.Helper()
+
+	appOptions := make(simtestutil.AppOptionsMap, 0)
+	appOptions[flags.FlagHome] = t.TempDir()
+
+	app := New(
+		log.NewNopLogger(),
+		dbm.NewMemDB(),
+		nil,
+		true,
+		appOptions,
+		baseapp.SetChainID(ClaimTestChainID),
+	)
+
+	r := rand.New(rand.NewSource(1))
+	accounts := simtypes.RandomAccounts(r, 5)
+	config := simtypes.Config{
+		ChainID:     ClaimTestChainID,
+		GenesisTime: time.Now().Unix(),
+	}
+	appStateFn := simtestutil.AppStateFn(app.AppCodec(), app.SimulationManager(), app.DefaultGenesis())
+	appState, simAccounts, _, _ := appStateFn(r, accounts, config)
+
+	_, err := app.InitChain(&abci.RequestInitChain{
+		ChainId:         ClaimTestChainID,
+		AppStateBytes:   appState,
+		ConsensusParams: simtestutil.DefaultConsensusParams,
+	})
+	require.NoError(t, err)
+	_, err = app.Commit()
+	require.NoError(t, err)
+
+	header := cmtproto.Header{
+		Height:  app.LastBlockHeight(),
+		Time:    time.Now().UTC(),
+		ChainID: ClaimTestChainID,
+	}
+	return claimTestSetup{
+		App:      app,
+		Ctx:      app.NewUncachedContext(false, header),
+		Accounts: simAccounts,
+	}
+}
+
+// reportBlock seals the block, see qbtctestutil.SealBlock, and submits it with
+// an attestation from every account. Only the accounts that back a bonded
+// validator count towards the attestation power.
+func (s claimTestSetup) reportBlock(t *testing.T, msgServer types.MsgServer, block *btcjson.GetBlockVerboseTxResult) {
+	t.Helper()
+
+	content := qbtctestutil.SealBlock(t, block)
+	compressed, err := types.GzipDeterministic(content, gzip.BestCompression)
+	require.NoError(t, err)
+
+	attestations := make([]*types.Attestation, 0, len(s.Accounts))
+	for _, account := range s.Accounts {
+		signature, err := account.ConsKey.Sign(compressed)
+		require.NoError(t, err)
+		attestations = append(attestations, &types.Attestation{
+			Address:   sdk.ConsAddress(account.ConsKey.PubKey().Address()).String(),
+			Signature: signature,
+		})
+	}
+
+	_, err = msgServer.SetMsgReportBlock(s.Ctx, &types.MsgBtcBlock{
+		Height:       uint64(block.Height),
+		Hash:         block.Hash,
+		BlockContent: compressed,
+		Attestations: attestations,
+		Signer:       s.Accounts[0].Address.String(),
+	})
+	require.NoError(t, err)
+}
@@ -3,11 +3,19 @@ package config
 import (
 	"fmt"
 	"os"
+	"reflect"
+	"strings"
 
 	"github.com/btcq-org/qbtc/bitcoin"
 	"github.com/spf13/viper"
 )
 
+// EnvPrefix is the prefix of the environment variables that override config
+// fields. A variable is named after the field's key path in upper case with
+// nested keys joined by underscores, e.g. QBTC_BIFROST_BITCOIN_PASSWORD
+// overrides bitcoin.password.
+const EnvPrefix = "QBTC_BIFROST"
+
 type Config struct {
 	ListenAddr           string         `mapstructure:"listen_addr" json:"listen_addr"`
 	HTTPListenAddress    string         `mapstructure:"http_listen_addr" json:"http_listen_addr"`
@@ -20,6 +28,51 @@ type Config struct {
 	EbifrostAddress      string         `mapstructure:"ebifrost_address" json:"ebifrost_address"`
 	QBTCGRPCAddress      string         `mapstructure:"qbtc_grpc_address" json:"qbtc_grpc_address"`
 	BackoffTimeInMinutes int64          `mapstructure:"backoff_time_in_minutes" json:"backoff_time_in_minutes"`
+	// PrefetchDepth is how many blocks ahead of the one being published are
+	// fetched concurrently from the bitcoin node. 0 uses the default, a
+	// negative value disables prefetching.
+	PrefetchDepth int64 `mapstructure:"prefetch_depth" json:"prefetch_depth"`
+	// BlockContentCodec is the compression used for gossiped block content,
+	// "gzip" (default) or "zstd". Attestations only aggregate over identical
+	// bytes, so all validators must switch codecs together.
+	BlockContentCodec string `mapstructure:"block_content_codec" json:"block_content_codec"`
+	// LogSampleEvery keeps only every Nth of the Info lines logged per block
+	// while bifrost catches up with the bitcoin chain. 0 or 1 logs every
+	// block.
+	LogSampleEvery int64 `mapstructure:"log_sample_every" json:"log_sample_every"`
+	// LogSampleTipDistance is how many blocks behind the bitcoin tip bifrost
+	// still logs every block; further behind it counts as catching up.
+	LogSampleTipDistance int64 `mapstructure:"log_sample_tip_distance" json:"log_sample_tip_distance"`
+	// ValidatorSigner selects how block attestations are signed with the
+	// validator's consensus key.
+	ValidatorSigner ValidatorSignerConfig `mapstructure:"validator_signer" json:"validator_signer"`
+}
+
+// Validator signer types.
+const (
+	// ValidatorSignerFile signs with priv_validator_key.json in QBTCHome.
+	ValidatorSignerFile = "file"
+	// ValidatorSignerRemote asks a signing service over HTTP, so the key
+	// never has to be on the bifrost host.
+	ValidatorSignerRemote = "remote"
+)
+
+// DefaultRemoteSignerTimeoutSeconds bounds a request to the remote signer
+// when RemoteTimeoutSeconds is not set.
+const DefaultRemoteSignerTimeoutSeconds = 10
+
+type ValidatorSignerConfig struct {
+	// Type is "file" (default) or "remote".
+	Type string `mapstructure:"type" json:"type"`
+	// RemoteAddress is the base URL of the remote signer, e.g.
+	// http://127.0.0.1:26659.
+	RemoteAddress string `mapstructure:"remote_address" json:"remote_address"`
+	// RemoteAuthToken is sent as a bearer token to the remote signer. Set it
+	// through QBTC_BIFROST_VALIDATOR_SIGNER_REMOTE_AUTH_TOKEN to keep it out
+	// of the config file.
+	RemoteAuthToken string `mapstructure:"remote_auth_token" json:"remote_auth_token"`
+	// RemoteTimeoutSeconds bounds each request to the remote signer.
+	RemoteTimeoutSeconds int64 `mapstructure:"remote_timeout_seconds" json:"remote_timeout_seconds"`
 }
 
 type P2PConfig struct {
@@ -36,19 +89,34 @@ func DefaultConfig() *Config {
 		KeyName:           "bifrost-p2p-key",
 		StartBlockHeight:  0,
 		BitcoinConfig: bitcoin.Config{
-			Host:        "localhost",
-			Port:        8332,
-			RPCUser:     "user",
-			Password:    "password",
-			LocalDBPath: "./db",
+			Host:              "localhost",
+			Port:              8332,
+			RPCUser:           "user",
+			Password:          "password",
+			LocalDBPath:       "./db",
+			RPCTimeoutSeconds: bitcoin.DefaultRPCTimeoutSeconds,
+			MaxConnections:    bitcoin.DefaultMaxConnections,
 		},
 		EbifrostAddress:      "localhost:50051",
 		QBTCGRPCAddress:      "localhost:9090",
 		BackoffTimeInMinutes: 1,
+		PrefetchDepth:        8,
+		BlockContentCodec:    "gzip",
+		LogSampleEvery:       100,
+		LogSampleTipDistance: 6,
+		ValidatorSigner: ValidatorSignerConfig{
+			Type:                 ValidatorSignerFile,
+			RemoteTimeoutSeconds: DefaultRemoteSignerTimeoutSeconds,
+		},
 	}
 }
 
-// GetConfig reads the config file and returns a Config struct
+// GetConfig reads the config file and returns a Config struct.
+//
+// Every field can be overridden by an environment variable (see EnvPrefix),
+// which takes precedence over the file. A field set in the environment may be
+// left out of the file, so secrets such as the bitcoin RPC credentials never
+// need to be written to disk.
 func GetConfig(configPath ...string) (*Config, error) {
 	viper.Reset() // Reset viper to avoid state from previous calls
 	viper.SetConfigType("json")
@@ -74,7 +142,11 @@ func GetConfig(configPath ...string) (*Config, error) {
 		viper.AddConfigPath(".")
 	}
 
-	viper.AutomaticEnv()
+	viper.SetEnvPrefix(EnvPrefix)
+	viper.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
+	if err := bindEnv(reflect.TypeOf(Config{}), ""); err != nil {
+		return nil, err
+	}
 
 	if err := viper.ReadInConfig(); err != nil {
 		return nil, fmt.Errorf("error reading config file: %w", err)
@@ -87,3 +159,28 @@ func GetConfig(configPath ...string) (*Config, error) {
 
 	return &cfg, nil
 }
+
+// bindEnv binds every field of t, recursing into nested structs, to its
+// environment variable. Fields are bound explicitly so they can be set from
+// the environment even when the config file does not mention them.
+func bindEnv(t reflect.Type, prefix string) error {
+	for i := range t.NumField() {
+		field := t.Field(i)
+		name := field.Tag.Get("mapstructure")
+		if name == "" || name == "-" {
+			continue
+		}
+		key := prefix + name
+		if field.Type.Kind() == reflect.Struct {
+			if err := bindEnv(field.Type, key+"."); err != nil {
+				return err
+			}
+			continue
+		}
+		envName := EnvPrefix + "_" + strings.ToUpper(strings.ReplaceAll(key, ".", "_"))
+		if err := viper.BindEnv(key, envName); err != nil {
+			return fmt.Errorf("failed to bind %s to %s: %w", key, envName, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetConfig_EnvOverrides(t *testing.T) {
+	dir := t.TempDir()
+	configFile := filepath.Join(dir, "config.json")
+	require.NoError(t, os.WriteFile(configFile, []byte(`{
+		"listen_addr": "0.0.0.0:30006",
+		"prefetch_depth": 8,
+		"bitcoin": {"host": "localhost", "port": 8332, "rpc_user": "file-user"}
+	}`), 0o600))
+
+	// the file provides the values the environment does not override
+	cfg, err := GetConfig(configFile)
+	require.NoError(t, err)
+	require.Equal(t, "file-user", cfg.BitcoinConfig.RPCUser)
+	require.Empty(t, cfg.BitcoinConfig.Password)
+
+	t.Setenv("QBTC_BIFROST_BITCOIN_RPC_USER", "env-user")
+	t.Setenv("QBTC_BIFROST_BITCOIN_PASSWORD", "env-secret")
+	t.Setenv("QBTC_BIFROST_PREFETCH_DEPTH", "3")
+	t.Setenv("QBTC_BIFROST_EXTERNAL_IP", "203.0.113.7")
+
+	cfg, err = GetConfig(dir)
+	require.NoError(t, err)
+	require.Equal(t, "env-user", cfg.BitcoinConfig.RPCUser)
+	require.Equal(t, "env-secret", cfg.BitcoinConfig.Password)
+	require.Equal(t, int64(3), cfg.PrefetchDepth)
+	require.Equal(t, "203.0.113.7", cfg.ExternalIP)
+	require.Equal(t, "localhost", cfg.BitcoinConfig.Host)
+	require.Equal(t, int64(8332), cfg.BitcoinConfig.Port)
+	require.Equal(t, "0.0.0.0:30006", cfg.ListenAddr)
+}
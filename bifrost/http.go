@@ -9,7 +9,7 @@ func (s *Service) handleHealth(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "text/plain")
 	w.WriteHeader(http.StatusOK)
 	if _, err := w.Write([]byte("OK")); err != nil {
-		s.logger.Error().Err(err).Msg("failed to write health response")
+		s.requestLogger(r).Error().Err(err).Msg("failed to write health response")
 	}
 }
 
@@ -17,7 +17,7 @@ func (s *Service) handleConnectedPeers(w http.ResponseWriter, r *http.Request) {
 	peers := s.network.ConnectedPeers()
 	w.Header().Set("Content-Type", "application/json")
 	if err := json.NewEncoder(w).Encode(peers); err != nil {
-		s.logger.Error().Err(err).Msg("failed to encode connected peers")
+		s.requestLogger(r).Error().Err(err).Msg("failed to encode connected peers")
 		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
 	}
 }
@@ -0,0 +1,39 @@
+package bifrost
+
+import (
+	"github.com/rs/zerolog"
+)
+
+// blockLogSampler thins out a log line written for every block while bifrost
+// catches up with the bitcoin chain, and logs every block near the tip. Each
+// line gets its own sampler, so lines logged for the same block don't share
+// a count and drop each other.
+type blockLogSampler struct {
+	full    zerolog.Logger
+	sampled zerolog.Logger
+}
+
+// newBlockLogSampler keeps one in every events of logger during catch-up.
+// An every of 1 or less keeps all of them.
+func newBlockLogSampler(logger zerolog.Logger, every int64) *blockLogSampler {
+	sampled := logger
+	if every > 1 {
+		sampled = logger.Sample(&zerolog.BasicSampler{N: uint32(every)})
+	}
+	return &blockLogSampler{full: logger, sampled: sampled}
+}
+
+// logger returns the sampled logger while catching up and the full one
+// otherwise.
+func (b *blockLogSampler) logger(catchingUp bool) *zerolog.Logger {
+	if catchingUp {
+		return &b.sampled
+	}
+	return &b.full
+}
+
+// isCatchingUp reports whether a block with the given number of
+// confirmations is more than tipDistance blocks behind the bitcoin tip.
+func isCatchingUp(confirmations int64, tipDistance int64) bool {
+	return confirmations-1 > tipDistance
+}
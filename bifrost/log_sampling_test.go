@@ -0,0 +1,39 @@
+package bifrost
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlockLogSampler(t *testing.T) {
+	var buf bytes.Buffer
+	sampler := newBlockLogSampler(zerolog.New(&buf), 10)
+
+	for range 30 {
+		sampler.logger(true).Info().Msg("catching up")
+	}
+	require.Equal(t, 3, strings.Count(buf.String(), "catching up"))
+
+	for range 5 {
+		sampler.logger(false).Info().Msg("at tip")
+	}
+	require.Equal(t, 5, strings.Count(buf.String(), "at tip"))
+
+	// sampling disabled
+	buf.Reset()
+	sampler = newBlockLogSampler(zerolog.New(&buf), 0)
+	for range 4 {
+		sampler.logger(true).Info().Msg("catching up")
+	}
+	require.Equal(t, 4, strings.Count(buf.String(), "catching up"))
+}
+
+func TestIsCatchingUp(t *testing.T) {
+	require.False(t, isCatchingUp(1, 6))
+	require.False(t, isCatchingUp(7, 6))
+	require.True(t, isCatchingUp(8, 6))
+}
@@ -0,0 +1,99 @@
+package bifrost
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+const (
+	// requestIDHeader carries the request ID, both inbound and in the response.
+	requestIDHeader = "X-Request-ID"
+	// maxRequestIDLength bounds caller supplied request IDs.
+	maxRequestIDLength = 64
+)
+
+// statusRecorder captures the status code written by a handler.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *statusRecorder) Write(b []byte) (int, error) {
+	if r.status == 0 {
+		r.status = http.StatusOK
+	}
+	return r.ResponseWriter.Write(b)
+}
+
+// withRequestLogging assigns every request an ID, attaches a logger carrying
+// that ID to the request context and logs the outcome of the request.
+// A well-formed X-Request-ID sent by the caller is reused so a request can be
+// traced across services; the ID is always echoed back in the response.
+func withRequestLogging(logger zerolog.Logger, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		requestID := r.Header.Get(requestIDHeader)
+		if !isValidRequestID(requestID) {
+			requestID = newRequestID()
+		}
+		w.Header().Set(requestIDHeader, requestID)
+
+		reqLogger := logger.With().Str("request_id", requestID).Logger()
+		rec := &statusRecorder{ResponseWriter: w}
+		next.ServeHTTP(rec, r.WithContext(reqLogger.WithContext(r.Context())))
+
+		status := rec.status
+		if status == 0 {
+			status = http.StatusOK
+		}
+		reqLogger.Info().
+			Str("method", r.Method).
+			Str("path", r.URL.Path).
+			Int("status", status).
+			Dur("duration", time.Since(start)).
+			Msg("http request")
+	})
+}
+
+// requestLogger returns the request scoped logger set up by withRequestLogging,
+// falling back to the service logger for requests that did not pass through it.
+func (s *Service) requestLogger(r *http.Request) *zerolog.Logger {
+	if l := zerolog.Ctx(r.Context()); l.GetLevel() != zerolog.Disabled {
+		return l
+	}
+	return &s.logger
+}
+
+// newRequestID returns a random 16 character hex request ID.
+func newRequestID() string {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "unknown"
+	}
+	return hex.EncodeToString(b)
+}
+
+// isValidRequestID only accepts short IDs made of URL-safe characters, so
+// caller supplied values cannot inject content into log lines.
+func isValidRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for _, c := range id {
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9', c == '-', c == '_', c == '.':
+		default:
+			return false
+		}
+	}
+	return true
+}
@@ -204,6 +204,10 @@ func (p *PubSubService) aggregateAttestations(block types.BlockGossip) error {
 	if !bytes.Equal(msgBlock.BlockContent, block.BlockContent) {
 		return fmt.Errorf("block content mismatch for block %s at height %d", block.Hash, block.Height)
 	}
+	// a validator retrying a publish sends the same attestation again
+	if hasAttestationFrom(msgBlock.Attestations, block.Attestation.Address) {
+		return nil
+	}
 	msgBlock.Attestations = append(msgBlock.Attestations, block.Attestation)
 
 	err = p.saveMsgBtcBlock(msgBlock, key)
@@ -213,6 +217,16 @@ func (p *PubSubService) aggregateAttestations(block types.BlockGossip) error {
 	return p.checkAttestations(&msgBlock)
 }
 
+// hasAttestationFrom reports whether attestations hold one from address.
+func hasAttestationFrom(attestations []*types.Attestation, address string) bool {
+	for _, attestation := range attestations {
+		if attestation != nil && attestation.Address == address {
+			return true
+		}
+	}
+	return false
+}
+
 func (p *PubSubService) saveMsgBtcBlock(msgBlock types.MsgBtcBlock, key string) error {
 	if p.db == nil {
 		return fmt.Errorf("leveldb instance is nil")
@@ -0,0 +1,161 @@
+package bifrost
+
+import (
+	"context"
+	"sync"
+
+	"github.com/btcsuite/btcd/btcjson"
+)
+
+// defaultPrefetchDepth is the number of blocks fetched ahead when the config
+// does not set one.
+const defaultPrefetchDepth = 8
+
+// blockFetchFunc fetches the verbose block at the given height.
+type blockFetchFunc func(height int64) (*btcjson.GetBlockVerboseTxResult, error)
+
+// blockPrefetcher fetches the blocks following the one being processed
+// concurrently, so the bitcoin RPC round trips of the next blocks overlap with
+// compressing, signing and gossiping the current one. Blocks are still handed
+// out one height at a time by the caller, so publication order is unchanged.
+//
+// Blocks are only fetched ahead up to the best height known from the
+// confirmations of the last block handed out, since fetches past the tip of
+// the chain are bound to fail.
+//
+// At most depth+1 fetches are in flight. A depth of zero or less disables
+// prefetching and every Get fetches synchronously.
+type blockPrefetcher struct {
+	fetch blockFetchFunc
+	depth int64
+
+	mu      sync.Mutex
+	pending map[int64]*prefetchedBlock
+	// best is the highest block height known to exist, zero before the
+	// first block is handed out
+	best int64
+}
+
+// prefetchedBlock is a fetch that is in flight or done; done is closed once
+// block or err is set.
+type prefetchedBlock struct {
+	done  chan struct{}
+	block *btcjson.GetBlockVerboseTxResult
+	err   error
+}
+
+func newBlockPrefetcher(fetch blockFetchFunc, depth int64) *blockPrefetcher {
+	return &blockPrefetcher{
+		fetch:   fetch,
+		depth:   depth,
+		pending: make(map[int64]*prefetchedBlock),
+	}
+}
+
+// Get returns the block at height, waiting for its fetch if it is still in
+// flight, and starts fetching the following depth blocks that are known to
+// exist. Blocks outside [height, height+depth] are dropped. A failed fetch is
+// not kept, so the next Get for that height fetches it again.
+//
+// A block fetched ahead can still fail, e.g. when a reorg shortened the
+// chain since, so a failed fetch started by an earlier Get is retried once
+// rather than its stale error returned.
+func (p *blockPrefetcher) Get(ctx context.Context, height int64) (*btcjson.GetBlockVerboseTxResult, error) {
+	if p.depth <= 0 {
+		return p.fetch(height)
+	}
+
+	p.mu.Lock()
+	entry, prefetched := p.pending[height]
+	if !prefetched {
+		entry = p.start(height)
+		p.pending[height] = entry
+	}
+	p.prefetchLocked(height)
+	p.mu.Unlock()
+
+	if err := entry.wait(ctx); err != nil {
+		return nil, err
+	}
+	if entry.err != nil && prefetched {
+		entry = p.restart(entry, height)
+		if err := entry.wait(ctx); err != nil {
+			return nil, err
+		}
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if entry.err != nil {
+		if p.pending[height] == entry {
+			delete(p.pending, height)
+		}
+		return nil, entry.err
+	}
+	// the confirmations of the block tell how far the chain extends, so the
+	// blocks up to there can be fetched ahead
+	if block := entry.block; block != nil {
+		p.best = max(p.best, block.Height+block.Confirmations-1)
+		p.prefetchLocked(height)
+	}
+	return entry.block, nil
+}
+
+// prefetchLocked drops the blocks outside [height, height+depth] and starts
+// fetching the blocks above height up to height+depth or the best known
+// height, whichever is lower. p.mu must be held.
+func (p *blockPrefetcher) prefetchLocked(height int64) {
+	for h := range p.pending {
+		if h < height || h > height+p.depth {
+			delete(p.pending, h)
+		}
+	}
+	for h := height + 1; h <= min(height+p.depth, p.best); h++ {
+		if _, ok := p.pending[h]; !ok {
+			p.pending[h] = p.start(h)
+		}
+	}
+}
+
+// restart fetches height again in place of the failed fetch entry, unless
+// another fetch replaced it already.
+func (p *blockPrefetcher) restart(entry *prefetchedBlock, height int64) *prefetchedBlock {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if current, ok := p.pending[height]; ok && current != entry {
+		return current
+	}
+	retry := p.start(height)
+	p.pending[height] = retry
+	return retry
+}
+
+// Invalidate drops the blocks at height and above, e.g. after a reorg made
+// them stale. They are fetched again on the next Get.
+func (p *blockPrefetcher) Invalidate(height int64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for h := range p.pending {
+		if h >= height {
+			delete(p.pending, h)
+		}
+	}
+}
+
+func (p *blockPrefetcher) start(height int64) *prefetchedBlock {
+	entry := &prefetchedBlock{done: make(chan struct{})}
+	go func() {
+		defer close(entry.done)
+		entry.block, entry.err = p.fetch(height)
+	}()
+	return entry
+}
+
+// wait blocks until the fetch is done or ctx is done.
+func (e *prefetchedBlock) wait(ctx context.Context) error {
+	select {
+	case <-e.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,142 @@
+package bifrost
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/stretchr/testify/require"
+)
+
+// countingFetcher returns a block per height and records how often each
+// height was fetched.
+type countingFetcher struct {
+	mu       sync.Mutex
+	calls    map[int64]int
+	inFlight atomic.Int64
+	maxSeen  atomic.Int64
+	failAt   map[int64]bool
+	// tip, when set, is the height of the last block that can be fetched
+	tip int64
+}
+
+func newCountingFetcher() *countingFetcher {
+	return &countingFetcher{calls: map[int64]int{}, failAt: map[int64]bool{}}
+}
+
+func (f *countingFetcher) fetch(height int64) (*btcjson.GetBlockVerboseTxResult, error) {
+	n := f.inFlight.Add(1)
+	defer f.inFlight.Add(-1)
+	for {
+		m := f.maxSeen.Load()
+		if n <= m || f.maxSeen.CompareAndSwap(m, n) {
+			break
+		}
+	}
+	time.Sleep(time.Millisecond)
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls[height]++
+	if f.tip > 0 && height > f.tip {
+		return nil, errors.New("block height out of range")
+	}
+	if f.failAt[height] {
+		delete(f.failAt, height)
+		return nil, errors.New("block not available")
+	}
+	confirmations := int64(1000)
+	if f.tip > 0 {
+		confirmations = f.tip - height + 1
+	}
+	return &btcjson.GetBlockVerboseTxResult{Height: height, Hash: fmt.Sprintf("hash-%d", height), Confirmations: confirmations}, nil
+}
+
+func (f *countingFetcher) callsAt(height int64) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.calls[height]
+}
+
+func TestBlockPrefetcher_InOrder(t *testing.T) {
+	f := newCountingFetcher()
+	p := newBlockPrefetcher(f.fetch, 4)
+
+	for h := int64(100); h < 120; h++ {
+		block, err := p.Get(context.Background(), h)
+		require.NoError(t, err)
+		require.Equal(t, h, block.Height)
+	}
+	for h := int64(100); h < 120; h++ {
+		require.Equal(t, 1, f.callsAt(h), "height %d fetched more than once", h)
+	}
+	require.LessOrEqual(t, f.maxSeen.Load(), int64(5))
+}
+
+func TestBlockPrefetcher_RetriesFailedFetch(t *testing.T) {
+	f := newCountingFetcher()
+	f.failAt[11] = true
+	p := newBlockPrefetcher(f.fetch, 2)
+
+	_, err := p.Get(context.Background(), 11)
+	require.Error(t, err)
+
+	block, err := p.Get(context.Background(), 11)
+	require.NoError(t, err)
+	require.Equal(t, int64(11), block.Height)
+	require.Equal(t, 2, f.callsAt(11))
+}
+
+func TestBlockPrefetcher_PastTip(t *testing.T) {
+	f := newCountingFetcher()
+	f.tip = 10
+	p := newBlockPrefetcher(f.fetch, 2)
+
+	_, err := p.Get(context.Background(), 10)
+	require.NoError(t, err)
+	// the blocks past the tip are not fetched ahead
+	time.Sleep(10 * time.Millisecond)
+	require.Equal(t, 0, f.callsAt(11))
+	require.Equal(t, 0, f.callsAt(12))
+
+	// once they are mined, each is fetched once
+	f.mu.Lock()
+	f.tip = 12
+	f.mu.Unlock()
+	for h := int64(11); h <= 12; h++ {
+		block, err := p.Get(context.Background(), h)
+		require.NoError(t, err)
+		require.Equal(t, h, block.Height)
+		require.Equal(t, 1, f.callsAt(h))
+	}
+}
+
+func TestBlockPrefetcher_Invalidate(t *testing.T) {
+	f := newCountingFetcher()
+	p := newBlockPrefetcher(f.fetch, 2)
+
+	_, err := p.Get(context.Background(), 10)
+	require.NoError(t, err)
+	// let the prefetches of 11 and 12 finish before dropping them
+	require.Eventually(t, func() bool { return f.callsAt(12) == 1 }, time.Second, time.Millisecond)
+
+	p.Invalidate(11)
+	_, err = p.Get(context.Background(), 11)
+	require.NoError(t, err)
+	require.Equal(t, 2, f.callsAt(11))
+}
+
+func TestBlockPrefetcher_Disabled(t *testing.T) {
+	f := newCountingFetcher()
+	p := newBlockPrefetcher(f.fetch, 0)
+
+	block, err := p.Get(context.Background(), 7)
+	require.NoError(t, err)
+	require.Equal(t, int64(7), block.Height)
+	require.Equal(t, 0, f.callsAt(8))
+}
@@ -0,0 +1,103 @@
+package bifrost
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/btcq-org/qbtc/x/qbtc/types"
+	"github.com/btcsuite/btcd/btcjson"
+)
+
+const (
+	// publishAttempts is how often a signed block gossip is published before
+	// getBtcBlock gives up and leaves it to the next round of the block loop.
+	publishAttempts = 3
+	// publishRetryDelay is the wait before the first retry; it doubles after
+	// every failed attempt.
+	publishRetryDelay = 500 * time.Millisecond
+)
+
+// blockPublishFunc publishes a signed block gossip to the network.
+type blockPublishFunc func(types.BlockGossip) error
+
+// publishWithRetry publishes gossip up to attempts times, waiting delay
+// before the first retry and doubling it after each one. Publishing the same
+// gossip again is harmless, as peers keep one attestation per validator and
+// block. It returns the last error once all attempts failed, or ctx's error if
+// ctx ends first.
+func publishWithRetry(ctx context.Context, publish blockPublishFunc, gossip types.BlockGossip, attempts int, delay time.Duration) error {
+	var err error
+	for attempt := range attempts {
+		if attempt > 0 {
+			select {
+			case <-time.After(delay):
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+			delay *= 2
+		}
+		if err = publish(gossip); err == nil {
+			return nil
+		}
+	}
+	return fmt.Errorf("failed to publish block gossip after %d attempts: %w", attempts, err)
+}
+
+// signedGossipCache keeps the signed gossip of the block being published, so
+// a failed publish is retried without fetching, compressing and signing the
+// block again.
+type signedGossipCache struct {
+	gossip *types.BlockGossip
+}
+
+// get returns the cached gossip if it is for the block with the given height
+// and hash. A block that was reorged out since it was signed does not match.
+func (c *signedGossipCache) get(height int64, hash string) (types.BlockGossip, bool) {
+	if c.gossip == nil || c.gossip.Height != uint64(height) || c.gossip.Hash != hash {
+		return types.BlockGossip{}, false
+	}
+	return *c.gossip, true
+}
+
+// set caches gossip, replacing the gossip of any other block.
+func (c *signedGossipCache) set(gossip types.BlockGossip) {
+	c.gossip = &gossip
+}
+
+// clear drops the cached gossip once it was published.
+func (c *signedGossipCache) clear() {
+	c.gossip = nil
+}
+
+// publishedTip remembers the block published last, so a prefetched block that
+// was reorged out since it was fetched is recognized by its previous block
+// hash instead of asking the bitcoin node for the hash at every height.
+type publishedTip struct {
+	height int64
+	hash   string
+	// refetched is the height of the block that was last dropped for not
+	// extending the tip, see extends
+	refetched int64
+}
+
+// extends reports whether block can be published after the tip. A block that
+// does not extend it was either fetched before a reorg and has to be fetched
+// again, or the reorg replaced the published tip itself. The block at a height
+// is therefore refused once; if the refetched block still does not extend the
+// tip, the tip is stale and the block is accepted. Blocks not directly above
+// the tip, e.g. after the block loop skipped ahead, are always accepted.
+func (t *publishedTip) extends(block *btcjson.GetBlockVerboseTxResult) bool {
+	if t.hash == "" || block.Height != t.height+1 || block.PreviousHash == t.hash || t.refetched == block.Height {
+		t.refetched = 0
+		return true
+	}
+	t.refetched = block.Height
+	return false
+}
+
+// set records gossip as the block published last.
+func (t *publishedTip) set(gossip types.BlockGossip) {
+	t.height = int64(gossip.Height)
+	t.hash = gossip.Hash
+}
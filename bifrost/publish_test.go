@@ -0,0 +1,94 @@
+package bifrost
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/btcq-org/qbtc/x/qbtc/types"
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPublishWithRetry(t *testing.T) {
+	gossip := types.BlockGossip{Hash: "aa", Height: 7}
+	errPublish := errors.New("no peers")
+
+	// succeeds on the third attempt, publishing the same gossip every time
+	var published []types.BlockGossip
+	publish := func(g types.BlockGossip) error {
+		published = append(published, g)
+		if len(published) < 3 {
+			return errPublish
+		}
+		return nil
+	}
+	require.NoError(t, publishWithRetry(context.Background(), publish, gossip, 3, time.Millisecond))
+	require.Equal(t, []types.BlockGossip{gossip, gossip, gossip}, published)
+
+	// gives up after the last attempt with the last error
+	calls := 0
+	failing := func(types.BlockGossip) error {
+		calls++
+		return errPublish
+	}
+	err := publishWithRetry(context.Background(), failing, gossip, 2, time.Millisecond)
+	require.ErrorIs(t, err, errPublish)
+	require.Equal(t, 2, calls)
+
+	// stops waiting once the context ends
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	calls = 0
+	err = publishWithRetry(ctx, failing, gossip, 3, time.Hour)
+	require.ErrorIs(t, err, context.Canceled)
+	require.Equal(t, 1, calls)
+}
+
+func TestSignedGossipCache(t *testing.T) {
+	var cache signedGossipCache
+	_, ok := cache.get(7, "aa")
+	require.False(t, ok)
+
+	gossip := types.BlockGossip{Hash: "aa", Height: 7, BlockContent: []byte("block")}
+	cache.set(gossip)
+	cached, ok := cache.get(7, "aa")
+	require.True(t, ok)
+	require.Equal(t, gossip, cached)
+
+	// another height or a reorged block must be signed anew
+	_, ok = cache.get(8, "aa")
+	require.False(t, ok)
+	_, ok = cache.get(7, "bb")
+	require.False(t, ok)
+
+	cache.clear()
+	_, ok = cache.get(7, "aa")
+	require.False(t, ok)
+}
+
+func TestPublishedTip(t *testing.T) {
+	var tip publishedTip
+	block := func(height int64, hash, previous string) *btcjson.GetBlockVerboseTxResult {
+		return &btcjson.GetBlockVerboseTxResult{Height: height, Hash: hash, PreviousHash: previous}
+	}
+	// nothing published yet
+	require.True(t, tip.extends(block(10, "a10", "a9")))
+	tip.set(types.BlockGossip{Height: 10, Hash: "a10"})
+
+	require.True(t, tip.extends(block(11, "a11", "a10")))
+	tip.set(types.BlockGossip{Height: 11, Hash: "a11"})
+
+	// a block fetched before a reorg is refused, its refetch accepted
+	require.False(t, tip.extends(block(12, "a12", "b11")))
+	require.True(t, tip.extends(block(12, "b12", "a11")))
+	tip.set(types.BlockGossip{Height: 12, Hash: "b12"})
+
+	// a reorg of the published tip itself is accepted on the refetch
+	require.False(t, tip.extends(block(13, "c13", "c12")))
+	require.True(t, tip.extends(block(13, "c13", "c12")))
+
+	// blocks not directly above the tip are not checked
+	require.True(t, tip.extends(block(20, "a20", "a19")))
+}
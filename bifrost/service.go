@@ -1,14 +1,11 @@
 package bifrost
 
 import (
-	"compress/gzip"
 	"context"
 	"encoding/json"
 	"fmt"
 	"net"
 	"net/http"
-	"os"
-	"path/filepath"
 	"sync"
 	"time"
 
@@ -20,9 +17,7 @@ import (
 	"github.com/btcq-org/qbtc/bitcoin"
 	"github.com/btcq-org/qbtc/x/qbtc/ebifrost"
 	"github.com/btcq-org/qbtc/x/qbtc/types"
-	"github.com/cometbft/cometbft/crypto"
-	cmtjson "github.com/cometbft/cometbft/libs/json"
-	"github.com/cometbft/cometbft/privval"
+	"github.com/btcsuite/btcd/btcjson"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
@@ -34,25 +29,42 @@ import (
 // Service represents the bifrost service
 // it wire up all the components together
 type Service struct {
-	cfg                 config.Config
-	logger              zerolog.Logger
-	btcClient           *bitcoin.BtcClient
-	pubsub              *p2p.PubSubService
-	network             *p2p.Network
-	privKey             *keystore.PrivKey
-	db                  *leveldb.DB
-	stopChan            chan struct{}
-	wg                  *sync.WaitGroup
-	qclient             qclient.QBTCNode
-	ebifrost            ebifrost.LocalhostBifrostClient
-	ebifrostConn        *grpc.ClientConn
-	validatorPrivateKey crypto.PrivKey
+	cfg             config.Config
+	logger          zerolog.Logger
+	btcClient       *bitcoin.BtcClient
+	pubsub          *p2p.PubSubService
+	network         *p2p.Network
+	privKey         *keystore.PrivKey
+	db              *leveldb.DB
+	stopChan        chan struct{}
+	wg              *sync.WaitGroup
+	qclient         qclient.QBTCNode
+	ebifrost        ebifrost.LocalhostBifrostClient
+	ebifrostConn    *grpc.ClientConn
+	validatorSigner ValidatorSigner
 
 	// http server
 	hs *http.Server
 
 	// metrics
 	metrics *metrics.Metrics
+
+	// prefetcher fetches the next blocks while the current one is published
+	prefetcher *blockPrefetcher
+
+	// blockCodec compresses the block content before it is signed
+	blockCodec types.BlockContentCodec
+
+	// signedGossip holds the signed block until it is published
+	signedGossip signedGossipCache
+	// published is the block published last, which the next one must extend
+	published publishedTip
+
+	// catchingUp is set while the blocks being published are far behind the
+	// bitcoin tip; the per-block log lines are sampled then
+	catchingUp   bool
+	blockHashLog *blockLogSampler
+	publishLog   *blockLogSampler
 }
 
 func NewService(cfg config.Config) (*Service, error) {
@@ -60,6 +72,10 @@ func NewService(cfg config.Config) (*Service, error) {
 	if err != nil {
 		return nil, err
 	}
+	blockCodec, err := types.ParseBlockContentCodec(cfg.BlockContentCodec)
+	if err != nil {
+		return nil, err
+	}
 	config := &config.P2PConfig{
 		Port:       cast.ToInt(p),
 		ExternalIP: cfg.ExternalIP,
@@ -125,12 +141,12 @@ func NewService(cfg config.Config) (*Service, error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed to create btc client: %w", err)
 	}
-	validatorPrivateKey, err := getValidatorKey(cfg.QBTCHome)
+	validatorSigner, err := newValidatorSigner(cfg)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get validator private key: %w", err)
+		return nil, fmt.Errorf("failed to set up validator signer: %w", err)
 	}
-	valAddr := sdk.ValAddress(validatorPrivateKey.PubKey().Address())
-	log.Info().Str("validator_address", valAddr.String()).Str("validator_pub_key", validatorPrivateKey.PubKey().Address().String()).Msg("loaded validator private key")
+	valAddr := sdk.ValAddress(validatorSigner.PubKey().Address())
+	log.Info().Str("validator_address", valAddr.String()).Str("validator_pub_key", validatorSigner.PubKey().Address().String()).Str("signer", cfg.ValidatorSigner.Type).Msg("loaded validator signer")
 
 	hs := &http.Server{
 		Addr:    cfg.HTTPListenAddress,
@@ -141,51 +157,32 @@ func NewService(cfg config.Config) (*Service, error) {
 	cleanupQClient = false
 	cleanupEbifrostConn = false
 
-	return &Service{
-		cfg:                 cfg,
-		network:             network,
-		privKey:             privKey,
-		db:                  db,
-		btcClient:           btcClient,
-		logger:              log.With().Str("module", "bifrost_service").Logger(),
-		stopChan:            make(chan struct{}),
-		wg:                  &sync.WaitGroup{},
-		qclient:             qClient,
-		ebifrost:            ebifrostClient,
-		ebifrostConn:        ebifrostConn,
-		validatorPrivateKey: validatorPrivateKey,
-		hs:                  hs,
-		metrics:             metrics,
-	}, nil
-}
-
-func getValidatorKey(qbtcHome string) (crypto.PrivKey, error) {
-	homeFolder := qbtcHome
-	if homeFolder == "" {
-		homeDir, err := os.UserHomeDir()
-		if err != nil {
-			return nil, fmt.Errorf("fail to get validator key,err: %w", err)
-		}
-		homeFolder = filepath.Join(homeDir, ".qbtc")
+	prefetchDepth := cfg.PrefetchDepth
+	if prefetchDepth == 0 {
+		prefetchDepth = defaultPrefetchDepth
 	}
-	validatorKeyPath := filepath.Join(homeFolder, "config", "priv_validator_key.json")
-	_, err := os.Stat(validatorKeyPath)
-	if err != nil {
-		if os.IsNotExist(err) {
-			return nil, fmt.Errorf("validator key file does not exist at path: %s", validatorKeyPath)
-		}
-		return nil, fmt.Errorf("error checking validator key file: %w", err)
-	}
-	fileContent, err := os.ReadFile(validatorKeyPath)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read validator key file: %w", err)
-	}
-	pvKey := privval.FilePVKey{}
-	err = cmtjson.Unmarshal(fileContent, &pvKey)
-	if err != nil {
-		return nil, fmt.Errorf("error reading PrivValidator key from %v: %w", validatorKeyPath, err)
-	}
-	return pvKey.PrivKey, nil
+
+	svc := &Service{
+		cfg:             cfg,
+		network:         network,
+		privKey:         privKey,
+		db:              db,
+		btcClient:       btcClient,
+		logger:          log.With().Str("module", "bifrost_service").Logger(),
+		stopChan:        make(chan struct{}),
+		wg:              &sync.WaitGroup{},
+		qclient:         qClient,
+		ebifrost:        ebifrostClient,
+		ebifrostConn:    ebifrostConn,
+		validatorSigner: validatorSigner,
+		hs:              hs,
+		metrics:         metrics,
+		blockCodec:      blockCodec,
+	}
+	svc.prefetcher = newBlockPrefetcher(svc.fetchBtcBlock, prefetchDepth)
+	svc.blockHashLog = newBlockLogSampler(svc.logger, cfg.LogSampleEvery)
+	svc.publishLog = newBlockLogSampler(svc.logger, cfg.LogSampleEvery)
+	return svc, nil
 }
 
 // Start starts the bifrost service
@@ -209,7 +206,7 @@ func (s *Service) Start(ctx context.Context) error {
 	// register routes and metrics
 	mux := s.registerRoutes()
 	metrics.RegisterHandlers(mux)
-	s.hs.Handler = mux
+	s.hs.Handler = withRequestLogging(s.logger, mux)
 	go func() {
 		if err := s.hs.ListenAndServe(); err != nil {
 			s.logger.Error().Err(err).Msg("failed to start http server")
@@ -281,9 +278,9 @@ func (s *Service) processBitcoinBlocks(ctx context.Context) {
 				}
 				continue
 			}
-			s.logger.Info().Str("block_hash", blockHash).Int64("block_height", blockHeight+1).Msg("retrieved latest block hash")
+			s.blockHashLog.logger(s.catchingUp).Info().Str("block_hash", blockHash).Int64("block_height", blockHeight+1).Msg("retrieved latest block hash")
 
-			if err := s.getBtcBlock(blockHeight); err != nil {
+			if err := s.getBtcBlock(ctx, blockHeight); err != nil {
 				// when there is an error , let's retry it
 				s.logger.Error().Err(err).Msgf("failed to get btc block at height %d", blockHeight)
 				continue
@@ -303,36 +300,54 @@ func (s *Service) getQBTCLatestProcessBTCBlockHeight(ctx context.Context) (uint6
 	return s.qclient.GetLatestBtcBlockHeight(newCtx)
 }
 
-// getBtcBlock retrieves the bitcoin block at the given height
-func (s *Service) getBtcBlock(height int64) error {
+// fetchBtcBlock fetches the verbose bitcoin block at the given height
+func (s *Service) fetchBtcBlock(height int64) (*btcjson.GetBlockVerboseTxResult, error) {
 	blockHash, err := s.btcClient.GetBlockHash(height)
 	if err != nil {
-		return fmt.Errorf("failed to get block hash at height %d: %w", height, err)
+		return nil, fmt.Errorf("failed to get block hash at height %d: %w", height, err)
 	}
 	block, err := s.btcClient.GetBlockVerboseTxs(blockHash)
 	if err != nil {
-		return fmt.Errorf("failed to get block verbose txs at height %d: %w", height, err)
+		return nil, fmt.Errorf("failed to get block verbose txs at height %d: %w", height, err)
+	}
+	return block, nil
+}
+
+// getBtcBlock retrieves the bitcoin block at the given height and publishes it
+func (s *Service) getBtcBlock(ctx context.Context, height int64) error {
+	block, err := s.prefetcher.Get(ctx, height)
+	if err != nil {
+		return err
 	}
 	if block == nil {
 		return nil
 	}
-	s.logger.Info().Int64("block_height", height).Msg("published block gossip")
+	// a prefetched block may have been reorged out since it was fetched
+	if !s.published.extends(block) {
+		s.prefetcher.Invalidate(height)
+		return fmt.Errorf("prefetched block %s at height %d does not extend the last published block %s", block.Hash, height, s.published.hash)
+	}
+	// a block whose publish failed before is signed already
+	if gossip, ok := s.signedGossip.get(height, block.Hash); ok {
+		return s.publishBlockGossip(ctx, gossip)
+	}
+	s.catchingUp = isCatchingUp(block.Confirmations, s.cfg.LogSampleTipDistance)
 	content, err := json.Marshal(block)
 	if err != nil {
 		return fmt.Errorf("failed to marshal block content at height %d: %w", height, err)
 	}
-	compressedContent, err := types.GzipDeterministic(content, gzip.BestCompression)
+	compressedContent, err := types.CompressBlockContent(content, s.blockCodec)
 	if err != nil {
 		return fmt.Errorf("failed to compress block content at height %d: %w", height, err)
 	}
-	sig, err := s.validatorPrivateKey.Sign(compressedContent)
+	sig, err := s.validatorSigner.Sign(compressedContent)
 	if err != nil {
 		return fmt.Errorf("failed to sign block content at height %d: %w", height, err)
 	}
 	// use consensus address to explicitly identify the consensus address of the validator
 	// sdk.ValAddress is reserved for the OperatorAddress
 	// eg: qbtcvalcons1...
-	valAddr := sdk.ConsAddress(s.validatorPrivateKey.PubKey().Address())
+	valAddr := sdk.ConsAddress(s.validatorSigner.PubKey().Address())
 	blockGassip := types.BlockGossip{
 		Hash:         block.Hash,
 		Height:       uint64(block.Height),
@@ -342,10 +357,20 @@ func (s *Service) getBtcBlock(height int64) error {
 			Signature: sig,
 		},
 	}
-	err = s.pubsub.Publish(blockGassip)
-	if err != nil {
-		return fmt.Errorf("failed to publish block gossip at height %d: %w", height, err)
-	}
+	s.signedGossip.set(blockGassip)
+	return s.publishBlockGossip(ctx, blockGassip)
+}
+
+// publishBlockGossip publishes a signed block, retrying on failure. The
+// gossip stays cached until it is published, so if every attempt fails the
+// next call for the same block publishes it without signing it again.
+func (s *Service) publishBlockGossip(ctx context.Context, gossip types.BlockGossip) error {
+	if err := publishWithRetry(ctx, s.pubsub.Publish, gossip, publishAttempts, publishRetryDelay); err != nil {
+		return fmt.Errorf("failed to publish block gossip at height %d: %w", gossip.Height, err)
+	}
+	s.signedGossip.clear()
+	s.published.set(gossip)
+	s.publishLog.logger(s.catchingUp).Info().Uint64("block_height", gossip.Height).Msg("published block gossip")
 	s.metrics.IncrCounter(metrics.MetricNameProcessedBlocks)
 	return nil
 }
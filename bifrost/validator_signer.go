@@ -0,0 +1,210 @@
+package bifrost
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/btcq-org/qbtc/bifrost/config"
+	"github.com/cometbft/cometbft/crypto"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	"github.com/cometbft/cometbft/privval"
+)
+
+// ValidatorSigner signs block attestations with the validator's consensus key.
+// Implementations may keep the key out of the bifrost process, e.g. in a
+// remote signer backed by an HSM.
+type ValidatorSigner interface {
+	// PubKey returns the consensus public key attestations are made with.
+	PubKey() crypto.PubKey
+	// Sign signs msg with the consensus key.
+	Sign(msg []byte) ([]byte, error)
+}
+
+// newValidatorSigner builds the signer selected by cfg.ValidatorSigner.
+func newValidatorSigner(cfg config.Config) (ValidatorSigner, error) {
+	switch strings.ToLower(cfg.ValidatorSigner.Type) {
+	case "", config.ValidatorSignerFile:
+		return newFileValidatorSigner(cfg.QBTCHome)
+	case config.ValidatorSignerRemote:
+		return newRemoteValidatorSigner(cfg.ValidatorSigner)
+	default:
+		return nil, fmt.Errorf("unknown validator signer type %q (expected %s or %s)", cfg.ValidatorSigner.Type, config.ValidatorSignerFile, config.ValidatorSignerRemote)
+	}
+}
+
+// fileValidatorSigner signs with the plaintext key in priv_validator_key.json.
+type fileValidatorSigner struct {
+	privKey crypto.PrivKey
+}
+
+func newFileValidatorSigner(qbtcHome string) (*fileValidatorSigner, error) {
+	privKey, err := getValidatorKey(qbtcHome)
+	if err != nil {
+		return nil, err
+	}
+	return &fileValidatorSigner{privKey: privKey}, nil
+}
+
+func (s *fileValidatorSigner) PubKey() crypto.PubKey {
+	return s.privKey.PubKey()
+}
+
+func (s *fileValidatorSigner) Sign(msg []byte) ([]byte, error) {
+	return s.privKey.Sign(msg)
+}
+
+func getValidatorKey(qbtcHome string) (crypto.PrivKey, error) {
+	homeFolder := qbtcHome
+	if homeFolder == "" {
+		homeDir, err := os.UserHomeDir()
+		if err != nil {
+			return nil, fmt.Errorf("fail to get validator key,err: %w", err)
+		}
+		homeFolder = filepath.Join(homeDir, ".qbtc")
+	}
+	validatorKeyPath := filepath.Join(homeFolder, "config", "priv_validator_key.json")
+	_, err := os.Stat(validatorKeyPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("validator key file does not exist at path: %s", validatorKeyPath)
+		}
+		return nil, fmt.Errorf("error checking validator key file: %w", err)
+	}
+	fileContent, err := os.ReadFile(validatorKeyPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read validator key file: %w", err)
+	}
+	pvKey := privval.FilePVKey{}
+	err = cmtjson.Unmarshal(fileContent, &pvKey)
+	if err != nil {
+		return nil, fmt.Errorf("error reading PrivValidator key from %v: %w", validatorKeyPath, err)
+	}
+	return pvKey.PrivKey, nil
+}
+
+// remoteValidatorSigner asks a signing service over HTTP for signatures. The
+// service exposes two endpoints:
+//
+//	GET  /pubkey -> {"pub_key": <public key in CometBFT JSON, as in priv_validator_key.json>}
+//	POST /sign   {"message": "<hex>"} -> {"signature": "<hex>"}
+//
+// CometBFT's privval protocol only signs votes and proposals, so a KMS such as
+// tmkms or an HSM is put behind a service speaking this protocol instead.
+type remoteValidatorSigner struct {
+	address   string
+	authToken string
+	client    *http.Client
+	pubKey    crypto.PubKey
+}
+
+type remotePubKeyResponse struct {
+	PubKey json.RawMessage `json:"pub_key"`
+}
+
+type remoteSignRequest struct {
+	Message string `json:"message"`
+}
+
+type remoteSignResponse struct {
+	Signature string `json:"signature"`
+}
+
+// newRemoteValidatorSigner connects to the signing service and fetches the
+// public key once, so a misconfigured signer fails at startup.
+func newRemoteValidatorSigner(cfg config.ValidatorSignerConfig) (*remoteValidatorSigner, error) {
+	if cfg.RemoteAddress == "" {
+		return nil, fmt.Errorf("validator_signer.remote_address is required for the %s signer", config.ValidatorSignerRemote)
+	}
+	timeout := time.Duration(cfg.RemoteTimeoutSeconds) * time.Second
+	if timeout <= 0 {
+		timeout = config.DefaultRemoteSignerTimeoutSeconds * time.Second
+	}
+	s := &remoteValidatorSigner{
+		address:   strings.TrimSuffix(cfg.RemoteAddress, "/"),
+		authToken: cfg.RemoteAuthToken,
+		client:    &http.Client{Timeout: timeout},
+	}
+
+	body, err := s.do(http.MethodGet, "/pubkey", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get validator public key from remote signer: %w", err)
+	}
+	var resp remotePubKeyResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("failed to parse remote signer public key response: %w", err)
+	}
+	if err := cmtjson.Unmarshal(resp.PubKey, &s.pubKey); err != nil {
+		return nil, fmt.Errorf("failed to decode remote signer public key: %w", err)
+	}
+	if s.pubKey == nil {
+		return nil, fmt.Errorf("remote signer returned no public key")
+	}
+	return s, nil
+}
+
+func (s *remoteValidatorSigner) PubKey() crypto.PubKey {
+	return s.pubKey
+}
+
+// Sign requests a signature and checks it against the public key, so a signer
+// using another key is caught here rather than by the chain dropping the
+// attestation.
+func (s *remoteValidatorSigner) Sign(msg []byte) ([]byte, error) {
+	reqBody, err := json.Marshal(remoteSignRequest{Message: hex.EncodeToString(msg)})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal sign request: %w", err)
+	}
+	body, err := s.do(http.MethodPost, "/sign", reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("remote signer failed to sign: %w", err)
+	}
+	var resp remoteSignResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("failed to parse remote signer response: %w", err)
+	}
+	sig, err := hex.DecodeString(resp.Signature)
+	if err != nil {
+		return nil, fmt.Errorf("invalid signature from remote signer: %w", err)
+	}
+	if !s.pubKey.VerifySignature(msg, sig) {
+		return nil, fmt.Errorf("remote signer returned a signature that does not verify against its public key")
+	}
+	return sig, nil
+}
+
+// do sends a request to the signing service and returns the response body of
+// a 200 response.
+func (s *remoteValidatorSigner) do(method, path string, body []byte) ([]byte, error) {
+	req, err := http.NewRequest(method, s.address+path, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to build request: %w", err)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if s.authToken != "" {
+		req.Header.Set("Authorization", "Bearer "+s.authToken)
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("HTTP request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("remote signer returned %s: %s", resp.Status, strings.TrimSpace(string(respBody)))
+	}
+	return respBody, nil
+}
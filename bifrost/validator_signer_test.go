@@ -0,0 +1,114 @@
+package bifrost
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/btcq-org/qbtc/bifrost/config"
+	"github.com/cometbft/cometbft/crypto"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	"github.com/cometbft/cometbft/privval"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestRemoteSigner serves the remote signer protocol for key. If wrongKey
+// is set, signatures are made with it instead.
+func newTestRemoteSigner(t *testing.T, key, wrongKey crypto.PrivKey) *httptest.Server {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.HandleFunc("/pubkey", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		pubKey, err := cmtjson.Marshal(key.PubKey())
+		require.NoError(t, err)
+		require.NoError(t, json.NewEncoder(w).Encode(remotePubKeyResponse{PubKey: pubKey}))
+	})
+	mux.HandleFunc("/sign", func(w http.ResponseWriter, r *http.Request) {
+		var req remoteSignRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		msg, err := hex.DecodeString(req.Message)
+		require.NoError(t, err)
+		signer := key
+		if wrongKey != nil {
+			signer = wrongKey
+		}
+		sig, err := signer.Sign(msg)
+		require.NoError(t, err)
+		require.NoError(t, json.NewEncoder(w).Encode(remoteSignResponse{Signature: hex.EncodeToString(sig)}))
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestFileValidatorSigner(t *testing.T) {
+	home := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(home, "config"), 0o755))
+	key := ed25519.GenPrivKey()
+	pvKey := privval.FilePVKey{Address: key.PubKey().Address(), PubKey: key.PubKey(), PrivKey: key}
+	content, err := cmtjson.Marshal(pvKey)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(home, "config", "priv_validator_key.json"), content, 0o600))
+
+	signer, err := newValidatorSigner(config.Config{QBTCHome: home})
+	require.NoError(t, err)
+	require.Equal(t, key.PubKey(), signer.PubKey())
+	sig, err := signer.Sign([]byte("block"))
+	require.NoError(t, err)
+	require.True(t, key.PubKey().VerifySignature([]byte("block"), sig))
+
+	_, err = newValidatorSigner(config.Config{QBTCHome: t.TempDir()})
+	require.ErrorContains(t, err, "validator key file does not exist")
+}
+
+func TestRemoteValidatorSigner(t *testing.T) {
+	key := ed25519.GenPrivKey()
+	server := newTestRemoteSigner(t, key, nil)
+
+	cfg := config.Config{ValidatorSigner: config.ValidatorSignerConfig{
+		Type:            config.ValidatorSignerRemote,
+		RemoteAddress:   server.URL + "/",
+		RemoteAuthToken: "secret",
+	}}
+	signer, err := newValidatorSigner(cfg)
+	require.NoError(t, err)
+	require.Equal(t, key.PubKey(), signer.PubKey())
+
+	sig, err := signer.Sign([]byte("block"))
+	require.NoError(t, err)
+	require.True(t, key.PubKey().VerifySignature([]byte("block"), sig))
+
+	// the service rejects requests without the token
+	cfg.ValidatorSigner.RemoteAuthToken = ""
+	_, err = newValidatorSigner(cfg)
+	require.ErrorContains(t, err, "401")
+}
+
+func TestRemoteValidatorSigner_WrongKey(t *testing.T) {
+	server := newTestRemoteSigner(t, ed25519.GenPrivKey(), ed25519.GenPrivKey())
+	signer, err := newValidatorSigner(config.Config{ValidatorSigner: config.ValidatorSignerConfig{
+		Type:            config.ValidatorSignerRemote,
+		RemoteAddress:   server.URL,
+		RemoteAuthToken: "secret",
+	}})
+	require.NoError(t, err)
+
+	_, err = signer.Sign([]byte("block"))
+	require.ErrorContains(t, err, "does not verify")
+}
+
+func TestNewValidatorSigner_Invalid(t *testing.T) {
+	_, err := newValidatorSigner(config.Config{ValidatorSigner: config.ValidatorSignerConfig{Type: "hsm"}})
+	require.ErrorContains(t, err, "unknown validator signer type")
+
+	_, err = newValidatorSigner(config.Config{ValidatorSigner: config.ValidatorSignerConfig{Type: config.ValidatorSignerRemote}})
+	require.ErrorContains(t, err, "remote_address is required")
+}
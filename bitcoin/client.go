@@ -25,7 +25,7 @@ type BtcClient struct {
 }
 
 func NewBtcClient(cfg Config, db *leveldb.DB) (*BtcClient, error) {
-	client, err := newClient(cfg.Host, cfg.Port, cfg.RPCUser, cfg.Password)
+	client, err := newClient(cfg.Host, cfg.Port, cfg.RPCUser, cfg.Password, cfg.Connections())
 	if err != nil {
 		return nil, fmt.Errorf("failed to create rpc client: %w", err)
 	}
@@ -37,8 +37,9 @@ func NewBtcClient(cfg Config, db *leveldb.DB) (*BtcClient, error) {
 	}, nil
 }
 
-// newClient returns a client connection to a UTXO daemon.
-func newClient(host string, port int64, user, password string) (*rpc.Client, error) {
+// newClient returns a client connection to a UTXO daemon. The underlying
+// HTTP transport keeps at most maxConns connections to the daemon.
+func newClient(host string, port int64, user, password string, maxConns int) (*rpc.Client, error) {
 	authFn := func(h http.Header) error {
 		auth := base64.StdEncoding.EncodeToString([]byte(user + ":" + password))
 		h.Set("Authorization", fmt.Sprintf("Basic %s", auth))
@@ -52,7 +53,11 @@ func newClient(host string, port int64, user, password string) (*rpc.Client, err
 	if port != 80 && port != 443 {
 		host = fmt.Sprintf("%s:%d", host, port)
 	}
-	c, err := rpc.DialOptions(context.Background(), host, rpc.WithHTTPAuth(authFn))
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.MaxConnsPerHost = maxConns
+	transport.MaxIdleConnsPerHost = maxConns
+	httpClient := &http.Client{Transport: transport}
+	c, err := rpc.DialOptions(context.Background(), host, rpc.WithHTTPAuth(authFn), rpc.WithHTTPClient(httpClient))
 	if err != nil {
 		return nil, err
 	}
@@ -60,7 +65,7 @@ func newClient(host string, port int64, user, password string) (*rpc.Client, err
 	return c, nil
 }
 func (c *BtcClient) GetStartBlockHeight() (int64, error) {
-	value, err := c.db.Get([]byte("start_block_height"), nil)
+	value, err := c.db.Get([]byte(startBlockHeightKey), nil)
 	if err != nil {
 		if errors.Is(err, leveldb.ErrNotFound) {
 			return 0, nil
@@ -78,7 +83,7 @@ func (c *BtcClient) GetStartBlockHeight() (int64, error) {
 // It stores the value as a decimal string (e.g. "12345").
 func (c *BtcClient) SetStartBlockHeight(height int64) error {
 	b := []byte(fmt.Sprintf("%d", height))
-	if err := c.db.Put([]byte("start_block_height"), b, nil); err != nil {
+	if err := c.db.Put([]byte(startBlockHeightKey), b, nil); err != nil {
 		return fmt.Errorf("failed to set start block height: %w", err)
 	}
 	return nil
@@ -95,17 +100,28 @@ func (c *BtcClient) ShouldBackoff(err error) bool {
 // GetBlockVerboseTxs returns information about the block with verbosity 2.
 func (c *BtcClient) GetBlockVerboseTxs(hash string) (*btcjson.GetBlockVerboseTxResult, error) {
 	var block btcjson.GetBlockVerboseTxResult
-	err := c.client.Call(&block, "getblock", hash, 2)
+	err := c.call(&block, "getblock", hash, 2)
 	return &block, extractBTCError(err)
 }
 
 // GetBlockHash returns the hash of the block in best-block-chain at the given height.
 func (c *BtcClient) GetBlockHash(height int64) (string, error) {
 	var hash string
-	err := c.client.Call(&hash, "getblockhash", height)
+	err := c.call(&hash, "getblockhash", height)
 	return hash, extractBTCError(err)
 }
 
+// call performs an RPC call bounded by the configured timeout.
+func (c *BtcClient) call(result any, method string, args ...any) error {
+	ctx, cancel := context.WithTimeout(context.Background(), c.cfg.RPCTimeout())
+	defer cancel()
+	err := c.client.CallContext(ctx, result, method, args...)
+	if errors.Is(err, context.DeadlineExceeded) {
+		return fmt.Errorf("%s timed out after %s: %w", method, c.cfg.RPCTimeout(), err)
+	}
+	return err
+}
+
 func (c *BtcClient) Close() error {
 	if c.client != nil {
 		c.client.Close()
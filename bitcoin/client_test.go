@@ -0,0 +1,68 @@
+package bitcoin
+
+import (
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// newTestBtcClient returns a client for a JSON-RPC server that answers
+// getblockhash with hash after the given delay.
+func newTestBtcClient(t *testing.T, delay time.Duration, hash string, timeoutSeconds int64) *BtcClient {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID json.RawMessage `json:"id"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		select {
+		case <-time.After(delay):
+		case <-r.Context().Done():
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"jsonrpc": "2.0", "id": req.ID, "result": hash})
+	}))
+	t.Cleanup(server.Close)
+
+	host, port, err := net.SplitHostPort(server.Listener.Addr().String())
+	require.NoError(t, err)
+	portNum, err := strconv.ParseInt(port, 10, 64)
+	require.NoError(t, err)
+	client, err := NewBtcClient(Config{Host: host, Port: portNum, RPCTimeoutSeconds: timeoutSeconds}, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = client.Close() })
+	return client
+}
+
+func TestBtcClient_RPCTimeout(t *testing.T) {
+	client := newTestBtcClient(t, 0, "00aa", 1)
+	hash, err := client.GetBlockHash(1)
+	require.NoError(t, err)
+	require.Equal(t, "00aa", hash)
+
+	slow := newTestBtcClient(t, 10*time.Second, "00aa", 1)
+	start := time.Now()
+	_, err = slow.GetBlockHash(1)
+	require.ErrorContains(t, err, "getblockhash timed out after 1s")
+	require.Less(t, time.Since(start), 5*time.Second)
+}
+
+func TestConfigDefaults(t *testing.T) {
+	var cfg Config
+	require.Equal(t, DefaultRPCTimeoutSeconds*time.Second, cfg.RPCTimeout())
+	require.Equal(t, DefaultMaxConnections, cfg.Connections())
+
+	cfg = Config{RPCTimeoutSeconds: 5, MaxConnections: 2}
+	require.Equal(t, 5*time.Second, cfg.RPCTimeout())
+	require.Equal(t, 2, cfg.Connections())
+}
@@ -2,10 +2,20 @@ package bitcoin
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/spf13/viper"
 )
 
+const (
+	// DefaultRPCTimeoutSeconds bounds an RPC call when no timeout is configured.
+	// getblock with verbosity 2 on a full block can take several seconds.
+	DefaultRPCTimeoutSeconds = 30
+	// DefaultMaxConnections matches the default prefetch depth of bifrost, so
+	// every prefetched block can be fetched on its own connection.
+	DefaultMaxConnections = 8
+)
+
 func GetConfig() (*Config, error) {
 	viper.SetConfigName("config")
 	viper.AddConfigPath(".")
@@ -30,4 +40,27 @@ type Config struct {
 	RPCUser     string `mapstructure:"rpc_user" json:"rpc_user"`
 	Password    string `mapstructure:"password" json:"password"`
 	LocalDBPath string `mapstructure:"local_db_path" json:"local_db_path"`
+	// RPCTimeoutSeconds bounds every RPC call to the node, so a hung call
+	// fails and is retried instead of stalling the block loop. 0 uses
+	// DefaultRPCTimeoutSeconds.
+	RPCTimeoutSeconds int64 `mapstructure:"rpc_timeout_seconds" json:"rpc_timeout_seconds"`
+	// MaxConnections caps the HTTP connections open to the node at once.
+	// Calls beyond it wait for a free connection. 0 uses DefaultMaxConnections.
+	MaxConnections int `mapstructure:"max_connections" json:"max_connections"`
+}
+
+// RPCTimeout returns the timeout of a single RPC call.
+func (c Config) RPCTimeout() time.Duration {
+	if c.RPCTimeoutSeconds <= 0 {
+		return DefaultRPCTimeoutSeconds * time.Second
+	}
+	return time.Duration(c.RPCTimeoutSeconds) * time.Second
+}
+
+// Connections returns the size of the RPC connection pool.
+func (c Config) Connections() int {
+	if c.MaxConnections <= 0 {
+		return DefaultMaxConnections
+	}
+	return c.MaxConnections
 }
@@ -2,6 +2,7 @@ package bitcoin
 
 import (
 	"fmt"
+	"regexp"
 
 	"github.com/rs/zerolog/log"
 	"github.com/syndtr/goleveldb/leveldb"
@@ -39,3 +40,91 @@ func NewLevelDB(path string, compactOnInit bool) (*leveldb.DB, error) {
 
 	return db, nil
 }
+
+// startBlockHeightKey stores the indexer checkpoint, the next block height to index.
+const startBlockHeightKey = "start_block_height"
+
+// utxoKeyPattern matches UTXO keys, "<txid>-<vout>".
+var utxoKeyPattern = regexp.MustCompile(`^[0-9a-f]{64}-[0-9]+$`)
+
+// DBKeyStats summarizes the entries of one key class in the indexer database.
+type DBKeyStats struct {
+	Name       string
+	Keys       int64
+	KeyBytes   int64
+	ValueBytes int64
+	// DiskBytes is LevelDB's approximate on-disk size of the key range. It is
+	// zero for classes that do not map to a contiguous key range.
+	DiskBytes int64
+}
+
+// dbKeyClass is a class of keys stored by the indexer.
+type dbKeyClass struct {
+	name  string
+	match func(key []byte) bool
+	// keyRange bounds the keys of the class, if they are contiguous
+	keyRange *util.Range
+}
+
+var dbKeyClasses = []dbKeyClass{
+	{
+		name:  "utxo",
+		match: utxoKeyPattern.Match,
+		// txids are lowercase hex
+		keyRange: &util.Range{Start: []byte("0"), Limit: []byte("g")},
+	},
+	{
+		name:     "checkpoint",
+		match:    func(key []byte) bool { return string(key) == startBlockHeightKey },
+		keyRange: &util.Range{Start: []byte(startBlockHeightKey), Limit: []byte(startBlockHeightKey + "\x00")},
+	},
+	{
+		name:  "other",
+		match: func([]byte) bool { return true },
+	},
+}
+
+// CollectDBStats walks the whole database and returns key counts and sizes
+// per key class, in the order utxo, checkpoint, other.
+func CollectDBStats(db *leveldb.DB) ([]DBKeyStats, error) {
+	stats := make([]DBKeyStats, len(dbKeyClasses))
+	for i, class := range dbKeyClasses {
+		stats[i].Name = class.name
+	}
+
+	it := db.NewIterator(nil, nil)
+	defer it.Release()
+	for it.First(); it.Valid(); it.Next() {
+		for i, class := range dbKeyClasses {
+			if class.match(it.Key()) {
+				stats[i].Keys++
+				stats[i].KeyBytes += int64(len(it.Key()))
+				stats[i].ValueBytes += int64(len(it.Value()))
+				break
+			}
+		}
+	}
+	if err := it.Error(); err != nil {
+		return nil, fmt.Errorf("iterator error while collecting db stats: %w", err)
+	}
+
+	for i, class := range dbKeyClasses {
+		if class.keyRange == nil {
+			continue
+		}
+		sizes, err := db.SizeOf([]util.Range{*class.keyRange})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get size of %s keys: %w", class.name, err)
+		}
+		stats[i].DiskBytes = sizes.Sum()
+	}
+	return stats, nil
+}
+
+// CompactDB compacts the whole key space of the database.
+func CompactDB(db *leveldb.DB) error {
+	if err := db.CompactRange(util.Range{}); err != nil {
+		return fmt.Errorf("failed to compact level db: %w", err)
+	}
+	return nil
+}
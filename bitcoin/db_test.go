@@ -0,0 +1,39 @@
+package bitcoin
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCollectDBStats(t *testing.T) {
+	db, err := NewLevelDB("", false)
+	assert.NoError(t, err)
+	defer db.Close()
+
+	for i := 0; i < 3; i++ {
+		key := fmt.Sprintf("%064x-%d", i, i)
+		assert.NoError(t, db.Put([]byte(key), []byte("vout"), nil))
+	}
+	assert.NoError(t, db.Put([]byte(startBlockHeightKey), []byte("840000"), nil))
+	assert.NoError(t, db.Put([]byte("legacy"), []byte("x"), nil))
+
+	assert.NoError(t, CompactDB(db))
+
+	stats, err := CollectDBStats(db)
+	assert.NoError(t, err)
+	assert.Len(t, stats, 3)
+
+	assert.Equal(t, "utxo", stats[0].Name)
+	assert.Equal(t, int64(3), stats[0].Keys)
+	assert.Equal(t, int64(3*66), stats[0].KeyBytes)
+	assert.Equal(t, int64(3*4), stats[0].ValueBytes)
+
+	assert.Equal(t, "checkpoint", stats[1].Name)
+	assert.Equal(t, int64(1), stats[1].Keys)
+	assert.Equal(t, int64(6), stats[1].ValueBytes)
+
+	assert.Equal(t, "other", stats[2].Name)
+	assert.Equal(t, int64(1), stats[2].Keys)
+}
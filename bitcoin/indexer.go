@@ -197,12 +197,17 @@ func (i *Indexer) ExportUTXO(outPath string) error {
 			i.logger.Error().Err(err).Msg("failed to unmarshal vout during export")
 			continue
 		}
+		amount, err := qbtctypes.FromBTCFloat(vOut.Value)
+		if err != nil {
+			i.logger.Error().Err(err).Str("key", string(k)).Msg("invalid vout amount during export")
+			continue
+		}
 		fields := strings.Split(string(k), "-")
 		pVout := qbtctypes.UTXO{
 			Txid:           fields[0],
 			Vout:           vOut.N,
-			Amount:         uint64(vOut.Value * 1e8), // convert to satoshis
-			EntitledAmount: uint64(vOut.Value * 1e8),
+			Amount:         amount.Uint64(),
+			EntitledAmount: amount.Uint64(),
 			ScriptPubKey: &qbtctypes.ScriptPubKeyResult{
 				Hex:     vOut.ScriptPubKey.Hex,
 				Type:    vOut.ScriptPubKey.Type,
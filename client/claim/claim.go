@@ -0,0 +1,192 @@
+// Package claim builds the unsigned transaction a wallet signs to submit a
+// MsgClaimWithProof. It is the reference for what the chain expects: a single
+// claim message, one signer using SIGN_MODE_DIRECT, and the SignDoc bytes that
+// key (typically an MLDSA key held by an MPC signer) has to sign.
+package claim
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+
+	"github.com/btcq-org/qbtc/x/qbtc/types"
+)
+
+// Params holds everything needed to build an unsigned claim transaction.
+type Params struct {
+	// Claimer is the bech32 address signing the transaction. It must be the
+	// address of PubKey. It receives the claimed tokens unless Destination is set.
+	Claimer string
+	// Destination optionally names the address receiving the claimed tokens,
+	// so a relayer can submit a proof bound to someone else's address.
+	Destination string
+	// Utxos are the Bitcoin outputs to claim.
+	Utxos []types.UTXORef
+	// Proof is the raw PLONK proof (zk.Proof.ProofData).
+	Proof []byte
+	// MessageHash is the claim message the proof was generated for.
+	MessageHash [32]byte
+	// AddressHash is the Hash160 of the Bitcoin address being claimed.
+	AddressHash [20]byte
+	// MessageVersion is the claim message version; empty selects the default.
+	MessageVersion string
+	// DestinationSalt is the salt of the destination commitment, for message
+	// versions that bind a salted destination (zk.ClaimMessageVersionV6).
+	DestinationSalt []byte
+	// ExpiryHeight is the last block height the claim is valid at, for
+	// message versions that bind an expiry (zk.ClaimMessageVersionV5).
+	ExpiryHeight uint64
+	// SignatureScheme is how the claim message was signed (zk.SignatureSchemeRaw
+	// or zk.SignatureSchemeBIP137); empty selects raw.
+	SignatureScheme string
+	// VKFingerprint optionally names the verifying key the proof was made
+	// with (zk.Proof.VKFingerprint), so the chain rejects a stale proof
+	// with a clear error.
+	VKFingerprint []byte
+
+	// PubKey is the public key of the claimer account.
+	PubKey cryptotypes.PubKey
+	// ChainID, AccountNumber and Sequence identify the signer's account state.
+	ChainID       string
+	AccountNumber uint64
+	Sequence      uint64
+
+	// Fee and GasLimit are the fee parameters of the transaction.
+	Fee      sdk.Coins
+	GasLimit uint64
+	// Memo is an optional transaction memo.
+	Memo string
+}
+
+// UnsignedTx is a claim transaction ready to be signed.
+type UnsignedTx struct {
+	Msg     *types.MsgClaimWithProof
+	SignDoc *sdktx.SignDoc
+}
+
+// NewMsgClaimWithProof assembles the claim message from the proof and its public inputs.
+func NewMsgClaimWithProof(p Params) (*types.MsgClaimWithProof, error) {
+	msg := &types.MsgClaimWithProof{
+		Claimer:         p.Claimer,
+		Destination:     p.Destination,
+		Utxos:           p.Utxos,
+		Proof:           hex.EncodeToString(p.Proof),
+		MessageHash:     hex.EncodeToString(p.MessageHash[:]),
+		AddressHash:     hex.EncodeToString(p.AddressHash[:]),
+		MessageVersion:  p.MessageVersion,
+		ExpiryHeight:    p.ExpiryHeight,
+		DestinationSalt: hex.EncodeToString(p.DestinationSalt),
+		SignatureScheme: p.SignatureScheme,
+		VkFingerprint:   hex.EncodeToString(p.VKFingerprint),
+	}
+	// the proof is bound to the address receiving the tokens
+	binding, err := msg.RecipientBinding()
+	if err != nil {
+		return nil, err
+	}
+	msg.QbtcAddressHash = hex.EncodeToString(binding[:])
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// BuildUnsignedTx builds the claim message and the SIGN_MODE_DIRECT SignDoc for it.
+func BuildUnsignedTx(p Params) (*UnsignedTx, error) {
+	if p.PubKey == nil {
+		return nil, fmt.Errorf("public key is required")
+	}
+	if p.ChainID == "" {
+		return nil, fmt.Errorf("chain id is required")
+	}
+	claimer, err := sdk.AccAddressFromBech32(p.Claimer)
+	if err != nil {
+		return nil, fmt.Errorf("invalid claimer address: %w", err)
+	}
+	if !claimer.Equals(sdk.AccAddress(p.PubKey.Address())) {
+		return nil, fmt.Errorf("public key does not belong to claimer %s", p.Claimer)
+	}
+	if !p.Fee.IsValid() {
+		return nil, fmt.Errorf("invalid fee: %s", p.Fee)
+	}
+
+	msg, err := NewMsgClaimWithProof(p)
+	if err != nil {
+		return nil, err
+	}
+
+	msgAny, err := codectypes.NewAnyWithValue(msg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack claim message: %w", err)
+	}
+	body := &sdktx.TxBody{
+		Messages: []*codectypes.Any{msgAny},
+		Memo:     p.Memo,
+	}
+	bodyBytes, err := body.Marshal()
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal tx body: %w", err)
+	}
+
+	pubKeyAny, err := codectypes.NewAnyWithValue(p.PubKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack public key: %w", err)
+	}
+	authInfo := &sdktx.AuthInfo{
+		SignerInfos: []*sdktx.SignerInfo{{
+			PublicKey: pubKeyAny,
+			ModeInfo: &sdktx.ModeInfo{
+				Sum: &sdktx.ModeInfo_Single_{
+					Single: &sdktx.ModeInfo_Single{Mode: signingtypes.SignMode_SIGN_MODE_DIRECT},
+				},
+			},
+			Sequence: p.Sequence,
+		}},
+		Fee: &sdktx.Fee{
+			Amount:   p.Fee,
+			GasLimit: p.GasLimit,
+		},
+	}
+	authInfoBytes, err := authInfo.Marshal()
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal auth info: %w", err)
+	}
+
+	return &UnsignedTx{
+		Msg: msg,
+		SignDoc: &sdktx.SignDoc{
+			BodyBytes:     bodyBytes,
+			AuthInfoBytes: authInfoBytes,
+			ChainId:       p.ChainID,
+			AccountNumber: p.AccountNumber,
+		},
+	}, nil
+}
+
+// SignBytes returns the bytes the claimer's key has to sign.
+func (u *UnsignedTx) SignBytes() ([]byte, error) {
+	return u.SignDoc.Marshal()
+}
+
+// TxRaw returns the transaction with the given signature attached. Passing a
+// nil signature yields the unsigned TxRaw, e.g. for simulation.
+func (u *UnsignedTx) TxRaw(signature []byte) *sdktx.TxRaw {
+	return &sdktx.TxRaw{
+		BodyBytes:     u.SignDoc.BodyBytes,
+		AuthInfoBytes: u.SignDoc.AuthInfoBytes,
+		Signatures:    [][]byte{signature},
+	}
+}
+
+// SignedTxBytes returns the broadcastable encoding of the transaction carrying signature.
+func (u *UnsignedTx) SignedTxBytes(signature []byte) ([]byte, error) {
+	if len(signature) == 0 {
+		return nil, fmt.Errorf("signature is required")
+	}
+	return u.TxRaw(signature).Marshal()
+}
@@ -0,0 +1,138 @@
+package claim_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/btcq-org/qbtc/client/claim"
+	"github.com/btcq-org/qbtc/common"
+	qbtctestutil "github.com/btcq-org/qbtc/x/qbtc/testutil"
+	"github.com/btcq-org/qbtc/x/qbtc/types"
+	"github.com/btcq-org/qbtc/x/qbtc/zk"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/stretchr/testify/require"
+)
+
+func testParams(t *testing.T) claim.Params {
+	t.Helper()
+	sdk.GetConfig().SetBech32PrefixForAccount(common.AccountAddressPrefix, common.AccountAddressPrefix+sdk.PrefixPublic)
+
+	pubKey := qbtctestutil.GetRandomMLDsaPublicKey()
+	return claim.Params{
+		Claimer: sdk.AccAddress(pubKey.Address()).String(),
+		Utxos: []types.UTXORef{
+			{Txid: "aaaa000000000000000000000000000000000000000000000000000000000001", Vout: 0},
+		},
+		Proof:         bytes.Repeat([]byte{0xab}, types.MinProofSize),
+		MessageHash:   [32]byte{1, 2, 3},
+		AddressHash:   [20]byte{4, 5, 6},
+		PubKey:        pubKey,
+		ChainID:       "qbtc-test-1",
+		AccountNumber: 7,
+		Sequence:      3,
+		Fee:           sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(1000))),
+		GasLimit:      200000,
+		Memo:          "claim",
+	}
+}
+
+func TestBuildUnsignedTx_StableSignBytes(t *testing.T) {
+	params := testParams(t)
+
+	first, err := claim.BuildUnsignedTx(params)
+	require.NoError(t, err)
+	second, err := claim.BuildUnsignedTx(params)
+	require.NoError(t, err)
+
+	firstBytes, err := first.SignBytes()
+	require.NoError(t, err)
+	secondBytes, err := second.SignBytes()
+	require.NoError(t, err)
+	require.Equal(t, firstBytes, secondBytes, "sign bytes must be deterministic")
+
+	// the sign bytes decode back into the expected transaction
+	var signDoc sdktx.SignDoc
+	require.NoError(t, signDoc.Unmarshal(firstBytes))
+	require.Equal(t, params.ChainID, signDoc.ChainId)
+	require.Equal(t, params.AccountNumber, signDoc.AccountNumber)
+
+	var body sdktx.TxBody
+	require.NoError(t, body.Unmarshal(signDoc.BodyBytes))
+	require.Len(t, body.Messages, 1)
+	require.Equal(t, sdk.MsgTypeURL(&types.MsgClaimWithProof{}), body.Messages[0].TypeUrl)
+	require.Equal(t, params.Memo, body.Memo)
+
+	var msg types.MsgClaimWithProof
+	require.NoError(t, msg.Unmarshal(body.Messages[0].Value))
+	require.Equal(t, *first.Msg, msg)
+
+	var authInfo sdktx.AuthInfo
+	require.NoError(t, authInfo.Unmarshal(signDoc.AuthInfoBytes))
+	require.Len(t, authInfo.SignerInfos, 1)
+	require.Equal(t, params.Sequence, authInfo.SignerInfos[0].Sequence)
+	require.Equal(t, params.GasLimit, authInfo.Fee.GasLimit)
+	require.True(t, params.Fee.Equal(authInfo.Fee.Amount))
+
+	// attaching a signature keeps body and auth info untouched
+	txBytes, err := first.SignedTxBytes([]byte{0x01, 0x02})
+	require.NoError(t, err)
+	var txRaw sdktx.TxRaw
+	require.NoError(t, txRaw.Unmarshal(txBytes))
+	require.Equal(t, signDoc.BodyBytes, txRaw.BodyBytes)
+	require.Equal(t, signDoc.AuthInfoBytes, txRaw.AuthInfoBytes)
+	require.Equal(t, [][]byte{{0x01, 0x02}}, txRaw.Signatures)
+}
+
+func TestNewMsgClaimWithProof_Destination(t *testing.T) {
+	params := testParams(t)
+
+	msg, err := claim.NewMsgClaimWithProof(params)
+	require.NoError(t, err)
+	claimerHash := zk.HashBTCQAddress(params.Claimer)
+	require.Equal(t, hex.EncodeToString(claimerHash[:]), msg.QbtcAddressHash)
+
+	// a relayed claim is bound to the destination, not the signer
+	params.Destination = qbtctestutil.GetRandomBTCQAddress()
+	msg, err = claim.NewMsgClaimWithProof(params)
+	require.NoError(t, err)
+	require.Equal(t, params.Claimer, msg.Claimer)
+	require.Equal(t, params.Destination, msg.Recipient())
+	destinationHash := zk.HashBTCQAddress(params.Destination)
+	require.Equal(t, hex.EncodeToString(destinationHash[:]), msg.QbtcAddressHash)
+
+	// a salted destination is bound through its commitment
+	salt := [zk.DestinationSaltSize]byte{7}
+	params.MessageVersion = zk.ClaimMessageVersionV6
+	params.DestinationSalt = salt[:]
+	msg, err = claim.NewMsgClaimWithProof(params)
+	require.NoError(t, err)
+	commitment := zk.CommitBTCQAddress(params.Destination, salt)
+	require.Equal(t, hex.EncodeToString(commitment[:]), msg.QbtcAddressHash)
+	require.Equal(t, hex.EncodeToString(salt[:]), msg.DestinationSalt)
+}
+
+func TestBuildUnsignedTx_Invalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		mutate func(p *claim.Params)
+	}{
+		{"missing pubkey", func(p *claim.Params) { p.PubKey = nil }},
+		{"missing chain id", func(p *claim.Params) { p.ChainID = "" }},
+		{"pubkey not owned by claimer", func(p *claim.Params) { p.PubKey = qbtctestutil.GetRandomMLDsaPublicKey() }},
+		{"no utxos", func(p *claim.Params) { p.Utxos = nil }},
+		{"proof too small", func(p *claim.Params) { p.Proof = []byte{1} }},
+		{"invalid destination", func(p *claim.Params) { p.Destination = "not-a-valid-bech32" }},
+		{"short vk fingerprint", func(p *claim.Params) { p.VKFingerprint = []byte{1, 2, 3} }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := testParams(t)
+			tt.mutate(&params)
+			_, err := claim.BuildUnsignedTx(params)
+			require.Error(t, err)
+		})
+	}
+}
@@ -32,7 +32,7 @@ func main() {
 	showVersion := flag.Bool("version", false, "Shows version")
 	logLevel := flag.StringP("log-level", "l", "info", "Log Level")
 	pretty := flag.BoolP("pretty-log", "p", false, "Enables unstructured prettified logging. This is useful for local debugging")
-	configPath := flag.StringP("config", "c", "", "Path to config file or directory containing config.json")
+	configPath := flag.StringP("config", "c", "", "Path to config file or directory containing config.json; QBTC_BIFROST_* environment variables override its fields")
 	flag.Parse()
 	initLog(*logLevel, *pretty)
 	if *showVersion {
@@ -6,6 +6,8 @@
 package main
 
 import (
+	"context"
+	"fmt"
 	"math/big"
 	"testing"
 
@@ -19,7 +21,7 @@ import (
 func TestDKLSTSS_2of2_Keygen(t *testing.T) {
 	t.Log("Running 2-of-2 DKLS keygen...")
 
-	keyshares, err := runKeygen(2, 2)
+	keyshares, err := runKeygen(t.Context(), 2, 2)
 	require.NoError(t, err, "keygen should succeed")
 	require.Len(t, keyshares, 2, "should have 2 keyshares")
 
@@ -43,7 +45,7 @@ func TestDKLSTSS_2of2_Keygen(t *testing.T) {
 // TestDKLSTSS_2of2_Sign tests basic 2-of-2 threshold signing
 func TestDKLSTSS_2of2_Sign(t *testing.T) {
 	t.Log("Running 2-of-2 DKLS keygen...")
-	keyshares, err := runKeygen(2, 2)
+	keyshares, err := runKeygen(t.Context(), 2, 2)
 	require.NoError(t, err, "keygen should succeed")
 	defer func() {
 		for _, share := range keyshares {
@@ -58,7 +60,7 @@ func TestDKLSTSS_2of2_Sign(t *testing.T) {
 	}
 
 	t.Log("Running 2-of-2 DKLS signing...")
-	signatures, err := runSign(keyshares, msg)
+	signatures, err := runSign(t.Context(), keyshares, msg)
 	require.NoError(t, err, "signing should succeed")
 	require.Len(t, signatures, 2, "should have 2 signatures")
 
@@ -118,7 +120,7 @@ func TestDKLSTSS_ZKProofIntegration(t *testing.T) {
 	// Step 2: 2-of-2 DKLS Keygen
 	// ========================================
 	t.Log("Step 2: Running 2-of-2 DKLS keygen...")
-	keyshares, err := runKeygen(2, 2)
+	keyshares, err := runKeygen(t.Context(), 2, 2)
 	require.NoError(t, err, "keygen should succeed")
 	require.Len(t, keyshares, 2)
 	defer func() {
@@ -156,7 +158,7 @@ func TestDKLSTSS_ZKProofIntegration(t *testing.T) {
 	// Step 4: Sign with TSS
 	// ========================================
 	t.Log("Step 4: Signing claim message with 2-of-2 TSS...")
-	signatures, err := runSign(keyshares, messageHash[:])
+	signatures, err := runSign(t.Context(), keyshares, messageHash[:])
 	require.NoError(t, err, "signing should succeed")
 
 	// All parties produce the same signature
@@ -169,8 +171,8 @@ func TestDKLSTSS_ZKProofIntegration(t *testing.T) {
 	sigS := new(big.Int).SetBytes(sig[32:64])
 
 	// Decompress public key to get X, Y coordinates
-	pubKeyX, pubKeyY := secp256k1.DecompressPubkey(pubKeyBytes)
-	require.NotNil(t, pubKeyX, "should decompress public key")
+	pubKeyX, pubKeyY, err := zk.DecompressPubKey(pubKeyBytes)
+	require.NoError(t, err, "should decompress public key")
 
 	// ========================================
 	// Step 5: Generate ZK Proof
@@ -193,7 +195,8 @@ func TestDKLSTSS_ZKProofIntegration(t *testing.T) {
 	// Step 6: Serialize/Deserialize Proof (TX Round-trip)
 	// ========================================
 	t.Log("Step 6: Testing proof serialization round-trip...")
-	protoBytes := proof.ToProtoZKProof()
+	protoBytes, err := proof.ToProtoZKProof()
+	require.NoError(t, err)
 	require.NotEmpty(t, protoBytes)
 
 	deserializedProof, err := zk.ProofFromProtoZKProof(protoBytes)
@@ -288,7 +291,7 @@ func TestDKLSTSS_2of3_ZKProof(t *testing.T) {
 
 	// 2-of-3 Keygen
 	t.Log("Running 2-of-3 DKLS keygen...")
-	keyshares, err := runKeygen(2, 3)
+	keyshares, err := runKeygen(t.Context(), 2, 3)
 	require.NoError(t, err)
 	require.Len(t, keyshares, 3)
 	defer func() {
@@ -313,15 +316,15 @@ func TestDKLSTSS_2of3_ZKProof(t *testing.T) {
 
 	// Sign with only 2 of 3 parties (parties 1 and 2)
 	t.Log("Signing with parties 1 and 2 (2-of-3)...")
-	signatures, err := runSign(keyshares[:2], messageHash[:])
+	signatures, err := runSign(t.Context(), keyshares[:2], messageHash[:])
 	require.NoError(t, err)
 
 	sig := signatures[0]
 	sigR := new(big.Int).SetBytes(sig[:32])
 	sigS := new(big.Int).SetBytes(sig[32:64])
 
-	pubKeyX, pubKeyY := secp256k1.DecompressPubkey(pubKeyBytes)
-	require.NotNil(t, pubKeyX)
+	pubKeyX, pubKeyY, err := zk.DecompressPubKey(pubKeyBytes)
+	require.NoError(t, err)
 
 	// Generate and verify proof
 	t.Log("Generating and verifying ZK proof...")
@@ -347,7 +350,7 @@ func TestDKLSTSS_2of3_ZKProof(t *testing.T) {
 
 	// Sign with different parties (parties 2 and 3)
 	t.Log("Signing with parties 2 and 3 (different 2-of-3 combination)...")
-	signatures2, err := runSign(keyshares[1:3], messageHash[:])
+	signatures2, err := runSign(t.Context(), keyshares[1:3], messageHash[:])
 	require.NoError(t, err)
 
 	sig2 := signatures2[0]
@@ -376,3 +379,37 @@ func TestDKLSTSS_2of3_ZKProof(t *testing.T) {
 
 	t.Log("2-of-3 TSS + ZK proof test passed!")
 }
+
+// TestDKLSTSS_StalledKeygen checks that a keygen with an unresponsive party
+// aborts and reports who stalled instead of looping forever
+func TestDKLSTSS_StalledKeygen(t *testing.T) {
+	newParties := func() []Participant {
+		setupMsg, err := session.DklsKeygenSetupMsgNew(2, nil, prepareIDSlice(2))
+		require.NoError(t, err)
+		parties := make([]Participant, 2)
+		for i := range parties {
+			id := fmt.Sprintf("p%d", i+1)
+			handle, err := session.DklsKeygenSessionFromSetup(setupMsg, []byte(id))
+			require.NoError(t, err)
+			parties[i] = Participant{Session: handle, ID: id}
+		}
+		return parties
+	}
+
+	// p2 never takes part, so p1 runs out of messages to send
+	_, err := runKeygenLoop(t.Context(), newParties()[:1])
+	var protocolErr *ProtocolError
+	require.ErrorAs(t, err, &protocolErr)
+	require.ErrorIs(t, err, errNoProgress)
+	require.Equal(t, "keygen", protocolErr.Protocol)
+	require.Empty(t, protocolErr.Completed)
+	require.Equal(t, []string{"p1"}, protocolErr.Stalled)
+
+	// an expired context aborts before the first round
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+	_, err = runKeygenLoop(ctx, newParties())
+	require.ErrorIs(t, err, context.Canceled)
+	require.ErrorAs(t, err, &protocolErr)
+	require.Equal(t, []string{"p1", "p2"}, protocolErr.Stalled)
+}
@@ -1,17 +1,28 @@
 // Package main provides a DKLS TSS (Threshold Signature Scheme) integration
 // with the ZK proof system. This demonstrates using the real DKLS library
 // for distributed key generation and signing with zero-knowledge proofs.
+//
+// Keygen and signing can be split across runs by persisting the keyshares:
+//
+//	go run ./cmd/dkls-tss --save-shares ./shares   # keygen + sign, keep shares
+//	go run ./cmd/dkls-tss --load-shares ./shares   # sign with existing shares
+//
+// The verification half runs on its own from a saved proof, which needs
+// neither DKLS nor a PLONK setup:
+//
+//	go run ./cmd/dkls-tss --save-proof ./proof
+//	go run ./cmd/dkls-tss --verify-only --proof ./proof/proof.hex --vk ./proof/verifying.key
 package main
 
 import (
+	"context"
+	"flag"
 	"fmt"
 	"log"
-	"math/big"
 	"os"
 	"strings"
 
 	"github.com/btcq-org/qbtc/x/qbtc/zk"
-	"github.com/ethereum/go-ethereum/crypto/secp256k1"
 	session "github.com/vultisig/go-wrappers/go-dkls/sessions"
 )
 
@@ -31,7 +42,7 @@ func prepareIDSlice(n int) []byte {
 }
 
 // runKeygen performs distributed key generation for n parties with threshold t
-func runKeygen(t, n int) ([]session.Handle, error) {
+func runKeygen(ctx context.Context, t, n int) ([]session.Handle, error) {
 	ids := prepareIDSlice(n)
 
 	setupMsg, err := session.DklsKeygenSetupMsgNew(t, nil, ids)
@@ -49,32 +60,41 @@ func runKeygen(t, n int) ([]session.Handle, error) {
 		parties[i-1] = Participant{Session: sessionHandle, ID: id}
 	}
 
-	return runKeygenLoop(parties)
+	return runKeygenLoop(ctx, parties)
 }
 
-// runKeygenLoop runs the message passing loop for keygen
-func runKeygenLoop(parties []Participant) ([]session.Handle, error) {
+// runKeygenLoop runs the message passing loop for keygen. It fails with a
+// *ProtocolError when ctx is done, a party errors, or a round passes without
+// any party producing a message.
+func runKeygenLoop(ctx context.Context, parties []Participant) ([]session.Handle, error) {
 	msgq := make(map[string][][]byte)
 	n := len(parties)
 	shares := make([]session.Handle, 0, n)
+	progress := newProtocolProgress("keygen", parties)
 
 	for len(shares) != n {
+		if err := ctx.Err(); err != nil {
+			return nil, progress.fail(err)
+		}
+
 		// Output messages from all parties
-		for _, party := range parties {
+		sent := 0
+		for _, party := range progress.running() {
 			for {
 				buf, err := session.DklsKeygenSessionOutputMessage(party.Session)
 				if err != nil {
-					return nil, fmt.Errorf("keygen output error for %s: %w", party.ID, err)
+					return nil, progress.fail(fmt.Errorf("keygen output error for %s: %w", party.ID, err))
 				}
 				if buf == nil {
 					break
 				}
+				sent++
 
 				// Route message to receivers
 				for idx := 0; idx < n; idx++ {
 					receiver, err := session.DklsKeygenSessionMessageReceiver(party.Session, buf, idx)
 					if err != nil {
-						return nil, fmt.Errorf("keygen receiver error: %w", err)
+						return nil, progress.fail(fmt.Errorf("keygen receiver error: %w", err))
 					}
 					if receiver == "" {
 						break
@@ -83,21 +103,26 @@ func runKeygenLoop(parties []Participant) ([]session.Handle, error) {
 				}
 			}
 		}
+		if sent == 0 {
+			return nil, progress.fail(errNoProgress)
+		}
 
 		// Input messages to all parties
-		for _, party := range parties {
+		for _, party := range progress.running() {
 			for _, msg := range msgq[party.ID] {
 				finished, err := session.DklsKeygenSessionInputMessage(party.Session, msg)
 				if err != nil {
-					return nil, fmt.Errorf("keygen input error for %s: %w", party.ID, err)
+					return nil, progress.fail(fmt.Errorf("keygen input error for %s: %w", party.ID, err))
 				}
 				if finished {
 					share, err := session.DklsKeygenSessionFinish(party.Session)
 					if err != nil {
-						return nil, fmt.Errorf("keygen finish error for %s: %w", party.ID, err)
+						return nil, progress.fail(fmt.Errorf("keygen finish error for %s: %w", party.ID, err))
 					}
 					shares = append(shares, share)
 					_ = session.DklsKeygenSessionFree(party.Session)
+					progress.finish(party.ID)
+					break
 				}
 			}
 			msgq[party.ID] = nil
@@ -108,7 +133,7 @@ func runKeygenLoop(parties []Participant) ([]session.Handle, error) {
 }
 
 // runSign performs distributed signing with the given keyshares
-func runSign(shares []session.Handle, msg []byte) ([][]byte, error) {
+func runSign(ctx context.Context, shares []session.Handle, msg []byte) ([][]byte, error) {
 	t := len(shares)
 
 	keyID, err := session.DklsKeyshareKeyID(shares[0])
@@ -132,32 +157,40 @@ func runSign(shares []session.Handle, msg []byte) ([][]byte, error) {
 		parties[i-1] = Participant{Session: sessionHandle, ID: id}
 	}
 
-	return runSignLoop(parties)
+	return runSignLoop(ctx, parties)
 }
 
-// runSignLoop runs the message passing loop for signing
-func runSignLoop(parties []Participant) ([][]byte, error) {
+// runSignLoop runs the message passing loop for signing. It fails like
+// runKeygenLoop when the protocol stops making progress.
+func runSignLoop(ctx context.Context, parties []Participant) ([][]byte, error) {
 	msgq := make(map[string][][]byte)
 	t := len(parties)
 	signatures := make([][]byte, 0, t)
+	progress := newProtocolProgress("sign", parties)
 
 	for len(signatures) != t {
+		if err := ctx.Err(); err != nil {
+			return nil, progress.fail(err)
+		}
+
 		// Output messages from all parties
-		for _, party := range parties {
+		sent := 0
+		for _, party := range progress.running() {
 			for {
 				buf, err := session.DklsSignSessionOutputMessage(party.Session)
 				if err != nil {
-					return nil, fmt.Errorf("sign output error for %s: %w", party.ID, err)
+					return nil, progress.fail(fmt.Errorf("sign output error for %s: %w", party.ID, err))
 				}
 				if len(buf) == 0 {
 					break
 				}
+				sent++
 
 				// Route message to receivers
 				for idx := 0; idx < t; idx++ {
 					receiver, err := session.DklsSignSessionMessageReceiver(party.Session, buf, idx)
 					if err != nil {
-						return nil, fmt.Errorf("sign receiver error: %w", err)
+						return nil, progress.fail(fmt.Errorf("sign receiver error: %w", err))
 					}
 					if len(receiver) == 0 {
 						break
@@ -166,21 +199,26 @@ func runSignLoop(parties []Participant) ([][]byte, error) {
 				}
 			}
 		}
+		if sent == 0 {
+			return nil, progress.fail(errNoProgress)
+		}
 
 		// Input messages to all parties
-		for _, party := range parties {
+		for _, party := range progress.running() {
 			for _, msg := range msgq[party.ID] {
 				finished, err := session.DklsSignSessionInputMessage(party.Session, msg)
 				if err != nil {
-					return nil, fmt.Errorf("sign input error for %s: %w", party.ID, err)
+					return nil, progress.fail(fmt.Errorf("sign input error for %s: %w", party.ID, err))
 				}
 				if finished {
 					sig, err := session.DklsSignSessionFinish(party.Session)
 					if err != nil {
-						return nil, fmt.Errorf("sign finish error for %s: %w", party.ID, err)
+						return nil, progress.fail(fmt.Errorf("sign finish error for %s: %w", party.ID, err))
 					}
 					signatures = append(signatures, sig)
 					_ = session.DklsSignSessionFree(party.Session)
+					progress.finish(party.ID)
+					break
 				}
 			}
 			msgq[party.ID] = nil
@@ -191,16 +229,55 @@ func runSignLoop(parties []Participant) ([][]byte, error) {
 }
 
 func main() {
+	saveSharesDir := flag.String("save-shares", "", "Directory to save the generated keyshares to (sensitive!)")
+	loadSharesDir := flag.String("load-shares", "", "Directory to load keyshares from instead of running keygen")
+	saveProofDir := flag.String("save-proof", "", "Directory to save the proof bundle and verifying key to, for --verify-only")
+	verifyOnly := flag.Bool("verify-only", false, "Only verify a saved proof against a verifying key, skipping DKLS and proving")
+	proofPath := flag.String("proof", "", "Proof bundle file (hex or raw) for --verify-only")
+	vkPath := flag.String("vk", "", "Verifying key file (hex or raw) for --verify-only")
+	timeout := flag.Duration("timeout", defaultProtocolTimeout, "Abort keygen or signing when it takes longer than this")
+	flag.Parse()
+
+	if *verifyOnly {
+		fmt.Println("=== ZK Proof Verification Demo ===")
+		fmt.Println()
+		if err := runVerifyOnly(*proofPath, *vkPath); err != nil {
+			log.Fatalf("Verification failed: %v", err)
+		}
+		return
+	}
+
 	fmt.Println("=== DKLS TSS + ZK Proof Integration Demo ===")
 	fmt.Println()
 
-	// Step 1: Run 2-of-2 DKLS keygen
-	fmt.Println("Step 1: Running 2-of-2 DKLS distributed key generation...")
-	keyshares, err := runKeygen(2, 2)
-	if err != nil {
-		log.Fatalf("Keygen failed: %v", err)
+	// Step 1: Run 2-of-2 DKLS keygen, or reuse keyshares from a previous run
+	var keyshares []session.Handle
+	var err error
+	if *loadSharesDir != "" {
+		fmt.Printf("Step 1: Loading DKLS keyshares from %s...\n", *loadSharesDir)
+		keyshares, err = loadKeyshares(*loadSharesDir)
+		if err != nil {
+			log.Fatalf("Loading keyshares failed: %v", err)
+		}
+		fmt.Printf("  ✓ Loaded %d keyshares\n", len(keyshares))
+	} else {
+		fmt.Println("Step 1: Running 2-of-2 DKLS distributed key generation...")
+		ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+		keyshares, err = runKeygen(ctx, 2, 2)
+		cancel()
+		if err != nil {
+			log.Fatalf("Keygen failed: %v", err)
+		}
+		fmt.Printf("  ✓ Generated %d keyshares\n", len(keyshares))
+	}
+
+	if *saveSharesDir != "" {
+		if err := saveKeyshares(*saveSharesDir, keyshares); err != nil {
+			log.Fatalf("Saving keyshares failed: %v", err)
+		}
+		fmt.Printf("  ✓ Keyshares saved to %s\n", *saveSharesDir)
+		fmt.Println(keyshareWarning)
 	}
-	fmt.Printf("  ✓ Generated %d keyshares\n", len(keyshares))
 
 	// Get the shared public key
 	pubKeyBytes, err := session.DklsKeysharePublicKey(keyshares[0])
@@ -232,7 +309,9 @@ func main() {
 
 	// Step 4: Sign with TSS
 	fmt.Println("\nStep 4: Signing claim message with 2-of-2 TSS...")
-	signatures, err := runSign(keyshares, messageHash[:])
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	signatures, err := runSign(ctx, keyshares, messageHash[:])
+	cancel()
 	if err != nil {
 		log.Fatalf("Signing failed: %v", err)
 	}
@@ -244,18 +323,22 @@ func main() {
 	if len(sig) != 65 {
 		log.Fatalf("Unexpected signature length: got %d, expected 65", len(sig))
 	}
-	sigR := new(big.Int).SetBytes(sig[:32])
-	sigS := new(big.Int).SetBytes(sig[32:64])
-	fmt.Printf("  ✓ R: %s\n", sigR.Text(16))
-	fmt.Printf("  ✓ S: %s\n", sigS.Text(16))
-
-	// Decompress public key to get X, Y coordinates
-	pubKeyX, pubKeyY := secp256k1.DecompressPubkey(pubKeyBytes)
-	if pubKeyX == nil {
-		log.Fatal("Failed to decompress public key")
+	fmt.Printf("  ✓ R: %x\n", sig[:32])
+	fmt.Printf("  ✓ S: %x\n", sig[32:64])
+
+	// Build the prover inputs; this decompresses the public key and checks it
+	// against the address hash
+	proofParams, err := zk.ProofParamsFromSignature(sig[:32], sig[32:64], pubKeyBytes, zk.VerificationParams{
+		MessageHash:     messageHash,
+		AddressHash:     addressHash,
+		QBTCAddressHash: btcqAddressHash,
+		ChainID:         chainIDHash,
+	})
+	if err != nil {
+		log.Fatalf("Failed to build proof params: %v", err)
 	}
-	fmt.Printf("  ✓ Public key X: %s\n", pubKeyX.Text(16))
-	fmt.Printf("  ✓ Public key Y: %s\n", pubKeyY.Text(16))
+	fmt.Printf("  ✓ Public key X: %s\n", proofParams.PublicKeyX.Text(16))
+	fmt.Printf("  ✓ Public key Y: %s\n", proofParams.PublicKeyY.Text(16))
 
 	// Step 5: Generate ZK proof
 	fmt.Println("\nStep 5: Setting up ZK prover and generating proof...")
@@ -280,20 +363,18 @@ func main() {
 
 	// Create prover and generate proof
 	prover := zk.ProverFromSetup(setup)
-	proof, err := prover.GenerateProof(zk.ProofParams{
-		SignatureR:      sigR,
-		SignatureS:      sigS,
-		PublicKeyX:      pubKeyX,
-		PublicKeyY:      pubKeyY,
-		MessageHash:     messageHash,
-		AddressHash:     addressHash,
-		BTCQAddressHash: btcqAddressHash,
-		ChainID:         chainIDHash,
-	})
+	proofBundle, err := prover.GenerateProofWithPublicInputs(proofParams)
 	if err != nil {
 		log.Fatalf("Proof generation failed: %v", err)
 	}
+	proof := proofBundle.ProofData
 	fmt.Printf("  ✓ Proof generated (%d bytes)\n", len(proof))
+	if *saveProofDir != "" {
+		if err := saveProof(*saveProofDir, proofBundle, vkBytes); err != nil {
+			log.Fatalf("Saving proof failed: %v", err)
+		}
+		fmt.Printf("  ✓ Proof and verifying key saved to %s\n", *saveProofDir)
+	}
 
 	// Step 6: Verify proof
 	fmt.Println("\nStep 6: Verifying ZK proof...")
@@ -316,9 +397,7 @@ func main() {
 	fmt.Println("  4. ZK proof verification")
 
 	// Cleanup keyshares
-	for _, share := range keyshares {
-		_ = session.DklsKeyshareFree(share)
-	}
+	freeKeyshares(keyshares)
 
 	os.Exit(0)
 }
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// defaultProtocolTimeout bounds a single keygen or signing run of the demo.
+// Both finish in well under a second when every party is responsive.
+const defaultProtocolTimeout = 2 * time.Minute
+
+// errNoProgress is the cause of a ProtocolError when a round passed without
+// any party producing a message, so the protocol can never finish.
+var errNoProgress = errors.New("no party produced a message in the last round")
+
+// ProtocolError reports a DKLS protocol run that did not finish, together with
+// the parties that completed and the ones that stalled.
+type ProtocolError struct {
+	Protocol  string
+	Completed []string
+	Stalled   []string
+	Cause     error
+}
+
+func (e *ProtocolError) Error() string {
+	return fmt.Sprintf("%s did not finish (completed: [%s], stalled: [%s]): %v",
+		e.Protocol, strings.Join(e.Completed, ", "), strings.Join(e.Stalled, ", "), e.Cause)
+}
+
+func (e *ProtocolError) Unwrap() error {
+	return e.Cause
+}
+
+// protocolProgress tracks which parties of a protocol run have finished.
+type protocolProgress struct {
+	protocol string
+	parties  []Participant
+	finished map[string]bool
+}
+
+func newProtocolProgress(protocol string, parties []Participant) *protocolProgress {
+	return &protocolProgress{
+		protocol: protocol,
+		parties:  parties,
+		finished: make(map[string]bool, len(parties)),
+	}
+}
+
+// running returns the parties that have not finished yet. Finished parties
+// have their session freed and must not be used again.
+func (p *protocolProgress) running() []Participant {
+	running := make([]Participant, 0, len(p.parties))
+	for _, party := range p.parties {
+		if !p.finished[party.ID] {
+			running = append(running, party)
+		}
+	}
+	return running
+}
+
+func (p *protocolProgress) finish(id string) {
+	p.finished[id] = true
+}
+
+// fail wraps cause into a ProtocolError describing the state of the run.
+func (p *protocolProgress) fail(cause error) error {
+	err := &ProtocolError{Protocol: p.protocol, Cause: cause}
+	for _, party := range p.parties {
+		if p.finished[party.ID] {
+			err.Completed = append(err.Completed, party.ID)
+		} else {
+			err.Stalled = append(err.Stalled, party.ID)
+		}
+	}
+	return err
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	session "github.com/vultisig/go-wrappers/go-dkls/sessions"
+)
+
+// keyshareFilePattern is the file name pattern used for persisted keyshares.
+const keyshareFilePattern = "keyshare_%d.bin"
+
+// keyshareWarning is printed whenever keyshares touch the disk.
+const keyshareWarning = `  ⚠️  Keyshares are secret key material. Anyone holding a threshold of them
+  ⚠️  can sign as the shared key. Keep the directory private and encrypted.`
+
+// saveKeyshares serializes each keyshare handle into dir as keyshare_<n>.bin.
+// The directory and files are created owner-only.
+func saveKeyshares(dir string, shares []session.Handle) error {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return fmt.Errorf("failed to create keyshare directory: %w", err)
+	}
+
+	for i, share := range shares {
+		shareBytes, err := session.DklsKeyshareToBytes(share)
+		if err != nil {
+			return fmt.Errorf("failed to serialize keyshare %d: %w", i+1, err)
+		}
+		path := filepath.Join(dir, fmt.Sprintf(keyshareFilePattern, i+1))
+		if err := os.WriteFile(path, shareBytes, 0600); err != nil {
+			return fmt.Errorf("failed to write keyshare %d: %w", i+1, err)
+		}
+	}
+
+	return nil
+}
+
+// loadKeyshares reads the keyshares written by saveKeyshares back into handles,
+// ordered by party index. The caller owns the returned handles and must free them.
+func loadKeyshares(dir string) ([]session.Handle, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "keyshare_*.bin"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list keyshares: %w", err)
+	}
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("no keyshares found in %s", dir)
+	}
+
+	// Order by party index so share i is used by party p(i+1)
+	indexed := make(map[int]string, len(paths))
+	indices := make([]int, 0, len(paths))
+	for _, path := range paths {
+		var idx int
+		if _, err := fmt.Sscanf(filepath.Base(path), keyshareFilePattern, &idx); err != nil {
+			return nil, fmt.Errorf("unexpected keyshare file name %s", path)
+		}
+		indexed[idx] = path
+		indices = append(indices, idx)
+	}
+	sort.Ints(indices)
+
+	shares := make([]session.Handle, 0, len(indices))
+	for _, idx := range indices {
+		shareBytes, err := os.ReadFile(indexed[idx])
+		if err != nil {
+			freeKeyshares(shares)
+			return nil, fmt.Errorf("failed to read keyshare %d: %w", idx, err)
+		}
+		share, err := session.DklsKeyshareFromBytes(shareBytes)
+		if err != nil {
+			freeKeyshares(shares)
+			return nil, fmt.Errorf("failed to deserialize keyshare %d: %w", idx, err)
+		}
+		shares = append(shares, share)
+	}
+
+	return shares, nil
+}
+
+// freeKeyshares releases the native memory held by keyshare handles.
+func freeKeyshares(shares []session.Handle) {
+	for _, share := range shares {
+		_ = session.DklsKeyshareFree(share)
+	}
+}
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/btcq-org/qbtc/x/qbtc/zk"
+)
+
+const (
+	// savedProofFile and savedVKFile are the names --save-proof writes, in
+	// the formats --verify-only reads.
+	savedProofFile = "proof.hex"
+	savedVKFile    = "verifying.key"
+)
+
+// runVerifyOnly verifies a proof bundle against a verifying key, without
+// DKLS or proving. This is all a light client needs: the verifying key and
+// the proof with its public inputs. The claim parameters are taken from the
+// public inputs, so only proofs of the default claim message version verify.
+func runVerifyOnly(proofPath, vkPath string) error {
+	if proofPath == "" || vkPath == "" {
+		return fmt.Errorf("--verify-only needs both --proof and --vk")
+	}
+
+	fmt.Println("Step 1: Loading verifying key and proof...")
+	vkBytes, err := readHexOrRaw(vkPath)
+	if err != nil {
+		return fmt.Errorf("failed to read verifying key: %w", err)
+	}
+	if err := zk.RegisterVerifier(vkBytes); err != nil {
+		return fmt.Errorf("failed to register verifier: %w", err)
+	}
+	fmt.Printf("  ✓ Verifier registered (fingerprint %s)\n", zk.VerifyingKeyFingerprint(vkBytes))
+
+	bundle, err := readHexOrRaw(proofPath)
+	if err != nil {
+		return fmt.Errorf("failed to read proof: %w", err)
+	}
+	proof, err := zk.ProofFromProtoZKProof(bundle)
+	if err != nil {
+		return fmt.Errorf("failed to parse proof bundle: %w", err)
+	}
+	params, err := zk.DecodePublicInputs(proof.PublicInputs)
+	if err != nil {
+		return fmt.Errorf("failed to decode public inputs: %w", err)
+	}
+	fmt.Printf("  ✓ Proof loaded (%d bytes)\n", len(proof.ProofData))
+	fmt.Printf("  ✓ Bitcoin address hash (Hash160): %x\n", params.AddressHash)
+	fmt.Printf("  ✓ Message hash: %x\n", params.MessageHash)
+
+	fmt.Println("\nStep 2: Verifying ZK proof...")
+	verifier, err := zk.GetVerifier()
+	if err != nil {
+		return err
+	}
+	if err := verifier.CheckVKFingerprint(proof.VKFingerprint); err != nil {
+		return err
+	}
+	if err := zk.VerifyProofGlobal(proof.ProofData, params); err != nil {
+		return fmt.Errorf("proof verification failed: %w", err)
+	}
+	fmt.Println("  ✓ Proof verified successfully!")
+	return nil
+}
+
+// saveProof writes the proof bundle and verifying key of a demo run into dir,
+// for a later --verify-only run.
+func saveProof(dir string, proof *zk.Proof, vkBytes []byte) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("failed to create proof directory: %w", err)
+	}
+	bundle, err := proof.ToProtoZKProof()
+	if err != nil {
+		return fmt.Errorf("failed to encode proof: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, savedProofFile), []byte(hex.EncodeToString(bundle)), 0644); err != nil {
+		return fmt.Errorf("failed to write proof: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, savedVKFile), vkBytes, 0644); err != nil {
+		return fmt.Errorf("failed to write verifying key: %w", err)
+	}
+	return nil
+}
+
+// readHexOrRaw reads a file holding either hex or raw bytes, like the
+// verifying.key and verifying.key.hex files of 'zkprover setup'.
+func readHexOrRaw(path string) ([]byte, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	trimmed := strings.TrimPrefix(strings.TrimSpace(string(contents)), "0x")
+	if decoded, err := hex.DecodeString(trimmed); err == nil {
+		return decoded, nil
+	}
+	return contents, nil
+}
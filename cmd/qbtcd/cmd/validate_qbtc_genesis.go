@@ -0,0 +1,138 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/spf13/cobra"
+
+	qbtctypes "github.com/btcq-org/qbtc/x/qbtc/types"
+	"github.com/btcq-org/qbtc/x/qbtc/zk"
+)
+
+// NewValidateQbtcGenesisCmd returns the command that checks the qbtc section
+// of a genesis file before a network is launched with it.
+func NewValidateQbtcGenesisCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "validate-qbtc-genesis <genesis.json>",
+		Short: "Validate the qbtc module section of a genesis file",
+		Long: `Validate the qbtc module section of a genesis file.
+
+Every peer address must belong to a valid validator operator address, appear
+once per validator and be <peerID>@<host>:<port> with a libp2p peer ID. The
+zk_verifying_key, when present, must deserialize and serialize back to the
+same bytes. All problems are reported, and the command fails if there is any.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			appGenesis, err := genutiltypes.AppGenesisFromFile(args[0])
+			if err != nil {
+				return fmt.Errorf("failed to read genesis file: %w", err)
+			}
+			var appState map[string]json.RawMessage
+			if err := json.Unmarshal(appGenesis.AppState, &appState); err != nil {
+				return fmt.Errorf("failed to unmarshal app state: %w", err)
+			}
+			raw, ok := appState[qbtctypes.ModuleName]
+			if !ok {
+				return fmt.Errorf("genesis has no %s section", qbtctypes.ModuleName)
+			}
+			var genState qbtctypes.GenesisState
+			if err := clientCtx.Codec.UnmarshalJSON(raw, &genState); err != nil {
+				return fmt.Errorf("failed to unmarshal %s genesis: %w", qbtctypes.ModuleName, err)
+			}
+
+			problems := validateQbtcGenesis(genState)
+			out := cmd.OutOrStdout()
+			if len(problems) > 0 {
+				for _, problem := range problems {
+					fmt.Fprintf(cmd.ErrOrStderr(), "- %v\n", problem)
+				}
+				return fmt.Errorf("%s genesis has %d problem(s)", qbtctypes.ModuleName, len(problems))
+			}
+
+			fmt.Fprintf(out, "%s genesis is valid: %d peer addresses, %d UTXOs\n",
+				qbtctypes.ModuleName, len(genState.PeerAddresses), len(genState.Utxos))
+			if len(genState.ZkVerifyingKey) > 0 {
+				fmt.Fprintf(out, "verifying key fingerprint: %s\n", zk.VerifyingKeyFingerprint(genState.ZkVerifyingKey))
+			} else {
+				fmt.Fprintln(out, "no verifying key: ClaimWithProof stays unavailable until one is registered")
+			}
+			return nil
+		},
+	}
+}
+
+// validateQbtcGenesis returns every problem found in the qbtc genesis state,
+// not just the first one like GenesisState.Validate.
+func validateQbtcGenesis(genState qbtctypes.GenesisState) []error {
+	var problems []error
+
+	validators := make(map[string]int, len(genState.PeerAddresses))
+	peerIDs := make(map[peer.ID]int, len(genState.PeerAddresses))
+	for i, entry := range genState.PeerAddresses {
+		if _, err := sdk.ValAddressFromBech32(entry.Validator); err != nil {
+			problems = append(problems, fmt.Errorf("peer_addresses[%d]: invalid validator %q: %w", i, entry.Validator, err))
+		} else if first, dup := validators[entry.Validator]; dup {
+			problems = append(problems, fmt.Errorf("peer_addresses[%d]: validator %s is already listed at peer_addresses[%d]", i, entry.Validator, first))
+		} else {
+			validators[entry.Validator] = i
+		}
+
+		if err := qbtctypes.ValidatePeerAddress(entry.PeerAddress); err != nil {
+			problems = append(problems, fmt.Errorf("peer_addresses[%d]: invalid peer address %q: %w", i, entry.PeerAddress, err))
+			continue
+		}
+		peerIDStr, _, _ := strings.Cut(entry.PeerAddress, "@")
+		peerID, err := peer.Decode(peerIDStr)
+		if err != nil {
+			problems = append(problems, fmt.Errorf("peer_addresses[%d]: invalid libp2p peer ID %q: %w", i, peerIDStr, err))
+			continue
+		}
+		if first, dup := peerIDs[peerID]; dup {
+			problems = append(problems, fmt.Errorf("peer_addresses[%d]: peer ID %s is already used at peer_addresses[%d]", i, peerID, first))
+			continue
+		}
+		peerIDs[peerID] = i
+	}
+
+	if len(genState.ZkVerifyingKey) > 0 {
+		if err := checkVerifyingKeyRoundTrip(genState.ZkVerifyingKey); err != nil {
+			problems = append(problems, fmt.Errorf("zk_verifying_key: %w", err))
+		}
+	}
+
+	// Validate repeats some of the checks above and stops at the first
+	// problem, so only run it for the remaining fields when all passed
+	if len(problems) == 0 {
+		if err := genState.Validate(); err != nil {
+			problems = append(problems, err)
+		}
+	}
+	return problems
+}
+
+// checkVerifyingKeyRoundTrip checks that the verifying key deserializes and
+// serializes back to the same bytes, so no trailing or altered data slips in.
+func checkVerifyingKeyRoundTrip(vkBytes []byte) error {
+	vk, err := zk.DeserializeVerifyingKey(vkBytes)
+	if err != nil {
+		return err
+	}
+	reserialized, err := zk.SerializeVerifyingKey(vk)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(reserialized, vkBytes) {
+		return errors.New("verifying key does not serialize back to the same bytes")
+	}
+	return nil
+}
@@ -159,7 +159,7 @@ func hash160(data []byte) []byte {
 
 func main() {
 	var (
-		port          string
+		opts          ServerOptions
 		privateKeyHex string
 	)
 
@@ -171,7 +171,11 @@ It exposes only a signing API endpoint while keeping the private key internal.
 This is used for testing and development of the signature-based ZK proof system.
 
 The emulator accepts a private key via flag or environment variable (TSS_PRIVATE_KEY)
-and provides a /sign endpoint that returns ECDSA signatures.`,
+and provides a /sign endpoint that returns ECDSA signatures.
+
+It serves plaintext HTTP by default. Use --tls-cert/--tls-key to serve HTTPS and
+--auth-token (or TSS_AUTH_TOKEN) to require "Authorization: Bearer <token>"
+before exposing it beyond localhost.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// Get private key from flag or environment
 			if privateKeyHex == "" {
@@ -181,6 +185,13 @@ and provides a /sign endpoint that returns ECDSA signatures.`,
 				return fmt.Errorf("private key required: use --private-key flag or TSS_PRIVATE_KEY env var")
 			}
 
+			if opts.AuthToken == "" {
+				opts.AuthToken = os.Getenv("TSS_AUTH_TOKEN")
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
 			// Create the emulator
 			emulator, err := NewTSSEmulator(privateKeyHex)
 			if err != nil {
@@ -188,37 +199,28 @@ and provides a /sign endpoint that returns ECDSA signatures.`,
 			}
 
 			// Log startup info (public key only, never the private key)
-			pubKeyHex := hex.EncodeToString(emulator.publicKey.SerializeCompressed())
-			addrHash := hex.EncodeToString(emulator.GetPublicKeyHash())
 			log.Printf("TSS Emulator starting...")
-			log.Printf("Public Key: %s", pubKeyHex)
-			log.Printf("Address Hash (Hash160): %s", addrHash)
-			log.Printf("Listening on %s", port)
-
-			// Set up HTTP handlers
-			http.HandleFunc("/sign", func(w http.ResponseWriter, r *http.Request) {
-				handleSign(w, r, emulator)
-			})
-
-			http.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
-				w.Header().Set("Content-Type", "application/json")
-				_ = json.NewEncoder(w).Encode(map[string]string{"status": "ok"})
-			})
-
-			http.HandleFunc("/info", func(w http.ResponseWriter, r *http.Request) {
-				w.Header().Set("Content-Type", "application/json")
-				_ = json.NewEncoder(w).Encode(map[string]string{
-					"public_key":   pubKeyHex,
-					"address_hash": addrHash,
-				})
-			})
-
-			// Start the server
-			return http.ListenAndServe(port, nil)
+			log.Printf("Public Key: %s", hex.EncodeToString(emulator.publicKey.SerializeCompressed()))
+			log.Printf("Address Hash (Hash160): %s", hex.EncodeToString(emulator.GetPublicKeyHash()))
+			if opts.TLSEnabled() {
+				log.Printf("Listening on %s (TLS)", opts.ListenAddr)
+			} else {
+				log.Printf("Listening on %s (plaintext HTTP)", opts.ListenAddr)
+			}
+			if opts.AuthToken == "" {
+				log.Printf("WARNING: no auth token set, /sign is open to anyone who can reach this address")
+			}
+
+			return serve(opts, newHandler(emulator, opts.AuthToken))
 		},
 	}
 
-	rootCmd.Flags().StringVarP(&port, "port", "p", ":8080", "Port to listen on")
+	rootCmd.Flags().StringVarP(&opts.ListenAddr, "listen", "l", ":8080", "Address to listen on")
+	rootCmd.Flags().StringVarP(&opts.ListenAddr, "port", "p", ":8080", "Address to listen on")
+	_ = rootCmd.Flags().MarkDeprecated("port", "use --listen instead")
+	rootCmd.Flags().StringVar(&opts.TLSCert, "tls-cert", "", "TLS certificate file; serves HTTPS when set together with --tls-key")
+	rootCmd.Flags().StringVar(&opts.TLSKey, "tls-key", "", "TLS private key file")
+	rootCmd.Flags().StringVar(&opts.AuthToken, "auth-token", "", "Require this bearer token on all endpoints except /health (or use TSS_AUTH_TOKEN env var)")
 	rootCmd.Flags().StringVar(&privateKeyHex, "private-key", "", "Private key in hex format (or use TSS_PRIVATE_KEY env var)")
 
 	if err := rootCmd.Execute(); err != nil {
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// readHeaderTimeout bounds how long a client may take to send request headers.
+const readHeaderTimeout = 10 * time.Second
+
+// ServerOptions configures how the emulator's HTTP server is exposed.
+// Without TLS files and an auth token it serves plaintext HTTP to anyone,
+// which is only suitable for local development.
+type ServerOptions struct {
+	ListenAddr string
+	TLSCert    string
+	TLSKey     string
+	AuthToken  string
+}
+
+// Validate checks that a listen address is set and that the TLS certificate
+// and key are either both set or both empty.
+func (o ServerOptions) Validate() error {
+	if o.ListenAddr == "" {
+		return fmt.Errorf("listen address must not be empty")
+	}
+	if (o.TLSCert == "") != (o.TLSKey == "") {
+		return fmt.Errorf("--tls-cert and --tls-key must be provided together")
+	}
+	return nil
+}
+
+// TLSEnabled reports whether the server should serve HTTPS.
+func (o ServerOptions) TLSEnabled() bool {
+	return o.TLSCert != ""
+}
+
+// newHandler returns the emulator's routes, wrapped in bearer-token auth when
+// a token is configured. /health stays open so liveness probes need no secret.
+func newHandler(emulator *TSSEmulator, authToken string) http.Handler {
+	pubKeyHex := hex.EncodeToString(emulator.publicKey.SerializeCompressed())
+	addrHash := hex.EncodeToString(emulator.GetPublicKeyHash())
+
+	protected := http.NewServeMux()
+	protected.HandleFunc("/sign", func(w http.ResponseWriter, r *http.Request) {
+		handleSign(w, r, emulator)
+	})
+	protected.HandleFunc("/info", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"public_key":   pubKeyHex,
+			"address_hash": addrHash,
+		})
+	})
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]string{"status": "ok"})
+	})
+	mux.Handle("/", withBearerAuth(authToken, protected))
+	return mux
+}
+
+// withBearerAuth rejects requests that do not carry "Authorization: Bearer <token>".
+// An empty token disables the check.
+func withBearerAuth(token string, next http.Handler) http.Handler {
+	if token == "" {
+		return next
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || subtle.ConstantTimeCompare([]byte(got), []byte(token)) != 1 {
+			w.Header().Set("Content-Type", "application/json")
+			w.Header().Set("WWW-Authenticate", `Bearer realm="tss-emulator"`)
+			w.WriteHeader(http.StatusUnauthorized)
+			_ = json.NewEncoder(w).Encode(ErrorResponse{Error: "unauthorized"})
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// serve runs the HTTP server until it fails, using TLS when configured.
+func serve(opts ServerOptions, handler http.Handler) error {
+	srv := &http.Server{
+		Addr:              opts.ListenAddr,
+		Handler:           handler,
+		ReadHeaderTimeout: readHeaderTimeout,
+	}
+	if opts.TLSEnabled() {
+		return srv.ListenAndServeTLS(opts.TLSCert, opts.TLSKey)
+	}
+	return srv.ListenAndServe()
+}
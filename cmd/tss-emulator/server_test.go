@@ -0,0 +1,100 @@
+package main
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestServerOptionsValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    ServerOptions
+		wantErr string
+	}{
+		{name: "plaintext", opts: ServerOptions{ListenAddr: ":8080"}},
+		{name: "tls", opts: ServerOptions{ListenAddr: ":8443", TLSCert: "cert.pem", TLSKey: "key.pem"}},
+		{name: "missing listen address", opts: ServerOptions{}, wantErr: "listen address"},
+		{name: "cert without key", opts: ServerOptions{ListenAddr: ":8443", TLSCert: "cert.pem"}, wantErr: "provided together"},
+		{name: "key without cert", opts: ServerOptions{ListenAddr: ":8443", TLSKey: "key.pem"}, wantErr: "provided together"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.opts.Validate()
+			if tc.wantErr != "" {
+				require.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestHandlerBearerAuth(t *testing.T) {
+	emulator, err := NewTSSEmulator(testPrivateKeyHex)
+	require.NoError(t, err)
+	handler := newHandler(emulator, "s3cret")
+
+	signRequest := func(authHeader string) *httptest.ResponseRecorder {
+		messageHash := sha256.Sum256([]byte("test"))
+		bodyBytes, _ := json.Marshal(SignRequest{MessageHash: hex.EncodeToString(messageHash[:])})
+		req := httptest.NewRequest(http.MethodPost, "/sign", bytes.NewReader(bodyBytes))
+		if authHeader != "" {
+			req.Header.Set("Authorization", authHeader)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	t.Run("missing token", func(t *testing.T) {
+		rec := signRequest("")
+		require.Equal(t, http.StatusUnauthorized, rec.Code)
+		require.NotEmpty(t, rec.Header().Get("WWW-Authenticate"))
+	})
+
+	t.Run("wrong token", func(t *testing.T) {
+		require.Equal(t, http.StatusUnauthorized, signRequest("Bearer wrong").Code)
+	})
+
+	t.Run("wrong scheme", func(t *testing.T) {
+		require.Equal(t, http.StatusUnauthorized, signRequest("Basic s3cret").Code)
+	})
+
+	t.Run("valid token", func(t *testing.T) {
+		require.Equal(t, http.StatusOK, signRequest("Bearer s3cret").Code)
+	})
+
+	t.Run("info requires token", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/info", nil))
+		require.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+
+	t.Run("health is open", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/health", nil))
+		require.Equal(t, http.StatusOK, rec.Code)
+	})
+}
+
+func TestHandlerWithoutAuthToken(t *testing.T) {
+	emulator, err := NewTSSEmulator(testPrivateKeyHex)
+	require.NoError(t, err)
+	handler := newHandler(emulator, "")
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/info", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var info map[string]string
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &info))
+	require.Len(t, info["public_key"], 66)
+	require.Len(t, info["address_hash"], 40)
+}
@@ -6,6 +6,8 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
+	"text/tabwriter"
+	"time"
 
 	"github.com/btcq-org/qbtc/bitcoin"
 )
@@ -13,6 +15,8 @@ import (
 var (
 	exportUTXO     = flag.Bool("export-utxo", false, "export utxo from db and exit")
 	exportUTXOFile = flag.String("export-utxo-file", "", "path to write exported utxos (default stdout)")
+	compactDB      = flag.Bool("compact", false, "compact the leveldb and exit (the indexer must not be running)")
+	dbStats        = flag.Bool("db-stats", false, "print key counts and sizes of the leveldb and exit (the indexer must not be running)")
 )
 
 func main() {
@@ -21,6 +25,13 @@ func main() {
 	if err != nil {
 		panic(err)
 	}
+	// maintenance flags only need the database, not the bitcoin node
+	if *compactDB || *dbStats {
+		if err := runDBMaintenance(cfg.LocalDBPath, *compactDB, *dbStats); err != nil {
+			panic(err)
+		}
+		return
+	}
 	indexer, err := bitcoin.NewIndexer(*cfg)
 	if err != nil {
 		panic(err)
@@ -42,3 +53,43 @@ func main() {
 	fmt.Printf("received signal %v, shutting down\n", s)
 	indexer.Stop()
 }
+
+// runDBMaintenance opens the indexer database, optionally compacts it and
+// prints its stats. Stats are printed after compaction so they reflect it.
+func runDBMaintenance(path string, compact, stats bool) error {
+	if path == "" {
+		return fmt.Errorf("local_db_path is not configured")
+	}
+	db, err := bitcoin.NewLevelDB(path, false)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	if compact {
+		start := time.Now()
+		if err := bitcoin.CompactDB(db); err != nil {
+			return err
+		}
+		fmt.Printf("compacted %s in %s\n", path, time.Since(start).Round(time.Millisecond))
+	}
+	if stats {
+		keyStats, err := bitcoin.CollectDBStats(db)
+		if err != nil {
+			return err
+		}
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', tabwriter.AlignRight)
+		fmt.Fprintln(w, "prefix\tkeys\tkey bytes\tvalue bytes\tdisk bytes (approx)\t")
+		for _, s := range keyStats {
+			fmt.Fprintf(w, "%s\t%d\t%d\t%d\t%d\t\n", s.Name, s.Keys, s.KeyBytes, s.ValueBytes, s.DiskBytes)
+		}
+		if err := w.Flush(); err != nil {
+			return err
+		}
+		if levels, err := db.GetProperty("leveldb.stats"); err == nil {
+			fmt.Println()
+			fmt.Println(levels)
+		}
+	}
+	return nil
+}
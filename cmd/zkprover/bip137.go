@@ -0,0 +1,156 @@
+package main
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/btcq-org/qbtc/x/qbtc/zk"
+	"github.com/spf13/cobra"
+)
+
+// proveMessageCmd creates the command that proves ownership with a wallet's
+// "Sign Message" (BIP-137) signature
+func proveMessageCmd() *cobra.Command {
+	var (
+		btcAddress     string
+		btcqAddress    string
+		chainID        string
+		setupDir       string
+		outputFile     string
+		messageVersion string
+		signature      string
+		utxos          string
+		expiryHeight   uint64
+		destSalt       string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "prove-message",
+		Short: "Generate a ZK proof from a wallet's Bitcoin Signed Message (BIP-137) signature",
+		Long: `Generate a zero-knowledge proof from a standard "Bitcoin Signed Message"
+(BIP-137) signature, for wallets that only offer the legacy sign-message feature.
+
+Run the command without --signature first. It prints the message text to sign
+with the wallet, using the same Bitcoin address. Then run it again with the
+base64 signature the wallet returned.
+
+The proof uses the same circuit as 'prove'. The claim must be submitted with
+signature_scheme "bip137" so the chain checks the proof against the digest
+of the signed message instead of the raw claim message.
+
+Only signatures made with compressed keys are supported, which covers all
+P2WPKH addresses and the P2PKH addresses of current wallets.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if btcAddress == "" {
+				return fmt.Errorf("--btc-address is required")
+			}
+			if btcqAddress == "" {
+				return fmt.Errorf("--btcq-address is required")
+			}
+			if chainID == "" {
+				return fmt.Errorf("--chain-id is required")
+			}
+
+			addressHash, err := zk.BitcoinAddressToHash160(btcAddress)
+			if err != nil {
+				return fmt.Errorf("invalid bitcoin address: %w", err)
+			}
+			addrType, err := zk.DetectAddressType(btcAddress)
+			if err != nil {
+				return err
+			}
+
+			utxoCommitment, err := utxoSetCommitment(messageVersion, utxos)
+			if err != nil {
+				return err
+			}
+			if err := checkExpiryHeight(messageVersion, expiryHeight); err != nil {
+				return err
+			}
+			btcqAddressHash, err := recipientBinding(messageVersion, btcqAddress, destSalt)
+			if err != nil {
+				return err
+			}
+
+			params := zk.VerificationParams{
+				AddressHash:       addressHash,
+				QBTCAddressHash:   btcqAddressHash,
+				ChainID:           zk.ComputeChainIDHash(chainID),
+				FullChainIDHash:   zk.ComputeFullChainIDHash(chainID),
+				MessageVersion:    messageVersion,
+				AddressType:       addrType,
+				SignatureScheme:   zk.SignatureSchemeBIP137,
+				UTXOSetCommitment: utxoCommitment,
+				ExpiryHeight:      expiryHeight,
+			}
+			claimMessage, err := zk.ComputeClaimMessageForParams(params)
+			if err != nil {
+				return err
+			}
+			params.MessageHash, err = zk.ComputeSignedMessageForParams(params)
+			if err != nil {
+				return err
+			}
+
+			if signature == "" {
+				fmt.Printf("Sign this message with %s using your wallet's \"Sign Message\" feature:\n\n", btcAddress)
+				fmt.Println(zk.BIP137MessageText(claimMessage))
+				fmt.Println("\nThen run this command again with --signature <base64 signature>.")
+				return nil
+			}
+
+			proofParams, err := zk.ProofParamsFromBIP137Signature(signature, params)
+			if err != nil {
+				return err
+			}
+			fmt.Println("Signature verified against address")
+
+			prover, err := loadProver(setupDir)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println("Generating PLONK proof...")
+			proof, err := prover.GenerateProofWithPublicInputs(proofParams)
+			if err != nil {
+				return fmt.Errorf("failed to generate proof: %w", err)
+			}
+			proof.VKFingerprint, err = loadVKFingerprint(setupDir)
+			if err != nil {
+				return err
+			}
+			proofBundle, err := proof.ToProtoZKProof()
+			if err != nil {
+				return fmt.Errorf("failed to encode proof: %w", err)
+			}
+
+			return writeProofOutput(ProofOutput{
+				BTCAddressHash:  hex.EncodeToString(addressHash[:]),
+				BTCQAddress:     btcqAddress,
+				ChainID:         chainID,
+				MessageHash:     hex.EncodeToString(params.MessageHash[:]),
+				MessageVersion:  zk.NormalizeClaimMessageVersion(messageVersion),
+				SignatureScheme: zk.SignatureSchemeBIP137,
+				UTXOs:           utxos,
+				ExpiryHeight:    expiryHeight,
+				DestinationSalt: destSalt,
+				ProofData:       hex.EncodeToString(proof.ProofData),
+				ProofBundle:     hex.EncodeToString(proofBundle),
+				VKFingerprint:   hex.EncodeToString(proof.VKFingerprint),
+			}, outputFile)
+		},
+	}
+
+	cmd.Flags().StringVar(&btcAddress, "btc-address", "", "Bitcoin address that signed the message (P2PKH or P2WPKH, required)")
+	cmd.Flags().StringVar(&btcqAddress, "btcq-address", "", "Your qbtc chain address (required)")
+	cmd.Flags().StringVar(&chainID, "chain-id", "", "Chain ID for the proof (required, e.g., 'qbtc-1')")
+	cmd.Flags().StringVar(&setupDir, "setup-dir", "./zk-setup", "Directory containing setup files")
+	cmd.Flags().StringVarP(&outputFile, "output", "o", "", "Output file for the proof (defaults to stdout)")
+	cmd.Flags().StringVar(&messageVersion, "message-version", zk.ClaimMessageVersion, "Claim message version to sign and prove")
+	cmd.Flags().StringVar(&utxos, "utxos", "", "Comma-separated txid:vout list the proof is bound to; required for message versions that bind the UTXO set")
+	cmd.Flags().Uint64Var(&expiryHeight, "expiry-height", 0, "Last qbtc block height the claim is valid at; required for message versions that bind an expiry")
+	cmd.Flags().StringVar(&destSalt, "destination-salt", "", "Hex 32-byte random salt hiding --btcq-address until the claim; required for message versions that bind a salted destination")
+	cmd.Flags().StringVar(&signature, "signature", "", "Base64 BIP-137 signature from the wallet; omit to print the message to sign")
+
+	return cmd
+}
@@ -0,0 +1,339 @@
+package main
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+
+	"github.com/btcq-org/qbtc/x/qbtc/zk"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// setupBundleFile is the name of the bundle 'zkprover setup --bundle' writes
+	setupBundleFile = "setup.tar.gz"
+	// bundleManifestFile describes the other files of a setup bundle
+	bundleManifestFile = "manifest.json"
+	// bundleCircuit names the circuit 'zkprover prove' uses, the only one
+	// load-bundle installs
+	bundleCircuit = "BTCSignatureCircuit"
+)
+
+// setupFiles are the files written by 'zkprover setup', in bundle order.
+var setupFiles = []string{"circuit.cs", "proving.key", "verifying.key", "verifying.key.hex"}
+
+// bundleManifest describes a setup bundle, so a prover can check that the
+// constraint system and keys it received come from the same setup.
+type bundleManifest struct {
+	Circuit     string `json:"circuit"`
+	Constraints int    `json:"constraints"`
+	// VKFingerprint is the zk.VerifyingKeyFingerprint of verifying.key
+	VKFingerprint string `json:"vk_fingerprint"`
+	// TestSRS marks a setup made with the unsafe test SRS
+	TestSRS bool `json:"test_srs"`
+	// Files maps every other file of the bundle to its hex SHA256
+	Files map[string]string `json:"files"`
+}
+
+// writeSetupBundle packs the setup files of dir into dir/setup.tar.gz,
+// preceded by a manifest describing them.
+func writeSetupBundle(dir, circuit string, constraints int, testSRS bool) (string, error) {
+	manifest := bundleManifest{
+		Circuit:     circuit,
+		Constraints: constraints,
+		TestSRS:     testSRS,
+		Files:       make(map[string]string, len(setupFiles)),
+	}
+	for _, name := range setupFiles {
+		hash, err := hashFile(filepath.Join(dir, name))
+		if err != nil {
+			return "", err
+		}
+		manifest.Files[name] = hash
+	}
+	vkBytes, err := os.ReadFile(filepath.Join(dir, "verifying.key"))
+	if err != nil {
+		return "", fmt.Errorf("failed to read verifying key: %w", err)
+	}
+	manifest.VKFingerprint = zk.VerifyingKeyFingerprint(vkBytes)
+	manifestBytes, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal manifest: %w", err)
+	}
+
+	bundlePath := filepath.Join(dir, setupBundleFile)
+	f, err := os.Create(bundlePath)
+	if err != nil {
+		return "", fmt.Errorf("failed to create bundle: %w", err)
+	}
+	defer f.Close()
+	gw := gzip.NewWriter(f)
+	tw := tar.NewWriter(gw)
+
+	if err := writeTarEntry(tw, bundleManifestFile, int64(len(manifestBytes)), bytes.NewReader(manifestBytes)); err != nil {
+		return "", err
+	}
+	for _, name := range setupFiles {
+		if err := writeTarFile(tw, dir, name); err != nil {
+			return "", err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return "", fmt.Errorf("failed to finish bundle: %w", err)
+	}
+	if err := gw.Close(); err != nil {
+		return "", fmt.Errorf("failed to finish bundle: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return "", fmt.Errorf("failed to write bundle: %w", err)
+	}
+	return bundlePath, nil
+}
+
+func writeTarFile(tw *tar.Writer, dir, name string) error {
+	f, err := os.Open(filepath.Join(dir, name))
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", name, err)
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return fmt.Errorf("failed to stat %s: %w", name, err)
+	}
+	return writeTarEntry(tw, name, info.Size(), f)
+}
+
+func writeTarEntry(tw *tar.Writer, name string, size int64, r io.Reader) error {
+	if err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: size, Typeflag: tar.TypeReg}); err != nil {
+		return fmt.Errorf("failed to add %s to bundle: %w", name, err)
+	}
+	if _, err := io.Copy(tw, r); err != nil {
+		return fmt.Errorf("failed to add %s to bundle: %w", name, err)
+	}
+	return nil
+}
+
+// installSetupBundle validates a setup bundle and installs its files into
+// dir. The bundle is unpacked into a staging directory first, so nothing in
+// dir changes unless every check passes.
+func installSetupBundle(bundlePath, dir string) (*bundleManifest, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create setup directory: %w", err)
+	}
+	staging, err := os.MkdirTemp(dir, ".bundle-")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create staging directory: %w", err)
+	}
+	defer os.RemoveAll(staging)
+
+	manifest, err := readSetupBundle(bundlePath, staging)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkBundleConstraints(staging, manifest.Constraints); err != nil {
+		return nil, err
+	}
+	for _, name := range setupFiles {
+		if err := os.Rename(filepath.Join(staging, name), filepath.Join(dir, name)); err != nil {
+			return nil, fmt.Errorf("failed to move %s into place: %w", name, err)
+		}
+	}
+	return manifest, nil
+}
+
+// readSetupBundle unpacks a setup bundle into the empty directory dir and
+// checks the files against the manifest. Only the manifest and the setup
+// files are accepted as entries.
+func readSetupBundle(bundlePath, dir string) (*bundleManifest, error) {
+	f, err := os.Open(bundlePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open bundle: %w", err)
+	}
+	defer f.Close()
+	gr, err := gzip.NewReader(f)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read bundle: %w", err)
+	}
+	defer gr.Close()
+
+	var manifest *bundleManifest
+	hashes := make(map[string]string, len(setupFiles))
+	tr := tar.NewReader(gr)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read bundle: %w", err)
+		}
+		switch {
+		case hdr.Name == bundleManifestFile && manifest == nil:
+			manifest = &bundleManifest{}
+			if err := json.NewDecoder(tr).Decode(manifest); err != nil {
+				return nil, fmt.Errorf("failed to parse manifest: %w", err)
+			}
+		case slices.Contains(setupFiles, hdr.Name) && hdr.Typeflag == tar.TypeReg:
+			if _, done := hashes[hdr.Name]; done {
+				return nil, fmt.Errorf("bundle contains %s twice", hdr.Name)
+			}
+			hashes[hdr.Name], err = extractTarEntry(tr, filepath.Join(dir, hdr.Name))
+			if err != nil {
+				return nil, fmt.Errorf("failed to extract %s: %w", hdr.Name, err)
+			}
+		default:
+			return nil, fmt.Errorf("unexpected bundle entry %q", hdr.Name)
+		}
+	}
+	if manifest == nil {
+		return nil, fmt.Errorf("bundle has no %s", bundleManifestFile)
+	}
+	if err := checkBundleManifest(manifest, hashes, dir); err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+func extractTarEntry(r io.Reader, path string) (string, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0644)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	hash := sha256.New()
+	if _, err := io.Copy(io.MultiWriter(f, hash), r); err != nil {
+		return "", err
+	}
+	if err := f.Close(); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// checkBundleManifest checks that the extracted files are exactly the ones
+// the manifest lists and that the verifying key matches its fingerprint and
+// hex copy.
+func checkBundleManifest(manifest *bundleManifest, hashes map[string]string, dir string) error {
+	if manifest.Circuit != bundleCircuit {
+		return fmt.Errorf("bundle is for circuit %q, expected %s", manifest.Circuit, bundleCircuit)
+	}
+	var problems []string
+	for _, name := range setupFiles {
+		want, listed := manifest.Files[name]
+		got, present := hashes[name]
+		switch {
+		case !present:
+			problems = append(problems, fmt.Sprintf("%s is missing", name))
+		case !listed:
+			problems = append(problems, fmt.Sprintf("%s is not in the manifest", name))
+		case got != want:
+			problems = append(problems, fmt.Sprintf("%s has SHA256 %s, manifest says %s", name, got, want))
+		}
+	}
+	for name := range manifest.Files {
+		if !slices.Contains(setupFiles, name) {
+			problems = append(problems, fmt.Sprintf("manifest lists unknown file %s", name))
+		}
+	}
+	if len(problems) > 0 {
+		sort.Strings(problems)
+		return fmt.Errorf("bundle does not match its manifest: %v", problems)
+	}
+
+	vkBytes, err := os.ReadFile(filepath.Join(dir, "verifying.key"))
+	if err != nil {
+		return fmt.Errorf("failed to read verifying key: %w", err)
+	}
+	if fingerprint := zk.VerifyingKeyFingerprint(vkBytes); fingerprint != manifest.VKFingerprint {
+		return fmt.Errorf("verifying key has fingerprint %s, manifest says %s", fingerprint, manifest.VKFingerprint)
+	}
+	vkHex, err := os.ReadFile(filepath.Join(dir, "verifying.key.hex"))
+	if err != nil {
+		return fmt.Errorf("failed to read verifying key hex: %w", err)
+	}
+	if !bytes.Equal(bytes.TrimSpace(vkHex), []byte(hex.EncodeToString(vkBytes))) {
+		return fmt.Errorf("verifying.key.hex does not hold verifying.key")
+	}
+	return nil
+}
+
+// checkBundleConstraints checks that the constraint system in dir has the
+// constraint count of the manifest and that the proving key deserializes.
+func checkBundleConstraints(dir string, constraints int) error {
+	csBytes, err := os.ReadFile(filepath.Join(dir, "circuit.cs"))
+	if err != nil {
+		return fmt.Errorf("failed to read constraint system: %w", err)
+	}
+	cs, err := zk.DeserializeConstraintSystem(csBytes)
+	if err != nil {
+		return fmt.Errorf("failed to deserialize constraint system: %w", err)
+	}
+	if got := cs.GetNbConstraints(); got != constraints {
+		return fmt.Errorf("constraint system has %d constraints, manifest says %d", got, constraints)
+	}
+	pkBytes, err := os.ReadFile(filepath.Join(dir, "proving.key"))
+	if err != nil {
+		return fmt.Errorf("failed to read proving key: %w", err)
+	}
+	if _, err := zk.DeserializeProvingKey(pkBytes); err != nil {
+		return fmt.Errorf("failed to deserialize proving key: %w", err)
+	}
+	return nil
+}
+
+func hashFile(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to open %s: %w", filepath.Base(path), err)
+	}
+	defer f.Close()
+	hash := sha256.New()
+	if _, err := io.Copy(hash, f); err != nil {
+		return "", fmt.Errorf("failed to hash %s: %w", filepath.Base(path), err)
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// loadBundleCmd creates the command that installs a setup bundle
+func loadBundleCmd() *cobra.Command {
+	var setupDir string
+
+	cmd := &cobra.Command{
+		Use:   "load-bundle <setup.tar.gz>",
+		Short: "Validate a setup bundle and install it into a setup directory",
+		Long: `Validate a setup bundle written by 'zkprover setup --bundle' and install its
+files into the setup directory used by 'zkprover prove'.
+
+Every file must match the SHA256 listed in the bundle manifest, the verifying
+key must match the manifest fingerprint, and the constraint system must have
+the manifest's constraint count. Nothing is installed if any check fails.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			manifest, err := installSetupBundle(args[0], setupDir)
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("Setup bundle installed to: %s\n", setupDir)
+			fmt.Printf("Circuit:        %s (%d constraints)\n", manifest.Circuit, manifest.Constraints)
+			fmt.Printf("VK fingerprint: %s\n", manifest.VKFingerprint)
+			if manifest.TestSRS {
+				fmt.Println("\n⚠️  This setup uses the UNSAFE test SRS - do not use it in production!")
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&setupDir, "setup-dir", "./zk-setup", "Directory to install the setup files into")
+	return cmd
+}
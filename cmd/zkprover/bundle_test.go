@@ -0,0 +1,159 @@
+package main
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/btcq-org/qbtc/x/qbtc/zk"
+	"github.com/stretchr/testify/require"
+)
+
+// writeFakeSetup writes setup files with placeholder contents; the bundle
+// checks up to the constraint count only look at their bytes.
+func writeFakeSetup(t *testing.T, dir string) []byte {
+	vk := []byte("verifying key bytes")
+	files := map[string][]byte{
+		"circuit.cs":        []byte("constraint system bytes"),
+		"proving.key":       []byte("proving key bytes"),
+		"verifying.key":     vk,
+		"verifying.key.hex": []byte(hex.EncodeToString(vk)),
+	}
+	for name, contents := range files {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), contents, 0644))
+	}
+	return vk
+}
+
+func TestSetupBundleRoundTrip(t *testing.T) {
+	setupDir := t.TempDir()
+	vk := writeFakeSetup(t, setupDir)
+
+	bundlePath, err := writeSetupBundle(setupDir, bundleCircuit, 1234, true)
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(setupDir, setupBundleFile), bundlePath)
+
+	outDir := t.TempDir()
+	manifest, err := readSetupBundle(bundlePath, outDir)
+	require.NoError(t, err)
+	require.Equal(t, bundleCircuit, manifest.Circuit)
+	require.Equal(t, 1234, manifest.Constraints)
+	require.True(t, manifest.TestSRS)
+	require.Equal(t, zk.VerifyingKeyFingerprint(vk), manifest.VKFingerprint)
+	require.Len(t, manifest.Files, len(setupFiles))
+	for _, name := range setupFiles {
+		want, err := os.ReadFile(filepath.Join(setupDir, name))
+		require.NoError(t, err)
+		got, err := os.ReadFile(filepath.Join(outDir, name))
+		require.NoError(t, err)
+		require.Equal(t, want, got, name)
+	}
+}
+
+func TestSetupBundleRejectsMismatch(t *testing.T) {
+	tests := []struct {
+		name   string
+		tamper func(t *testing.T, dir string, manifest *bundleManifest)
+		extra  string
+		errMsg string
+	}{
+		{
+			name: "file hash differs",
+			tamper: func(t *testing.T, dir string, _ *bundleManifest) {
+				require.NoError(t, os.WriteFile(filepath.Join(dir, "proving.key"), []byte("other proving key"), 0644))
+			},
+			errMsg: "proving.key has SHA256",
+		},
+		{
+			name: "fingerprint differs",
+			tamper: func(t *testing.T, _ string, manifest *bundleManifest) {
+				manifest.VKFingerprint = zk.VerifyingKeyFingerprint([]byte("another key"))
+			},
+			errMsg: "manifest says",
+		},
+		{
+			name: "hex copy differs",
+			tamper: func(t *testing.T, dir string, manifest *bundleManifest) {
+				path := filepath.Join(dir, "verifying.key.hex")
+				require.NoError(t, os.WriteFile(path, []byte("00"), 0644))
+				hash, err := hashFile(path)
+				require.NoError(t, err)
+				manifest.Files["verifying.key.hex"] = hash
+			},
+			errMsg: "verifying.key.hex does not hold verifying.key",
+		},
+		{
+			name: "other circuit",
+			tamper: func(t *testing.T, _ string, manifest *bundleManifest) {
+				manifest.Circuit = "OtherCircuit"
+			},
+			errMsg: "bundle is for circuit",
+		},
+		{
+			name: "file missing from manifest",
+			tamper: func(t *testing.T, _ string, manifest *bundleManifest) {
+				delete(manifest.Files, "circuit.cs")
+			},
+			errMsg: "circuit.cs is not in the manifest",
+		},
+		{
+			name:   "unexpected entry",
+			extra:  "../escape",
+			errMsg: `unexpected bundle entry "../escape"`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			setupDir := t.TempDir()
+			vk := writeFakeSetup(t, setupDir)
+			manifest := bundleManifest{
+				Circuit:       bundleCircuit,
+				Constraints:   1,
+				VKFingerprint: zk.VerifyingKeyFingerprint(vk),
+				Files:         make(map[string]string),
+			}
+			for _, name := range setupFiles {
+				hash, err := hashFile(filepath.Join(setupDir, name))
+				require.NoError(t, err)
+				manifest.Files[name] = hash
+			}
+			if tc.tamper != nil {
+				tc.tamper(t, setupDir, &manifest)
+			}
+
+			bundlePath := filepath.Join(t.TempDir(), setupBundleFile)
+			writeBundle(t, bundlePath, setupDir, manifest, tc.extra)
+
+			_, err := readSetupBundle(bundlePath, t.TempDir())
+			require.ErrorContains(t, err, tc.errMsg)
+		})
+	}
+}
+
+// writeBundle writes a bundle of the files in dir with the given manifest,
+// plus an extra entry when one is named.
+func writeBundle(t *testing.T, path, dir string, manifest bundleManifest, extra string) {
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	defer f.Close()
+	gw := gzip.NewWriter(f)
+	tw := tar.NewWriter(gw)
+
+	manifestBytes, err := json.Marshal(manifest)
+	require.NoError(t, err)
+	require.NoError(t, writeTarEntry(tw, bundleManifestFile, int64(len(manifestBytes)), bytes.NewReader(manifestBytes)))
+	for _, name := range setupFiles {
+		require.NoError(t, writeTarFile(tw, dir, name))
+	}
+	if extra != "" {
+		require.NoError(t, writeTarEntry(tw, extra, 1, bytes.NewReader([]byte{0})))
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gw.Close())
+}
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/btcq-org/qbtc/x/qbtc/zk"
+)
+
+// defaultCircuitType is the circuit 'zkprover setup' generates keys for
+// unless --circuit-type says otherwise
+const defaultCircuitType = zk.CircuitTypeECDSA
+
+// setupCircuit is a circuit 'zkprover setup' can generate keys for.
+type setupCircuit struct {
+	// name identifies the circuit in setup bundles
+	name  string
+	setup func(zk.SetupOptions) (*zk.SetupResult, error)
+}
+
+// setupCircuits maps the --circuit-type values to their circuits.
+var setupCircuits = map[string]setupCircuit{
+	defaultCircuitType: {name: bundleCircuit, setup: zk.SetupWithOptions},
+}
+
+// plannedCircuitTypes are circuit types the chain is meant to verify that
+// have no circuit yet.
+var plannedCircuitTypes = []string{"schnorr", "p2sh-p2wpkh", "p2pk", zk.CircuitTypeP2WSH}
+
+// lookupSetupCircuit returns the circuit of a --circuit-type value.
+func lookupSetupCircuit(circuitType string) (setupCircuit, error) {
+	circuitType = strings.ToLower(strings.TrimSpace(circuitType))
+	if circuit, ok := setupCircuits[circuitType]; ok {
+		return circuit, nil
+	}
+	supported := make([]string, 0, len(setupCircuits))
+	for name := range setupCircuits {
+		supported = append(supported, name)
+	}
+	sort.Strings(supported)
+	if slices.Contains(plannedCircuitTypes, circuitType) {
+		return setupCircuit{}, fmt.Errorf("circuit type %q is not implemented yet (supported: %s)", circuitType, strings.Join(supported, ", "))
+	}
+	return setupCircuit{}, fmt.Errorf("unknown circuit type %q (supported: %s)", circuitType, strings.Join(supported, ", "))
+}
@@ -0,0 +1,22 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLookupSetupCircuit(t *testing.T) {
+	circuit, err := lookupSetupCircuit("ecdsa")
+	require.NoError(t, err)
+	require.Equal(t, bundleCircuit, circuit.name)
+	require.NotNil(t, circuit.setup)
+
+	_, err = lookupSetupCircuit(" ECDSA ")
+	require.NoError(t, err)
+
+	_, err = lookupSetupCircuit("schnorr")
+	require.ErrorContains(t, err, `circuit type "schnorr" is not implemented yet (supported: ecdsa)`)
+	_, err = lookupSetupCircuit("groth16")
+	require.ErrorContains(t, err, `unknown circuit type "groth16"`)
+}
@@ -0,0 +1,256 @@
+package main
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+
+	"github.com/btcq-org/qbtc/x/qbtc/zk"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/spf13/cobra"
+)
+
+// claimInputs holds the flags describing a claim and where its signature
+// comes from, shared by prove and debug-witness so both build the same
+// witness.
+type claimInputs struct {
+	tssURL         string
+	tssAuthToken   string
+	signatureFile  string
+	btcqAddress    string
+	chainID        string
+	addressHashHex string
+	messageVersion string
+	sigFormat      string
+	addressType    string
+	utxos          string
+	expiryHeight   uint64
+	destSalt       string
+}
+
+// addFlags registers the claim input flags on cmd.
+func (in *claimInputs) addFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&in.tssURL, "tss-url", "", "URL of the TSS signer API (e.g., http://localhost:8080); required unless --signature-file is given")
+	cmd.Flags().StringVar(&in.signatureFile, "signature-file", "", "JSON file with a signature made out of band, in the TSS response format (\"-\" for stdin)")
+	cmd.Flags().StringVar(&in.tssAuthToken, "tss-auth-token", "", "Bearer token for the TSS signer API (or use TSS_AUTH_TOKEN env var)")
+	cmd.Flags().StringVar(&in.btcqAddress, "btcq-address", "", "Your qbtc chain address (required)")
+	cmd.Flags().StringVar(&in.chainID, "chain-id", "", "Chain ID for the proof (required, e.g., 'qbtc-1')")
+	cmd.Flags().StringVar(&in.addressHashHex, "address-hash", "", "Hash160 of your Bitcoin address in hex (required)")
+	cmd.Flags().StringVar(&in.messageVersion, "message-version", zk.ClaimMessageVersion, "Claim message version to sign and prove")
+	cmd.Flags().StringVar(&in.addressType, "address-type", "", "Address type the proof is bound to (p2pkh|p2wpkh); required for message versions that bind it")
+	cmd.Flags().StringVar(&in.utxos, "utxos", "", "Comma-separated txid:vout list the proof is bound to; required for message versions that bind the UTXO set")
+	cmd.Flags().Uint64Var(&in.expiryHeight, "expiry-height", 0, "Last qbtc block height the claim is valid at; required for message versions that bind an expiry")
+	cmd.Flags().StringVar(&in.destSalt, "destination-salt", "", "Hex 32-byte random salt hiding --btcq-address until the claim; required for message versions that bind a salted destination")
+	cmd.Flags().StringVar(&in.sigFormat, "sig-format", sigFormatAuto, "Encoding of the TSS signature: "+strings.Join(validSigFormats, "|"))
+}
+
+// validate checks the flags are complete and consistent.
+func (in *claimInputs) validate() error {
+	if in.tssURL == "" && in.signatureFile == "" {
+		return fmt.Errorf("--tss-url or --signature-file is required")
+	}
+	if in.tssURL != "" && in.signatureFile != "" {
+		return fmt.Errorf("--tss-url and --signature-file cannot be used together")
+	}
+	if in.btcqAddress == "" {
+		return fmt.Errorf("--btcq-address is required")
+	}
+	if in.chainID == "" {
+		return fmt.Errorf("--chain-id is required")
+	}
+	if in.addressHashHex == "" {
+		return fmt.Errorf("--address-hash is required (Hash160 of your Bitcoin address)")
+	}
+	switch in.sigFormat {
+	case sigFormatAuto, sigFormatDER, sigFormatCompact, sigFormatRS:
+	default:
+		return fmt.Errorf("invalid --sig-format %q (expected one of %s)", in.sigFormat, strings.Join(validSigFormats, ", "))
+	}
+	return nil
+}
+
+// claimWitness is a claim's signature together with the proof inputs built
+// from it. rBytes and sBytes are the signature scalars as the signer returned
+// them.
+type claimWitness struct {
+	params zk.ProofParams
+	pubKey *btcec.PublicKey
+	rBytes []byte
+	sBytes []byte
+}
+
+// resolve computes the claim message, obtains its signature from the TSS
+// signer or the signature file and builds the proof inputs. It does not check
+// that the signature verifies or that the public key matches the address
+// hash, so callers can decide how to report either.
+func (in *claimInputs) resolve(cmd *cobra.Command) (claimWitness, error) {
+	// Parse address hash
+	addressHash, err := zk.AddressHashFromHex(in.addressHashHex)
+	if err != nil {
+		return claimWitness{}, fmt.Errorf("invalid address hash: %w", err)
+	}
+
+	// Versions that bind the address type need to know which output
+	// type (P2PKH or P2WPKH) the proof is for
+	var addrType zk.AddressType
+	if zk.ClaimMessageBindsAddressType(in.messageVersion) {
+		if in.addressType == "" {
+			return claimWitness{}, fmt.Errorf("--address-type is required for message version %s", zk.NormalizeClaimMessageVersion(in.messageVersion))
+		}
+		addrType, err = zk.ParseAddressType(in.addressType)
+		if err != nil {
+			return claimWitness{}, err
+		}
+	}
+
+	// Versions that bind the UTXO set need the exact outpoints the
+	// claim will list
+	utxoCommitment, err := utxoSetCommitment(in.messageVersion, in.utxos)
+	if err != nil {
+		return claimWitness{}, err
+	}
+	if err := checkExpiryHeight(in.messageVersion, in.expiryHeight); err != nil {
+		return claimWitness{}, err
+	}
+
+	// Compute btcq address hash for binding
+	btcqAddressHash, err := recipientBinding(in.messageVersion, in.btcqAddress, in.destSalt)
+	if err != nil {
+		return claimWitness{}, err
+	}
+
+	// Compute chain ID hash
+	chainIDHash := zk.ComputeChainIDHash(in.chainID)
+
+	// Compute the claim message that TSS needs to sign
+	messageHash, err := zk.ComputeClaimMessageForParams(zk.VerificationParams{
+		AddressHash:       addressHash,
+		QBTCAddressHash:   btcqAddressHash,
+		ChainID:           chainIDHash,
+		FullChainIDHash:   zk.ComputeFullChainIDHash(in.chainID),
+		MessageVersion:    in.messageVersion,
+		AddressType:       addrType,
+		UTXOSetCommitment: utxoCommitment,
+		ExpiryHeight:      in.expiryHeight,
+	})
+	if err != nil {
+		return claimWitness{}, err
+	}
+	fmt.Printf("Message to sign: %s\n", hex.EncodeToString(messageHash[:]))
+
+	var signResp *TSSSignResponse
+	if in.signatureFile != "" {
+		signResp, err = readSignatureFile(in.signatureFile, cmd.InOrStdin())
+		if err != nil {
+			return claimWitness{}, err
+		}
+		fmt.Println("Read signature from file")
+	} else {
+		// Request signature from TSS
+		fmt.Printf("Requesting signature from TSS at %s...\n", in.tssURL)
+		authToken := in.tssAuthToken
+		if authToken == "" {
+			authToken = os.Getenv("TSS_AUTH_TOKEN")
+		}
+		signResp, err = requestTSSSignature(in.tssURL, authToken, messageHash)
+		if err != nil {
+			return claimWitness{}, fmt.Errorf("failed to get TSS signature: %w", err)
+		}
+		fmt.Println("Received signature from TSS")
+	}
+
+	// Parse the signature components
+	rBytes, sBytes, err := parseTSSSignature(signResp.Signature, in.sigFormat)
+	if err != nil {
+		return claimWitness{}, fmt.Errorf("invalid TSS signature: %w", err)
+	}
+	pubKey, err := signerPublicKey(signResp, rBytes, sBytes, messageHash)
+	if err != nil {
+		return claimWitness{}, err
+	}
+
+	return claimWitness{
+		params: zk.ProofParams{
+			SignatureR:      new(big.Int).SetBytes(padTo32Bytes(rBytes)),
+			SignatureS:      new(big.Int).SetBytes(padTo32Bytes(sBytes)),
+			PublicKeyX:      pubKey.X(),
+			PublicKeyY:      pubKey.Y(),
+			MessageHash:     messageHash,
+			AddressHash:     addressHash,
+			BTCQAddressHash: btcqAddressHash,
+			ChainID:         chainIDHash,
+			MessageVersion:  in.messageVersion,
+			AddressType:     addrType,
+		},
+		pubKey: pubKey,
+		rBytes: rBytes,
+		sBytes: sBytes,
+	}, nil
+}
+
+// signerPublicKey returns the public key of the TSS response, or recovers it
+// from the signature's recovery ID for signers that only return (r, s, v).
+func signerPublicKey(signResp *TSSSignResponse, rBytes, sBytes []byte, messageHash [32]byte) (*btcec.PublicKey, error) {
+	if signResp.PublicKey == "" {
+		v, err := parseTSSRecoveryID(signResp.Signature)
+		if err != nil {
+			return nil, fmt.Errorf("TSS response has no public_key and it cannot be recovered: %w", err)
+		}
+		pubKey, err := zk.RecoverPublicKey(new(big.Int).SetBytes(rBytes), new(big.Int).SetBytes(sBytes), v, messageHash)
+		if err != nil {
+			return nil, err
+		}
+		fmt.Println("Recovered public key from signature")
+		return pubKey, nil
+	}
+
+	pubKeyBytes, err := hex.DecodeString(signResp.PublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid public key: %w", err)
+	}
+	// Parse public key to get X, Y coordinates
+	pubKey, err := btcec.ParsePubKey(pubKeyBytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse public key: %w", err)
+	}
+	return pubKey, nil
+}
+
+// checkExpiryHeight checks the --expiry-height flag is set exactly when the
+// message version binds an expiry height.
+func checkExpiryHeight(messageVersion string, expiryHeight uint64) error {
+	if !zk.ClaimMessageBindsExpiry(messageVersion) {
+		if expiryHeight != 0 {
+			return fmt.Errorf("--expiry-height is only used by message versions that bind an expiry")
+		}
+		return nil
+	}
+	if expiryHeight == 0 {
+		return fmt.Errorf("--expiry-height is required for message version %s", zk.NormalizeClaimMessageVersion(messageVersion))
+	}
+	return nil
+}
+
+// recipientBinding returns the QBTCAddressHash binding the proof to
+// btcqAddress: the commitment salted with the --destination-salt flag for
+// message versions binding a salted destination, its plain hash otherwise.
+func recipientBinding(messageVersion, btcqAddress, saltHex string) ([32]byte, error) {
+	if !zk.ClaimMessageSaltsDestination(messageVersion) {
+		if saltHex != "" {
+			return [32]byte{}, fmt.Errorf("--destination-salt is only used by message versions that bind a salted destination")
+		}
+		return zk.HashBTCQAddress(btcqAddress), nil
+	}
+	if saltHex == "" {
+		return [32]byte{}, fmt.Errorf("--destination-salt is required for message version %s", zk.NormalizeClaimMessageVersion(messageVersion))
+	}
+	var salt [zk.DestinationSaltSize]byte
+	decoded, err := hex.DecodeString(saltHex)
+	if err != nil || len(decoded) != len(salt) {
+		return [32]byte{}, fmt.Errorf("--destination-salt must be %d hex-encoded bytes", len(salt))
+	}
+	copy(salt[:], decoded)
+	return zk.CommitBTCQAddress(btcqAddress, salt), nil
+}
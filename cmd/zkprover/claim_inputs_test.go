@@ -0,0 +1,40 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/btcq-org/qbtc/x/qbtc/zk"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecipientBinding(t *testing.T) {
+	const address = "qbtc1test"
+	saltHex := strings.Repeat("5a", zk.DestinationSaltSize)
+
+	binding, err := recipientBinding(zk.ClaimMessageVersionV6, address, saltHex)
+	require.NoError(t, err)
+	var salt [zk.DestinationSaltSize]byte
+	for i := range salt {
+		salt[i] = 0x5a
+	}
+	require.Equal(t, zk.CommitBTCQAddress(address, salt), binding)
+
+	binding, err = recipientBinding(zk.ClaimMessageVersionV3, address, "")
+	require.NoError(t, err)
+	require.Equal(t, zk.HashBTCQAddress(address), binding)
+
+	_, err = recipientBinding(zk.ClaimMessageVersionV6, address, "")
+	require.ErrorContains(t, err, "--destination-salt is required")
+	_, err = recipientBinding(zk.ClaimMessageVersionV6, address, "abcd")
+	require.ErrorContains(t, err, "32 hex-encoded bytes")
+	_, err = recipientBinding(zk.ClaimMessageVersionV3, address, saltHex)
+	require.ErrorContains(t, err, "only used")
+}
+
+func TestCheckExpiryHeight(t *testing.T) {
+	require.NoError(t, checkExpiryHeight(zk.ClaimMessageVersionV5, 100))
+	require.NoError(t, checkExpiryHeight(zk.ClaimMessageVersionV3, 0))
+	require.ErrorContains(t, checkExpiryHeight(zk.ClaimMessageVersionV5, 0), "--expiry-height is required")
+	require.ErrorContains(t, checkExpiryHeight(zk.ClaimMessageVersionV3, 100), "only used")
+}
@@ -0,0 +1,38 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"runtime"
+	"strconv"
+
+	"github.com/btcq-org/qbtc/x/qbtc/zk"
+)
+
+// proverConcurrencyEnv sets the default of --prover-concurrency
+const proverConcurrencyEnv = "ZKPROVER_CONCURRENCY"
+
+// defaultProverConcurrency reads the --prover-concurrency default from the
+// environment, falling back to zero (all CPUs)
+func defaultProverConcurrency() int {
+	n, err := strconv.Atoi(os.Getenv(proverConcurrencyEnv))
+	if err != nil {
+		return 0
+	}
+	return n
+}
+
+// applyProverConcurrency caps proving at n cores. zkprover generates a
+// single proof per process, so besides gnark's solver tasks it also lowers
+// GOMAXPROCS, which bounds the FFT and MSM stages.
+func applyProverConcurrency(n int) error {
+	if n < 0 {
+		return fmt.Errorf("invalid --prover-concurrency %d", n)
+	}
+	if n == 0 {
+		return nil
+	}
+	zk.SetProverConcurrency(n)
+	runtime.GOMAXPROCS(n)
+	return nil
+}
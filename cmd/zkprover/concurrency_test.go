@@ -0,0 +1,21 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultProverConcurrency(t *testing.T) {
+	t.Setenv(proverConcurrencyEnv, "")
+	require.Zero(t, defaultProverConcurrency())
+
+	t.Setenv(proverConcurrencyEnv, "4")
+	require.Equal(t, 4, defaultProverConcurrency())
+
+	t.Setenv(proverConcurrencyEnv, "four")
+	require.Zero(t, defaultProverConcurrency())
+
+	require.ErrorContains(t, applyProverConcurrency(-1), "invalid --prover-concurrency")
+	require.NoError(t, applyProverConcurrency(0))
+}
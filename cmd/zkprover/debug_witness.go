@@ -0,0 +1,52 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/btcq-org/qbtc/x/qbtc/zk"
+	"github.com/spf13/cobra"
+)
+
+// debugWitnessCmd creates the command that reports which circuit constraint
+// the witness prove would build does not satisfy
+func debugWitnessCmd() *cobra.Command {
+	var inputs claimInputs
+
+	cmd := &cobra.Command{
+		Use:   "debug-witness",
+		Short: "Report which circuit constraint a claim's witness fails",
+		Long: `Build the witness 'zkprover prove' would prove with the same flags and check
+it against the circuit, reporting the first constraint it does not satisfy.
+
+When proving fails, plonk only reports that the witness is unsatisfied. This
+command instead names the failing check, e.g. an address hash mismatch at the
+Hash160 check when the signer's public key belongs to another address, or a
+signature that does not verify at the ECDSA check when another message was
+signed. Inputs passing those checks are run through gnark's test solver,
+whose error points at the failing constraint in the circuit.
+
+The signature is obtained like for prove, from --tss-url or --signature-file.
+No setup files are needed; solving the circuit takes about as long as
+compiling it.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := inputs.validate(); err != nil {
+				return err
+			}
+			claim, err := inputs.resolve(cmd)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println("Solving the circuit for the witness...")
+			if err := zk.CheckWitness(claim.params); err != nil {
+				return fmt.Errorf("witness does not satisfy the circuit: %w", err)
+			}
+			fmt.Println("Witness satisfies the circuit; prove succeeds with these inputs")
+			return nil
+		},
+	}
+
+	inputs.addFlags(cmd)
+
+	return cmd
+}
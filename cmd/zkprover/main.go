@@ -8,13 +8,12 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
-	"math/big"
 	"net/http"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/btcq-org/qbtc/x/qbtc/zk"
-	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/spf13/cobra"
 )
 
@@ -33,10 +32,23 @@ and public key from on-chain observers.
 Uses PLONK proof system with Hermez Powers of Tau ceremony SRS.`,
 	}
 
+	var proverConcurrency int
+	rootCmd.PersistentFlags().IntVar(&proverConcurrency, "prover-concurrency", defaultProverConcurrency(),
+		"Maximum number of CPU cores used to generate a proof; 0 uses all cores (or set "+proverConcurrencyEnv+")")
+	rootCmd.PersistentPreRunE = func(cmd *cobra.Command, args []string) error {
+		return applyProverConcurrency(proverConcurrency)
+	}
+
 	rootCmd.AddCommand(
 		setupCmd(),
 		proveCmd(),
+		proveMessageCmd(),
 		addressCmd(),
+		inspectCmd(),
+		testVectorsCmd(),
+		loadBundleCmd(),
+		memprofileCmd(),
+		debugWitnessCmd(),
 	)
 
 	if err := rootCmd.Execute(); err != nil {
@@ -48,9 +60,13 @@ Uses PLONK proof system with Hermez Powers of Tau ceremony SRS.`,
 // setupCmd creates the trusted setup command
 func setupCmd() *cobra.Command {
 	var (
-		outputDir string
-		testMode  bool
-		cacheDir  string
+		outputDir       string
+		testMode        bool
+		cacheDir        string
+		srsFile         string
+		srsLagrangeFile string
+		bundle          bool
+		circuitType     string
 	)
 
 	cmd := &cobra.Command{
@@ -64,32 +80,52 @@ By default, this command downloads and uses the Hermez/Polygon Powers of Tau
 ceremony SRS, which is a production-ready trusted setup. The SRS is cached
 locally for future use.
 
-Use --test flag only for development/testing with an unsafe test SRS.`,
+Use --test flag only for development/testing with an unsafe test SRS. It is
+refused when ` + zk.ProductionEnv + ` is set to true.
+
+Use --srs-file and --srs-lagrange-file together to load a pre-staged SRS
+instead of downloading it, e.g. on an air-gapped machine. Both are in gnark's
+format, like the srs_bn254_*.dat and srs_lagrange_bn254_*.dat files a
+previous run left in its --cache-dir; the Lagrange SRS must fit the circuit.
+
+Use --circuit-type to choose the circuit. Only the ECDSA signature circuit
+(ecdsa) exists so far; the Schnorr, P2SH-P2WPKH, P2PK and P2WSH circuits the
+chain is meant to verify are rejected until they are implemented.
+
+Use --bundle to also pack the files with a manifest into setup.tar.gz, which
+'zkprover load-bundle' validates and installs on the proving machine.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			fmt.Println("Generating PLONK trusted setup...")
+			if testMode && zk.IsProduction() {
+				return zk.ErrTestSRSInProduction
+			}
+			opts, err := setupOptions(testMode, cacheDir, srsFile, srsLagrangeFile)
+			if err != nil {
+				return err
+			}
+			circuit, err := lookupSetupCircuit(circuitType)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("Generating PLONK trusted setup for %s...\n", circuit.name)
 			fmt.Println("This may take a few minutes...")
 
-			var opts zk.SetupOptions
-			if testMode {
-				fmt.Println("")
+			fmt.Println("")
+			switch opts.Mode {
+			case zk.SetupModeTest:
 				fmt.Println("⚠️  WARNING: Using UNSAFE test SRS!")
 				fmt.Println("⚠️  DO NOT use these keys in production!")
 				fmt.Println("⚠️  Anyone can forge proofs with test SRS keys.")
-				fmt.Println("")
-				opts = zk.TestSetupOptions()
-			} else {
-				fmt.Println("")
+			case zk.SetupModeFile:
+				fmt.Printf("✓ Using SRS from %s and %s\n", srsFile, srsLagrangeFile)
+				fmt.Println("✓ Make sure these files come from a trusted ceremony")
+			default:
 				fmt.Println("✓ Using Hermez/Polygon Powers of Tau ceremony SRS")
 				fmt.Println("✓ This is a production-ready trusted setup")
-				fmt.Println("")
-				opts = zk.DefaultSetupOptions()
-				if cacheDir != "" {
-					opts.CacheDir = cacheDir
-				}
 			}
+			fmt.Println("")
 
 			// Run the setup
-			setup, err := zk.SetupWithOptions(opts)
+			setup, err := circuit.setup(opts)
 			if err != nil {
 				return fmt.Errorf("setup failed: %w", err)
 			}
@@ -139,6 +175,14 @@ Use --test flag only for development/testing with an unsafe test SRS.`,
 			}
 			fmt.Printf("Verifying key (hex) saved to: %s\n", vkHexPath)
 
+			if bundle {
+				bundlePath, err := writeSetupBundle(outputDir, circuit.name, setup.ConstraintSystem.GetNbConstraints(), testMode)
+				if err != nil {
+					return fmt.Errorf("failed to write setup bundle: %w", err)
+				}
+				fmt.Printf("Setup bundle saved to: %s\n", bundlePath)
+			}
+
 			fmt.Println("\nSetup complete!")
 			fmt.Println("Use the proving.key and circuit.cs files to generate proofs.")
 			fmt.Println("Add the verifying.key.hex content to genesis.json as zk_verifying_key.")
@@ -155,6 +199,10 @@ Use --test flag only for development/testing with an unsafe test SRS.`,
 	cmd.Flags().StringVarP(&outputDir, "output", "o", "./zk-setup", "Output directory for keys")
 	cmd.Flags().BoolVar(&testMode, "test", false, "Use unsafe test SRS (development only, DO NOT use in production)")
 	cmd.Flags().StringVar(&cacheDir, "cache-dir", "", "Directory to cache downloaded SRS files (default: ~/.qbtc/zk-cache)")
+	cmd.Flags().StringVar(&srsFile, "srs-file", "", "Load the canonical SRS from this gnark-format file instead of downloading it (requires --srs-lagrange-file)")
+	cmd.Flags().StringVar(&srsLagrangeFile, "srs-lagrange-file", "", "Load the Lagrange SRS from this gnark-format file (requires --srs-file)")
+	cmd.Flags().StringVar(&circuitType, "circuit-type", defaultCircuitType, "Circuit to set up: ecdsa (schnorr, p2sh-p2wpkh, p2pk and p2wsh are not implemented yet)")
+	cmd.Flags().BoolVar(&bundle, "bundle", false, "Also write all setup files with a manifest to "+setupBundleFile)
 
 	return cmd
 }
@@ -162,12 +210,10 @@ Use --test flag only for development/testing with an unsafe test SRS.`,
 // proveCmd creates the prove command for TSS-compatible proof generation
 func proveCmd() *cobra.Command {
 	var (
-		tssURL         string
-		btcqAddress    string
-		chainID        string
-		addressHashHex string
-		setupDir       string
-		outputFile     string
+		inputs        claimInputs
+		setupDir      string
+		outputFile    string
+		skipAddrCheck bool
 	)
 
 	cmd := &cobra.Command{
@@ -181,159 +227,104 @@ The command will:
 2. Request a signature from the TSS signer API
 3. Generate a ZK proof that the signature is valid for the claimed address
 
-The proof proves ownership without revealing the signature or public key.`,
-		RunE: func(cmd *cobra.Command, args []string) error {
-			if tssURL == "" {
-				return fmt.Errorf("--tss-url is required")
-			}
-			if btcqAddress == "" {
-				return fmt.Errorf("--btcq-address is required")
-			}
-			if chainID == "" {
-				return fmt.Errorf("--chain-id is required")
-			}
-			if addressHashHex == "" {
-				return fmt.Errorf("--address-hash is required (Hash160 of your Bitcoin address)")
-			}
+The proof proves ownership without revealing the signature or public key.
 
-			// Parse address hash
-			addressHash, err := zk.AddressHashFromHex(addressHashHex)
-			if err != nil {
-				return fmt.Errorf("invalid address hash: %w", err)
-			}
+Message versions that bind the UTXO set (qbtc-claim-v4 and later) pin the
+proof to the outpoints given with --utxos. The claim must then list exactly
+those UTXOs.
 
-			// Compute btcq address hash for binding
-			btcqAddressHash := zk.HashBTCQAddress(btcqAddress)
+The TSS signature may be returned as separate r/s fields or as a single
+DER or compact (64/65-byte) hex string. By default the encoding is detected
+automatically; use --sig-format to force one.
 
-			// Compute chain ID hash
-			chainIDHash := zk.ComputeChainIDHash(chainID)
+For signers that run offline, pass the signature with --signature-file
+instead of --tss-url. The file holds the same JSON as the TSS response,
+{"signature": ..., "public_key": "..."}, and "-" reads it from stdin. Sign the
+message printed by this command, which does not depend on the signature.
 
-			// Compute the claim message that TSS needs to sign
-			messageHash := zk.ComputeClaimMessage(addressHash, btcqAddressHash, chainIDHash)
-			fmt.Printf("Message to sign: %s\n", hex.EncodeToString(messageHash[:]))
+public_key may be left out when the signature carries a recovery ID (a v
+field, or a 65-byte compact signature); the key is then recovered from it.
 
-			// Request signature from TSS
-			fmt.Printf("Requesting signature from TSS at %s...\n", tssURL)
-			signResp, err := requestTSSSignature(tssURL, messageHash)
-			if err != nil {
-				return fmt.Errorf("failed to get TSS signature: %w", err)
-			}
-			fmt.Println("Received signature from TSS")
+--skip-address-check is for debugging only: it proves even when the signer's
+public key does not hash to --address-hash, to tell an address derivation
+mismatch apart from an unsatisfied circuit. Such a proof never verifies.`,
 
-			// Parse the signature components
-			rBytes, err := hex.DecodeString(signResp.Signature.R)
-			if err != nil {
-				return fmt.Errorf("invalid signature R: %w", err)
-			}
-			sBytes, err := hex.DecodeString(signResp.Signature.S)
-			if err != nil {
-				return fmt.Errorf("invalid signature S: %w", err)
-			}
-			pubKeyBytes, err := hex.DecodeString(signResp.PublicKey)
-			if err != nil {
-				return fmt.Errorf("invalid public key: %w", err)
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := inputs.validate(); err != nil {
+				return err
 			}
-
-			// Parse public key to get X, Y coordinates
-			pubKey, err := btcec.ParsePubKey(pubKeyBytes)
+			claim, err := inputs.resolve(cmd)
 			if err != nil {
-				return fmt.Errorf("failed to parse public key: %w", err)
+				return err
 			}
+			params := claim.params
 
 			// Verify the public key matches the claimed address hash
-			computedHash, err := zk.PublicKeyToAddressHash(pubKey.SerializeCompressed())
+			computedHash, err := zk.PublicKeyToAddressHash(claim.pubKey.SerializeCompressed())
 			if err != nil {
 				return fmt.Errorf("failed to compute address hash from public key: %w", err)
 			}
-			if !bytes.Equal(computedHash[:], addressHash[:]) {
-				return fmt.Errorf("public key from TSS does not match claimed address hash")
+			switch {
+			case bytes.Equal(computedHash[:], params.AddressHash[:]):
+				fmt.Println("Public key verified against address hash")
+			case skipAddrCheck:
+				fmt.Println("⚠️  WARNING: --skip-address-check is for debugging only!")
+				fmt.Printf("⚠️  The signer's public key hashes to %x, not to the claimed %x.\n", computedHash, params.AddressHash)
+				fmt.Println("⚠️  Proving anyway; the circuit rejects the mismatch, no valid proof can result.")
+			default:
+				return fmt.Errorf("public key from the signer does not match claimed address hash")
 			}
-			fmt.Println("Public key verified against address hash")
 
-			// Convert to big.Int for the prover
-			sigR := new(big.Int).SetBytes(padTo32Bytes(rBytes))
-			sigS := new(big.Int).SetBytes(padTo32Bytes(sBytes))
-			pubKeyX := pubKey.X()
-			pubKeyY := pubKey.Y()
+			// A signer working out of band may have signed another message;
+			// catch it here rather than as an unsatisfied circuit constraint
+			if !verifySignature(claim.rBytes, claim.sBytes, params.MessageHash, claim.pubKey) {
+				return fmt.Errorf("signature does not verify for message %s", hex.EncodeToString(params.MessageHash[:]))
+			}
 
 			// Load the setup files
-			csPath := filepath.Join(setupDir, "circuit.cs")
-			csBytes, err := os.ReadFile(csPath)
-			if err != nil {
-				return fmt.Errorf("failed to read constraint system: %w", err)
-			}
-			cs, err := zk.DeserializeConstraintSystem(csBytes)
+			prover, err := loadProver(setupDir)
 			if err != nil {
-				return fmt.Errorf("failed to deserialize constraint system: %w", err)
+				return err
 			}
 
-			pkPath := filepath.Join(setupDir, "proving.key")
-			pkBytes, err := os.ReadFile(pkPath)
+			// Generate the proof
+			fmt.Println("Generating PLONK proof...")
+			proof, err := prover.GenerateProofWithPublicInputs(params)
 			if err != nil {
-				return fmt.Errorf("failed to read proving key: %w", err)
+				return fmt.Errorf("failed to generate proof: %w (run 'zkprover debug-witness' with the same flags to find the failing constraint)", err)
 			}
-			pk, err := zk.DeserializeProvingKey(pkBytes)
+			proof.VKFingerprint, err = loadVKFingerprint(setupDir)
 			if err != nil {
-				return fmt.Errorf("failed to deserialize proving key: %w", err)
+				return err
 			}
 
-			// Create prover
-			prover := zk.NewProver(cs, pk)
-
-			// Generate the proof
-			fmt.Println("Generating PLONK proof...")
-			proof, err := prover.GenerateProof(zk.ProofParams{
-				SignatureR:      sigR,
-				SignatureS:      sigS,
-				PublicKeyX:      pubKeyX,
-				PublicKeyY:      pubKeyY,
-				MessageHash:     messageHash,
-				AddressHash:     addressHash,
-				BTCQAddressHash: btcqAddressHash,
-				ChainID:         chainIDHash,
-			})
+			proofBundle, err := proof.ToProtoZKProof()
 			if err != nil {
-				return fmt.Errorf("failed to generate proof: %w", err)
+				return fmt.Errorf("failed to encode proof: %w", err)
 			}
 
 			// Create the output
 			output := ProofOutput{
-				BTCAddressHash: hex.EncodeToString(addressHash[:]),
-				BTCQAddress:    btcqAddress,
-				ChainID:        chainID,
-				MessageHash:    hex.EncodeToString(messageHash[:]),
-				ProofData:      hex.EncodeToString(proof),
-			}
-
-			// Serialize to JSON
-			outputBytes, err := json.MarshalIndent(output, "", "  ")
-			if err != nil {
-				return fmt.Errorf("failed to serialize output: %w", err)
-			}
-
-			// Write to file or stdout
-			if outputFile != "" {
-				if err := os.WriteFile(outputFile, outputBytes, 0644); err != nil {
-					return fmt.Errorf("failed to write output: %w", err)
-				}
-				fmt.Printf("Proof saved to: %s\n", outputFile)
-			} else {
-				fmt.Println(string(outputBytes))
-			}
-
-			fmt.Println("\nProof generation complete!")
-			fmt.Println("Submit this proof to the qbtc chain to claim your airdrop.")
-
-			return nil
+				BTCAddressHash:  hex.EncodeToString(params.AddressHash[:]),
+				BTCQAddress:     inputs.btcqAddress,
+				ChainID:         inputs.chainID,
+				MessageHash:     hex.EncodeToString(params.MessageHash[:]),
+				MessageVersion:  zk.NormalizeClaimMessageVersion(inputs.messageVersion),
+				UTXOs:           inputs.utxos,
+				ExpiryHeight:    inputs.expiryHeight,
+				DestinationSalt: inputs.destSalt,
+				ProofData:       hex.EncodeToString(proof.ProofData),
+				ProofBundle:     hex.EncodeToString(proofBundle),
+				VKFingerprint:   hex.EncodeToString(proof.VKFingerprint),
+			}
+			return writeProofOutput(output, outputFile)
 		},
 	}
 
-	cmd.Flags().StringVar(&tssURL, "tss-url", "", "URL of the TSS signer API (required, e.g., http://localhost:8080)")
-	cmd.Flags().StringVar(&btcqAddress, "btcq-address", "", "Your qbtc chain address (required)")
-	cmd.Flags().StringVar(&chainID, "chain-id", "", "Chain ID for the proof (required, e.g., 'qbtc-1')")
-	cmd.Flags().StringVar(&addressHashHex, "address-hash", "", "Hash160 of your Bitcoin address in hex (required)")
+	inputs.addFlags(cmd)
 	cmd.Flags().StringVar(&setupDir, "setup-dir", "./zk-setup", "Directory containing setup files")
 	cmd.Flags().StringVarP(&outputFile, "output", "o", "", "Output file for the proof (defaults to stdout)")
+	cmd.Flags().BoolVar(&skipAddrCheck, "skip-address-check", false, "DEBUG ONLY: prove even if the signer's public key does not match --address-hash")
 
 	return cmd
 }
@@ -357,6 +348,9 @@ func addressCmd() *cobra.Command {
 			}
 
 			fmt.Printf("Address Hash (Hash160): %s\n", hex.EncodeToString(addressHash[:]))
+			if addressType, err := zk.DetectAddressType(btcAddress); err == nil {
+				fmt.Printf("Address Type:           %s\n", addressType)
+			}
 			return nil
 		},
 	}
@@ -365,13 +359,155 @@ func addressCmd() *cobra.Command {
 	return cmd
 }
 
+// inspectCmd creates the command that decodes a serialized proof bundle
+func inspectCmd() *cobra.Command {
+	var proofArg string
+
+	cmd := &cobra.Command{
+		Use:   "inspect",
+		Short: "Decode a serialized proof bundle and print its public inputs",
+		Long: `Parse a proof bundle (the proof_bundle field of 'zkprover prove' output)
+and print its structure together with the public inputs it was generated for.
+
+This shows which Bitcoin address, destination and chain a proof is bound to
+without needing access to the chain. The --proof value may be a hex string
+or the path to a file containing hex or raw bytes.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if proofArg == "" {
+				return fmt.Errorf("--proof is required")
+			}
+
+			data, err := readProofArg(proofArg)
+			if err != nil {
+				return err
+			}
+
+			proof, err := zk.ProofFromProtoZKProof(data)
+			if err != nil {
+				return fmt.Errorf("failed to parse proof bundle: %w", err)
+			}
+
+			fmt.Printf("Proof data length:    %d bytes\n", len(proof.ProofData))
+			fmt.Printf("Public inputs length: %d bytes\n", len(proof.PublicInputs))
+			if len(proof.VKFingerprint) > 0 {
+				fmt.Printf("VK fingerprint:       %s\n", hex.EncodeToString(proof.VKFingerprint))
+			}
+
+			params, err := zk.DecodePublicInputs(proof.PublicInputs)
+			if err != nil {
+				return fmt.Errorf("failed to decode public inputs: %w", err)
+			}
+
+			fmt.Printf("MessageHash:          %s\n", hex.EncodeToString(params.MessageHash[:]))
+			fmt.Printf("AddressHash:          %s\n", hex.EncodeToString(params.AddressHash[:]))
+			fmt.Printf("BTCQAddressHash:      %s\n", hex.EncodeToString(params.QBTCAddressHash[:]))
+			fmt.Printf("ChainID:              %s\n", hex.EncodeToString(params.ChainID[:]))
+
+			if !zk.VerifyClaimMessage(params.MessageHash, params.AddressHash, params.QBTCAddressHash, params.ChainID) {
+				fmt.Println("\n⚠️  MessageHash does not match the claim message computed from the other inputs")
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&proofArg, "proof", "", "Proof bundle as hex, or path to a file containing it (required)")
+	return cmd
+}
+
+// readProofArg resolves a --proof value that is either a file path or a hex string.
+// File contents are hex-decoded when possible and used as raw bytes otherwise.
+func readProofArg(arg string) ([]byte, error) {
+	if _, err := os.Stat(arg); err == nil {
+		contents, err := os.ReadFile(arg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read proof file: %w", err)
+		}
+		if decoded, err := hex.DecodeString(strings.TrimSpace(string(contents))); err == nil {
+			return decoded, nil
+		}
+		return contents, nil
+	}
+
+	decoded, err := hex.DecodeString(strings.TrimPrefix(strings.TrimSpace(arg), "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("--proof is neither a readable file nor valid hex: %w", err)
+	}
+	return decoded, nil
+}
+
 // ProofOutput is the JSON output structure for a generated proof
 type ProofOutput struct {
-	BTCAddressHash string `json:"btc_address_hash"`
-	BTCQAddress    string `json:"btcq_address"`
-	ChainID        string `json:"chain_id"`
-	MessageHash    string `json:"message_hash"`
-	ProofData      string `json:"proof_data"`
+	BTCAddressHash  string `json:"btc_address_hash"`
+	BTCQAddress     string `json:"btcq_address"`
+	ChainID         string `json:"chain_id"`
+	MessageHash     string `json:"message_hash"`
+	MessageVersion  string `json:"message_version"`
+	SignatureScheme string `json:"signature_scheme,omitempty"`
+	UTXOs           string `json:"utxos,omitempty"`
+	ExpiryHeight    uint64 `json:"expiry_height,omitempty"`
+	DestinationSalt string `json:"destination_salt,omitempty"`
+	ProofData       string `json:"proof_data"`
+	ProofBundle     string `json:"proof_bundle"`
+	VKFingerprint   string `json:"vk_fingerprint"`
+}
+
+// writeProofOutput writes the proof output as JSON to outputFile, or to
+// stdout when outputFile is empty.
+func writeProofOutput(output ProofOutput, outputFile string) error {
+	outputBytes, err := json.MarshalIndent(output, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to serialize output: %w", err)
+	}
+
+	// Write to file or stdout
+	if outputFile != "" {
+		if err := os.WriteFile(outputFile, outputBytes, 0644); err != nil {
+			return fmt.Errorf("failed to write output: %w", err)
+		}
+		fmt.Printf("Proof saved to: %s\n", outputFile)
+	} else {
+		fmt.Println(string(outputBytes))
+	}
+
+	fmt.Println("\nProof generation complete!")
+	fmt.Println("Submit this proof to the qbtc chain to claim your airdrop.")
+	return nil
+}
+
+// loadProver loads the constraint system and proving key written by setup.
+func loadProver(setupDir string) (*zk.Prover, error) {
+	csBytes, err := os.ReadFile(filepath.Join(setupDir, "circuit.cs"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read constraint system: %w", err)
+	}
+	cs, err := zk.DeserializeConstraintSystem(csBytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to deserialize constraint system: %w", err)
+	}
+
+	pkBytes, err := os.ReadFile(filepath.Join(setupDir, "proving.key"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read proving key: %w", err)
+	}
+	pk, err := zk.DeserializeProvingKey(pkBytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to deserialize proving key: %w", err)
+	}
+
+	return zk.NewProver(cs, pk), nil
+}
+
+// loadVKFingerprint returns the short fingerprint of the verifying key in the
+// setup directory, which the proof bundle carries so a chain running another
+// key rejects it with a clear error.
+func loadVKFingerprint(setupDir string) ([]byte, error) {
+	vkBytes, err := os.ReadFile(filepath.Join(setupDir, "verifying.key"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read verifying key: %w", err)
+	}
+	fingerprint := zk.ShortVerifyingKeyFingerprint(vkBytes)
+	return fingerprint[:], nil
 }
 
 // TSSSignRequest is the request body for the TSS /sign endpoint
@@ -380,20 +516,23 @@ type TSSSignRequest struct {
 }
 
 // TSSSignatureData contains the ECDSA signature components from TSS
+// signers that return R and S as separate fields
 type TSSSignatureData struct {
 	R string `json:"r"`
 	S string `json:"s"`
 	V int    `json:"v"`
 }
 
-// TSSSignResponse is the response from the TSS /sign endpoint
+// TSSSignResponse is the response from the TSS /sign endpoint.
+// Signature is either a TSSSignatureData object or a DER/compact hex string.
 type TSSSignResponse struct {
-	Signature TSSSignatureData `json:"signature"`
-	PublicKey string           `json:"public_key"`
+	Signature json.RawMessage `json:"signature"`
+	PublicKey string          `json:"public_key"`
 }
 
-// requestTSSSignature requests a signature from the TSS emulator
-func requestTSSSignature(tssURL string, messageHash [32]byte) (*TSSSignResponse, error) {
+// requestTSSSignature requests a signature from the TSS emulator.
+// authToken is sent as a bearer token when non-empty.
+func requestTSSSignature(tssURL, authToken string, messageHash [32]byte) (*TSSSignResponse, error) {
 	// Prepare request
 	reqBody := TSSSignRequest{
 		MessageHash: hex.EncodeToString(messageHash[:]),
@@ -404,7 +543,15 @@ func requestTSSSignature(tssURL string, messageHash [32]byte) (*TSSSignResponse,
 	}
 
 	// Make HTTP request
-	resp, err := http.Post(tssURL+"/sign", "application/json", bytes.NewReader(reqBytes))
+	req, err := http.NewRequest(http.MethodPost, tssURL+"/sign", bytes.NewReader(reqBytes))
+	if err != nil {
+		return nil, fmt.Errorf("failed to build request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if authToken != "" {
+		req.Header.Set("Authorization", "Bearer "+authToken)
+	}
+	resp, err := http.DefaultClient.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("HTTP request failed: %w", err)
 	}
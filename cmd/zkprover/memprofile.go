@@ -0,0 +1,227 @@
+package main
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"os"
+	"runtime"
+	"runtime/pprof"
+	"sync"
+	"time"
+
+	"github.com/btcq-org/qbtc/x/qbtc/zk"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	"github.com/spf13/cobra"
+)
+
+// memprofileSeed derives the throwaway key 'zkprover memprofile' proves with
+const memprofileSeed = "zkprover-memprofile"
+
+// memprofileChainID is the chain the throwaway claim is bound to
+const memprofileChainID = "memprofile"
+
+// memprofileCmd creates the command that measures the memory one proof takes
+func memprofileCmd() *cobra.Command {
+	var (
+		circuitType    string
+		setupDir       string
+		heapProfile    string
+		sampleInterval time.Duration
+	)
+
+	cmd := &cobra.Command{
+		Use:   "memprofile",
+		Short: "Measure the memory used to generate one proof",
+		Long: `Generate a single proof for a throwaway key with the setup in --setup-dir
+and report how much memory it took, to size proving machines.
+
+The heap is sampled every --sample-interval while proving. The report shows
+the memory held after loading the setup, the peak heap in use and the peak
+memory obtained from the OS, which is what the machine has to provide per
+prover. Divide the memory of the machine by the peak to choose how many
+provers it runs, and tune --prover-concurrency along with it: fewer cores per
+proof trade proving time for running more proofs side by side.
+
+Use --heap-profile to also write a pprof heap profile, taken right after
+proving, for 'go tool pprof'.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			circuit, err := lookupSetupCircuit(circuitType)
+			if err != nil {
+				return err
+			}
+			if sampleInterval <= 0 {
+				return fmt.Errorf("invalid --sample-interval %s", sampleInterval)
+			}
+			params, err := memprofileProofParams()
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("Loading %s setup from %s...\n", circuit.name, setupDir)
+			prover, err := loadProver(setupDir)
+			if err != nil {
+				return err
+			}
+			runtime.GC()
+			var loaded runtime.MemStats
+			runtime.ReadMemStats(&loaded)
+
+			fmt.Println("Generating PLONK proof...")
+			sampler := startMemSampler(sampleInterval)
+			start := time.Now()
+			_, err = prover.GenerateProof(params)
+			elapsed := time.Since(start)
+			peak := sampler.stop()
+			if err != nil {
+				return fmt.Errorf("failed to generate proof: %w", err)
+			}
+
+			if heapProfile != "" {
+				if err := writeHeapProfile(heapProfile); err != nil {
+					return err
+				}
+				fmt.Printf("Heap profile saved to: %s\n", heapProfile)
+			}
+
+			fmt.Println("")
+			fmt.Printf("Circuit:                 %s\n", circuit.name)
+			fmt.Printf("Prover concurrency:      %s\n", describeProverConcurrency())
+			fmt.Printf("Proving time:            %s\n", elapsed.Round(time.Millisecond))
+			fmt.Printf("Heap after setup load:   %s\n", formatBytes(loaded.HeapInuse))
+			fmt.Printf("Peak heap in use:        %s\n", formatBytes(peak.HeapInuse))
+			fmt.Printf("Peak memory from OS:     %s\n", formatBytes(peak.Sys))
+			fmt.Printf("Allocated while proving: %s\n", formatBytes(peak.TotalAlloc-loaded.TotalAlloc))
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&circuitType, "circuit-type", defaultCircuitType, "Circuit to profile: ecdsa (schnorr, p2sh-p2wpkh, p2pk and p2wsh are not implemented yet)")
+	cmd.Flags().StringVar(&setupDir, "setup-dir", "./zk-setup", "Directory containing setup files for the circuit")
+	cmd.Flags().StringVar(&heapProfile, "heap-profile", "", "Also write a pprof heap profile to this file")
+	cmd.Flags().DurationVar(&sampleInterval, "sample-interval", 50*time.Millisecond, "How often to sample memory usage while proving")
+
+	return cmd
+}
+
+// memprofileProofParams signs a claim with a fixed throwaway key, so the
+// profiled proof does the same work as a real one without needing a signer.
+func memprofileProofParams() (zk.ProofParams, error) {
+	seed := sha256.Sum256([]byte(memprofileSeed))
+	privKey, pubKey := btcec.PrivKeyFromBytes(seed[:])
+	compressed := pubKey.SerializeCompressed()
+
+	addressHash, err := zk.PublicKeyToAddressHash(compressed)
+	if err != nil {
+		return zk.ProofParams{}, err
+	}
+	params := zk.VerificationParams{
+		AddressHash:     addressHash,
+		QBTCAddressHash: zk.HashBTCQAddress(memprofileSeed),
+		ChainID:         zk.ComputeChainIDHash(memprofileChainID),
+		FullChainIDHash: zk.ComputeFullChainIDHash(memprofileChainID),
+	}
+	params.MessageHash, err = zk.ComputeClaimMessageForParams(params)
+	if err != nil {
+		return zk.ProofParams{}, err
+	}
+
+	compact := ecdsa.SignCompact(privKey, params.MessageHash[:], true)
+	return zk.ProofParamsFromSignature(compact[1:33], compact[33:65], compressed, params)
+}
+
+// memSample is the peak memory usage seen by a memSampler. TotalAlloc is
+// the cumulative value at the last sample.
+type memSample struct {
+	HeapInuse  uint64
+	Sys        uint64
+	TotalAlloc uint64
+}
+
+// memSampler polls runtime.ReadMemStats in the background and keeps the
+// peak values, since MemStats itself only reports the current ones.
+type memSampler struct {
+	done chan struct{}
+	wg   sync.WaitGroup
+	mu   sync.Mutex
+	peak memSample
+}
+
+// startMemSampler takes a first sample and keeps sampling every interval
+// until stop is called.
+func startMemSampler(interval time.Duration) *memSampler {
+	s := &memSampler{done: make(chan struct{})}
+	s.sample()
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				s.sample()
+			case <-s.done:
+				return
+			}
+		}
+	}()
+	return s
+}
+
+func (s *memSampler) sample() {
+	var stats runtime.MemStats
+	runtime.ReadMemStats(&stats)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.peak.HeapInuse = max(s.peak.HeapInuse, stats.HeapInuse)
+	s.peak.Sys = max(s.peak.Sys, stats.Sys)
+	s.peak.TotalAlloc = stats.TotalAlloc
+}
+
+// stop ends sampling, takes a last sample and returns the peaks.
+func (s *memSampler) stop() memSample {
+	close(s.done)
+	s.wg.Wait()
+	s.sample()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.peak
+}
+
+// writeHeapProfile writes a pprof heap profile to path.
+func writeHeapProfile(path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create heap profile: %w", err)
+	}
+	if err := pprof.WriteHeapProfile(f); err != nil {
+		f.Close()
+		return fmt.Errorf("failed to write heap profile: %w", err)
+	}
+	return f.Close()
+}
+
+// describeProverConcurrency reports the core cap the proof ran with.
+func describeProverConcurrency() string {
+	if n := zk.ProverConcurrency(); n > 0 {
+		return fmt.Sprintf("%d cores", n)
+	}
+	return fmt.Sprintf("all cores (%d)", runtime.NumCPU())
+}
+
+// formatBytes renders a byte count in binary units, e.g. "1.5 GiB".
+func formatBytes(n uint64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := uint64(unit), 0
+	for m := n / unit; m >= unit && exp < 4; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTP"[exp])
+}
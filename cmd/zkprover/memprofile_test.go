@@ -0,0 +1,33 @@
+package main
+
+import (
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatBytes(t *testing.T) {
+	require.Equal(t, "0 B", formatBytes(0))
+	require.Equal(t, "1023 B", formatBytes(1023))
+	require.Equal(t, "1.0 KiB", formatBytes(1024))
+	require.Equal(t, "1.5 MiB", formatBytes(3<<19))
+	require.Equal(t, "12.0 GiB", formatBytes(12<<30))
+	require.Equal(t, "2048.0 PiB", formatBytes(1<<61))
+}
+
+func TestMemSamplerKeepsPeak(t *testing.T) {
+	sampler := startMemSampler(time.Millisecond)
+	buf := make([]byte, 64<<20)
+	for i := range buf {
+		buf[i] = byte(i)
+	}
+	time.Sleep(10 * time.Millisecond)
+	peak := sampler.stop()
+	runtime.KeepAlive(buf)
+
+	require.GreaterOrEqual(t, peak.HeapInuse, uint64(len(buf)))
+	require.GreaterOrEqual(t, peak.Sys, peak.HeapInuse)
+	require.GreaterOrEqual(t, peak.TotalAlloc, uint64(len(buf)))
+}
@@ -0,0 +1,39 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/btcq-org/qbtc/x/qbtc/zk"
+)
+
+// setupOptions picks the SRS source for 'zkprover setup' from its flags: the
+// unsafe test SRS for --test, local files for --srs-file and
+// --srs-lagrange-file, and the cached Hermez download otherwise.
+func setupOptions(testMode bool, cacheDir, srsFile, srsLagrangeFile string) (zk.SetupOptions, error) {
+	fromFiles := srsFile != "" || srsLagrangeFile != ""
+	if fromFiles && (srsFile == "" || srsLagrangeFile == "") {
+		return zk.SetupOptions{}, fmt.Errorf("--srs-file and --srs-lagrange-file must be given together")
+	}
+
+	switch {
+	case testMode && fromFiles:
+		return zk.SetupOptions{}, fmt.Errorf("--test cannot be combined with --srs-file")
+	case testMode:
+		return zk.TestSetupOptions(), nil
+	case fromFiles:
+		if cacheDir != "" {
+			return zk.SetupOptions{}, fmt.Errorf("--cache-dir is only used for the downloaded SRS, not with --srs-file")
+		}
+		return zk.SetupOptions{
+			Mode:            zk.SetupModeFile,
+			SRSPath:         srsFile,
+			SRSLagrangePath: srsLagrangeFile,
+		}, nil
+	}
+
+	opts := zk.DefaultSetupOptions()
+	if cacheDir != "" {
+		opts.CacheDir = cacheDir
+	}
+	return opts, nil
+}
@@ -0,0 +1,36 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/btcq-org/qbtc/x/qbtc/zk"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetupOptions(t *testing.T) {
+	opts, err := setupOptions(false, "", "srs.dat", "srs_lagrange.dat")
+	require.NoError(t, err)
+	require.Equal(t, zk.SetupOptions{
+		Mode:            zk.SetupModeFile,
+		SRSPath:         "srs.dat",
+		SRSLagrangePath: "srs_lagrange.dat",
+	}, opts)
+
+	opts, err = setupOptions(false, "/tmp/zk-cache", "", "")
+	require.NoError(t, err)
+	require.Equal(t, zk.SetupModeDownload, opts.Mode)
+	require.Equal(t, "/tmp/zk-cache", opts.CacheDir)
+
+	opts, err = setupOptions(true, "", "", "")
+	require.NoError(t, err)
+	require.Equal(t, zk.SetupModeTest, opts.Mode)
+
+	_, err = setupOptions(false, "", "srs.dat", "")
+	require.ErrorContains(t, err, "must be given together")
+	_, err = setupOptions(false, "", "", "srs_lagrange.dat")
+	require.ErrorContains(t, err, "must be given together")
+	_, err = setupOptions(true, "", "srs.dat", "srs_lagrange.dat")
+	require.ErrorContains(t, err, "--test cannot be combined")
+	_, err = setupOptions(false, "/tmp/zk-cache", "srs.dat", "srs_lagrange.dat")
+	require.ErrorContains(t, err, "--cache-dir is only used")
+}
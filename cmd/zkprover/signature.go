@@ -0,0 +1,228 @@
+package main
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
+)
+
+// Supported values for the --sig-format flag.
+const (
+	sigFormatAuto    = "auto"
+	sigFormatDER     = "der"
+	sigFormatCompact = "compact"
+	sigFormatRS      = "rs"
+)
+
+// validSigFormats lists the accepted --sig-format values in help-text order.
+var validSigFormats = []string{sigFormatAuto, sigFormatDER, sigFormatCompact, sigFormatRS}
+
+// parseTSSSignature extracts the R and S components from the signature field
+// of a TSS response. The field is either an object with separate r/s hex
+// fields, or a single hex string holding a DER or compact signature.
+//
+// With format auto, an object is read as r/s and a string is tried as DER
+// first and then as compact. Any other format forces that encoding.
+func parseTSSSignature(raw json.RawMessage, format string) (r, s []byte, err error) {
+	raw = bytes.TrimSpace(raw)
+	if len(raw) == 0 || bytes.Equal(raw, []byte("null")) {
+		return nil, nil, fmt.Errorf("TSS response has no signature")
+	}
+
+	if raw[0] == '{' {
+		if format != sigFormatAuto && format != sigFormatRS {
+			return nil, nil, fmt.Errorf("TSS returned r/s signature fields but --sig-format is %q", format)
+		}
+		var data TSSSignatureData
+		if err := json.Unmarshal(raw, &data); err != nil {
+			return nil, nil, fmt.Errorf("failed to parse signature fields: %w", err)
+		}
+		return parseRSSignature(data)
+	}
+
+	var sigHex string
+	if err := json.Unmarshal(raw, &sigHex); err != nil {
+		return nil, nil, fmt.Errorf("signature must be an object with r/s fields or a hex string: %w", err)
+	}
+	sig, err := hex.DecodeString(strings.TrimPrefix(strings.TrimSpace(sigHex), "0x"))
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid signature hex: %w", err)
+	}
+
+	switch format {
+	case sigFormatDER:
+		return parseDERSignature(sig)
+	case sigFormatCompact:
+		return parseCompactSignature(sig)
+	case sigFormatAuto:
+		r, s, derErr := parseDERSignature(sig)
+		if derErr == nil {
+			return r, s, nil
+		}
+		r, s, compactErr := parseCompactSignature(sig)
+		if compactErr == nil {
+			return r, s, nil
+		}
+		return nil, nil, fmt.Errorf("signature is neither DER (%v) nor compact (%v)", derErr, compactErr)
+	case sigFormatRS:
+		return nil, nil, fmt.Errorf("TSS returned a single signature value but --sig-format is rs")
+	default:
+		return nil, nil, fmt.Errorf("unknown signature format %q (expected one of %s)", format, strings.Join(validSigFormats, ", "))
+	}
+}
+
+// parseRSSignature decodes separately hex-encoded R and S values.
+func parseRSSignature(data TSSSignatureData) (r, s []byte, err error) {
+	r, err = hex.DecodeString(strings.TrimPrefix(data.R, "0x"))
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid signature R: %w", err)
+	}
+	s, err = hex.DecodeString(strings.TrimPrefix(data.S, "0x"))
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid signature S: %w", err)
+	}
+	if err := checkSignatureScalars(r, s); err != nil {
+		return nil, nil, err
+	}
+	return r, s, nil
+}
+
+// parseDERSignature decodes a strict DER-encoded ECDSA signature.
+func parseDERSignature(sig []byte) (r, s []byte, err error) {
+	parsed, err := ecdsa.ParseDERSignature(sig)
+	if err != nil {
+		return nil, nil, err
+	}
+	rScalar, sScalar := parsed.R(), parsed.S()
+	rBytes, sBytes := rScalar.Bytes(), sScalar.Bytes()
+	return rBytes[:], sBytes[:], nil
+}
+
+// parseCompactSignature decodes a 64-byte R || S signature, or a 65-byte
+// signature carrying a recovery byte. The recovery byte is either a leading
+// Bitcoin compact header (27-34) or a trailing Ethereum-style V (0, 1, 27, 28).
+func parseCompactSignature(sig []byte) (r, s []byte, err error) {
+	switch len(sig) {
+	case 64:
+		r, s = sig[:32], sig[32:]
+	case 65:
+		switch {
+		case sig[0] >= 27 && sig[0] <= 34:
+			r, s = sig[1:33], sig[33:]
+		case sig[64] <= 1 || sig[64] == 27 || sig[64] == 28:
+			r, s = sig[:32], sig[32:64]
+		default:
+			return nil, nil, fmt.Errorf("unrecognized recovery byte in 65-byte compact signature")
+		}
+	default:
+		return nil, nil, fmt.Errorf("compact signature must be 64 or 65 bytes, got %d", len(sig))
+	}
+	if err := checkSignatureScalars(r, s); err != nil {
+		return nil, nil, err
+	}
+	return r, s, nil
+}
+
+// parseTSSRecoveryID extracts the recovery ID from the signature field of a
+// TSS response: the v field of an r/s object, or the header or trailing byte
+// of a 65-byte compact signature. It is needed to recover the public key when
+// the signer does not return one.
+func parseTSSRecoveryID(raw json.RawMessage) (int, error) {
+	raw = bytes.TrimSpace(raw)
+	if len(raw) > 0 && raw[0] == '{' {
+		var data struct {
+			V *int `json:"v"`
+		}
+		if err := json.Unmarshal(raw, &data); err != nil {
+			return 0, fmt.Errorf("failed to parse signature fields: %w", err)
+		}
+		if data.V == nil {
+			return 0, fmt.Errorf("signature has no v field")
+		}
+		return *data.V, nil
+	}
+
+	var sigHex string
+	if err := json.Unmarshal(raw, &sigHex); err != nil {
+		return 0, fmt.Errorf("signature must be an object with r/s fields or a hex string: %w", err)
+	}
+	sig, err := hex.DecodeString(strings.TrimPrefix(strings.TrimSpace(sigHex), "0x"))
+	if err != nil {
+		return 0, fmt.Errorf("invalid signature hex: %w", err)
+	}
+	if len(sig) == 65 {
+		switch {
+		case sig[0] >= 27 && sig[0] <= 34:
+			return int(sig[0]), nil
+		case sig[64] <= 1 || sig[64] == 27 || sig[64] == 28:
+			return int(sig[64]), nil
+		}
+	}
+	return 0, fmt.Errorf("only r/s/v objects and 65-byte compact signatures carry a recovery ID")
+}
+
+// checkSignatureScalars ensures R and S are non-zero and below the curve order.
+func checkSignatureScalars(r, s []byte) error {
+	if err := checkSignatureScalar("R", r); err != nil {
+		return err
+	}
+	return checkSignatureScalar("S", s)
+}
+
+func checkSignatureScalar(name string, v []byte) error {
+	if len(v) == 0 || len(v) > 32 {
+		return fmt.Errorf("signature %s must be 1-32 bytes, got %d", name, len(v))
+	}
+	var scalar btcec.ModNScalar
+	if overflow := scalar.SetByteSlice(v); overflow {
+		return fmt.Errorf("signature %s is not below the curve order", name)
+	}
+	if scalar.IsZero() {
+		return fmt.Errorf("signature %s is zero", name)
+	}
+	return nil
+}
+
+// verifySignature reports whether r and s are a valid ECDSA signature of hash
+// under pubKey.
+func verifySignature(r, s []byte, hash [32]byte, pubKey *btcec.PublicKey) bool {
+	var rScalar, sScalar btcec.ModNScalar
+	if rScalar.SetByteSlice(r) || sScalar.SetByteSlice(s) {
+		return false
+	}
+	return ecdsa.NewSignature(&rScalar, &sScalar).Verify(hash[:], pubKey)
+}
+
+// readSignatureFile reads a signature produced out of band, e.g. by an
+// offline MPC ceremony. The file has the same JSON shape as the response of
+// the TSS /sign endpoint. A path of "-" reads from stdin.
+func readSignatureFile(path string, stdin io.Reader) (*TSSSignResponse, error) {
+	var (
+		data []byte
+		err  error
+	)
+	if path == "-" {
+		data, err = io.ReadAll(stdin)
+	} else {
+		data, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read signature file: %w", err)
+	}
+
+	var signResp TSSSignResponse
+	if err := json.Unmarshal(data, &signResp); err != nil {
+		return nil, fmt.Errorf("failed to parse signature file: %w", err)
+	}
+	if len(signResp.Signature) == 0 {
+		return nil, fmt.Errorf("signature file has no signature")
+	}
+	return &signResp, nil
+}
@@ -0,0 +1,170 @@
+package main
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTSSSignature(t *testing.T) {
+	privKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	hash := make([]byte, 32)
+	for i := range hash {
+		hash[i] = byte(i)
+	}
+
+	sig := ecdsa.Sign(privKey, hash)
+	r, s := sig.R(), sig.S()
+	rBytes, sBytes := r.Bytes(), s.Bytes()
+
+	// header || R || S as produced by SignCompact
+	compact := ecdsa.SignCompact(privKey, hash, true)
+	rs := append(append([]byte{}, rBytes[:]...), sBytes[:]...)
+	rsv := append(append([]byte{}, rs...), 1)
+
+	quoted := func(b []byte) json.RawMessage {
+		raw, err := json.Marshal(hex.EncodeToString(b))
+		require.NoError(t, err)
+		return raw
+	}
+	rsObject, err := json.Marshal(TSSSignatureData{R: hex.EncodeToString(rBytes[:]), S: hex.EncodeToString(sBytes[:])})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		raw    json.RawMessage
+		format string
+	}{
+		{"rs object auto", rsObject, sigFormatAuto},
+		{"rs object forced", rsObject, sigFormatRS},
+		{"der auto", quoted(sig.Serialize()), sigFormatAuto},
+		{"der forced", quoted(sig.Serialize()), sigFormatDER},
+		{"compact 64 auto", quoted(rs), sigFormatAuto},
+		{"compact 65 header auto", quoted(compact), sigFormatAuto},
+		{"compact 65 trailing v forced", quoted(rsv), sigFormatCompact},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotR, gotS, err := parseTSSSignature(tt.raw, tt.format)
+			require.NoError(t, err)
+			require.Equal(t, rBytes[:], padTo32Bytes(gotR))
+			require.Equal(t, sBytes[:], padTo32Bytes(gotS))
+		})
+	}
+}
+
+func TestParseTSSSignature_Invalid(t *testing.T) {
+	rsObject := json.RawMessage(`{"r":"01","s":"02"}`)
+	zeroR := json.RawMessage(`"` + hex.EncodeToString(make([]byte, 64)) + `"`)
+
+	tests := []struct {
+		name   string
+		raw    json.RawMessage
+		format string
+	}{
+		{"missing", nil, sigFormatAuto},
+		{"null", json.RawMessage("null"), sigFormatAuto},
+		{"object with der format", rsObject, sigFormatDER},
+		{"string with rs format", json.RawMessage(`"0102"`), sigFormatRS},
+		{"bad hex", json.RawMessage(`"zz"`), sigFormatAuto},
+		{"wrong length", json.RawMessage(`"0102"`), sigFormatAuto},
+		{"zero scalars", zeroR, sigFormatCompact},
+		{"unknown format", json.RawMessage(`"0102"`), "pem"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := parseTSSSignature(tt.raw, tt.format)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestReadSignatureFile(t *testing.T) {
+	const contents = `{"signature":{"r":"01","s":"02","v":0},"public_key":"02aa"}`
+	path := filepath.Join(t.TempDir(), "signature.json")
+	require.NoError(t, os.WriteFile(path, []byte(contents), 0o600))
+
+	fromFile, err := readSignatureFile(path, nil)
+	require.NoError(t, err)
+	require.Equal(t, "02aa", fromFile.PublicKey)
+	r, s, err := parseTSSSignature(fromFile.Signature, sigFormatRS)
+	require.NoError(t, err)
+	require.Equal(t, []byte{1}, r)
+	require.Equal(t, []byte{2}, s)
+
+	fromStdin, err := readSignatureFile("-", strings.NewReader(contents))
+	require.NoError(t, err)
+	require.Equal(t, fromFile, fromStdin)
+
+	_, err = readSignatureFile("-", strings.NewReader(`{"public_key":"02aa"}`))
+	require.ErrorContains(t, err, "no signature")
+	// The public key is optional, it can be recovered from the signature
+	withoutKey, err := readSignatureFile("-", strings.NewReader(`{"signature":{"r":"01","s":"02","v":1}}`))
+	require.NoError(t, err)
+	require.Empty(t, withoutKey.PublicKey)
+	_, err = readSignatureFile("-", strings.NewReader(`not json`))
+	require.ErrorContains(t, err, "failed to parse")
+	_, err = readSignatureFile(filepath.Join(t.TempDir(), "missing.json"), nil)
+	require.ErrorContains(t, err, "failed to read")
+}
+
+func TestVerifySignature(t *testing.T) {
+	privKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	var hash [32]byte
+	hash[0] = 1
+
+	sig := ecdsa.Sign(privKey, hash[:])
+	r, s := sig.R(), sig.S()
+	rBytes, sBytes := r.Bytes(), s.Bytes()
+	require.True(t, verifySignature(rBytes[:], sBytes[:], hash, privKey.PubKey()))
+
+	var other [32]byte
+	require.False(t, verifySignature(rBytes[:], sBytes[:], other, privKey.PubKey()))
+}
+
+func TestParseTSSRecoveryID(t *testing.T) {
+	privKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	var hash [32]byte
+	hash[0] = 1
+	compact := ecdsa.SignCompact(privKey, hash[:], true)
+	quoted := func(b []byte) json.RawMessage {
+		raw, err := json.Marshal(hex.EncodeToString(b))
+		require.NoError(t, err)
+		return raw
+	}
+
+	v, err := parseTSSRecoveryID(quoted(compact))
+	require.NoError(t, err)
+	require.Equal(t, int(compact[0]), v)
+
+	// The recovered key is the signer's
+	r, s, err := parseTSSSignature(quoted(compact), sigFormatAuto)
+	require.NoError(t, err)
+	pubKey, err := signerPublicKey(&TSSSignResponse{Signature: quoted(compact)}, r, s, hash)
+	require.NoError(t, err)
+	require.True(t, pubKey.IsEqual(privKey.PubKey()))
+
+	trailing := append(append([]byte{}, compact[1:]...), 1)
+	v, err = parseTSSRecoveryID(quoted(trailing))
+	require.NoError(t, err)
+	require.Equal(t, 1, v)
+
+	v, err = parseTSSRecoveryID(json.RawMessage(`{"r":"01","s":"02","v":28}`))
+	require.NoError(t, err)
+	require.Equal(t, 28, v)
+
+	_, err = parseTSSRecoveryID(json.RawMessage(`{"r":"01","s":"02"}`))
+	require.ErrorContains(t, err, "no v field")
+	_, err = parseTSSRecoveryID(quoted(compact[1:]))
+	require.ErrorContains(t, err, "carry a recovery ID")
+}
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/btcq-org/qbtc/x/qbtc/zk"
+	"github.com/spf13/cobra"
+)
+
+// testVectorsCmd creates the command that writes golden test vectors for
+// other prover implementations
+func testVectorsCmd() *cobra.Command {
+	var (
+		outputFile string
+		setupDir   string
+		noProofs   bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "test-vectors",
+		Short: "Write deterministic test vectors for cross-implementation testing",
+		Long: `Write golden test vectors as JSON. For every vector case the file contains
+a fixed-seed private key, the derived address and address hash, the claim
+message, the signed digest, the signature, the public inputs and a proof.
+
+Everything except the proofs is deterministic: the keys are derived from the
+case names and signatures use RFC 6979 nonces. PLONK proofs are randomized,
+so they change on every run. They always verify against the verifying key from
+--setup-dir, which is embedded in the file. Reuse the same setup directory to
+keep the verifying key stable across regenerations.
+
+Use --no-proofs to skip proving. The file then contains only the deterministic
+fields and needs no setup.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if outputFile == "" {
+				return fmt.Errorf("--out is required")
+			}
+
+			var (
+				prover  *zk.Prover
+				vkBytes []byte
+				err     error
+			)
+			if !noProofs {
+				prover, err = loadProver(setupDir)
+				if err != nil {
+					return err
+				}
+				vkBytes, err = os.ReadFile(filepath.Join(setupDir, "verifying.key"))
+				if err != nil {
+					return fmt.Errorf("failed to read verifying key: %w", err)
+				}
+				fmt.Println("Generating proofs for the test vectors...")
+			}
+
+			file, err := zk.GenerateTestVectors(prover, vkBytes)
+			if err != nil {
+				return err
+			}
+			// Check the file like consumers will before writing it
+			if err := zk.VerifyTestVectors(file); err != nil {
+				return fmt.Errorf("generated test vectors do not verify: %w", err)
+			}
+
+			data, err := json.MarshalIndent(file, "", "  ")
+			if err != nil {
+				return fmt.Errorf("failed to serialize test vectors: %w", err)
+			}
+			if err := os.WriteFile(outputFile, append(data, '\n'), 0644); err != nil {
+				return fmt.Errorf("failed to write test vectors: %w", err)
+			}
+			fmt.Printf("Wrote %d test vectors to %s\n", len(file.Vectors), outputFile)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&outputFile, "out", "", "Output file for the test vectors (required)")
+	cmd.Flags().StringVar(&setupDir, "setup-dir", "./zk-setup", "Directory containing setup files")
+	cmd.Flags().BoolVar(&noProofs, "no-proofs", false, "Only write the deterministic fields, without proofs")
+
+	return cmd
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/btcq-org/qbtc/x/qbtc/zk"
+)
+
+// parseOutpoints parses a comma-separated list of txid:vout outpoints
+func parseOutpoints(value string) ([]zk.Outpoint, error) {
+	var outpoints []zk.Outpoint
+	for _, item := range strings.Split(value, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		txid, voutStr, ok := strings.Cut(item, ":")
+		if !ok {
+			return nil, fmt.Errorf("invalid outpoint %q (expected txid:vout)", item)
+		}
+		vout, err := strconv.ParseUint(voutStr, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid output index in %q: %w", item, err)
+		}
+		outpoints = append(outpoints, zk.Outpoint{Txid: txid, Vout: uint32(vout)})
+	}
+	return outpoints, nil
+}
+
+// utxoSetCommitment returns the UTXO set commitment for the --utxos flag when
+// the message version binds the UTXO set, and the zero commitment otherwise.
+func utxoSetCommitment(messageVersion, utxos string) ([32]byte, error) {
+	if !zk.ClaimMessageBindsUTXOSet(messageVersion) {
+		if utxos != "" {
+			return [32]byte{}, fmt.Errorf("--utxos is only used by message versions that bind the UTXO set")
+		}
+		return [32]byte{}, nil
+	}
+	if utxos == "" {
+		return [32]byte{}, fmt.Errorf("--utxos is required for message version %s", zk.NormalizeClaimMessageVersion(messageVersion))
+	}
+	outpoints, err := parseOutpoints(utxos)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	return zk.ComputeUTXOSetCommitment(outpoints)
+}
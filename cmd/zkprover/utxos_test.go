@@ -0,0 +1,36 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/btcq-org/qbtc/x/qbtc/zk"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUTXOSetCommitment(t *testing.T) {
+	txid := strings.Repeat("ab", 32)
+
+	outpoints, err := parseOutpoints(txid + ":1, " + txid + ":0")
+	require.NoError(t, err)
+	require.Equal(t, []zk.Outpoint{{Txid: txid, Vout: 1}, {Txid: txid, Vout: 0}}, outpoints)
+
+	commitment, err := utxoSetCommitment(zk.ClaimMessageVersionV4, txid+":0,"+txid+":1")
+	require.NoError(t, err)
+	expected, err := zk.ComputeUTXOSetCommitment(outpoints)
+	require.NoError(t, err)
+	require.Equal(t, expected, commitment)
+
+	_, err = utxoSetCommitment(zk.ClaimMessageVersionV4, "")
+	require.ErrorContains(t, err, "--utxos is required")
+	_, err = utxoSetCommitment(zk.ClaimMessageVersionV3, txid+":0")
+	require.ErrorContains(t, err, "only used")
+	_, err = utxoSetCommitment(zk.ClaimMessageVersionV4, txid)
+	require.ErrorContains(t, err, "expected txid:vout")
+	_, err = utxoSetCommitment(zk.ClaimMessageVersionV4, txid+":x")
+	require.ErrorContains(t, err, "invalid output index")
+
+	commitment, err = utxoSetCommitment(zk.ClaimMessageVersionV3, "")
+	require.NoError(t, err)
+	require.Zero(t, commitment)
+}
@@ -68,6 +68,19 @@ func (c Chain) AddressPrefix(cn ChainNetwork) string {
 	return ""
 }
 
+// BitcoinNetParams returns the parameters of the Bitcoin network a BTCQ network
+// indexes: mainnet for mainnet and stagenet, regtest for mocknet.
+func BitcoinNetParams(cn ChainNetwork) *chaincfg.Params {
+	switch cn {
+	case MockNet:
+		return &chaincfg.RegressionNetParams
+	case TestNet:
+		return &chaincfg.TestNet3Params
+	default:
+		return &chaincfg.MainNetParams
+	}
+}
+
 func (c Chain) IsBTCQChain() bool {
 	return c.Equals(BTCQChain)
 }
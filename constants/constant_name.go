@@ -9,6 +9,19 @@ const (
 	EmissionCurve ConstantName = iota
 	BlocksPerYear
 	ClaimWithProofDisabled
+	ClaimedUTXOPruningDisabled
+	ClaimedUTXORetentionBlocks
+	FirstClaimAccountCreationDisabled
+	FirstClaimMaxGas
+	ClaimCooldownBlocks
+	AttestationSlack
+	AttestationValidatorGas
+	AttestationSignatureGas
+	CoinbaseMaturity
+	BlockMerkleRootCheckDisabled
+	ClaimExpiryRequired
+	MaxClaimExpiryBlocks
+	UTXOBackfillBatchSize
 )
 
 func FromString(s string) (ConstantName, bool) {
@@ -19,6 +32,32 @@ func FromString(s string) (ConstantName, bool) {
 		return BlocksPerYear, true
 	case "ClaimWithProofDisabled":
 		return ClaimWithProofDisabled, true
+	case "ClaimedUTXOPruningDisabled":
+		return ClaimedUTXOPruningDisabled, true
+	case "ClaimedUTXORetentionBlocks":
+		return ClaimedUTXORetentionBlocks, true
+	case "FirstClaimAccountCreationDisabled":
+		return FirstClaimAccountCreationDisabled, true
+	case "FirstClaimMaxGas":
+		return FirstClaimMaxGas, true
+	case "ClaimCooldownBlocks":
+		return ClaimCooldownBlocks, true
+	case "AttestationSlack":
+		return AttestationSlack, true
+	case "AttestationValidatorGas":
+		return AttestationValidatorGas, true
+	case "AttestationSignatureGas":
+		return AttestationSignatureGas, true
+	case "CoinbaseMaturity":
+		return CoinbaseMaturity, true
+	case "BlockMerkleRootCheckDisabled":
+		return BlockMerkleRootCheckDisabled, true
+	case "ClaimExpiryRequired":
+		return ClaimExpiryRequired, true
+	case "MaxClaimExpiryBlocks":
+		return MaxClaimExpiryBlocks, true
+	case "UTXOBackfillBatchSize":
+		return UTXOBackfillBatchSize, true
 	default:
 		return 0, false
 	}
@@ -10,11 +10,25 @@ func _() {
 	var x [1]struct{}
 	_ = x[EmissionCurve-0]
 	_ = x[BlocksPerYear-1]
+	_ = x[ClaimWithProofDisabled-2]
+	_ = x[ClaimedUTXOPruningDisabled-3]
+	_ = x[ClaimedUTXORetentionBlocks-4]
+	_ = x[FirstClaimAccountCreationDisabled-5]
+	_ = x[FirstClaimMaxGas-6]
+	_ = x[ClaimCooldownBlocks-7]
+	_ = x[AttestationSlack-8]
+	_ = x[AttestationValidatorGas-9]
+	_ = x[AttestationSignatureGas-10]
+	_ = x[CoinbaseMaturity-11]
+	_ = x[BlockMerkleRootCheckDisabled-12]
+	_ = x[ClaimExpiryRequired-13]
+	_ = x[MaxClaimExpiryBlocks-14]
+	_ = x[UTXOBackfillBatchSize-15]
 }
 
-const _ConstantName_name = "EmissionCurveBlocksPerYear"
+const _ConstantName_name = "EmissionCurveBlocksPerYearClaimWithProofDisabledClaimedUTXOPruningDisabledClaimedUTXORetentionBlocksFirstClaimAccountCreationDisabledFirstClaimMaxGasClaimCooldownBlocksAttestationSlackAttestationValidatorGasAttestationSignatureGasCoinbaseMaturityBlockMerkleRootCheckDisabledClaimExpiryRequiredMaxClaimExpiryBlocksUTXOBackfillBatchSize"
 
-var _ConstantName_index = [...]uint8{0, 13, 26}
+var _ConstantName_index = [...]uint16{0, 13, 26, 48, 74, 100, 133, 149, 168, 184, 207, 230, 246, 274, 293, 313, 334}
 
 func (i ConstantName) String() string {
 	idx := int(i) - 0
@@ -3,7 +3,20 @@
 package constants
 
 var DefaultValues = map[ConstantName]int64{
-	EmissionCurve:          5,
-	BlocksPerYear:          10 * 60 * 24 * 365, // 10 blocks per minute
-	ClaimWithProofDisabled: 0,
+	EmissionCurve:                     5,
+	BlocksPerYear:                     10 * 60 * 24 * 365, // 10 blocks per minute
+	ClaimWithProofDisabled:            0,
+	ClaimedUTXOPruningDisabled:        0,
+	ClaimedUTXORetentionBlocks:        10 * 60 * 24 * 14, // ~14 days at 10 blocks per minute
+	FirstClaimAccountCreationDisabled: 0,
+	FirstClaimMaxGas:                  2_000_000, // gas limit cap for fee-free first-time claims
+	ClaimCooldownBlocks:               0,         // blocks between claims to one recipient, 0 disables
+	AttestationSlack:                  5,         // attestations accepted per block report beyond the bonded set size
+	AttestationValidatorGas:           100,       // gas charged per bonded validator when checking attestations
+	AttestationSignatureGas:           3000,      // gas charged per attestation signature verified
+	CoinbaseMaturity:                  100,       // confirmations before a coinbase output is claimable, 0 disables
+	BlockMerkleRootCheckDisabled:      0,         // set to 1 to process reported blocks without checking them against their hash
+	ClaimExpiryRequired:               0,         // set to 1 to only accept claims bound to an expiry height (qbtc-claim-v5 and later)
+	MaxClaimExpiryBlocks:              0,         // furthest a claim expiry height may be past the current height, 0 disables
+	UTXOBackfillBatchSize:             10000,     // UTXOs visited per block by the UTXO backfill an upgrade starts
 }
@@ -0,0 +1,148 @@
+package ante
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/btcq-org/qbtc/constants"
+	"github.com/btcq-org/qbtc/x/qbtc/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	se "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// AccountKeeper defines the account methods required by ClaimAccountDecorator.
+type AccountKeeper interface {
+	HasAccount(ctx context.Context, addr sdk.AccAddress) bool
+	NewAccountWithAddress(ctx context.Context, addr sdk.AccAddress) sdk.AccountI
+	SetAccount(ctx context.Context, acc sdk.AccountI)
+}
+
+// ClaimKeeper defines the qbtc keeper methods required by ClaimAccountDecorator.
+type ClaimKeeper interface {
+	GetConfig(ctx sdk.Context, constName constants.ConstantName) int64
+	CheckFirstClaim(ctx sdk.Context, msg *types.MsgClaimWithProof) error
+}
+
+// ClaimAccountDecorator lets a first-time claimer submit MsgClaimWithProof.
+// Such a claimer has never received funds, so their account does not exist
+// and the fee and signature decorators would reject the transaction.
+//
+// The carve-out only applies when all of the following hold:
+//   - the tx contains exactly one message, a MsgClaimWithProof
+//   - the claim pays out to the claimer rather than a separate destination
+//   - the fee payer is the claimer and there is no fee granter
+//   - the claimer account does not exist yet
+//   - the tx pays zero fees and its gas limit is at most FirstClaimMaxGas
+//
+// In that case the claim is checked with CheckFirstClaim: every UTXO it
+// references must be releasable. Only then is the base account created and
+// are validator minimum gas prices waived for this tx, as neither is undone
+// if the claim fails later. The check only reads state: the proof is
+// verified by the msg handler, after the signature, so a tx that pays
+// nothing can't make nodes verify proofs in CheckTx. The signer must sign
+// with the account number the new account is assigned. The carve-out can be
+// turned off with FirstClaimAccountCreationDisabled.
+//
+// A lone MsgClaimWithProof whose fee payer has no account and that the
+// carve-out does not cover fails with ErrFeePayerAccountNotFound, so wallets
+// can tell it apart and ask the user to fund the account first. Every other
+// tx passes through untouched.
+//
+// It must run before the fee deduction and signature verification decorators.
+type ClaimAccountDecorator struct {
+	ak AccountKeeper
+	k  ClaimKeeper
+}
+
+// NewClaimAccountDecorator creates a new ClaimAccountDecorator.
+func NewClaimAccountDecorator(ak AccountKeeper, k ClaimKeeper) ClaimAccountDecorator {
+	return ClaimAccountDecorator{ak: ak, k: k}
+}
+
+// AnteHandle creates the claimer account for eligible first-time claims.
+func (d ClaimAccountDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	claimer, msg, ok := d.firstTimeClaimer(ctx, tx)
+	if !ok {
+		if err := d.checkClaimFeePayer(ctx, tx); err != nil {
+			return ctx, err
+		}
+		return next(ctx, tx, simulate)
+	}
+
+	// firstTimeClaimer only matches fee txs
+	feeTx := tx.(sdk.FeeTx)
+	if !feeTx.GetFee().IsZero() {
+		return ctx, types.ErrFeePayerAccountNotFound.Wrap("submit the first claim with zero fees")
+	}
+	maxGas := d.k.GetConfig(ctx, constants.FirstClaimMaxGas)
+	if maxGas < 0 || feeTx.GetGas() > uint64(maxGas) {
+		return ctx, se.ErrInvalidRequest.Wrapf("gas limit %d exceeds the first-time claim cap of %d", feeTx.GetGas(), maxGas)
+	}
+	if err := d.k.CheckFirstClaim(ctx, msg); err != nil {
+		return ctx, err
+	}
+
+	d.ak.SetAccount(ctx, d.ak.NewAccountWithAddress(ctx, claimer))
+	ctx.Logger().Debug("created account for first-time claimer", "claimer", claimer.String())
+
+	// the new account holds no funds, so the zero fee must not be rejected
+	// by the validator's minimum gas prices
+	return next(ctx.WithMinGasPrices(sdk.DecCoins{}), tx, simulate)
+}
+
+// checkClaimFeePayer rejects a lone MsgClaimWithProof whose fee payer has no
+// account. The fee decorator would reject it as well, but with the generic
+// unknown address error.
+func (d ClaimAccountDecorator) checkClaimFeePayer(ctx sdk.Context, tx sdk.Tx) error {
+	msgs := tx.GetMsgs()
+	if len(msgs) != 1 {
+		return nil
+	}
+	if _, ok := msgs[0].(*types.MsgClaimWithProof); !ok {
+		return nil
+	}
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return nil
+	}
+	feePayer := sdk.AccAddress(feeTx.FeePayer())
+	if d.ak.HasAccount(ctx, feePayer) {
+		return nil
+	}
+	return types.ErrFeePayerAccountNotFound.Wrapf("fund %s before it submits this claim", feePayer)
+}
+
+// firstTimeClaimer returns the claimer address and the claim if tx is a lone
+// MsgClaimWithProof paid for by a claimer whose account does not exist.
+func (d ClaimAccountDecorator) firstTimeClaimer(ctx sdk.Context, tx sdk.Tx) (sdk.AccAddress, *types.MsgClaimWithProof, bool) {
+	msgs := tx.GetMsgs()
+	if len(msgs) != 1 {
+		return nil, nil, false
+	}
+	msg, ok := msgs[0].(*types.MsgClaimWithProof)
+	if !ok {
+		return nil, nil, false
+	}
+	if d.k.GetConfig(ctx, constants.FirstClaimAccountCreationDisabled) > 0 {
+		return nil, nil, false
+	}
+	// relayers submitting a claim for another destination pay their own way
+	if msg.Recipient() != msg.Claimer {
+		return nil, nil, false
+	}
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return nil, nil, false
+	}
+	claimer, err := sdk.AccAddressFromBech32(msg.Claimer)
+	if err != nil {
+		return nil, nil, false
+	}
+	if !bytes.Equal(feeTx.FeePayer(), claimer) || len(feeTx.FeeGranter()) > 0 {
+		return nil, nil, false
+	}
+	if d.ak.HasAccount(ctx, claimer) {
+		return nil, nil, false
+	}
+	return claimer, msg, true
+}
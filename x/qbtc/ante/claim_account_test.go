@@ -0,0 +1,212 @@
+package ante_test
+
+import (
+	"context"
+	"testing"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/btcq-org/qbtc/common"
+	"github.com/btcq-org/qbtc/constants"
+	"github.com/btcq-org/qbtc/x/qbtc/ante"
+	"github.com/btcq-org/qbtc/x/qbtc/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+	protov2 "google.golang.org/protobuf/proto"
+)
+
+type mockAccountKeeper struct {
+	accounts map[string]sdk.AccountI
+}
+
+func (m *mockAccountKeeper) HasAccount(_ context.Context, addr sdk.AccAddress) bool {
+	_, ok := m.accounts[addr.String()]
+	return ok
+}
+
+func (m *mockAccountKeeper) NewAccountWithAddress(_ context.Context, addr sdk.AccAddress) sdk.AccountI {
+	return authtypes.NewBaseAccountWithAddress(addr)
+}
+
+func (m *mockAccountKeeper) SetAccount(_ context.Context, acc sdk.AccountI) {
+	m.accounts[acc.GetAddress().String()] = acc
+}
+
+type mockClaimKeeper struct {
+	overrides map[constants.ConstantName]int64
+	claimErr  error
+}
+
+func (m mockClaimKeeper) GetConfig(_ sdk.Context, constName constants.ConstantName) int64 {
+	if v, ok := m.overrides[constName]; ok {
+		return v
+	}
+	return constants.DefaultValues[constName]
+}
+
+func (m mockClaimKeeper) CheckFirstClaim(_ sdk.Context, _ *types.MsgClaimWithProof) error {
+	return m.claimErr
+}
+
+type mockFeeTx struct {
+	msgs       []sdk.Msg
+	fee        sdk.Coins
+	gas        uint64
+	feePayer   sdk.AccAddress
+	feeGranter sdk.AccAddress
+}
+
+func (tx mockFeeTx) GetMsgs() []sdk.Msg                    { return tx.msgs }
+func (tx mockFeeTx) GetMsgsV2() ([]protov2.Message, error) { return nil, nil }
+func (tx mockFeeTx) GetGas() uint64                        { return tx.gas }
+func (tx mockFeeTx) GetFee() sdk.Coins                     { return tx.fee }
+func (tx mockFeeTx) FeePayer() []byte                      { return tx.feePayer }
+func (tx mockFeeTx) FeeGranter() []byte                    { return tx.feeGranter }
+
+func TestClaimAccountDecorator(t *testing.T) {
+	sdk.GetConfig().SetBech32PrefixForAccount(common.AccountAddressPrefix, common.AccountAddressPrefix+sdk.PrefixPublic)
+
+	claimer := sdk.AccAddress([]byte("first-time-claimer__"))
+	other := sdk.AccAddress([]byte("someone-else________"))
+	existing := sdk.AccAddress([]byte("existing-claimer____"))
+	claimMsg := func(addr sdk.AccAddress) *types.MsgClaimWithProof {
+		return &types.MsgClaimWithProof{Claimer: addr.String()}
+	}
+	fee := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(10)))
+	maxGas := uint64(constants.DefaultValues[constants.FirstClaimMaxGas])
+
+	tests := []struct {
+		name          string
+		tx            mockFeeTx
+		disabled      bool
+		claimErr      error
+		expectErr     bool
+		expectErrIs   error
+		expectCreated bool
+	}{
+		{
+			name:          "first-time claim creates account",
+			tx:            mockFeeTx{msgs: []sdk.Msg{claimMsg(claimer)}, gas: maxGas, feePayer: claimer},
+			expectCreated: true,
+		},
+		{
+			name:        "first-time claim with fee is rejected",
+			tx:          mockFeeTx{msgs: []sdk.Msg{claimMsg(claimer)}, fee: fee, gas: maxGas, feePayer: claimer},
+			expectErr:   true,
+			expectErrIs: types.ErrFeePayerAccountNotFound,
+		},
+		{
+			name:        "first-time claim that does not pay out is rejected",
+			tx:          mockFeeTx{msgs: []sdk.Msg{claimMsg(claimer)}, gas: maxGas, feePayer: claimer},
+			claimErr:    types.ErrNoClaimableUTXOs,
+			expectErr:   true,
+			expectErrIs: types.ErrNoClaimableUTXOs,
+		},
+		{
+			name:      "first-time claim above gas cap is rejected",
+			tx:        mockFeeTx{msgs: []sdk.Msg{claimMsg(claimer)}, gas: maxGas + 1, feePayer: claimer},
+			expectErr: true,
+		},
+		{
+			name: "existing account passes through",
+			tx:   mockFeeTx{msgs: []sdk.Msg{claimMsg(existing)}, fee: fee, gas: maxGas + 1, feePayer: existing},
+		},
+		{
+			name: "existing fee payer other than claimer passes through",
+			tx:   mockFeeTx{msgs: []sdk.Msg{claimMsg(claimer)}, feePayer: existing},
+		},
+		{
+			name:        "missing fee payer other than claimer is rejected",
+			tx:          mockFeeTx{msgs: []sdk.Msg{claimMsg(claimer)}, feePayer: other},
+			expectErr:   true,
+			expectErrIs: types.ErrFeePayerAccountNotFound,
+		},
+		{
+			name: "relayed claim paid by an existing account passes through",
+			tx: mockFeeTx{msgs: []sdk.Msg{&types.MsgClaimWithProof{
+				Claimer:     claimer.String(),
+				Destination: other.String(),
+			}}, gas: maxGas, feePayer: existing},
+		},
+		{
+			name: "relayed claim from a missing account is rejected",
+			tx: mockFeeTx{msgs: []sdk.Msg{&types.MsgClaimWithProof{
+				Claimer:     claimer.String(),
+				Destination: other.String(),
+			}}, gas: maxGas, feePayer: claimer},
+			expectErr:   true,
+			expectErrIs: types.ErrFeePayerAccountNotFound,
+		},
+		{
+			name:        "fee granter for a missing account is rejected",
+			tx:          mockFeeTx{msgs: []sdk.Msg{claimMsg(claimer)}, feePayer: claimer, feeGranter: other},
+			expectErr:   true,
+			expectErrIs: types.ErrFeePayerAccountNotFound,
+		},
+		{
+			name: "additional messages pass through",
+			tx: mockFeeTx{msgs: []sdk.Msg{
+				claimMsg(claimer),
+				&banktypes.MsgSend{FromAddress: claimer.String(), ToAddress: other.String()},
+			}, feePayer: claimer},
+		},
+		{
+			name: "other message types pass through",
+			tx:   mockFeeTx{msgs: []sdk.Msg{&banktypes.MsgSend{FromAddress: claimer.String(), ToAddress: other.String()}}, feePayer: claimer},
+		},
+		{
+			name:        "disabled rejects a missing account",
+			tx:          mockFeeTx{msgs: []sdk.Msg{claimMsg(claimer)}, feePayer: claimer},
+			disabled:    true,
+			expectErr:   true,
+			expectErrIs: types.ErrFeePayerAccountNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key := storetypes.NewKVStoreKey("test")
+			ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test"))
+			ctx = ctx.WithMinGasPrices(sdk.NewDecCoins(sdk.NewDecCoin(sdk.DefaultBondDenom, math.NewInt(1))))
+
+			ak := &mockAccountKeeper{accounts: map[string]sdk.AccountI{
+				existing.String(): authtypes.NewBaseAccountWithAddress(existing),
+			}}
+			ck := mockClaimKeeper{overrides: map[constants.ConstantName]int64{}, claimErr: tt.claimErr}
+			if tt.disabled {
+				ck.overrides[constants.FirstClaimAccountCreationDisabled] = 1
+			}
+			decorator := ante.NewClaimAccountDecorator(ak, ck)
+
+			nextCalled := false
+			var nextCtx sdk.Context
+			next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+				nextCalled = true
+				nextCtx = ctx
+				return ctx, nil
+			}
+
+			_, err := decorator.AnteHandle(ctx, tt.tx, false, next)
+			if tt.expectErr {
+				require.Error(t, err)
+				if tt.expectErrIs != nil {
+					require.ErrorIs(t, err, tt.expectErrIs)
+				}
+				require.False(t, nextCalled)
+				require.False(t, ak.HasAccount(ctx, claimer))
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, nextCalled)
+			require.Equal(t, tt.expectCreated, ak.HasAccount(ctx, claimer))
+			if tt.expectCreated {
+				require.True(t, nextCtx.MinGasPrices().IsZero())
+			} else {
+				require.False(t, nextCtx.MinGasPrices().IsZero())
+			}
+		})
+	}
+}
@@ -2,8 +2,10 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"cosmossdk.io/collections"
 	"github.com/btcq-org/qbtc/x/qbtc/types"
 	"github.com/btcq-org/qbtc/x/qbtc/zk"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -20,10 +22,15 @@ func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) er
 		}
 	}
 	for _, utxo := range genState.Utxos {
-		err := k.Utxoes.Set(ctx, utxo.GetKey(), *utxo)
+		err := k.SetUTXO(ctx, *utxo)
 		if err != nil {
 			return fmt.Errorf("failed to set UTXO %s: %w", utxo.Txid, err)
 		}
+		if utxo.EntitledAmount == 0 {
+			if err := k.markUTXOClaimed(ctx, utxo.GetKey()); err != nil {
+				return fmt.Errorf("failed to index claimed UTXO %s: %w", utxo.Txid, err)
+			}
+		}
 	}
 	for _, item := range genState.Params {
 		err := k.ConstOverrides.Set(ctx, item.Key, item.Value)
@@ -32,6 +39,22 @@ func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) er
 		}
 	}
 
+	if len(genState.ClaimMemoPrefixes) > 0 {
+		if err := k.ClaimMemoPrefixes.Set(ctx, types.ClaimMemoPrefixes{Prefixes: genState.ClaimMemoPrefixes}); err != nil {
+			return fmt.Errorf("failed to set claim memo prefixes: %w", err)
+		}
+	}
+
+	for _, address := range genState.ClaimDenyList {
+		acc, err := sdk.AccAddressFromBech32(address)
+		if err != nil {
+			return fmt.Errorf("invalid claim deny list address %s: %w", address, err)
+		}
+		if err := k.ClaimDenyList.Set(ctx, acc.String()); err != nil {
+			return fmt.Errorf("failed to set claim deny list address %s: %w", address, err)
+		}
+	}
+
 	// Initialize ZK verifying key from genesis
 	if len(genState.ZkVerifyingKey) > 0 {
 		// Store the VK in state
@@ -39,15 +62,21 @@ func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) er
 			return fmt.Errorf("failed to set ZK verifying key: %w", err)
 		}
 
-		// Register the global verifier (for BTCSignatureCircuit - TSS compatible)
-		if err := zk.RegisterVerifier(genState.ZkVerifyingKey); err != nil {
-			sdkCtx.Logger().Error("failed to register ZK verifier from genesis", "error", err)
-			return fmt.Errorf("failed to register ZK verifier: %w", err)
+		// Reject a key claims could never be verified with
+		if _, err := k.verifiers.get(genState.ZkVerifyingKey); err != nil {
+			sdkCtx.Logger().Error("invalid ZK verifying key in genesis", "error", err)
+			return fmt.Errorf("failed to initialize ZK verifier: %w", err)
 		}
-		sdkCtx.Logger().Info("ZK PLONK verifier registered from genesis")
+		sdkCtx.Logger().Info("ZK PLONK verifying key loaded from genesis")
 	} else {
 		sdkCtx.Logger().Warn("no ZK verifying key in genesis - airdrop claims will fail until VK is set")
 	}
+	for _, entry := range genState.VersionVerifyingKeys {
+		version := zk.NormalizeClaimMessageVersion(entry.MessageVersion)
+		if err := k.VersionVerifyingKeys.Set(ctx, version, entry.VerifyingKey); err != nil {
+			return fmt.Errorf("failed to set ZK verifying key of %s: %w", version, err)
+		}
+	}
 
 	// set the last processed block height to the initial block height - 1
 	if genState.BtcInitialHeight > 0 {
@@ -94,11 +123,31 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 	}
 	genesis.Params = params
 
+	memoPrefixes, err := k.ClaimMemoPrefixes.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, fmt.Errorf("failed to export claim memo prefixes: %w", err)
+	}
+	genesis.ClaimMemoPrefixes = memoPrefixes.Prefixes
+
+	genesis.ClaimDenyList, err = k.GetClaimDenyList(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to export claim deny list: %w", err)
+	}
+
 	// Export ZK verifying key
 	zkVK, err := k.ZkVerifyingKey.Get(ctx)
 	if err == nil && len(zkVK) > 0 {
 		genesis.ZkVerifyingKey = zkVK
 	}
+	if err := k.VersionVerifyingKeys.Walk(ctx, nil, func(version string, vkBytes []byte) (bool, error) {
+		genesis.VersionVerifyingKeys = append(genesis.VersionVerifyingKeys, types.VersionVerifyingKey{
+			MessageVersion: version,
+			VerifyingKey:   vkBytes,
+		})
+		return false, nil
+	}); err != nil {
+		return nil, fmt.Errorf("failed to export ZK verifying keys of message versions: %w", err)
+	}
 
 	return genesis, nil
 }
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"testing"
 
+	qbtctestutil "github.com/btcq-org/qbtc/x/qbtc/testutil"
 	"github.com/btcq-org/qbtc/x/qbtc/types"
 
 	"github.com/stretchr/testify/require"
@@ -17,5 +18,31 @@ func TestGenesis(t *testing.T) {
 	got, err := f.keeper.ExportGenesis(f.ctx)
 	require.NoError(t, err)
 	require.NotNil(t, got)
+	require.Empty(t, got.ClaimMemoPrefixes)
+	require.Empty(t, got.ClaimDenyList)
+}
+
+func TestGenesis_ClaimMemoPrefixes(t *testing.T) {
+	genesisState := types.GenesisState{
+		ClaimMemoPrefixes: []string{"claim:", "claim2:"},
+	}
 
+	f := initFixture(t)
+	require.NoError(t, f.keeper.InitGenesis(f.ctx, genesisState))
+	require.Equal(t, genesisState.ClaimMemoPrefixes, f.keeper.GetClaimMemoPrefixes(f.ctx))
+	got, err := f.keeper.ExportGenesis(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, genesisState.ClaimMemoPrefixes, got.ClaimMemoPrefixes)
+}
+
+func TestGenesis_ClaimDenyList(t *testing.T) {
+	genesisState := types.GenesisState{
+		ClaimDenyList: []string{qbtctestutil.GetRandomBTCQAddress()},
+	}
+
+	f := initFixture(t)
+	require.NoError(t, f.keeper.InitGenesis(f.ctx, genesisState))
+	got, err := f.keeper.ExportGenesis(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, genesisState.ClaimDenyList, got.ClaimDenyList)
 }
@@ -4,8 +4,11 @@ import (
 	"bytes"
 	"context"
 	"encoding/hex"
+	"errors"
 	"fmt"
+	"strings"
 
+	"cosmossdk.io/collections"
 	"github.com/btcq-org/qbtc/constants"
 	"github.com/btcq-org/qbtc/x/qbtc/types"
 	"github.com/btcq-org/qbtc/x/qbtc/zk"
@@ -17,56 +20,97 @@ import (
 // It looks up all specified UTXOs, verifies the ZK proof against the first UTXO's address,
 // and releases only the UTXOs that match the proven address.
 // UTXOs with non-matching addresses are skipped (not failed) for better UX.
+// The tokens go to the recipient the proof is bound to, which is the optional
+// destination or else the claimer; the claimer only signs the transaction.
+// If every claimable UTXO was already claimed by the same recipient, e.g. because
+// a wallet broadcast the claim twice, it succeeds without claiming anything.
 func (s *msgServer) ClaimWithProof(ctx context.Context, msg *types.MsgClaimWithProof) (*types.MsgClaimWithProofResponse, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	isDisabled := s.k.GetConfig(sdkCtx, constants.ClaimWithProofDisabled)
 	if isDisabled > 0 {
 		return nil, sdkerror.ErrInvalidRequest.Wrap("ClaimWithProof feature is disabled")
 	}
+	// Claims arriving before a verifying key is stored fail with their own
+	// code, so wallets can tell "too early" apart from a bad proof. Each
+	// message version is verified with its own key once governance added one,
+	// so proofs of older versions keep verifying during a transition
+	verifier, err := s.k.ClaimVerifier(sdkCtx, msg.MessageVersion)
+	if err != nil {
+		return nil, err
+	}
 	// Validate the message
 	if err := msg.ValidateBasic(); err != nil {
 		return nil, err
 	}
-
-	// Ensure the ZK verifier is initialized
-	if !zk.IsVerifierInitialized() {
-		return nil, sdkerror.ErrInvalidRequest.Wrap("ZK verifier not initialized - genesis VK not loaded")
+	if err := s.k.checkClaimExpiry(sdkCtx, msg); err != nil {
+		return nil, err
 	}
 
-	// Parse the claimer address upfront
+	// Parse the recipient address upfront. The proof is bound to it, so the
+	// claimer signing the transaction may be a relayer that never receives
+	// the funds.
+	recipientAddr, err := sdk.AccAddressFromBech32(msg.Recipient())
+	if err != nil {
+		return nil, sdkerror.ErrInvalidAddress.Wrapf("invalid recipient address: %v", err)
+	}
 	claimerAddr, err := sdk.AccAddressFromBech32(msg.Claimer)
 	if err != nil {
 		return nil, sdkerror.ErrInvalidAddress.Wrapf("invalid claimer address: %v", err)
 	}
+	if err := s.k.checkClaimDenyList(sdkCtx, claimerAddr, recipientAddr); err != nil {
+		return nil, err
+	}
+
+	if err := checkVKFingerprint(msg.VkFingerprint, verifier); err != nil {
+		return nil, err
+	}
+
+	// Message versions that bind the address type only release UTXOs of the
+	// same type as the one the proof was generated for
+	bindsAddressType := zk.ClaimMessageBindsAddressType(msg.MessageVersion)
+
+	maturity, err := s.k.getCoinbaseMaturity(sdkCtx)
+	if err != nil {
+		return nil, sdkerror.ErrUnknownRequest.Wrapf("failed to get last processed block height: %v", err)
+	}
 
 	// Find the first valid UTXO to determine the proven address
-	var provenAddressHash [20]byte
+	var proven claimScript
 	var provenBtcAddress string
 	var foundValidUtxo bool
+	var alreadyClaimedCount uint32
+	unusable := claimSkips{}
 
 	for i, utxoRef := range msg.Utxos {
 		utxoKey := getUTXOKey(utxoRef.Txid, utxoRef.Vout)
 		utxo, err := s.k.Utxoes.Get(sdkCtx, utxoKey)
 		if err != nil {
+			unusable.add(types.SkipReasonNotFound)
 			continue // Skip non-existent UTXOs
 		}
 
 		if utxo.EntitledAmount == 0 {
+			if s.k.isClaimedBy(sdkCtx, utxoKey, recipientAddr) {
+				alreadyClaimedCount++
+			}
+			unusable.add(types.SkipReasonAlreadyClaimed)
 			continue // Skip already claimed UTXOs
 		}
 
-		if utxo.ScriptPubKey == nil || utxo.ScriptPubKey.Address == "" {
-			continue // Skip UTXOs without address
+		if maturity.immature(utxo) {
+			unusable.add(types.SkipReasonImmatureCoinbase)
+			continue // Skip coinbase outputs Bitcoin can't spend yet
 		}
 
-		addressHash, err := zk.BitcoinAddressToHash160(utxo.ScriptPubKey.Address)
+		script, err := claimScriptFromScriptPubKey(utxo.ScriptPubKey)
 		if err != nil {
-			continue // Skip UTXOs with invalid addresses
+			unusable.add(types.SkipReasonNotClaimable)
+			continue // Skip UTXOs without a claimable script or address
 		}
 
 		// Found a valid UTXO - use its address for proof verification
-		provenAddressHash = addressHash
-		provenBtcAddress = utxo.ScriptPubKey.Address
+		proven = script
+		provenBtcAddress = utxo.Address()
 		foundValidUtxo = true
 		sdkCtx.Logger().Debug("using UTXO for proof verification",
 			"index", i,
@@ -78,110 +122,162 @@ func (s *msgServer) ClaimWithProof(ctx context.Context, msg *types.MsgClaimWithP
 	}
 
 	if !foundValidUtxo {
-		return nil, sdkerror.ErrInvalidRequest.Wrap("no valid claimable UTXOs found")
+		// Only a claim that references nothing but UTXOs already released to
+		// the recipient is answered without a proof. Any other UTXO would let
+		// a proofless message pass as a successful claim.
+		if alreadyClaimedCount == uint32(len(msg.Utxos)) {
+			return s.alreadyClaimedResponse(sdkCtx, msg, alreadyClaimedCount), nil
+		}
+		return nil, types.ErrNoClaimableUTXOs.Wrapf("no valid claimable UTXOs found (%s)", unusable)
+	}
+
+	// Rate limit claims to the same recipient before spending gas on the proof
+	cooldown := s.k.GetConfig(sdkCtx, constants.ClaimCooldownBlocks)
+	if err := s.k.checkClaimCooldown(sdkCtx, recipientAddr, cooldown); err != nil {
+		return nil, err
 	}
 
 	// Verify the ZK proof against the determined address
-	if err := s.verifyProof(sdkCtx, msg, provenAddressHash); err != nil {
+	if err := verifyProof(sdkCtx, verifier, msg, proven); err != nil {
 		return nil, sdkerror.ErrInvalidRequest.Wrapf("proof verification failed: %v", err)
 	}
 
 	// Collect UTXOs that match the proven address
 	type claimableUTXO struct {
-		index  int
-		txid   string
-		vout   uint32
-		amount uint64
+		index int
+		txid  string
+		vout  uint32
 	}
 	var claimableUTXOs []claimableUTXO
-	var skippedCount uint32
+	skipped := claimSkips{}
 
 	for i, utxoRef := range msg.Utxos {
 		utxoKey := getUTXOKey(utxoRef.Txid, utxoRef.Vout)
 		utxo, err := s.k.Utxoes.Get(sdkCtx, utxoKey)
 		if err != nil {
-			skippedCount++
+			skipped.add(types.SkipReasonNotFound)
 			sdkCtx.Logger().Debug("skipping UTXO: not found",
 				"index", i, "txid", utxoRef.Txid, "vout", utxoRef.Vout)
 			continue
 		}
 
 		if utxo.EntitledAmount == 0 {
-			skippedCount++
+			skipped.add(types.SkipReasonAlreadyClaimed)
 			sdkCtx.Logger().Debug("skipping UTXO: already claimed",
 				"index", i, "txid", utxoRef.Txid, "vout", utxoRef.Vout)
 			continue
 		}
 
-		if utxo.ScriptPubKey == nil || utxo.ScriptPubKey.Address == "" {
-			skippedCount++
-			sdkCtx.Logger().Debug("skipping UTXO: no address",
-				"index", i, "txid", utxoRef.Txid, "vout", utxoRef.Vout)
+		if maturity.immature(utxo) {
+			skipped.add(types.SkipReasonImmatureCoinbase)
+			sdkCtx.Logger().Debug("skipping UTXO: immature coinbase",
+				"index", i, "txid", utxoRef.Txid, "vout", utxoRef.Vout, "created_at_height", utxo.CreatedAtHeight)
 			continue
 		}
 
-		utxoAddressHash, err := zk.BitcoinAddressToHash160(utxo.ScriptPubKey.Address)
+		utxoScript, err := claimScriptFromScriptPubKey(utxo.ScriptPubKey)
 		if err != nil {
-			skippedCount++
-			sdkCtx.Logger().Debug("skipping UTXO: invalid address format",
+			skipped.add(types.SkipReasonNotClaimable)
+			sdkCtx.Logger().Debug("skipping UTXO: not claimable",
 				"index", i, "txid", utxoRef.Txid, "vout", utxoRef.Vout, "error", err)
 			continue
 		}
 
 		// Check if this UTXO's address matches the proven address
-		if !bytes.Equal(provenAddressHash[:], utxoAddressHash[:]) {
-			skippedCount++
+		if !proven.sameCommitment(utxoScript) {
+			skipped.add(types.SkipReasonWrongAddress)
 			sdkCtx.Logger().Debug("skipping UTXO: address mismatch",
 				"index", i,
 				"txid", utxoRef.Txid,
 				"vout", utxoRef.Vout,
 				"expected", provenBtcAddress,
-				"got", utxo.ScriptPubKey.Address,
+				"got", utxo.Address(),
+			)
+			continue
+		}
+
+		if bindsAddressType && utxoScript.addressType != proven.addressType {
+			skipped.add(types.SkipReasonWrongAddressType)
+			sdkCtx.Logger().Debug("skipping UTXO: address type mismatch",
+				"index", i,
+				"txid", utxoRef.Txid,
+				"vout", utxoRef.Vout,
+				"expected", proven.addressType.String(),
+				"got", utxo.Address(),
 			)
 			continue
 		}
 
 		// This UTXO matches - add to claimable list
 		claimableUTXOs = append(claimableUTXOs, claimableUTXO{
-			index:  i,
-			txid:   utxoRef.Txid,
-			vout:   utxoRef.Vout,
-			amount: utxo.EntitledAmount,
+			index: i,
+			txid:  utxoRef.Txid,
+			vout:  utxoRef.Vout,
 		})
 	}
 
 	if len(claimableUTXOs) == 0 {
-		return nil, sdkerror.ErrInvalidRequest.Wrap("no UTXOs match the proven address")
+		return nil, types.ErrNoClaimableUTXOs.Wrapf("no UTXOs match the proven address (%s)", skipped)
 	}
 
 	// Use cache context for atomic batch claim
 	cacheCtx, write := sdkCtx.CacheContext()
 
+	// release every UTXO, then mint their sum in one go
 	var totalClaimed uint64
 	for _, utxo := range claimableUTXOs {
-		if err := s.k.ClaimUTXO(cacheCtx, utxo.txid, utxo.vout, claimerAddr); err != nil {
+		amount, err := s.k.releaseUTXO(cacheCtx, getUTXOKey(utxo.txid, utxo.vout), recipientAddr)
+		if err != nil {
 			return nil, sdkerror.ErrInvalidRequest.Wrapf("failed to claim UTXO[%d]: %v", utxo.index, err)
 		}
-		totalClaimed += utxo.amount
+		totalClaimed += amount
+	}
+	if err := s.k.mintClaimed(cacheCtx, totalClaimed, recipientAddr); err != nil {
+		return nil, sdkerror.ErrInvalidRequest.Wrapf("failed to mint claimed amount: %v", err)
+	}
+	if cooldown > 0 {
+		if err := s.k.LastClaimHeight.Set(cacheCtx, recipientAddr.String(), sdkCtx.BlockHeight()); err != nil {
+			return nil, err
+		}
 	}
 
 	// Commit all claims atomically
 	write()
 
+	skippedCount := skipped.total()
+	skipReasons := skipped.reasons()
+
 	// Emit batch event
 	sdkCtx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			"claim_with_proof",
 			sdk.NewAttribute("claimer", msg.Claimer),
+			sdk.NewAttribute("recipient", msg.Recipient()),
 			sdk.NewAttribute("btc_address", provenBtcAddress),
 			sdk.NewAttribute("utxos_claimed", fmt.Sprintf("%d", len(claimableUTXOs))),
 			sdk.NewAttribute("utxos_skipped", fmt.Sprintf("%d", skippedCount)),
 			sdk.NewAttribute("total_amount", fmt.Sprintf("%d", totalClaimed)),
 		),
 	)
+	// Typed counterpart for indexers, with a schema defined in proto
+	if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventClaimSucceeded{
+		Claimer:        msg.Claimer,
+		Recipient:      msg.Recipient(),
+		BtcAddress:     provenBtcAddress,
+		BtcAddressHash: hex.EncodeToString(proven.identifier),
+		Amount:         totalClaimed,
+		UtxosClaimed:   uint32(len(claimableUTXOs)),
+		UtxosSkipped:   skippedCount,
+		SkipReasons:    skipReasons,
+		CircuitType:    zk.CircuitTypeForAddressType(proven.addressType),
+		MessageVersion: zk.NormalizeClaimMessageVersion(msg.MessageVersion),
+	}); err != nil {
+		return nil, err
+	}
 
 	sdkCtx.Logger().Info("batch claimed with proof",
 		"claimer", msg.Claimer,
+		"recipient", msg.Recipient(),
 		"btc_address", provenBtcAddress,
 		"utxos_claimed", len(claimableUTXOs),
 		"utxos_skipped", skippedCount,
@@ -189,39 +285,327 @@ func (s *msgServer) ClaimWithProof(ctx context.Context, msg *types.MsgClaimWithP
 	)
 
 	return &types.MsgClaimWithProofResponse{
-		TotalAmountClaimed: totalClaimed,
-		UtxosClaimed:       uint32(len(claimableUTXOs)),
-		UtxosSkipped:       skippedCount,
+		TotalAmountClaimed:  totalClaimed,
+		UtxosClaimed:        uint32(len(claimableUTXOs)),
+		UtxosSkipped:        skippedCount,
+		UtxosAlreadyClaimed: alreadyClaimedCount,
+		SkipReasons:         skipReasons,
 	}, nil
 }
 
+// claimSkips counts the UTXOs of a claim that were skipped, by reason.
+type claimSkips map[string]uint32
+
+func (c claimSkips) add(reason string) {
+	c[reason]++
+}
+
+func (c claimSkips) total() uint32 {
+	var total uint32
+	for _, count := range c {
+		total += count
+	}
+	return total
+}
+
+// reasons returns the counts in the order of types.ClaimSkipReasons.
+func (c claimSkips) reasons() []types.ClaimSkipReason {
+	var reasons []types.ClaimSkipReason
+	for _, reason := range types.ClaimSkipReasons {
+		if count := c[reason]; count > 0 {
+			reasons = append(reasons, types.ClaimSkipReason{Reason: reason, Count: count})
+		}
+	}
+	return reasons
+}
+
+// String renders the counts for error messages, e.g. "not_found: 1, wrong_address: 2".
+func (c claimSkips) String() string {
+	parts := make([]string, 0, len(c))
+	for _, reason := range c.reasons() {
+		parts = append(parts, fmt.Sprintf("%s: %d", reason.Reason, reason.Count))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// checkClaimCooldown rejects a claim to a recipient that received a claim
+// less than cooldown blocks ago. It bounds how fast someone holding many
+// candidate proofs can hammer the verifier for one destination, without
+// affecting claimers that claim once. A cooldown of 0 disables the check.
+func (k Keeper) checkClaimCooldown(ctx sdk.Context, recipient sdk.AccAddress, cooldown int64) error {
+	if cooldown <= 0 {
+		return nil
+	}
+	last, err := k.LastClaimHeight.Get(ctx, recipient.String())
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+	if next := last + cooldown; ctx.BlockHeight() < next {
+		return types.ErrClaimCooldown.Wrapf("%s last claimed at height %d, next claim allowed at height %d", recipient, last, next)
+	}
+	return nil
+}
+
+// checkClaimExpiry rejects a claim whose message version binds an expiry
+// height the chain has passed. Deployments that want every proof to expire set
+// ClaimExpiryRequired, which rejects versions without an expiry, and may bound
+// how far ahead the expiry can be set with MaxClaimExpiryBlocks.
+func (k Keeper) checkClaimExpiry(ctx sdk.Context, msg *types.MsgClaimWithProof) error {
+	if !zk.ClaimMessageBindsExpiry(msg.MessageVersion) {
+		if k.GetConfig(ctx, constants.ClaimExpiryRequired) > 0 {
+			return sdkerror.ErrInvalidRequest.Wrapf("claims must use a message version that binds an expiry height (%s)", zk.ClaimMessageVersionV5)
+		}
+		return nil
+	}
+	height := uint64(ctx.BlockHeight())
+	if height > msg.ExpiryHeight {
+		return types.ErrClaimExpired.Wrapf("claim expired at height %d, current height is %d", msg.ExpiryHeight, height)
+	}
+	if maxBlocks := k.GetConfig(ctx, constants.MaxClaimExpiryBlocks); maxBlocks > 0 && msg.ExpiryHeight-height > uint64(maxBlocks) {
+		return sdkerror.ErrInvalidRequest.Wrapf("expiry height %d is more than MaxClaimExpiryBlocks %d past the current height %d", msg.ExpiryHeight, maxBlocks, height)
+	}
+	return nil
+}
+
+// CheckFirstClaim checks that msg could release every UTXO it references. The
+// ante handler runs it before it creates the account of a first-time claimer
+// and waives the fees of its transaction: each UTXO must exist, be unclaimed
+// and mature, and be locked to the declared address hash, and neither the
+// claimer nor the recipient may be denied or within the claim cooldown.
+// Unlike ClaimWithProof, which skips the UTXOs it can't release, it fails on
+// the first of them.
+//
+// The proof is not verified here. The ante handler runs before the fee is
+// deducted and the signature verified, so verifying it would let anyone make
+// every node run a proof verification for free in CheckTx. ClaimWithProof
+// verifies it once the transaction is executed.
+func (k Keeper) CheckFirstClaim(ctx sdk.Context, msg *types.MsgClaimWithProof) error {
+	if k.GetConfig(ctx, constants.ClaimWithProofDisabled) > 0 {
+		return sdkerror.ErrInvalidRequest.Wrap("ClaimWithProof feature is disabled")
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+	if err := k.checkClaimExpiry(ctx, msg); err != nil {
+		return err
+	}
+	// ValidateBasic checked the claimer and destination addresses
+	recipientAddr := sdk.MustAccAddressFromBech32(msg.Recipient())
+	if err := k.checkClaimDenyList(ctx, sdk.MustAccAddressFromBech32(msg.Claimer), recipientAddr); err != nil {
+		return err
+	}
+	if err := k.checkClaimCooldown(ctx, recipientAddr, k.GetConfig(ctx, constants.ClaimCooldownBlocks)); err != nil {
+		return err
+	}
+	addressHash, err := hex.DecodeString(msg.AddressHash)
+	if err != nil {
+		return sdkerror.ErrInvalidRequest.Wrapf("address hash is not valid hex: %v", err)
+	}
+	maturity, err := k.getCoinbaseMaturity(ctx)
+	if err != nil {
+		return sdkerror.ErrUnknownRequest.Wrapf("failed to get last processed block height: %v", err)
+	}
+
+	var proven claimScript
+	for i, utxoRef := range msg.Utxos {
+		utxo, err := k.Utxoes.Get(ctx, getUTXOKey(utxoRef.Txid, utxoRef.Vout))
+		if err != nil {
+			return types.ErrNoClaimableUTXOs.Wrapf("utxos[%d]: %s", i, types.SkipReasonNotFound)
+		}
+		if utxo.EntitledAmount == 0 {
+			return types.ErrNoClaimableUTXOs.Wrapf("utxos[%d]: %s", i, types.SkipReasonAlreadyClaimed)
+		}
+		if maturity.immature(utxo) {
+			return types.ErrNoClaimableUTXOs.Wrapf("utxos[%d]: %s", i, types.SkipReasonImmatureCoinbase)
+		}
+		script, err := claimScriptFromScriptPubKey(utxo.ScriptPubKey)
+		if err != nil {
+			return types.ErrNoClaimableUTXOs.Wrapf("utxos[%d]: %s", i, types.SkipReasonNotClaimable)
+		}
+		if !bytes.Equal(script.identifier, addressHash) {
+			return types.ErrNoClaimableUTXOs.Wrapf("utxos[%d]: %s", i, types.SkipReasonWrongAddress)
+		}
+		if i == 0 {
+			proven = script
+		} else if zk.ClaimMessageBindsAddressType(msg.MessageVersion) && script.addressType != proven.addressType {
+			return types.ErrNoClaimableUTXOs.Wrapf("utxos[%d]: %s", i, types.SkipReasonWrongAddressType)
+		}
+	}
+	return nil
+}
+
+// alreadyClaimedResponse is the response to a claim whose UTXOs were all
+// claimed by the same recipient before. The proof is not verified again since
+// nothing is released.
+func (s *msgServer) alreadyClaimedResponse(sdkCtx sdk.Context, msg *types.MsgClaimWithProof, alreadyClaimed uint32) *types.MsgClaimWithProofResponse {
+	const reason = "all UTXOs were already claimed by this claimer"
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"claim_with_proof",
+			sdk.NewAttribute("claimer", msg.Claimer),
+			sdk.NewAttribute("recipient", msg.Recipient()),
+			sdk.NewAttribute("utxos_claimed", "0"),
+			sdk.NewAttribute("utxos_already_claimed", fmt.Sprintf("%d", alreadyClaimed)),
+			sdk.NewAttribute("reason", reason),
+		),
+	)
+	sdkCtx.Logger().Info("claim with proof already processed",
+		"claimer", msg.Claimer,
+		"utxos_already_claimed", alreadyClaimed,
+	)
+	return &types.MsgClaimWithProofResponse{
+		UtxosSkipped:        uint32(len(msg.Utxos)),
+		UtxosAlreadyClaimed: alreadyClaimed,
+		Reason:              reason,
+	}
+}
+
+// checkVKFingerprint rejects a claim whose declared verifying key fingerprint
+// is not the one of the key verifying it, e.g. a proof made before a key
+// rotation. Without this, such a proof only fails as an opaque verification
+// error.
+func checkVKFingerprint(fingerprint string, verifier *zk.Verifier) error {
+	if fingerprint == "" {
+		return nil
+	}
+	vkBytes, err := verifier.GetVerifyingKeyBytes()
+	if err != nil {
+		return err
+	}
+	active := shortVKFingerprint(vkBytes)
+	if !strings.EqualFold(fingerprint, active) {
+		return types.ErrVerifyingKeyMismatch.Wrapf("proof was made for verifying key %s, the active key is %s", fingerprint, active)
+	}
+	return nil
+}
+
+// claimScript is the output script commitment a claim proof is bound to, as
+// returned by types.ScriptPubKeyIdentifier: the Hash160 of the public key for
+// single-key types, the 32-byte witness program for P2WSH.
+type claimScript struct {
+	addressType zk.AddressType
+	identifier  []byte
+}
+
+// claimScriptFromScriptPubKey decodes the commitment of a UTXO's output script.
+func claimScriptFromScriptPubKey(spk *types.ScriptPubKeyResult) (claimScript, error) {
+	addressType, identifier, err := types.ScriptPubKeyIdentifier(spk)
+	if err != nil {
+		return claimScript{}, err
+	}
+	return claimScript{addressType: addressType, identifier: identifier}, nil
+}
+
+// sameCommitment reports whether other commits to the same key or script.
+// P2WSH outputs are matched by witness program and never match a Hash160;
+// the Hash160-based types match each other, as a single key controls both.
+func (c claimScript) sameCommitment(other claimScript) bool {
+	if (c.addressType == zk.AddressTypeP2WSH) != (other.addressType == zk.AddressTypeP2WSH) {
+		return false
+	}
+	return bytes.Equal(c.identifier, other.identifier)
+}
+
 // verifyProof verifies the ZK proof for the claim.
 // The proof must demonstrate a valid ECDSA signature from the key that controls the Bitcoin address.
-func (s *msgServer) verifyProof(sdkCtx sdk.Context, msg *types.MsgClaimWithProof, addressHash [20]byte) error {
+// The address type is only committed to by message versions that bind the address type,
+// and the UTXO references only by versions that bind the UTXO set.
+func verifyProof(sdkCtx sdk.Context, verifier *zk.Verifier, msg *types.MsgClaimWithProof, script claimScript) error {
+	// The registered circuit proves knowledge of a key behind a Hash160; there
+	// is no circuit yet that commits to a P2WSH witness program
+	if script.addressType == zk.AddressTypeP2WSH {
+		return fmt.Errorf("no verifier registered for %s claims", script.addressType)
+	}
+	addressType := script.addressType
+	var addressHash [20]byte
+	if len(script.identifier) != len(addressHash) {
+		return fmt.Errorf("invalid %s address hash length: %d", addressType, len(script.identifier))
+	}
+	copy(addressHash[:], script.identifier)
+
 	// Convert the proof from proto format
 	proofBytes, err := hex.DecodeString(msg.Proof)
 	if err != nil {
 		return fmt.Errorf("proof data is not valid hex: %w", err)
 	}
 
-	// Compute the btcq address hash for binding (prevents front-running)
-	btcqAddressHash := zk.HashBTCQAddress(msg.Claimer)
+	// Compute the btcq address hash of the recipient for binding (prevents
+	// front-running: a relayer cannot redirect the funds to itself). Versions
+	// salting it only learn the recipient here, from the revealed salt
+	btcqAddressHash, err := msg.RecipientBinding()
+	if err != nil {
+		return err
+	}
 
 	// Compute chain ID hash from the chain ID (prevents cross-chain replay)
 	chainID := sdkCtx.ChainID()
-	chainIDHash := zk.ComputeChainIDHash(chainID)
-
-	// Compute expected message hash that should have been signed
-	messageHash := zk.ComputeClaimMessage(addressHash, btcqAddressHash, chainIDHash)
 
 	// Build verification params
 	params := zk.VerificationParams{
-		MessageHash:     messageHash,
 		AddressHash:     addressHash,
 		QBTCAddressHash: btcqAddressHash,
-		ChainID:         chainIDHash,
+		ChainID:         zk.ComputeChainIDHash(chainID),
+		FullChainIDHash: zk.ComputeFullChainIDHash(chainID),
+		MessageVersion:  msg.MessageVersion,
+		AddressType:     addressType,
+		SignatureScheme: msg.SignatureScheme,
 	}
 
-	// Verify the proof using the global verifier
-	return zk.VerifyProofGlobal(proofBytes, params)
+	// Versions that bind the UTXO set pin the proof to the exact list of
+	// references in the message, including ones that end up skipped
+	if zk.ClaimMessageBindsUTXOSet(msg.MessageVersion) {
+		params.UTXOSetCommitment, err = msg.UTXOSetCommitment()
+		if err != nil {
+			return err
+		}
+	}
+	// checkClaimExpiry already rejected expired claims; hashing the declared
+	// expiry means a claim can't extend it without a new signature
+	if zk.ClaimMessageBindsExpiry(msg.MessageVersion) {
+		params.ExpiryHeight = msg.ExpiryHeight
+	}
+
+	// Compute expected message hash that should have been signed for the
+	// declared message version and signature scheme (unknown ones are rejected)
+	params.MessageHash, err = zk.ComputeSignedMessageForParams(params)
+	if err != nil {
+		return err
+	}
+
+	// The public inputs declared in the message are never used for
+	// verification; a claim that declares different ones is rejected rather
+	// than silently verified against the chain's
+	if err := checkDeclaredPublicInputs(msg, params); err != nil {
+		return err
+	}
+
+	// Verify the proof with the verifier of the claim's message version
+	return verifier.VerifyProof(proofBytes, params)
+}
+
+// checkDeclaredPublicInputs compares the hex public inputs declared in the
+// message with the ones the chain derived for the claim.
+func checkDeclaredPublicInputs(msg *types.MsgClaimWithProof, params zk.VerificationParams) error {
+	declared := []struct {
+		name     string
+		value    string
+		expected []byte
+	}{
+		{"message_hash", msg.MessageHash, params.MessageHash[:]},
+		{"address_hash", msg.AddressHash, params.AddressHash[:]},
+		{"qbtc_address_hash", msg.QbtcAddressHash, params.QBTCAddressHash[:]},
+	}
+	for _, d := range declared {
+		value, err := hex.DecodeString(d.value)
+		if err != nil {
+			return fmt.Errorf("%s is not valid hex: %w", d.name, err)
+		}
+		if !bytes.Equal(value, d.expected) {
+			return fmt.Errorf("%s does not match the claim: expected %x", d.name, d.expected)
+		}
+	}
+	return nil
 }
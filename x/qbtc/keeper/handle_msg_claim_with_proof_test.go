@@ -2,21 +2,20 @@ package keeper_test
 
 import (
 	"encoding/hex"
-	"errors"
 	"fmt"
-	"math/big"
 	"testing"
 
 	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 	"github.com/btcq-org/qbtc/common"
+	"github.com/btcq-org/qbtc/constants"
 	"github.com/btcq-org/qbtc/x/qbtc/keeper"
 	module "github.com/btcq-org/qbtc/x/qbtc/module"
 	qbtctestutil "github.com/btcq-org/qbtc/x/qbtc/testutil"
 	"github.com/btcq-org/qbtc/x/qbtc/types"
 	"github.com/btcq-org/qbtc/x/qbtc/zk"
 	"github.com/btcsuite/btcd/btcec/v2"
-	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/crypto/mldsa"
 	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
 	"github.com/cosmos/cosmos-sdk/crypto/codec"
@@ -58,11 +57,6 @@ func setupClaimTest(t *testing.T) *claimTestFixture {
 	vkBytes, err := zk.SerializeVerifyingKey(setup.VerifyingKey)
 	require.NoError(t, err, "VK serialization should succeed")
 
-	err = zk.RegisterVerifier(vkBytes)
-	if err != nil && !errors.Is(err, zk.ErrVerifierAlreadyInitialized) {
-		t.Fatalf("verifier registration failed: %v", err)
-	}
-
 	prover := zk.ProverFromSetup(setup)
 
 	// Initialize SDK config
@@ -105,6 +99,7 @@ func setupClaimTest(t *testing.T) *claimTestFixture {
 		authKeeper,
 		govtypes.ModuleName,
 	)
+	require.NoError(t, k.ZkVerifyingKey.Set(ctx, vkBytes), "storing the VK should succeed")
 
 	// Create claimer address
 	claimerAddr := qbtctestutil.GetRandomBTCQAddress()
@@ -129,66 +124,6 @@ func setupClaimTest(t *testing.T) *claimTestFixture {
 	}
 }
 
-type publicInput struct {
-	MessageHash     [32]byte
-	AddressHash     [20]byte
-	BTCQAddressHash [32]byte
-}
-
-// generateProof generates a ZK proof for the test fixture's claimer
-func (f *claimTestFixture) generateProof(t *testing.T) ([]byte, publicInput) {
-	t.Helper()
-
-	btcqAddressHash := zk.HashBTCQAddress(f.claimerAddr)
-	chainIDHash := zk.ComputeChainIDHash(testChainID)
-
-	// Compute the claim message that needs to be signed
-	messageHash := zk.ComputeClaimMessage(f.addressHash, btcqAddressHash, chainIDHash)
-
-	// Sign the message with ECDSA (simulating what TSS would do)
-	sig := ecdsa.Sign(f.btcPrivKey, messageHash[:])
-
-	// Parse R and S from DER-encoded signature
-	sigBytes := sig.Serialize()
-	rLen := int(sigBytes[3])
-	rBytes := sigBytes[4 : 4+rLen]
-	sLen := int(sigBytes[4+rLen+1])
-	sBytes := sigBytes[4+rLen+2 : 4+rLen+2+sLen]
-
-	// Remove leading zeros (DER uses signed integers)
-	if len(rBytes) > 0 && rBytes[0] == 0 {
-		rBytes = rBytes[1:]
-	}
-	if len(sBytes) > 0 && sBytes[0] == 0 {
-		sBytes = sBytes[1:]
-	}
-
-	sigR := new(big.Int).SetBytes(rBytes)
-	sigS := new(big.Int).SetBytes(sBytes)
-
-	// Get public key coordinates
-	pubKey := f.btcPrivKey.PubKey()
-
-	// Generate the ZK proof
-	proof, err := f.prover.GenerateProof(zk.ProofParams{
-		SignatureR:      sigR,
-		SignatureS:      sigS,
-		PublicKeyX:      pubKey.X(),
-		PublicKeyY:      pubKey.Y(),
-		MessageHash:     messageHash,
-		AddressHash:     f.addressHash,
-		BTCQAddressHash: btcqAddressHash,
-		ChainID:         chainIDHash,
-	})
-	require.NoError(t, err, "proof generation should succeed")
-
-	return proof, publicInput{
-		MessageHash:     messageHash,
-		AddressHash:     f.addressHash,
-		BTCQAddressHash: btcqAddressHash,
-	}
-}
-
 // bitcoinAddressFromHash creates a valid P2PKH Bitcoin address from hash160
 // this method is only used in test , so it is ok to panic on error
 func bitcoinAddressFromHash(hash [20]byte) string {
@@ -214,6 +149,7 @@ func TestClaimWithProof_PartialClaiming(t *testing.T) {
 		expectedClaim  uint32
 		expectedSkip   uint32
 		expectedAmount uint64
+		skipReasons    []types.ClaimSkipReason
 		expectErr      bool
 		errContains    string
 	}{
@@ -238,9 +174,11 @@ func TestClaimWithProof_PartialClaiming(t *testing.T) {
 				require.NoError(t, f.keeper.Utxoes.Set(f.ctx, "aaaa000000000000000000000000000000000000000000000000000000000001-0", utxo1))
 				require.NoError(t, f.keeper.Utxoes.Set(f.ctx, "aaaa000000000000000000000000000000000000000000000000000000000002-1", utxo2))
 
-				// When recipient is provided, MintCoins uses ModuleName, then SendCoinsFromModuleToAccount
-				f.bankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, gomock.Any()).Return(nil).Times(2)
-				f.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, gomock.Any(), gomock.Any()).Return(nil).Times(2)
+				// When recipient is provided, MintCoins uses ModuleName, then SendCoinsFromModuleToAccount,
+				// once for the sum of all claimed UTXOs
+				total := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 200000000))
+				f.bankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, total).Return(nil).Times(1)
+				f.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, gomock.Any(), total).Return(nil).Times(1)
 			},
 			utxos: []types.UTXORef{
 				{Txid: "aaaa000000000000000000000000000000000000000000000000000000000001", Vout: 0},
@@ -286,9 +224,9 @@ func TestClaimWithProof_PartialClaiming(t *testing.T) {
 				require.NoError(t, f.keeper.Utxoes.Set(f.ctx, "bbbb000000000000000000000000000000000000000000000000000000000002-1", utxo2))
 				require.NoError(t, f.keeper.Utxoes.Set(f.ctx, "bbbb000000000000000000000000000000000000000000000000000000000003-2", utxo3))
 
-				// Only 2 UTXOs should be minted (the matching ones)
-				f.bankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, gomock.Any()).Return(nil).Times(2)
-				f.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, gomock.Any(), gomock.Any()).Return(nil).Times(2)
+				// Only the 2 matching UTXOs are minted, in a single mint
+				f.bankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, gomock.Any()).Return(nil).Times(1)
+				f.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, gomock.Any(), gomock.Any()).Return(nil).Times(1)
 			},
 			utxos: []types.UTXORef{
 				{Txid: "bbbb000000000000000000000000000000000000000000000000000000000001", Vout: 0},
@@ -300,6 +238,41 @@ func TestClaimWithProof_PartialClaiming(t *testing.T) {
 			expectedAmount: 300000000, // 50M + 250M
 			expectErr:      false,
 		},
+		{
+			name: "partial claim - P2WSH UTXO does not match a Hash160 proof",
+			setupUTXOs: func(t *testing.T, f *claimTestFixture) {
+				btcAddr := bitcoinAddressFromHash(f.addressHash)
+				p2wshAddr := "bc1qrp33g0q5c5txsp9arysrx4k6zdkfs4nce4xj0gdcccefvpysxf3qccfmv3"
+
+				utxo1 := types.UTXO{
+					Txid:           "bbbb100000000000000000000000000000000000000000000000000000000001",
+					Vout:           0,
+					Amount:         100000000,
+					EntitledAmount: 50000000,
+					ScriptPubKey:   &types.ScriptPubKeyResult{Address: btcAddr},
+				}
+				utxo2 := types.UTXO{
+					Txid:           "bbbb100000000000000000000000000000000000000000000000000000000002",
+					Vout:           0,
+					Amount:         200000000,
+					EntitledAmount: 150000000,
+					ScriptPubKey:   &types.ScriptPubKeyResult{Address: p2wshAddr},
+				}
+				require.NoError(t, f.keeper.Utxoes.Set(f.ctx, "bbbb100000000000000000000000000000000000000000000000000000000001-0", utxo1))
+				require.NoError(t, f.keeper.Utxoes.Set(f.ctx, "bbbb100000000000000000000000000000000000000000000000000000000002-0", utxo2))
+
+				f.bankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, gomock.Any()).Return(nil).Times(1)
+				f.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			},
+			utxos: []types.UTXORef{
+				{Txid: "bbbb100000000000000000000000000000000000000000000000000000000001", Vout: 0},
+				{Txid: "bbbb100000000000000000000000000000000000000000000000000000000002", Vout: 0},
+			},
+			expectedClaim:  1,
+			expectedSkip:   1,
+			expectedAmount: 50000000,
+			expectErr:      false,
+		},
 		{
 			name: "partial claim - some UTXOs not found",
 			setupUTXOs: func(t *testing.T, f *claimTestFixture) {
@@ -362,6 +335,47 @@ func TestClaimWithProof_PartialClaiming(t *testing.T) {
 			expectedAmount: 80000000,
 			expectErr:      false,
 		},
+		{
+			name: "partial claim - immature coinbase UTXO is skipped",
+			setupUTXOs: func(t *testing.T, f *claimTestFixture) {
+				btcAddr := bitcoinAddressFromHash(f.addressHash)
+
+				utxo1 := types.UTXO{
+					Txid:           "dddd100000000000000000000000000000000000000000000000000000000001",
+					Vout:           0,
+					Amount:         100000000,
+					EntitledAmount: 70000000,
+					ScriptPubKey:   &types.ScriptPubKeyResult{Address: btcAddr},
+				}
+				// mined above the last processed block, so it has no confirmations yet
+				coinbase := types.UTXO{
+					Txid:            "dddd100000000000000000000000000000000000000000000000000000000002",
+					Vout:            0,
+					Amount:          625000000,
+					EntitledAmount:  625000000,
+					ScriptPubKey:    &types.ScriptPubKeyResult{Address: btcAddr},
+					CreatedAtHeight: 900000,
+					Coinbase:        true,
+				}
+
+				require.NoError(t, f.keeper.Utxoes.Set(f.ctx, utxo1.GetKey(), utxo1))
+				require.NoError(t, f.keeper.Utxoes.Set(f.ctx, coinbase.GetKey(), coinbase))
+
+				f.bankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, gomock.Any()).Return(nil).Times(1)
+				f.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			},
+			utxos: []types.UTXORef{
+				{Txid: "dddd100000000000000000000000000000000000000000000000000000000002", Vout: 0},
+				{Txid: "dddd100000000000000000000000000000000000000000000000000000000001", Vout: 0},
+			},
+			expectedClaim:  1,
+			expectedSkip:   1,
+			expectedAmount: 70000000,
+			skipReasons: []types.ClaimSkipReason{
+				{Reason: types.SkipReasonImmatureCoinbase, Count: 1},
+			},
+			expectErr: false,
+		},
 		{
 			name: "no valid UTXOs - error",
 			setupUTXOs: func(t *testing.T, f *claimTestFixture) {
@@ -371,6 +385,58 @@ func TestClaimWithProof_PartialClaiming(t *testing.T) {
 				{Txid: "eeee000000000000000000000000000000000000000000000000000000000001", Vout: 0},
 			},
 			expectErr:   true,
+			errContains: "no valid claimable UTXOs found (not_found: 1)",
+		},
+		{
+			name: "already claimed by same claimer - success with nothing claimed",
+			setupUTXOs: func(t *testing.T, f *claimTestFixture) {
+				btcAddr := bitcoinAddressFromHash(f.addressHash)
+				utxo := types.UTXO{
+					Txid:           "eeee000000000000000000000000000000000000000000000000000000000002",
+					Vout:           0,
+					Amount:         100000000,
+					EntitledAmount: 0,
+					ScriptPubKey:   &types.ScriptPubKeyResult{Address: btcAddr},
+				}
+				key := "eeee000000000000000000000000000000000000000000000000000000000002-0"
+				require.NoError(t, f.keeper.Utxoes.Set(f.ctx, key, utxo))
+				require.NoError(t, f.keeper.ClaimRecords.Set(f.ctx, key, types.ClaimRecord{
+					Claimer: f.claimerAddr,
+					Amount:  50000000,
+					Height:  1,
+				}))
+			},
+			utxos: []types.UTXORef{
+				{Txid: "eeee000000000000000000000000000000000000000000000000000000000002", Vout: 0},
+			},
+			expectedClaim:  0,
+			expectedSkip:   1,
+			expectedAmount: 0,
+			expectErr:      false,
+		},
+		{
+			name: "already claimed by another claimer - error",
+			setupUTXOs: func(t *testing.T, f *claimTestFixture) {
+				btcAddr := bitcoinAddressFromHash(f.addressHash)
+				utxo := types.UTXO{
+					Txid:           "eeee000000000000000000000000000000000000000000000000000000000003",
+					Vout:           0,
+					Amount:         100000000,
+					EntitledAmount: 0,
+					ScriptPubKey:   &types.ScriptPubKeyResult{Address: btcAddr},
+				}
+				key := "eeee000000000000000000000000000000000000000000000000000000000003-0"
+				require.NoError(t, f.keeper.Utxoes.Set(f.ctx, key, utxo))
+				require.NoError(t, f.keeper.ClaimRecords.Set(f.ctx, key, types.ClaimRecord{
+					Claimer: sdk.AccAddress([]byte("other_claimer_______")).String(),
+					Amount:  50000000,
+					Height:  1,
+				}))
+			},
+			utxos: []types.UTXORef{
+				{Txid: "eeee000000000000000000000000000000000000000000000000000000000003", Vout: 0},
+			},
+			expectErr:   true,
 			errContains: "no valid claimable UTXOs found",
 		},
 		{
@@ -417,9 +483,9 @@ func TestClaimWithProof_PartialClaiming(t *testing.T) {
 				require.NoError(t, f.keeper.Utxoes.Set(f.ctx, "ffff000000000000000000000000000000000000000000000000000000000003-2", utxo3))
 				require.NoError(t, f.keeper.Utxoes.Set(f.ctx, "ffff000000000000000000000000000000000000000000000000000000000004-3", utxo4))
 
-				// Only 2 valid UTXOs
-				f.bankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, gomock.Any()).Return(nil).Times(2)
-				f.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, gomock.Any(), gomock.Any()).Return(nil).Times(2)
+				// Only 2 valid UTXOs, minted together
+				f.bankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, gomock.Any()).Return(nil).Times(1)
+				f.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, gomock.Any(), gomock.Any()).Return(nil).Times(1)
 			},
 			utxos: []types.UTXORef{
 				{Txid: "ffff000000000000000000000000000000000000000000000000000000000001", Vout: 0},
@@ -431,7 +497,13 @@ func TestClaimWithProof_PartialClaiming(t *testing.T) {
 			expectedClaim:  2,
 			expectedSkip:   3,         // already claimed + wrong address + not found
 			expectedAmount: 100000000, // 40M + 60M
-			expectErr:      false,
+			// the wrong address does not decode, so it is not claimable at all
+			skipReasons: []types.ClaimSkipReason{
+				{Reason: types.SkipReasonNotFound, Count: 1},
+				{Reason: types.SkipReasonAlreadyClaimed, Count: 1},
+				{Reason: types.SkipReasonNotClaimable, Count: 1},
+			},
+			expectErr: false,
 		},
 	}
 
@@ -446,7 +518,7 @@ func TestClaimWithProof_PartialClaiming(t *testing.T) {
 			}
 
 			// Generate proof
-			proofData, publicInput := f.generateProof(st)
+			proofData, publicInput := qbtctestutil.GenerateClaimProof(st, f.prover, f.btcPrivKey, f.claimerAddr, testChainID)
 			// Create claim message
 			msg := &types.MsgClaimWithProof{
 				Claimer:         f.claimerAddr,
@@ -454,7 +526,7 @@ func TestClaimWithProof_PartialClaiming(t *testing.T) {
 				Proof:           hex.EncodeToString(proofData),
 				MessageHash:     hex.EncodeToString(publicInput.MessageHash[:]),
 				AddressHash:     hex.EncodeToString(publicInput.AddressHash[:]),
-				QbtcAddressHash: hex.EncodeToString(publicInput.BTCQAddressHash[:]),
+				QbtcAddressHash: hex.EncodeToString(publicInput.QBTCAddressHash[:]),
 			}
 
 			// Execute
@@ -474,6 +546,9 @@ func TestClaimWithProof_PartialClaiming(t *testing.T) {
 				assert.Equal(t, tc.expectedClaim, resp.UtxosClaimed, "claimed count mismatch")
 				assert.Equal(t, tc.expectedSkip, resp.UtxosSkipped, "skipped count mismatch")
 				assert.Equal(t, tc.expectedAmount, resp.TotalAmountClaimed, "amount mismatch")
+				if tc.skipReasons != nil {
+					assert.Equal(t, tc.skipReasons, resp.SkipReasons, "skip reasons mismatch")
+				}
 			}
 		})
 	}
@@ -519,3 +594,738 @@ func TestClaimWithProof_InvalidProof(t *testing.T) {
 	assert.Contains(t, err.Error(), "proof verification failed")
 	assert.Nil(t, resp)
 }
+
+// TestClaimWithProof_VerifierNotInitialized tests that a claim arriving
+// before a verifying key is stored fails with ErrClaimsNotEnabled
+func TestClaimWithProof_VerifierNotInitialized(t *testing.T) {
+	f := initFixture(t)
+	claimer := sdk.AccAddress([]byte("early_claimer_______")).String()
+	qbtcAddr := zk.HashBTCQAddress(claimer)
+	msg := &types.MsgClaimWithProof{
+		Claimer: claimer,
+		Utxos: []types.UTXORef{
+			{Txid: "6666000000000000000000000000000000000000000000000000000000000001", Vout: 0},
+		},
+		Proof:           hex.EncodeToString(make([]byte, 500)),
+		MessageHash:     hex.EncodeToString(make([]byte, 32)),
+		AddressHash:     hex.EncodeToString(make([]byte, 20)),
+		QbtcAddressHash: hex.EncodeToString(qbtcAddr[:]),
+	}
+
+	server := keeper.NewMsgServerImpl(f.keeper)
+	resp, err := server.ClaimWithProof(f.ctx, msg)
+	require.ErrorIs(t, err, types.ErrClaimsNotEnabled)
+	require.Nil(t, resp)
+}
+
+// TestClaimWithProof_UTXOSetBinding tests that a proof for a message version
+// binding the UTXO set is only accepted for the exact UTXOs it was signed for
+func TestClaimWithProof_UTXOSetBinding(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	f := setupClaimTest(t)
+	btcAddr := bitcoinAddressFromHash(f.addressHash)
+	refs := []types.UTXORef{
+		{Txid: "7777000000000000000000000000000000000000000000000000000000000001", Vout: 0},
+		{Txid: "7777000000000000000000000000000000000000000000000000000000000002", Vout: 1},
+	}
+	for _, ref := range refs {
+		require.NoError(t, f.keeper.Utxoes.Set(f.ctx, fmt.Sprintf("%s-%d", ref.Txid, ref.Vout), types.UTXO{
+			Txid:           ref.Txid,
+			Vout:           ref.Vout,
+			Amount:         100000000,
+			EntitledAmount: 100000000,
+			ScriptPubKey:   &types.ScriptPubKeyResult{Address: btcAddr},
+		}))
+	}
+
+	// Sign for the full set, listed in reverse order
+	pinned := &types.MsgClaimWithProof{Utxos: []types.UTXORef{refs[1], refs[0]}}
+	commitment, err := pinned.UTXOSetCommitment()
+	require.NoError(t, err)
+	proof, params := qbtctestutil.GenerateClaimProofForParams(t, f.prover, f.btcPrivKey, zk.VerificationParams{
+		QBTCAddressHash:   zk.HashBTCQAddress(f.claimerAddr),
+		ChainID:           zk.ComputeChainIDHash(testChainID),
+		FullChainIDHash:   zk.ComputeFullChainIDHash(testChainID),
+		MessageVersion:    zk.ClaimMessageVersionV4,
+		AddressType:       zk.AddressTypeP2PKH,
+		UTXOSetCommitment: commitment,
+	})
+
+	newMsg := func(utxos ...types.UTXORef) *types.MsgClaimWithProof {
+		return &types.MsgClaimWithProof{
+			Claimer:         f.claimerAddr,
+			Utxos:           utxos,
+			Proof:           hex.EncodeToString(proof),
+			MessageHash:     hex.EncodeToString(params.MessageHash[:]),
+			AddressHash:     hex.EncodeToString(f.addressHash[:]),
+			QbtcAddressHash: hex.EncodeToString(params.QBTCAddressHash[:]),
+			MessageVersion:  zk.ClaimMessageVersionV4,
+		}
+	}
+	server := keeper.NewMsgServerImpl(f.keeper)
+
+	// The proof does not cover a subset of the UTXOs it was signed for
+	_, err = server.ClaimWithProof(f.ctx, newMsg(refs[0]))
+	require.ErrorContains(t, err, "proof verification failed")
+
+	// The exact set is accepted in any order
+	f.bankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, gomock.Any()).Return(nil).Times(1)
+	f.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	resp, err := server.ClaimWithProof(f.ctx, newMsg(refs...))
+	require.NoError(t, err)
+	require.Equal(t, uint32(2), resp.UtxosClaimed)
+	require.Equal(t, uint64(200000000), resp.TotalAmountClaimed)
+}
+
+// TestClaimWithProof_ExpiryBinding tests that a proof for a message version
+// binding an expiry height is only accepted up to that height
+func TestClaimWithProof_ExpiryBinding(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	f := setupClaimTest(t)
+	btcAddr := bitcoinAddressFromHash(f.addressHash)
+	ref := types.UTXORef{Txid: "7878000000000000000000000000000000000000000000000000000000000001", Vout: 0}
+	require.NoError(t, f.keeper.Utxoes.Set(f.ctx, fmt.Sprintf("%s-%d", ref.Txid, ref.Vout), types.UTXO{
+		Txid:           ref.Txid,
+		Vout:           ref.Vout,
+		Amount:         100000000,
+		EntitledAmount: 100000000,
+		ScriptPubKey:   &types.ScriptPubKeyResult{Address: btcAddr},
+	}))
+
+	const expiry = 110
+	commitment, err := (&types.MsgClaimWithProof{Utxos: []types.UTXORef{ref}}).UTXOSetCommitment()
+	require.NoError(t, err)
+	proof, params := qbtctestutil.GenerateClaimProofForParams(t, f.prover, f.btcPrivKey, zk.VerificationParams{
+		QBTCAddressHash:   zk.HashBTCQAddress(f.claimerAddr),
+		ChainID:           zk.ComputeChainIDHash(testChainID),
+		FullChainIDHash:   zk.ComputeFullChainIDHash(testChainID),
+		MessageVersion:    zk.ClaimMessageVersionV5,
+		AddressType:       zk.AddressTypeP2PKH,
+		UTXOSetCommitment: commitment,
+		ExpiryHeight:      expiry,
+	})
+
+	newMsg := func(expiryHeight uint64) *types.MsgClaimWithProof {
+		return &types.MsgClaimWithProof{
+			Claimer:         f.claimerAddr,
+			Utxos:           []types.UTXORef{ref},
+			Proof:           hex.EncodeToString(proof),
+			MessageHash:     hex.EncodeToString(params.MessageHash[:]),
+			AddressHash:     hex.EncodeToString(f.addressHash[:]),
+			QbtcAddressHash: hex.EncodeToString(params.QBTCAddressHash[:]),
+			MessageVersion:  zk.ClaimMessageVersionV5,
+			ExpiryHeight:    expiryHeight,
+		}
+	}
+	server := keeper.NewMsgServerImpl(f.keeper)
+
+	// Past the expiry height the claim is rejected
+	_, err = server.ClaimWithProof(f.ctx.WithBlockHeight(expiry+1), newMsg(expiry))
+	require.ErrorIs(t, err, types.ErrClaimExpired)
+
+	// Declaring a later expiry than the one signed does not extend it
+	_, err = server.ClaimWithProof(f.ctx.WithBlockHeight(expiry+1), newMsg(expiry+10))
+	require.ErrorContains(t, err, "proof verification failed")
+
+	// An expiry further ahead than MaxClaimExpiryBlocks is rejected
+	require.NoError(t, f.keeper.ConstOverrides.Set(f.ctx, constants.MaxClaimExpiryBlocks.String(), int64(5)))
+	_, err = server.ClaimWithProof(f.ctx.WithBlockHeight(expiry-6), newMsg(expiry))
+	require.ErrorContains(t, err, "MaxClaimExpiryBlocks")
+
+	// Up to and including the expiry height the claim goes through
+	f.bankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, gomock.Any()).Return(nil).Times(1)
+	f.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	resp, err := server.ClaimWithProof(f.ctx.WithBlockHeight(expiry), newMsg(expiry))
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), resp.UtxosClaimed)
+}
+
+// TestClaimWithProof_SaltedDestination tests that a proof bound to a salted
+// destination commitment is only accepted with the salt it was made for
+func TestClaimWithProof_SaltedDestination(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	f := setupClaimTest(t)
+	ref := types.UTXORef{Txid: "7a7a000000000000000000000000000000000000000000000000000000000001", Vout: 0}
+	require.NoError(t, f.keeper.Utxoes.Set(f.ctx, fmt.Sprintf("%s-%d", ref.Txid, ref.Vout), types.UTXO{
+		Txid:           ref.Txid,
+		Vout:           ref.Vout,
+		Amount:         100000000,
+		EntitledAmount: 100000000,
+		ScriptPubKey:   &types.ScriptPubKeyResult{Address: bitcoinAddressFromHash(f.addressHash)},
+	}))
+
+	// The proof only commits to the destination; a relayer submits it
+	destination := qbtctestutil.GetRandomBTCQAddress()
+	salt := [zk.DestinationSaltSize]byte{0x5a, 0x17}
+	expiry := uint64(f.ctx.BlockHeight()) + 10
+	commitment, err := (&types.MsgClaimWithProof{Utxos: []types.UTXORef{ref}}).UTXOSetCommitment()
+	require.NoError(t, err)
+	proof, params := qbtctestutil.GenerateClaimProofForParams(t, f.prover, f.btcPrivKey, zk.VerificationParams{
+		QBTCAddressHash:   zk.CommitBTCQAddress(destination, salt),
+		ChainID:           zk.ComputeChainIDHash(testChainID),
+		FullChainIDHash:   zk.ComputeFullChainIDHash(testChainID),
+		MessageVersion:    zk.ClaimMessageVersionV6,
+		AddressType:       zk.AddressTypeP2PKH,
+		UTXOSetCommitment: commitment,
+		ExpiryHeight:      expiry,
+	})
+	require.NotEqual(t, zk.HashBTCQAddress(destination), params.QBTCAddressHash)
+
+	newMsg := func(salt [zk.DestinationSaltSize]byte) *types.MsgClaimWithProof {
+		return &types.MsgClaimWithProof{
+			Claimer:         f.claimerAddr,
+			Destination:     destination,
+			Utxos:           []types.UTXORef{ref},
+			Proof:           hex.EncodeToString(proof),
+			MessageHash:     hex.EncodeToString(params.MessageHash[:]),
+			AddressHash:     hex.EncodeToString(f.addressHash[:]),
+			QbtcAddressHash: hex.EncodeToString(params.QBTCAddressHash[:]),
+			MessageVersion:  zk.ClaimMessageVersionV6,
+			ExpiryHeight:    expiry,
+			DestinationSalt: hex.EncodeToString(salt[:]),
+		}
+	}
+	server := keeper.NewMsgServerImpl(f.keeper)
+
+	// Another salt commits to another destination
+	wrongSalt := salt
+	wrongSalt[0] ^= 0xff
+	_, err = server.ClaimWithProof(f.ctx, newMsg(wrongSalt))
+	require.ErrorContains(t, err, "qbtc_address_hash does not match")
+
+	// The salt it was made for reveals the destination, which receives the funds
+	f.bankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, gomock.Any()).Return(nil).Times(1)
+	f.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, sdk.MustAccAddressFromBech32(destination), gomock.Any()).Return(nil).Times(1)
+	resp, err := server.ClaimWithProof(f.ctx, newMsg(salt))
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), resp.UtxosClaimed)
+}
+
+// TestClaimWithProof_ExpiryRequired tests that a chain requiring an expiry
+// rejects claims of message versions without one
+func TestClaimWithProof_ExpiryRequired(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	f := setupClaimTest(t)
+	proof, input := qbtctestutil.GenerateClaimProof(t, f.prover, f.btcPrivKey, f.claimerAddr, testChainID)
+	msg := &types.MsgClaimWithProof{
+		Claimer:         f.claimerAddr,
+		Utxos:           []types.UTXORef{{Txid: "7979000000000000000000000000000000000000000000000000000000000001", Vout: 0}},
+		Proof:           hex.EncodeToString(proof),
+		MessageHash:     hex.EncodeToString(input.MessageHash[:]),
+		AddressHash:     hex.EncodeToString(input.AddressHash[:]),
+		QbtcAddressHash: hex.EncodeToString(input.QBTCAddressHash[:]),
+	}
+	server := keeper.NewMsgServerImpl(f.keeper)
+
+	require.NoError(t, f.keeper.ConstOverrides.Set(f.ctx, constants.ClaimExpiryRequired.String(), int64(1)))
+	_, err := server.ClaimWithProof(f.ctx, msg)
+	require.ErrorContains(t, err, "binds an expiry height")
+
+	// Without the requirement the claim reaches the UTXO lookup
+	require.NoError(t, f.keeper.ConstOverrides.Set(f.ctx, constants.ClaimExpiryRequired.String(), int64(0)))
+	_, err = server.ClaimWithProof(f.ctx, msg)
+	require.ErrorIs(t, err, types.ErrNoClaimableUTXOs)
+}
+
+// TestClaimWithProof_DeclaredPublicInputs tests that a claim is rejected when
+// the public inputs it declares differ from the ones the chain derives
+func TestClaimWithProof_DeclaredPublicInputs(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	f := setupClaimTest(t)
+	ref := types.UTXORef{Txid: "6666000000000000000000000000000000000000000000000000000000000001", Vout: 0}
+	require.NoError(t, f.keeper.Utxoes.Set(f.ctx, ref.Txid+"-0", types.UTXO{
+		Txid:           ref.Txid,
+		Amount:         100000000,
+		EntitledAmount: 100000000,
+		ScriptPubKey:   &types.ScriptPubKeyResult{Address: bitcoinAddressFromHash(f.addressHash)},
+	}))
+	proof, pi := qbtctestutil.GenerateClaimProof(t, f.prover, f.btcPrivKey, f.claimerAddr, testChainID)
+	other := zk.HashBTCQAddress("qbtc1someoneelse")
+
+	tests := []struct {
+		name        string
+		tamper      func(msg *types.MsgClaimWithProof)
+		errContains string
+	}{
+		{
+			name:        "message hash",
+			tamper:      func(msg *types.MsgClaimWithProof) { msg.MessageHash = hex.EncodeToString(other[:]) },
+			errContains: "message_hash does not match",
+		},
+		{
+			name:        "address hash",
+			tamper:      func(msg *types.MsgClaimWithProof) { msg.AddressHash = hex.EncodeToString(other[:20]) },
+			errContains: "address_hash does not match",
+		},
+		{
+			name:        "qbtc address hash",
+			tamper:      func(msg *types.MsgClaimWithProof) { msg.QbtcAddressHash = hex.EncodeToString(other[:]) },
+			errContains: "qbtc_address_hash does not match",
+		},
+	}
+	server := keeper.NewMsgServerImpl(f.keeper)
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := &types.MsgClaimWithProof{
+				Claimer:         f.claimerAddr,
+				Utxos:           []types.UTXORef{ref},
+				Proof:           hex.EncodeToString(proof),
+				MessageHash:     hex.EncodeToString(pi.MessageHash[:]),
+				AddressHash:     hex.EncodeToString(pi.AddressHash[:]),
+				QbtcAddressHash: hex.EncodeToString(pi.QBTCAddressHash[:]),
+			}
+			tc.tamper(msg)
+			_, err := server.ClaimWithProof(f.ctx, msg)
+			require.ErrorContains(t, err, tc.errContains)
+		})
+	}
+}
+
+// TestClaimWithProof_RelayedDestination tests that a relayer can submit a
+// proof bound to someone else's address and that the funds only ever go to
+// the bound destination
+func TestClaimWithProof_RelayedDestination(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	f := setupClaimTest(t)
+	ref := types.UTXORef{Txid: "5555000000000000000000000000000000000000000000000000000000000001", Vout: 0}
+	require.NoError(t, f.keeper.Utxoes.Set(f.ctx, ref.Txid+"-0", types.UTXO{
+		Txid:           ref.Txid,
+		Amount:         100000000,
+		EntitledAmount: 100000000,
+		ScriptPubKey:   &types.ScriptPubKeyResult{Address: bitcoinAddressFromHash(f.addressHash)},
+	}))
+	// The proof is bound to the fixture's claimer address
+	proof, pi := qbtctestutil.GenerateClaimProof(t, f.prover, f.btcPrivKey, f.claimerAddr, testChainID)
+	relayer := qbtctestutil.GetRandomBTCQAddress()
+	relayerHash := zk.HashBTCQAddress(relayer)
+
+	newMsg := func(destination string, qbtcAddressHash [32]byte) *types.MsgClaimWithProof {
+		return &types.MsgClaimWithProof{
+			Claimer:         relayer,
+			Destination:     destination,
+			Utxos:           []types.UTXORef{ref},
+			Proof:           hex.EncodeToString(proof),
+			MessageHash:     hex.EncodeToString(pi.MessageHash[:]),
+			AddressHash:     hex.EncodeToString(pi.AddressHash[:]),
+			QbtcAddressHash: hex.EncodeToString(qbtcAddressHash[:]),
+		}
+	}
+	server := keeper.NewMsgServerImpl(f.keeper)
+
+	// The relayer cannot take the funds, neither by claiming for itself nor
+	// by naming itself as the destination
+	_, err := server.ClaimWithProof(f.ctx, newMsg("", pi.QBTCAddressHash))
+	require.ErrorContains(t, err, "qbtc_address_hash does not match")
+	_, err = server.ClaimWithProof(f.ctx, newMsg(relayer, relayerHash))
+	require.ErrorContains(t, err, "proof verification failed")
+
+	// Relaying to the bound destination mints to the destination only
+	destination := sdk.MustAccAddressFromBech32(f.claimerAddr)
+	f.bankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, gomock.Any()).Return(nil).Times(1)
+	f.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, destination, gomock.Any()).Return(nil).Times(1)
+	resp, err := server.ClaimWithProof(f.ctx, newMsg(f.claimerAddr, pi.QBTCAddressHash))
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), resp.UtxosClaimed)
+	require.Equal(t, uint64(100000000), resp.TotalAmountClaimed)
+
+	// Relaying the same claim again is a no-op for the destination
+	resp, err = server.ClaimWithProof(f.ctx, newMsg(f.claimerAddr, pi.QBTCAddressHash))
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), resp.UtxosAlreadyClaimed)
+	require.Zero(t, resp.TotalAmountClaimed)
+}
+
+// TestClaimWithProof_ClaimSucceededEvent tests that a successful claim emits
+// a typed EventClaimSucceeded matching the response
+func TestClaimWithProof_ClaimSucceededEvent(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	f := setupClaimTest(t)
+	ref := types.UTXORef{Txid: "5656000000000000000000000000000000000000000000000000000000000001", Vout: 0}
+	missing := types.UTXORef{Txid: "5656000000000000000000000000000000000000000000000000000000000002", Vout: 0}
+	btcAddress := bitcoinAddressFromHash(f.addressHash)
+	require.NoError(t, f.keeper.Utxoes.Set(f.ctx, ref.Txid+"-0", types.UTXO{
+		Txid:           ref.Txid,
+		Amount:         100000000,
+		EntitledAmount: 100000000,
+		ScriptPubKey:   &types.ScriptPubKeyResult{Address: btcAddress},
+	}))
+	proof, pi := qbtctestutil.GenerateClaimProof(t, f.prover, f.btcPrivKey, f.claimerAddr, testChainID)
+	msg := &types.MsgClaimWithProof{
+		Claimer:         f.claimerAddr,
+		Utxos:           []types.UTXORef{ref, missing},
+		Proof:           hex.EncodeToString(proof),
+		MessageHash:     hex.EncodeToString(pi.MessageHash[:]),
+		AddressHash:     hex.EncodeToString(pi.AddressHash[:]),
+		QbtcAddressHash: hex.EncodeToString(pi.QBTCAddressHash[:]),
+	}
+
+	f.bankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, gomock.Any()).Return(nil).Times(1)
+	f.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	server := keeper.NewMsgServerImpl(f.keeper)
+	resp, err := server.ClaimWithProof(f.ctx, msg)
+	require.NoError(t, err)
+
+	found := claimSucceededEvents(t, f.ctx.EventManager().Events())
+	require.Len(t, found, 1)
+	require.Equal(t, &types.EventClaimSucceeded{
+		Claimer:        f.claimerAddr,
+		Recipient:      f.claimerAddr,
+		BtcAddress:     btcAddress,
+		BtcAddressHash: hex.EncodeToString(f.addressHash[:]),
+		Amount:         resp.TotalAmountClaimed,
+		UtxosClaimed:   1,
+		UtxosSkipped:   1,
+		SkipReasons:    []types.ClaimSkipReason{{Reason: types.SkipReasonNotFound, Count: 1}},
+		CircuitType:    zk.CircuitTypeForAddressType(zk.AddressTypeP2PKH),
+		MessageVersion: zk.ClaimMessageVersionV1,
+	}, found[0])
+
+	// Resubmitting releases nothing. With a UTXO that was not claimed by the
+	// recipient among them, that is a failed claim rather than a no-op
+	_, err = server.ClaimWithProof(f.ctx, msg)
+	require.ErrorIs(t, err, types.ErrNoClaimableUTXOs)
+
+	// With only the claimed UTXO it succeeds, and no event is emitted for it
+	msg.Utxos = []types.UTXORef{ref}
+	ctx := f.ctx.WithEventManager(sdk.NewEventManager())
+	resp, err = server.ClaimWithProof(ctx, msg)
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), resp.UtxosAlreadyClaimed)
+	require.Empty(t, claimSucceededEvents(t, ctx.EventManager().Events()))
+}
+
+// claimSucceededEvents returns the EventClaimSucceeded events among events
+func claimSucceededEvents(t *testing.T, events sdk.Events) []*types.EventClaimSucceeded {
+	t.Helper()
+	var found []*types.EventClaimSucceeded
+	for _, event := range events {
+		if event.Type != "qbtc.qbtc.v1.EventClaimSucceeded" {
+			continue
+		}
+		parsed, err := sdk.ParseTypedEvent(abci.Event(event))
+		require.NoError(t, err)
+		found = append(found, parsed.(*types.EventClaimSucceeded))
+	}
+	return found
+}
+
+func TestClaimWithProof_DenyList(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	f := setupClaimTest(t)
+	ref := types.UTXORef{Txid: "6666000000000000000000000000000000000000000000000000000000000001", Vout: 0}
+	require.NoError(t, f.keeper.Utxoes.Set(f.ctx, ref.Txid+"-0", types.UTXO{
+		Txid:           ref.Txid,
+		Amount:         100000000,
+		EntitledAmount: 100000000,
+		ScriptPubKey:   &types.ScriptPubKeyResult{Address: bitcoinAddressFromHash(f.addressHash)},
+	}))
+	proof, pi := qbtctestutil.GenerateClaimProof(t, f.prover, f.btcPrivKey, f.claimerAddr, testChainID)
+	relayer := qbtctestutil.GetRandomBTCQAddress()
+	newMsg := func(claimer, destination string) *types.MsgClaimWithProof {
+		return &types.MsgClaimWithProof{
+			Claimer:         claimer,
+			Destination:     destination,
+			Utxos:           []types.UTXORef{ref},
+			Proof:           hex.EncodeToString(proof),
+			MessageHash:     hex.EncodeToString(pi.MessageHash[:]),
+			AddressHash:     hex.EncodeToString(pi.AddressHash[:]),
+			QbtcAddressHash: hex.EncodeToString(pi.QBTCAddressHash[:]),
+		}
+	}
+	server := keeper.NewMsgServerImpl(f.keeper)
+
+	// A listed claimer is rejected, and so is a listed destination behind an
+	// unlisted relayer
+	_, err := server.UpdateClaimDenyList(f.ctx, types.NewMsgUpdateClaimDenyList(f.keeper.GetAuthority(), []string{f.claimerAddr}, nil))
+	require.NoError(t, err)
+	_, err = server.ClaimWithProof(f.ctx, newMsg(f.claimerAddr, ""))
+	require.ErrorIs(t, err, types.ErrClaimDenied)
+	_, err = server.ClaimWithProof(f.ctx, newMsg(relayer, f.claimerAddr))
+	require.ErrorIs(t, err, types.ErrClaimDenied)
+
+	// Once removed from the list the claim goes through
+	_, err = server.UpdateClaimDenyList(f.ctx, types.NewMsgUpdateClaimDenyList(f.keeper.GetAuthority(), nil, []string{f.claimerAddr}))
+	require.NoError(t, err)
+	f.bankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, gomock.Any()).Return(nil).Times(1)
+	f.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	resp, err := server.ClaimWithProof(f.ctx, newMsg(f.claimerAddr, ""))
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), resp.UtxosClaimed)
+}
+
+func TestClaimWithProof_Cooldown(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	const cooldown = 10
+	f := setupClaimTest(t)
+	require.NoError(t, f.keeper.ConstOverrides.Set(f.ctx, constants.ClaimCooldownBlocks.String(), cooldown))
+	refs := make([]types.UTXORef, 2)
+	for i := range refs {
+		refs[i] = types.UTXORef{Txid: fmt.Sprintf("7777%060d", i), Vout: 0}
+		require.NoError(t, f.keeper.Utxoes.Set(f.ctx, refs[i].Txid+"-0", types.UTXO{
+			Txid:           refs[i].Txid,
+			Amount:         100000000,
+			EntitledAmount: 100000000,
+			ScriptPubKey:   &types.ScriptPubKeyResult{Address: bitcoinAddressFromHash(f.addressHash)},
+		}))
+	}
+	proof, pi := qbtctestutil.GenerateClaimProof(t, f.prover, f.btcPrivKey, f.claimerAddr, testChainID)
+	newMsg := func(ref types.UTXORef) *types.MsgClaimWithProof {
+		return &types.MsgClaimWithProof{
+			Claimer:         f.claimerAddr,
+			Utxos:           []types.UTXORef{ref},
+			Proof:           hex.EncodeToString(proof),
+			MessageHash:     hex.EncodeToString(pi.MessageHash[:]),
+			AddressHash:     hex.EncodeToString(pi.AddressHash[:]),
+			QbtcAddressHash: hex.EncodeToString(pi.QBTCAddressHash[:]),
+		}
+	}
+	f.bankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, gomock.Any()).Return(nil).Times(2)
+	f.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, gomock.Any(), gomock.Any()).Return(nil).Times(2)
+	server := keeper.NewMsgServerImpl(f.keeper)
+
+	ctx := f.ctx.WithBlockHeight(100)
+	_, err := server.ClaimWithProof(ctx, newMsg(refs[0]))
+	require.NoError(t, err)
+
+	// Rebroadcasting the claim is still a no-op rather than a cooldown error
+	resp, err := server.ClaimWithProof(ctx.WithBlockHeight(101), newMsg(refs[0]))
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), resp.UtxosAlreadyClaimed)
+
+	// A new claim to the same recipient waits out the cooldown
+	_, err = server.ClaimWithProof(ctx.WithBlockHeight(100+cooldown-1), newMsg(refs[1]))
+	require.ErrorIs(t, err, types.ErrClaimCooldown)
+	_, err = server.ClaimWithProof(ctx.WithBlockHeight(100+cooldown), newMsg(refs[1]))
+	require.NoError(t, err)
+
+	last, err := f.keeper.LastClaimHeight.Get(f.ctx, f.claimerAddr)
+	require.NoError(t, err)
+	require.Equal(t, int64(100+cooldown), last)
+}
+
+func TestClaimWithProof_VKFingerprint(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	f := setupClaimTest(t)
+	ref := types.UTXORef{Txid: "8888000000000000000000000000000000000000000000000000000000000001", Vout: 0}
+	require.NoError(t, f.keeper.Utxoes.Set(f.ctx, ref.Txid+"-0", types.UTXO{
+		Txid:           ref.Txid,
+		Amount:         100000000,
+		EntitledAmount: 100000000,
+		ScriptPubKey:   &types.ScriptPubKeyResult{Address: bitcoinAddressFromHash(f.addressHash)},
+	}))
+	proof, pi := qbtctestutil.GenerateClaimProof(t, f.prover, f.btcPrivKey, f.claimerAddr, testChainID)
+	newMsg := func(fingerprint string) *types.MsgClaimWithProof {
+		return &types.MsgClaimWithProof{
+			Claimer:         f.claimerAddr,
+			Utxos:           []types.UTXORef{ref},
+			Proof:           hex.EncodeToString(proof),
+			MessageHash:     hex.EncodeToString(pi.MessageHash[:]),
+			AddressHash:     hex.EncodeToString(pi.AddressHash[:]),
+			QbtcAddressHash: hex.EncodeToString(pi.QBTCAddressHash[:]),
+			VkFingerprint:   fingerprint,
+		}
+	}
+	server := keeper.NewMsgServerImpl(f.keeper)
+
+	// A proof made for a rotated-out key is named as such
+	stale := zk.ShortVerifyingKeyFingerprint([]byte("previous verifying key"))
+	_, err := server.ClaimWithProof(f.ctx, newMsg(hex.EncodeToString(stale[:])))
+	require.ErrorIs(t, err, types.ErrVerifyingKeyMismatch)
+
+	vkBytes, err := f.keeper.ZkVerifyingKey.Get(f.ctx)
+	require.NoError(t, err)
+	active := zk.VerifyingKeyFingerprint(vkBytes)
+	f.bankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, gomock.Any()).Return(nil).Times(1)
+	f.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	resp, err := server.ClaimWithProof(f.ctx, newMsg(active[:2*zk.VKFingerprintSize]))
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), resp.UtxosClaimed)
+}
+
+// TestClaimWithProof_VersionVerifyingKeys tests that claims are verified with
+// the key of their message version, and with the default key for versions
+// without one
+func TestClaimWithProof_VersionVerifyingKeys(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	f := setupClaimTest(t)
+	refs := make([]types.UTXORef, 2)
+	for i := range refs {
+		refs[i] = types.UTXORef{Txid: fmt.Sprintf("5555%060d", i), Vout: 0}
+		require.NoError(t, f.keeper.Utxoes.Set(f.ctx, refs[i].Txid+"-0", types.UTXO{
+			Txid:           refs[i].Txid,
+			Amount:         100000000,
+			EntitledAmount: 100000000,
+			ScriptPubKey:   &types.ScriptPubKeyResult{Address: bitcoinAddressFromHash(f.addressHash)},
+		}))
+	}
+
+	// A second key, only for claims of message version 2
+	v2Setup, err := zk.SetupWithSeed([]byte("qbtc claim v2 verifying key test"))
+	require.NoError(t, err)
+	v2VK, err := zk.SerializeVerifyingKey(v2Setup.VerifyingKey)
+	require.NoError(t, err)
+	server := keeper.NewMsgServerImpl(f.keeper)
+	_, err = server.ReplaceVerifyingKey(f.ctx, &types.MsgReplaceVerifyingKey{
+		Authority:      f.keeper.GetAuthority(),
+		VerifyingKey:   v2VK,
+		MessageVersion: zk.ClaimMessageVersionV2,
+	})
+	require.NoError(t, err)
+
+	// The same version 2 claim, proven with the default key and with its own
+	defaultKeyProof, v2Params := qbtctestutil.GenerateClaimProofForParams(t, f.prover, f.btcPrivKey, zk.VerificationParams{
+		QBTCAddressHash: zk.HashBTCQAddress(f.claimerAddr),
+		ChainID:         zk.ComputeChainIDHash(testChainID),
+		FullChainIDHash: zk.ComputeFullChainIDHash(testChainID),
+		MessageVersion:  zk.ClaimMessageVersionV2,
+		AddressType:     zk.AddressTypeP2PKH,
+	})
+	v2Proof, _ := qbtctestutil.GenerateClaimProofForParams(t, zk.ProverFromSetup(v2Setup), f.btcPrivKey, v2Params)
+	v2Msg := func(proof []byte) *types.MsgClaimWithProof {
+		return &types.MsgClaimWithProof{
+			Claimer:         f.claimerAddr,
+			Utxos:           []types.UTXORef{refs[1]},
+			Proof:           hex.EncodeToString(proof),
+			MessageHash:     hex.EncodeToString(v2Params.MessageHash[:]),
+			AddressHash:     hex.EncodeToString(f.addressHash[:]),
+			QbtcAddressHash: hex.EncodeToString(v2Params.QBTCAddressHash[:]),
+			MessageVersion:  zk.ClaimMessageVersionV2,
+		}
+	}
+
+	// A version 2 proof made with the default key no longer verifies
+	_, err = server.ClaimWithProof(f.ctx, v2Msg(defaultKeyProof))
+	require.ErrorContains(t, err, "proof verification failed")
+
+	f.bankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, gomock.Any()).Return(nil).Times(2)
+	f.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, gomock.Any(), gomock.Any()).Return(nil).Times(2)
+
+	// Version 1 claims keep using the default key
+	v1Proof, pi := qbtctestutil.GenerateClaimProof(t, f.prover, f.btcPrivKey, f.claimerAddr, testChainID)
+	resp, err := server.ClaimWithProof(f.ctx, &types.MsgClaimWithProof{
+		Claimer:         f.claimerAddr,
+		Utxos:           []types.UTXORef{refs[0]},
+		Proof:           hex.EncodeToString(v1Proof),
+		MessageHash:     hex.EncodeToString(pi.MessageHash[:]),
+		AddressHash:     hex.EncodeToString(pi.AddressHash[:]),
+		QbtcAddressHash: hex.EncodeToString(pi.QBTCAddressHash[:]),
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), resp.UtxosClaimed)
+
+	// Version 2 claims verify with their own key
+	v2Fingerprint := zk.ShortVerifyingKeyFingerprint(v2VK)
+	msg := v2Msg(v2Proof)
+	msg.VkFingerprint = hex.EncodeToString(v2Fingerprint[:])
+	resp, err = server.ClaimWithProof(f.ctx, msg)
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), resp.UtxosClaimed)
+
+	// Removing the version key falls back to the default key
+	_, err = server.ReplaceVerifyingKey(f.ctx, &types.MsgReplaceVerifyingKey{
+		Authority:      f.keeper.GetAuthority(),
+		MessageVersion: zk.ClaimMessageVersionV2,
+	})
+	require.NoError(t, err)
+	verifier, err := f.keeper.ClaimVerifier(f.ctx, zk.ClaimMessageVersionV2)
+	require.NoError(t, err)
+	defaultVerifier, err := f.keeper.ClaimVerifier(f.ctx, zk.ClaimMessageVersionV1)
+	require.NoError(t, err)
+	require.Same(t, defaultVerifier, verifier)
+}
+
+// TestCheckFirstClaim tests that a first-time claim only passes the check if
+// it can release every UTXO it references, and that the check leaves the
+// proof to the handler
+func TestCheckFirstClaim(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	f := setupClaimTest(t)
+	claimable := types.UTXORef{Txid: "7777000000000000000000000000000000000000000000000000000000000001", Vout: 0}
+	claimed := types.UTXORef{Txid: "7777000000000000000000000000000000000000000000000000000000000002", Vout: 0}
+	foreign := types.UTXORef{Txid: "7777000000000000000000000000000000000000000000000000000000000003", Vout: 0}
+	missing := types.UTXORef{Txid: "7777000000000000000000000000000000000000000000000000000000000004", Vout: 0}
+	for _, utxo := range []types.UTXO{
+		{Txid: claimable.Txid, Amount: 100000000, EntitledAmount: 100000000, ScriptPubKey: &types.ScriptPubKeyResult{Address: bitcoinAddressFromHash(f.addressHash)}},
+		{Txid: claimed.Txid, Amount: 100000000, ScriptPubKey: &types.ScriptPubKeyResult{Address: bitcoinAddressFromHash(f.addressHash)}},
+		{Txid: foreign.Txid, Amount: 100000000, EntitledAmount: 100000000, ScriptPubKey: &types.ScriptPubKeyResult{Address: bitcoinAddressFromHash([20]byte{1})}},
+	} {
+		require.NoError(t, f.keeper.Utxoes.Set(f.ctx, utxo.GetKey(), utxo))
+	}
+	proof, pi := qbtctestutil.GenerateClaimProof(t, f.prover, f.btcPrivKey, f.claimerAddr, testChainID)
+	newMsg := func(refs ...types.UTXORef) *types.MsgClaimWithProof {
+		return &types.MsgClaimWithProof{
+			Claimer:         f.claimerAddr,
+			Utxos:           refs,
+			Proof:           hex.EncodeToString(proof),
+			MessageHash:     hex.EncodeToString(pi.MessageHash[:]),
+			AddressHash:     hex.EncodeToString(pi.AddressHash[:]),
+			QbtcAddressHash: hex.EncodeToString(pi.QBTCAddressHash[:]),
+		}
+	}
+
+	require.NoError(t, f.keeper.CheckFirstClaim(f.ctx, newMsg(claimable)))
+	for _, ref := range []types.UTXORef{claimed, foreign, missing} {
+		err := f.keeper.CheckFirstClaim(f.ctx, newMsg(claimable, ref))
+		require.ErrorIs(t, err, types.ErrNoClaimableUTXOs, ref.Txid)
+	}
+
+	// a denied recipient is refused like a denied claimer
+	destination := qbtctestutil.GetRandomBTCQAddress()
+	require.NoError(t, f.keeper.ClaimDenyList.Set(f.ctx, destination))
+	toDenied := newMsg(claimable)
+	toDenied.Destination = destination
+	require.ErrorIs(t, f.keeper.CheckFirstClaim(f.ctx, toDenied), types.ErrClaimDenied)
+
+	// the check never builds a verifier: with an unusable verifying key in
+	// state an invalid proof passes it, and fails on its UTXOs alone
+	vkBytes, err := f.keeper.ZkVerifyingKey.Get(f.ctx)
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.ZkVerifyingKey.Set(f.ctx, make([]byte, 2048)))
+	invalid := newMsg(claimable)
+	invalid.Proof = hex.EncodeToString(make([]byte, 500))
+	require.NoError(t, f.keeper.CheckFirstClaim(f.ctx, invalid))
+	invalid.Utxos = []types.UTXORef{claimed}
+	require.ErrorIs(t, f.keeper.CheckFirstClaim(f.ctx, invalid), types.ErrNoClaimableUTXOs)
+
+	// the handler rejects the invalid proof
+	require.NoError(t, f.keeper.ZkVerifyingKey.Set(f.ctx, vkBytes))
+	invalid.Utxos = []types.UTXORef{claimable}
+	_, err = keeper.NewMsgServerImpl(f.keeper).ClaimWithProof(f.ctx, invalid)
+	require.ErrorContains(t, err, "proof verification failed")
+}
@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/btcq-org/qbtc/x/qbtc/types"
+	"github.com/btcq-org/qbtc/x/qbtc/zk"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ReplaceVerifyingKey replaces the stored ZK verifying key. Claims read the
+// key from state, so they verify against the new key from the next message on,
+// and against the old one again if this message is rolled back. It is
+// restricted to the module authority (the gov module), so arbitrary callers
+// cannot swap the VK.
+// With a message version it sets or removes the key of that version instead,
+// see replaceVersionVerifyingKey.
+func (s *msgServer) ReplaceVerifyingKey(ctx context.Context, msg *types.MsgReplaceVerifyingKey) (*types.MsgEmpty, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	if msg.Authority != s.k.GetAuthority() {
+		return nil, sdkerrors.ErrUnauthorized.Wrap("unauthorized")
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if msg.MessageVersion != "" {
+		return s.replaceVersionVerifyingKey(sdkCtx, msg)
+	}
+
+	// build the verifier before touching state so an unusable key changes nothing
+	if _, err := zk.NewVerifierFromBytes(msg.VerifyingKey); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid verifying key: %v", err)
+	}
+
+	if err := s.k.ZkVerifyingKey.Set(sdkCtx, msg.VerifyingKey); err != nil {
+		return nil, err
+	}
+
+	vkHash := sha256.Sum256(msg.VerifyingKey)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeReplaceVerifyingKey,
+			sdk.NewAttribute(types.AttributeKeyVerifyingKeyHash, hex.EncodeToString(vkHash[:])),
+		),
+	)
+	sdkCtx.Logger().Info("ZK verifying key replaced", "vk_hash", hex.EncodeToString(vkHash[:]))
+	return &types.MsgEmpty{}, nil
+}
+
+// replaceVersionVerifyingKey sets the key that verifies claims of a single
+// message version, leaving the default key and the keys of other versions in
+// place. An empty key removes the version's key, after which its claims are
+// verified with the default key again.
+func (s *msgServer) replaceVersionVerifyingKey(sdkCtx sdk.Context, msg *types.MsgReplaceVerifyingKey) (*types.MsgEmpty, error) {
+	version := zk.NormalizeClaimMessageVersion(msg.MessageVersion)
+	if len(msg.VerifyingKey) == 0 {
+		if err := s.k.VersionVerifyingKeys.Remove(sdkCtx, version); err != nil {
+			return nil, err
+		}
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeReplaceVerifyingKey,
+				sdk.NewAttribute(types.AttributeKeyMessageVersion, version),
+			),
+		)
+		sdkCtx.Logger().Info("ZK verifying key of message version removed", "message_version", version)
+		return &types.MsgEmpty{}, nil
+	}
+
+	if _, err := zk.NewVerifierFromBytes(msg.VerifyingKey); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid verifying key: %v", err)
+	}
+	if err := s.k.VersionVerifyingKeys.Set(sdkCtx, version, msg.VerifyingKey); err != nil {
+		return nil, err
+	}
+
+	vkHash := sha256.Sum256(msg.VerifyingKey)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeReplaceVerifyingKey,
+			sdk.NewAttribute(types.AttributeKeyVerifyingKeyHash, hex.EncodeToString(vkHash[:])),
+			sdk.NewAttribute(types.AttributeKeyMessageVersion, version),
+		),
+	)
+	sdkCtx.Logger().Info("ZK verifying key of message version replaced", "message_version", version, "vk_hash", hex.EncodeToString(vkHash[:]))
+	return &types.MsgEmpty{}, nil
+}
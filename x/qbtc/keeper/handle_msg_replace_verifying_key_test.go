@@ -0,0 +1,98 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/btcq-org/qbtc/x/qbtc/keeper"
+	"github.com/btcq-org/qbtc/x/qbtc/types"
+	"github.com/btcq-org/qbtc/x/qbtc/zk"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_msgServer_ReplaceVerifyingKey(t *testing.T) {
+	garbageVK := make([]byte, 2048)
+	for i := range garbageVK {
+		garbageVK[i] = byte(i)
+	}
+
+	tests := []struct {
+		name string // description of this test case
+		// Named input parameters for target function.
+		msg     *types.MsgReplaceVerifyingKey
+		wantErr bool
+	}{
+		{
+			name: "invalid message - authority empty",
+			msg: &types.MsgReplaceVerifyingKey{
+				Authority:    "",
+				VerifyingKey: garbageVK,
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid message - verifying key empty",
+			msg: &types.MsgReplaceVerifyingKey{
+				Authority: "gov",
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid message - verifying key does not parse",
+			msg: &types.MsgReplaceVerifyingKey{
+				Authority:    "gov",
+				VerifyingKey: garbageVK,
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid message - unsupported message version",
+			msg: &types.MsgReplaceVerifyingKey{
+				Authority:      "gov",
+				MessageVersion: "qbtc-claim-v99",
+			},
+			wantErr: true,
+		},
+		{
+			name: "remove the key of a message version",
+			msg: &types.MsgReplaceVerifyingKey{
+				Authority:      "gov",
+				MessageVersion: zk.ClaimMessageVersionV2,
+			},
+		},
+		{
+			name: "unauthorized",
+			msg: &types.MsgReplaceVerifyingKey{
+				Authority:    "qbtc1validaddressxxxxxxxxxxxxxxxx",
+				VerifyingKey: garbageVK,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(st *testing.T) {
+			f := initFixture(st)
+			assert.NotNil(st, f)
+
+			server := keeper.NewMsgServerImpl(f.keeper)
+
+			_, gotErr := server.ReplaceVerifyingKey(f.ctx, tt.msg)
+			if tt.wantErr {
+				assert.Error(st, gotErr)
+			} else {
+				assert.NoError(st, gotErr)
+			}
+
+			// a rejected replacement must leave the stored key untouched
+			if gotErr != nil {
+				has, err := f.keeper.ZkVerifyingKey.Has(f.ctx)
+				assert.NoError(st, err)
+				assert.False(st, has)
+			}
+			if tt.msg.MessageVersion != "" {
+				has, err := f.keeper.VersionVerifyingKeys.Has(f.ctx, zk.NormalizeClaimMessageVersion(tt.msg.MessageVersion))
+				assert.NoError(st, err)
+				assert.False(st, has)
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	"github.com/btcq-org/qbtc/x/qbtc/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// SetClaimMemoPrefixes replaces the memo prefixes that mark a claim
+// transaction. Accepting an old and a new prefix side by side lets the memo
+// format change without a coordinated upgrade.
+func (s *msgServer) SetClaimMemoPrefixes(ctx context.Context, msg *types.MsgSetClaimMemoPrefixes) (*types.MsgEmpty, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	if msg.Authority != s.k.GetAuthority() {
+		return nil, sdkerrors.ErrUnauthorized.Wrap("unauthorized")
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	cacheCtx, write := sdkCtx.CacheContext()
+	if err := s.k.ClaimMemoPrefixes.Set(cacheCtx, types.ClaimMemoPrefixes{Prefixes: msg.Prefixes}); err != nil {
+		return nil, err
+	}
+	write()
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetClaimMemoPrefixes,
+			sdk.NewAttribute(types.AttributeKeyClaimMemoPrefixes, strings.Join(msg.Prefixes, ",")),
+		),
+	)
+	sdkCtx.Logger().Info("claim memo prefixes updated", "prefixes", msg.Prefixes)
+	return &types.MsgEmpty{}, nil
+}
@@ -0,0 +1,99 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/btcq-org/qbtc/x/qbtc/keeper"
+	"github.com/btcq-org/qbtc/x/qbtc/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_msgServer_SetClaimMemoPrefixes(t *testing.T) {
+	tests := []struct {
+		name    string
+		msg     *types.MsgSetClaimMemoPrefixes
+		wantErr bool
+		want    []string
+	}{
+		{
+			name: "invalid message - authority empty",
+			msg: &types.MsgSetClaimMemoPrefixes{
+				Prefixes: []string{"claim:"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid message - no prefixes",
+			msg: &types.MsgSetClaimMemoPrefixes{
+				Authority: "gov",
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid message - empty prefix",
+			msg: &types.MsgSetClaimMemoPrefixes{
+				Authority: "gov",
+				Prefixes:  []string{"claim:", ""},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid message - prefix with space",
+			msg: &types.MsgSetClaimMemoPrefixes{
+				Authority: "gov",
+				Prefixes:  []string{"claim :"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid message - prefix too long",
+			msg: &types.MsgSetClaimMemoPrefixes{
+				Authority: "gov",
+				Prefixes:  []string{"claimclaimclaimclaim:"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid message - duplicate prefix in another case",
+			msg: &types.MsgSetClaimMemoPrefixes{
+				Authority: "gov",
+				Prefixes:  []string{"claim:", "CLAIM:"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "unauthorized",
+			msg: &types.MsgSetClaimMemoPrefixes{
+				Authority: "qbtc1validaddressxxxxxxxxxxxxxxxx",
+				Prefixes:  []string{"claim2:"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "success",
+			msg: &types.MsgSetClaimMemoPrefixes{
+				Authority: "gov",
+				Prefixes:  []string{"claim:", "claim2:"},
+			},
+			want: []string{"claim:", "claim2:"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(st *testing.T) {
+			f := initFixture(st)
+			assert.NotNil(st, f)
+			assert.Equal(st, types.DefaultClaimMemoPrefixes(), f.keeper.GetClaimMemoPrefixes(f.ctx))
+
+			server := keeper.NewMsgServerImpl(f.keeper)
+			_, gotErr := server.SetClaimMemoPrefixes(f.ctx, tt.msg)
+			if tt.wantErr {
+				assert.Error(st, gotErr)
+				// a rejected update keeps the default prefix
+				assert.Equal(st, types.DefaultClaimMemoPrefixes(), f.keeper.GetClaimMemoPrefixes(f.ctx))
+				return
+			}
+			assert.NoError(st, gotErr)
+			assert.Equal(st, tt.want, f.keeper.GetClaimMemoPrefixes(f.ctx))
+		})
+	}
+}
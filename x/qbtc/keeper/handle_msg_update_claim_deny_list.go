@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	"github.com/btcq-org/qbtc/x/qbtc/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// UpdateClaimDenyList adds and removes addresses on the claim deny list. The
+// list is empty by default; deployments that must exclude addresses from
+// claiming fill it through governance.
+func (s *msgServer) UpdateClaimDenyList(ctx context.Context, msg *types.MsgUpdateClaimDenyList) (*types.MsgEmpty, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	if msg.Authority != s.k.GetAuthority() {
+		return nil, sdkerrors.ErrUnauthorized.Wrap("unauthorized")
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	cacheCtx, write := sdkCtx.CacheContext()
+	for _, address := range msg.Add {
+		if err := s.k.ClaimDenyList.Set(cacheCtx, sdk.MustAccAddressFromBech32(address).String()); err != nil {
+			return nil, err
+		}
+	}
+	for _, address := range msg.Remove {
+		if err := s.k.ClaimDenyList.Remove(cacheCtx, sdk.MustAccAddressFromBech32(address).String()); err != nil {
+			return nil, err
+		}
+	}
+	write()
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateClaimDenyList,
+			sdk.NewAttribute(types.AttributeKeyDenied, strings.Join(msg.Add, ",")),
+			sdk.NewAttribute(types.AttributeKeyAllowed, strings.Join(msg.Remove, ",")),
+		),
+	)
+	sdkCtx.Logger().Info("claim deny list updated", "denied", msg.Add, "allowed", msg.Remove)
+	return &types.MsgEmpty{}, nil
+}
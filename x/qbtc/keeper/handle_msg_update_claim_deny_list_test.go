@@ -0,0 +1,84 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/btcq-org/qbtc/x/qbtc/keeper"
+	qbtctestutil "github.com/btcq-org/qbtc/x/qbtc/testutil"
+	"github.com/btcq-org/qbtc/x/qbtc/types"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_msgServer_UpdateClaimDenyList(t *testing.T) {
+	first := qbtctestutil.GetRandomBTCQAddress()
+	second := qbtctestutil.GetRandomBTCQAddress()
+
+	tests := []struct {
+		name    string
+		initial []string
+		msg     *types.MsgUpdateClaimDenyList
+		wantErr bool
+		want    []string
+	}{
+		{
+			name:    "invalid message - authority empty",
+			msg:     &types.MsgUpdateClaimDenyList{Add: []string{first}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid message - nothing to update",
+			msg:     &types.MsgUpdateClaimDenyList{Authority: "gov"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid message - bad address",
+			msg:     &types.MsgUpdateClaimDenyList{Authority: "gov", Add: []string{"qbtc1notanaddress"}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid message - added and removed at once",
+			msg:     &types.MsgUpdateClaimDenyList{Authority: "gov", Add: []string{first}, Remove: []string{first}},
+			wantErr: true,
+		},
+		{
+			name:    "unauthorized",
+			msg:     &types.MsgUpdateClaimDenyList{Authority: second, Add: []string{first}},
+			wantErr: true,
+		},
+		{
+			name: "add",
+			msg:  &types.MsgUpdateClaimDenyList{Authority: "gov", Add: []string{first}},
+			want: []string{first},
+		},
+		{
+			name:    "add and remove",
+			initial: []string{first},
+			msg:     &types.MsgUpdateClaimDenyList{Authority: "gov", Add: []string{second}, Remove: []string{first}},
+			want:    []string{second},
+		},
+		{
+			name: "removing an unlisted address is a no-op",
+			msg:  &types.MsgUpdateClaimDenyList{Authority: "gov", Remove: []string{first}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(st *testing.T) {
+			f := initFixture(st)
+			for _, address := range tt.initial {
+				require.NoError(st, f.keeper.ClaimDenyList.Set(f.ctx, address))
+			}
+
+			server := keeper.NewMsgServerImpl(f.keeper)
+			_, gotErr := server.UpdateClaimDenyList(f.ctx, tt.msg)
+			got, err := f.keeper.GetClaimDenyList(f.ctx)
+			require.NoError(st, err)
+			if tt.wantErr {
+				require.Error(st, gotErr)
+				require.Empty(st, got)
+				return
+			}
+			require.NoError(st, gotErr)
+			require.ElementsMatch(st, tt.want, got)
+		})
+	}
+}
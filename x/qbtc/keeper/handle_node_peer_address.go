@@ -22,17 +22,11 @@ func (s *msgServer) SetNodePeerAddress(ctx context.Context, msg *types.MsgSetNod
 
 	// Convert account address to validator address
 	signer := sdk.ValAddress(signerAcc)
-	// Get the validator
-	validator, err := s.k.stakingKeeper.GetValidator(ctx, signer)
+	validator, err := s.k.getBondedValidator(ctx, signer)
 	if err != nil {
 		return nil, err
 	}
 
-	// Check if the validator is bonded (Active)
-	if validator.Status != stakingtypes.Bonded {
-		return nil, errors.New("validator is not bonded")
-	}
-
 	// Set the IP address
 	err = s.k.NodePeerAddresses.Set(ctx, validator.GetOperator(), msg.PeerAddress)
 	if err != nil {
@@ -41,3 +35,28 @@ func (s *msgServer) SetNodePeerAddress(ctx context.Context, msg *types.MsgSetNod
 
 	return &types.MsgEmpty{}, nil
 }
+
+// getBondedValidator returns the validator with the given operator address, or
+// an error that tells apart an unknown, jailed, unbonding and unbonded validator.
+// The status alone can't tell a jailed validator from one that unbonds on its
+// own, and the difference matters to an operator trying to fix it.
+func (k Keeper) getBondedValidator(ctx context.Context, valAddr sdk.ValAddress) (stakingtypes.Validator, error) {
+	validator, err := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if errors.Is(err, stakingtypes.ErrNoValidatorFound) {
+		return stakingtypes.Validator{}, types.ErrValidatorNotFound.Wrapf("%s is not a registered validator", valAddr)
+	}
+	if err != nil {
+		return stakingtypes.Validator{}, err
+	}
+	if validator.Jailed {
+		return stakingtypes.Validator{}, types.ErrValidatorJailed.Wrap(valAddr.String())
+	}
+	switch validator.Status {
+	case stakingtypes.Bonded:
+		return validator, nil
+	case stakingtypes.Unbonding:
+		return stakingtypes.Validator{}, types.ErrValidatorNotBonded.Wrapf("%s is unbonding", valAddr)
+	default:
+		return stakingtypes.Validator{}, types.ErrValidatorNotBonded.Wrapf("%s is unbonded", valAddr)
+	}
+}
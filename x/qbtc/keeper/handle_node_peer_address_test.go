@@ -0,0 +1,113 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/btcq-org/qbtc/x/qbtc/keeper"
+	module "github.com/btcq-org/qbtc/x/qbtc/module"
+	qbtctestutil "github.com/btcq-org/qbtc/x/qbtc/testutil"
+	"github.com/btcq-org/qbtc/x/qbtc/types"
+)
+
+func TestSetNodePeerAddress_ValidatorStatus(t *testing.T) {
+	// Build the shared fixture only for the address prefixes and a validator
+	f := initFixture(t)
+	signer := sdk.AccAddress([]byte("peer-address-signer1"))
+	valAddr := sdk.ValAddress(signer)
+
+	validatorWith := func(status stakingtypes.BondStatus, jailed bool) stakingtypes.Validator {
+		v := f.validator
+		v.OperatorAddress = valAddr.String()
+		v.Status = status
+		v.Jailed = jailed
+		return v
+	}
+
+	tests := []struct {
+		name      string
+		validator stakingtypes.Validator
+		lookupErr error
+		expErr    error
+		expErrMsg string
+	}{
+		{
+			name:      "not a validator",
+			lookupErr: stakingtypes.ErrNoValidatorFound,
+			expErr:    types.ErrValidatorNotFound,
+			expErrMsg: "is not a registered validator",
+		},
+		{
+			name:      "jailed",
+			validator: validatorWith(stakingtypes.Unbonding, true),
+			expErr:    types.ErrValidatorJailed,
+		},
+		{
+			name:      "unbonding",
+			validator: validatorWith(stakingtypes.Unbonding, false),
+			expErr:    types.ErrValidatorNotBonded,
+			expErrMsg: "is unbonding",
+		},
+		{
+			name:      "unbonded",
+			validator: validatorWith(stakingtypes.Unbonded, false),
+			expErr:    types.ErrValidatorNotBonded,
+			expErrMsg: "is unbonded",
+		},
+		{
+			name:      "store error is passed through",
+			lookupErr: errors.New("store unavailable"),
+			expErrMsg: "store unavailable",
+		},
+		{
+			name:      "bonded",
+			validator: validatorWith(stakingtypes.Bonded, false),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+			ctx := testutil.DefaultContextWithDB(t, storeKey, storetypes.NewTransientStoreKey("transient_test")).Ctx
+			ctrl := gomock.NewController(t)
+			stakingKeeper := qbtctestutil.NewMockStakingKeeper(ctrl)
+			stakingKeeper.EXPECT().GetValidator(gomock.Any(), valAddr).Return(tc.validator, tc.lookupErr)
+			k := keeper.NewKeeper(
+				runtime.NewKVStoreService(storeKey),
+				moduletestutil.MakeTestEncodingConfig(module.AppModule{}).Codec,
+				f.addressCodec,
+				stakingKeeper,
+				qbtctestutil.NewMockBankKeeper(ctrl),
+				qbtctestutil.NewMockAuthKeeper(ctrl),
+				govtypes.ModuleName,
+			)
+
+			msg := types.NewMsgSetNodePeerAddress("peer1@10.0.0.1:30006", signer)
+			_, err := keeper.NewMsgServerImpl(k).SetNodePeerAddress(ctx, msg)
+
+			stored, hasErr := k.NodePeerAddresses.Has(ctx, valAddr.String())
+			require.NoError(t, hasErr)
+			if tc.expErr == nil && tc.expErrMsg == "" {
+				require.NoError(t, err)
+				require.True(t, stored)
+				return
+			}
+			require.Error(t, err)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+			}
+			require.Contains(t, err.Error(), tc.expErrMsg)
+			require.False(t, stored)
+		})
+	}
+}
@@ -2,15 +2,21 @@ package keeper
 
 import (
 	"context"
+	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math/bits"
 	"slices"
+	"sort"
+	"strconv"
 	"strings"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/math"
+	"github.com/btcq-org/qbtc/common"
+	"github.com/btcq-org/qbtc/constants"
 	"github.com/btcq-org/qbtc/x/qbtc/types"
 	"github.com/btcsuite/btcd/btcjson"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -18,12 +24,53 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
-func (s *msgServer) ValidateMsgBtcBlockAttestation(ctx sdk.Context, msg *types.MsgBtcBlock) error {
+// ValidateMsgBtcBlockAttestation checks that more than 2/3 of the total power
+// attested the block content of msg. Attestations are counted per bonded
+// validator, together with the validators recorded in PendingAttestations by
+// earlier reports of the same height and block content, so attestations can be
+// spread over several reports instead of one large transaction.
+//
+// It returns the validators whose attestation in msg verified and that were
+// not recorded yet. When the power is not sufficient the error wraps
+// types.ErrInsufficientAttestationPower.
+func (s *msgServer) ValidateMsgBtcBlockAttestation(ctx sdk.Context, msg *types.MsgBtcBlock) ([]string, error) {
+	var recorded []string
+	digest := blockContentDigest(msg.BlockContent)
+	err := s.k.PendingAttestations.Walk(ctx, collections.NewSuperPrefixedTripleRange[uint64, []byte, string](msg.Height, digest), func(key collections.Triple[uint64, []byte, string]) (bool, error) {
+		recorded = append(recorded, key.K3())
+		return false, nil
+	})
+	if err != nil {
+		return nil, sdkerror.ErrUnknownRequest.Wrapf("failed to read pending attestations: %v", err)
+	}
+	return s.verifyAttestations(ctx, msg.BlockContent, msg.Attestations, recorded)
+}
+
+// verifyAttestations checks that more than 2/3 of the total power attested
+// signBytes, counting the bonded validators in recorded, whose attestations
+// were verified earlier, and those whose attestation verifies.
+//
+// The attestations may hold at most one attestation per bonded validator plus
+// the AttestationSlack param, and signatures are only verified until the power
+// is sufficient, which bounds the verification cost of a single message. The
+// work is charged to the gas meter: AttestationValidatorGas per bonded
+// validator and AttestationSignatureGas per verified signature, so a large
+// validator set costs gas deterministically instead of only block time.
+//
+// It returns the validators whose attestation verified and that are not in
+// recorded. When the power is not sufficient the error wraps
+// types.ErrInsufficientAttestationPower.
+func (s *msgServer) verifyAttestations(ctx sdk.Context, signBytes []byte, attestations []*types.Attestation, recorded []string) ([]string, error) {
 	validPower := math.ZeroInt()
-	processedValidator := make(map[string]bool, len(msg.Attestations))
+	processedValidator := make(map[string]bool, len(attestations)+len(recorded))
 	validators, err := s.k.stakingKeeper.GetBondedValidatorsByPower(ctx)
 	if err != nil {
-		return sdkerror.ErrUnknownRequest.Wrapf("failed to get bonded validators by power: %v", err)
+		return nil, sdkerror.ErrUnknownRequest.Wrapf("failed to get bonded validators by power: %v", err)
+	}
+	ctx.GasMeter().ConsumeGas(s.configGas(ctx, constants.AttestationValidatorGas)*uint64(len(validators)), "attestation validator set")
+	maxAttestations := len(validators) + int(max(s.k.GetConfig(ctx, constants.AttestationSlack), 0))
+	if len(attestations) > maxAttestations {
+		return nil, sdkerror.ErrInvalidRequest.Wrapf("too many attestations: %d, at most %d for %d bonded validators", len(attestations), maxAttestations, len(validators))
 	}
 	validatorsByConsAddr := make(map[string]stakingtypes.Validator, len(validators))
 	for _, validator := range validators {
@@ -37,7 +84,30 @@ func (s *msgServer) ValidateMsgBtcBlockAttestation(ctx sdk.Context, msg *types.M
 		validatorsByConsAddr[consAddr.String()] = validator
 
 	}
-	for _, attestation := range msg.Attestations {
+	powerReduction := s.k.stakingKeeper.PowerReduction(ctx)
+
+	// recorded attestations only count while the validator is still bonded
+	for _, address := range recorded {
+		processedValidator[address] = true
+		if val, found := validatorsByConsAddr[address]; found {
+			validPower = validPower.Add(math.NewInt(val.ConsensusPower(powerReduction)))
+		}
+	}
+
+	totalPower, err := s.k.stakingKeeper.GetLastTotalPower(ctx)
+	if err != nil {
+		return nil, sdkerror.ErrUnknownRequest.Wrapf("failed to get total staking power: %v", err)
+	}
+	// require more than 2/3 of total staking power to attest
+	requiredPower := totalPower.Mul(math.NewInt(2)).Quo(math.NewInt(3))
+
+	signatureGas := s.configGas(ctx, constants.AttestationSignatureGas)
+	var newAttesters []string
+	for _, attestation := range attestations {
+		if validPower.GT(requiredPower) {
+			// the remaining signatures can't change the outcome
+			break
+		}
 		if processedValidator[attestation.Address] {
 			// skip duplicate attestation from the same validator
 			continue
@@ -52,24 +122,68 @@ func (s *msgServer) ValidateMsgBtcBlockAttestation(ctx sdk.Context, msg *types.M
 			ctx.Logger().Error("failed to get consensus public key for validator", "address", attestation.Address, "error", err)
 			continue
 		}
-		if publicKey.VerifySignature(msg.BlockContent, attestation.Signature) {
-			validPower = validPower.Add(math.NewInt(val.ConsensusPower(s.k.stakingKeeper.PowerReduction(ctx))))
+		ctx.GasMeter().ConsumeGas(signatureGas, "attestation signature")
+		if publicKey.VerifySignature(signBytes, attestation.Signature) {
+			validPower = validPower.Add(math.NewInt(val.ConsensusPower(powerReduction)))
+			newAttesters = append(newAttesters, attestation.Address)
 		}
 		processedValidator[attestation.Address] = true
 	}
-	totalPower, err := s.k.stakingKeeper.GetLastTotalPower(ctx)
+	if validPower.LTE(requiredPower) {
+		return newAttesters, types.ErrInsufficientAttestationPower.Wrapf("%s, required: more than %s", validPower.String(), requiredPower.String())
+	}
+	return newAttesters, nil
+}
+
+// configGas reads a gas amount param, treating negative values as zero.
+func (s *msgServer) configGas(ctx sdk.Context, name constants.ConstantName) uint64 {
+	return uint64(max(s.k.GetConfig(ctx, name), 0))
+}
+
+// blockContentDigest identifies the reported block content attestations sign.
+func blockContentDigest(blockContent []byte) []byte {
+	digest := sha256.Sum256(blockContent)
+	return digest[:]
+}
+
+// recordPendingAttestations stores the validators that attested a block whose
+// report did not reach the required power yet.
+func (s *msgServer) recordPendingAttestations(ctx sdk.Context, msg *types.MsgBtcBlock, attesters []string) error {
+	digest := blockContentDigest(msg.BlockContent)
+	for _, address := range attesters {
+		if err := s.k.PendingAttestations.Set(ctx, collections.Join3(msg.Height, digest, address)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// prunePendingAttestations removes the pending attestations of every block
+// up to height, which can no longer be processed once height is.
+func (s *msgServer) prunePendingAttestations(ctx sdk.Context, height uint64) error {
+	var keys []collections.Triple[uint64, []byte, string]
+	err := s.k.PendingAttestations.Walk(ctx, collections.NewPrefixUntilTripleRange[uint64, []byte, string](height), func(key collections.Triple[uint64, []byte, string]) (bool, error) {
+		keys = append(keys, key)
+		return false, nil
+	})
 	if err != nil {
-		return sdkerror.ErrUnknownRequest.Wrapf("failed to get total staking power: %v", err)
+		return err
 	}
-	// require more than 2/3 of total staking power to attest the block
-	requiredPower := totalPower.Mul(math.NewInt(2)).Quo(math.NewInt(3))
-	if validPower.LTE(requiredPower) {
-		return sdkerror.ErrUnauthorized.Wrapf("insufficient attestation power: %s, required: %s", validPower.String(), requiredPower.String())
+	for _, key := range keys {
+		if err := s.k.PendingAttestations.Remove(ctx, key); err != nil {
+			return err
+		}
 	}
 	return nil
 }
 
 // SetMsgReportBlock processes a reported Bitcoin block.
+//
+// Blocks are processed strictly in height order, and Bitcoin reorgs are not
+// handled: once a block is processed, a report of another block at its height
+// is ignored like any report that is not of the next height, and the UTXO set
+// keeps following the blocks already processed. There is no rollback of
+// processed blocks.
 func (s *msgServer) SetMsgReportBlock(ctx context.Context, msg *types.MsgBtcBlock) (*types.MsgEmpty, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	lastProcessedBlock, err := s.k.GetLastProcessedBlock(ctx)
@@ -86,24 +200,50 @@ func (s *msgServer) SetMsgReportBlock(ctx context.Context, msg *types.MsgBtcBloc
 		return nil, sdkerror.ErrInvalidRequest.Wrap("invalid MsgBtcBlock")
 	}
 
-	if err := s.ValidateMsgBtcBlockAttestation(sdkCtx, msg); err != nil {
-		return nil, err
+	newAttesters, err := s.ValidateMsgBtcBlockAttestation(sdkCtx, msg)
+	if err != nil {
+		// a report that adds attestations below the required power is kept
+		// for later reports of the same block to build on
+		if !errors.Is(err, types.ErrInsufficientAttestationPower) || len(newAttesters) == 0 {
+			return nil, err
+		}
+		if err := s.recordPendingAttestations(sdkCtx, msg, newAttesters); err != nil {
+			return nil, sdkerror.ErrUnknownRequest.Wrapf("failed to record pending attestations: %v", err)
+		}
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeBlockAttestationPending,
+				sdk.NewAttribute(types.AttributeKeyBlockHeight, strconv.FormatUint(msg.Height, 10)),
+				sdk.NewAttribute(types.AttributeKeyBlockHash, msg.Hash),
+				sdk.NewAttribute(types.AttributeKeyNewAttesters, strconv.Itoa(len(newAttesters))),
+			),
+		)
+		sdkCtx.Logger().Info("recorded partial btc block attestation", "height", msg.Height, "hash", msg.Hash, "new_attesters", len(newAttesters), "reason", err)
+		return &types.MsgEmpty{}, nil
 	}
-	// unzip block content
-	rawBlockContent, err := types.GzipUnzip(msg.BlockContent)
+	// decompress block content with whichever codec it was framed with
+	rawBlockContent, err := types.DecompressBlockContent(msg.BlockContent)
 	if err != nil {
-		return nil, sdkerror.ErrInvalidRequest.Wrap("failed to unzip block content")
+		return nil, sdkerror.ErrInvalidRequest.Wrap("failed to decompress block content")
 	}
 	var block btcjson.GetBlockVerboseTxResult
 	if err := json.Unmarshal(rawBlockContent, &block); err != nil {
 		return nil, sdkerror.ErrInvalidRequest.Wrap("failed to unmarshal block content")
 	}
+	amounts, err := blockOutputAmounts(rawBlockContent)
+	if err != nil {
+		return nil, sdkerror.ErrInvalidRequest.Wrapf("invalid output amount in block content: %v", err)
+	}
+	if err := s.checkBlockContent(sdkCtx, msg.Hash, block, amounts); err != nil {
+		return nil, err
+	}
 	cacheContext, writeCache := sdkCtx.CacheContext()
 	claimTxIds := make([]string, 0)
 	totalFee := uint64(0)
 	var coinBaseTx *btcjson.TxRawResult
+	var coinBaseAmounts []types.Satoshi
 	// process the reported block
-	for _, tx := range block.Tx {
+	for i, tx := range block.Tx {
 		// check if it is a claim transaction , need to check it before the transaction is processed
 		// because utxo that has been spent will be removed from the store, so we can't check it after processing the transaction
 		if s.isClaimTx(cacheContext, tx) {
@@ -113,9 +253,10 @@ func (s *msgServer) SetMsgReportBlock(ctx context.Context, msg *types.MsgBtcBloc
 		if len(tx.Vin) > 0 && tx.Vin[0].IsCoinBase() {
 			// coinbase transaction , process it later, need to calculate the transaction fee first
 			coinBaseTx = &tx
+			coinBaseAmounts = amounts[i]
 			continue
 		}
-		fee, err := s.processTransaction(cacheContext, tx)
+		fee, err := s.processTransaction(cacheContext, tx, amounts[i], msg.Height)
 		if err != nil {
 			cacheContext.Logger().Error("failed to process transaction", "txid", tx.Txid, "error", err)
 			return nil, sdkerror.ErrUnknownRequest.Wrapf("failed to process transaction %s: %v", tx.Txid, err)
@@ -124,7 +265,7 @@ func (s *msgServer) SetMsgReportBlock(ctx context.Context, msg *types.MsgBtcBloc
 	}
 	// update coinbase transaction
 	if coinBaseTx != nil {
-		if err := s.processCoinbaseVOuts(cacheContext, coinBaseTx.Vout, coinBaseTx.Txid, totalFee); err != nil {
+		if err := s.processCoinbaseVOuts(cacheContext, coinBaseTx.Vout, coinBaseAmounts, coinBaseTx.Txid, totalFee, msg.Height); err != nil {
 			cacheContext.Logger().Error("failed to process coinbase transaction", "txid", coinBaseTx.Txid, "error", err)
 			return nil, sdkerror.ErrUnknownRequest.Wrapf("failed to process coinbase transaction %s: %v", coinBaseTx.Txid, err)
 		}
@@ -146,24 +287,46 @@ func (s *msgServer) SetMsgReportBlock(ctx context.Context, msg *types.MsgBtcBloc
 		cacheContext.Logger().Error("failed to set last processed block height", "height", msg.Height, "error", err)
 		return nil, sdkerror.ErrUnknownRequest.Wrapf("failed to set last processed block height: %v", err)
 	}
+	if err := s.k.LastProcessedBlockHash.Set(cacheContext, msg.Hash); err != nil {
+		cacheContext.Logger().Error("failed to set last processed block hash", "hash", msg.Hash, "error", err)
+		return nil, sdkerror.ErrUnknownRequest.Wrapf("failed to set last processed block hash: %v", err)
+	}
+	if err := s.prunePendingAttestations(cacheContext, msg.Height); err != nil {
+		return nil, sdkerror.ErrUnknownRequest.Wrapf("failed to prune pending attestations: %v", err)
+	}
 	sdkCtx.Logger().Info("processed btc block", "height", msg.Height, "hash", msg.Hash)
 	// write the cache context to the main context if we reach here without error
 	writeCache()
 	return &types.MsgEmpty{}, nil
 }
 
-func (s *msgServer) processTransaction(ctx sdk.Context, tx btcjson.TxRawResult) (uint64, error) {
+// checkBlockContent rejects a block content that is not the block with the
+// attested hash, so a report can't add, drop or alter transactions of the
+// block: the header must hash to hash, and the transactions, decoded from
+// their raw hex, must hash into its merkle root and match the verbose fields
+// the block is processed from. The BlockMerkleRootCheckDisabled param turns it
+// off.
+func (s *msgServer) checkBlockContent(ctx sdk.Context, hash string, block btcjson.GetBlockVerboseTxResult, amounts [][]types.Satoshi) error {
+	if s.k.GetConfig(ctx, constants.BlockMerkleRootCheckDisabled) > 0 {
+		return nil
+	}
+	if err := types.VerifyBlockContent(hash, block, amounts); err != nil {
+		return sdkerror.ErrInvalidRequest.Wrapf("invalid block content: %v", err)
+	}
+	return nil
+}
+
+// processTransaction processes a non-coinbase transaction of a block. amounts
+// holds the value of each output of tx in satoshis, see blockOutputAmounts.
+func (s *msgServer) processTransaction(ctx sdk.Context, tx btcjson.TxRawResult, amounts []types.Satoshi, height uint64) (uint64, error) {
 	fee := uint64(0)
 	totalClaimable, totalInput, hasClaimed, err := s.processVIn(ctx, tx.Vin)
 	if err != nil {
 		return fee, err
 	}
 	totalOutput := uint64(0)
-	for _, out := range tx.Vout {
-		if out.Value == 0 {
-			continue
-		}
-		totalOutput += uint64(out.Value * 1e8)
+	for _, amount := range amounts {
+		totalOutput += amount.Uint64()
 	}
 	if totalInput > 0 && totalInput > totalOutput {
 		// calculate the transaction fee
@@ -174,16 +337,13 @@ func (s *msgServer) processTransaction(ctx sdk.Context, tx btcjson.TxRawResult)
 			totalClaimable = 0
 		}
 	}
-	if err := s.processVOuts(ctx, tx.Vout, tx.Txid, totalClaimable, hasClaimed, totalOutput); err != nil {
+	if err := s.processVOuts(ctx, tx.Vout, amounts, tx.Txid, totalClaimable, hasClaimed, totalOutput, height); err != nil {
 		return fee, err
 	}
 
 	return fee, nil
 }
 
-const claimPrefix = "claim:"
-const nullDataType = "nulldata"
-
 func (s *msgServer) isClaimTx(ctx sdk.Context, tx btcjson.TxRawResult) bool {
 	// ignore if vOut length is not 2
 	if len(tx.Vout) != 2 {
@@ -254,13 +414,21 @@ func (s *msgServer) hasUtxoSendToItself(ctx sdk.Context, tx btcjson.TxRawResult)
 			return false, nil
 		}
 
-		// UTXO must already exist since it is used as input
+		// UTXO must already exist since it is used as input, unless it was
+		// fully claimed and pruned already, in which case there is nothing left to claim
 		utxoKey := getUTXOKey(in.Txid, in.Vout)
-		utxo, err := s.k.Utxoes.Get(ctx, utxoKey)
+		utxo, err := s.k.getInputUTXO(ctx, utxoKey)
 		if err != nil {
+			if errors.Is(err, collections.ErrNotFound) {
+				return false, nil
+			}
 			return false, err
 		}
-		sourceAddress = append(sourceAddress, utxo.ScriptPubKey.Address)
+		// without script data the owner of the input is unknown
+		if utxo.ScriptPubKey == nil {
+			return false, nil
+		}
+		sourceAddress = append(sourceAddress, scriptOwnerKey(utxo.ScriptPubKey))
 	}
 
 	var destAddress []string
@@ -268,7 +436,7 @@ func (s *msgServer) hasUtxoSendToItself(ctx sdk.Context, tx btcjson.TxRawResult)
 		if out.Value == 0 {
 			continue
 		}
-		destAddress = append(destAddress, out.ScriptPubKey.Address)
+		destAddress = append(destAddress, scriptOwnerKey(scriptPubKeyFromVout(out)))
 	}
 
 	for _, dest := range destAddress {
@@ -280,36 +448,116 @@ func (s *msgServer) hasUtxoSendToItself(ctx sdk.Context, tx btcjson.TxRawResult)
 	return true, nil
 }
 
-// hasClaimMemo checks if any of the vOuts contains a claim memo
+// scriptOwnerKey identifies who controls an output: its address type and
+// claim identifier, or the raw address for outputs that cannot be claimed.
+func scriptOwnerKey(spk *types.ScriptPubKeyResult) string {
+	addressType, identifier, err := types.ScriptPubKeyIdentifier(spk)
+	if err != nil {
+		if spk == nil {
+			return ""
+		}
+		return spk.Address
+	}
+	return fmt.Sprintf("%s:%s", addressType, hex.EncodeToString(identifier))
+}
+
+// scriptPubKeyFromVout converts the script of a bitcoind vout into the form
+// UTXOs are stored with. Nodes before Bitcoin Core 22 list the address in
+// the deprecated addresses field, which is used when it holds just one.
+func scriptPubKeyFromVout(out btcjson.Vout) *types.ScriptPubKeyResult {
+	address := out.ScriptPubKey.Address
+	if address == "" && len(out.ScriptPubKey.Addresses) == 1 {
+		address = out.ScriptPubKey.Addresses[0]
+	}
+	return &types.ScriptPubKeyResult{
+		Hex:     out.ScriptPubKey.Hex,
+		Type:    out.ScriptPubKey.Type,
+		Address: address,
+	}
+}
+
+// checkVoutScript refuses an output without script data. bitcoind reports a
+// script type for every output, "nonstandard" included, so such an output
+// can only come from malformed block content, and the UTXO it would create
+// could never be matched by a claim.
+func checkVoutScript(txID string, out btcjson.Vout) error {
+	if out.ScriptPubKey.Hex == "" && out.ScriptPubKey.Type == "" {
+		return fmt.Errorf("output %s-%d has no script data", txID, out.N)
+	}
+	return nil
+}
+
+// voutOnNetwork reports whether the address of an output is the one its
+// script encodes on the Bitcoin network this chain indexes, which claims and
+// queries decode addresses for as well. The script decides what the output
+// is, so a mismatch only shows that the node of a reporter renders addresses
+// for another network. The output is logged and skipped rather than stored
+// with an address no claim or query would decode, and the rest of the block
+// is processed.
+func voutOnNetwork(ctx sdk.Context, txID string, out btcjson.Vout) bool {
+	err := types.CheckScriptAddress(scriptPubKeyFromVout(out), common.BitcoinNetParams(common.CurrentChainNetwork))
+	if err != nil {
+		ctx.Logger().Error("skipping block output with an address of another bitcoin network, check the bitcoin node of the reporters",
+			"txid", txID, "vout", out.N, "error", err)
+		return false
+	}
+	return true
+}
+
+// getClaimMemo returns the destination of the first claim memo in the vOuts
 func (s *msgServer) getClaimMemo(ctx sdk.Context, vOuts []btcjson.Vout) string {
+	var prefixes []string
 	for _, item := range vOuts {
-		switch item.ScriptPubKey.Type {
-		case nullDataType:
-			fields := strings.Fields(item.ScriptPubKey.Asm)
-			if len(fields) < 2 || fields[0] != "OP_RETURN" {
-				continue
-			}
-			memo, err := hex.DecodeString(fields[1])
-			if err != nil {
-				ctx.Logger().Debug("failed to decode memo", "error", err)
-				continue
-			}
-			memoStr := strings.ToLower(string(memo))
-			if !strings.HasPrefix(memoStr, claimPrefix) {
-				continue
+		if item.ScriptPubKey.Type != types.ScriptTypeNullData {
+			continue
+		}
+		memo, err := types.NullDataPayload(scriptPubKeyFromVout(item))
+		if err != nil {
+			ctx.Logger().Debug("failed to decode memo", "error", err)
+			continue
+		}
+		if prefixes == nil {
+			prefixes = s.k.GetClaimMemoPrefixes(ctx)
+		}
+		for _, prefix := range prefixes {
+			if after, ok := cutClaimPrefix(string(memo), prefix); ok {
+				return after
 			}
-			after, _ := strings.CutPrefix(memoStr, claimPrefix)
-			return after
 		}
 	}
 	return ""
 }
 
+// cutClaimPrefix matches the claim prefix case-insensitively and returns the
+// rest of the memo unchanged. The destination keeps its original case so that
+// bech32 decoding can reject mixed-case addresses.
+func cutClaimPrefix(memo, prefix string) (string, bool) {
+	if len(memo) < len(prefix) || !strings.EqualFold(memo[:len(prefix)], prefix) {
+		return "", false
+	}
+	return memo[len(prefix):], true
+}
+
 // getUTXOKey returns the key used to store UTXO in the key value store
 func getUTXOKey(txID string, vOut uint32) string {
 	return fmt.Sprintf("%s-%d", txID, vOut)
 }
 
+// getInputUTXO returns the UTXO stored under key for a transaction input that
+// spends it. Of a UTXO pruned after its claim only the amount is left, and it
+// is returned without entitlement or script.
+func (k Keeper) getInputUTXO(ctx context.Context, key string) (types.UTXO, error) {
+	utxo, err := k.Utxoes.Get(ctx, key)
+	if !errors.Is(err, collections.ErrNotFound) {
+		return utxo, err
+	}
+	amount, err := k.PrunedUTXOAmounts.Get(ctx, key)
+	if err != nil {
+		return types.UTXO{}, err
+	}
+	return types.UTXO{Amount: amount}, nil
+}
+
 // processVIn remove the UTXOs from the key value store since it has been spent , can't be claim anymore
 // return the total amount that can be claimed
 func (s *msgServer) processVIn(ctx sdk.Context, ins []btcjson.Vin) (uint64, uint64, bool, error) {
@@ -321,10 +569,11 @@ func (s *msgServer) processVIn(ctx sdk.Context, ins []btcjson.Vin) (uint64, uint
 			continue
 		}
 		key := getUTXOKey(in.Txid, in.Vout)
-		existingUtxo, err := s.k.Utxoes.Get(ctx, key)
+		existingUtxo, err := s.k.getInputUTXO(ctx, key)
 		if err != nil {
-			// UTXO not found, it must have been spent already
-			// on production environment , it should not happen, because we will load all unspent UTXOs from bitcoin node at genesis
+			// UTXO not found: all unspent UTXOs are loaded from bitcoin node at genesis, and pruned ones keep their
+			// amount in PrunedUTXOAmounts, so only a UTXO pruned before the amounts were kept is missing. It never
+			// carries an entitlement, but its amount is unknown, so the fee of this transaction is not deducted
 			if !errors.Is(err, collections.ErrNotFound) {
 				ctx.Logger().Error("failed to get UTXO", "key", key, "error", err)
 			} else {
@@ -338,75 +587,201 @@ func (s *msgServer) processVIn(ctx sdk.Context, ins []btcjson.Vin) (uint64, uint
 		totalClaimableAmount = totalClaimableAmount + existingUtxo.EntitledAmount
 		totalInputAmount = totalInputAmount + existingUtxo.Amount
 		// delete the UTXO since it has been spent
-		if err := s.k.Utxoes.Remove(ctx, key); err != nil {
+		if err := s.k.RemoveUTXO(ctx, key); err != nil {
 			return 0, 0, false, fmt.Errorf("fail to delete UTXO,error: %w", err)
 		}
+		if err := s.k.PrunedUTXOAmounts.Remove(ctx, key); err != nil {
+			return 0, 0, false, fmt.Errorf("fail to delete pruned UTXO amount,error: %w", err)
+		}
 	}
 	return totalClaimableAmount, totalInputAmount, hasClaimed, nil
 }
 
 func (s *msgServer) processVOuts(ctx sdk.Context,
 	outs []btcjson.Vout,
+	amounts []types.Satoshi,
 	txID string,
 	totalClaimableAmount uint64,
 	hasClaim bool,
-	totalOutputAmount uint64) error {
-	for _, out := range outs {
-		if out.Value == 0 {
+	totalOutputAmount uint64,
+	height uint64) error {
+	for i, out := range outs {
+		amount := amounts[i].Uint64()
+		if amount == 0 {
+			continue
+		}
+		if err := checkVoutScript(txID, out); err != nil {
+			return err
+		}
+		if !voutOnNetwork(ctx, txID, out) {
 			continue
 		}
 		// when none of the txout has been claimed before, each utxo can claim the same amount as its value
 		// when any of the txout has been claimed before, each utxo can claim an amount proportional to its value
-		entitleAmount := uint64(out.Value * 1e8)
+		entitleAmount := amount
 		if hasClaim {
-			entitleAmount = totalClaimableAmount * uint64(out.Value*1e8) / totalOutputAmount
+			entitleAmount = totalClaimableAmount * amount / totalOutputAmount
 		}
 		utxo := types.UTXO{
-			Txid:           txID,
-			Vout:           out.N,
-			Amount:         uint64(out.Value * 1e8),
-			EntitledAmount: entitleAmount,
-			ScriptPubKey: &types.ScriptPubKeyResult{
-				Hex:     out.ScriptPubKey.Hex,
-				Type:    out.ScriptPubKey.Type,
-				Address: out.ScriptPubKey.Address,
-			},
-		}
-		if err := s.k.Utxoes.Set(ctx, utxo.GetKey(), utxo); err != nil {
+			Txid:            txID,
+			Vout:            out.N,
+			Amount:          amount,
+			EntitledAmount:  entitleAmount,
+			ScriptPubKey:    scriptPubKeyFromVout(out),
+			CreatedAtHeight: height,
+		}
+		if err := s.k.SetUTXO(ctx, utxo); err != nil {
 			ctx.Logger().Error("failed to save UTXO", "key", utxo.GetKey(), "error", err)
 			return fmt.Errorf("fail to save UTXO,error: %w", err)
 		}
+		if utxo.EntitledAmount == 0 {
+			// nothing left to claim on this output, make it eligible for pruning
+			if err := s.k.markUTXOClaimed(ctx, utxo.GetKey()); err != nil {
+				return fmt.Errorf("fail to index claimed UTXO,error: %w", err)
+			}
+		}
 	}
 	return nil
 }
 func (s *msgServer) processCoinbaseVOuts(ctx sdk.Context,
 	outs []btcjson.Vout,
+	outAmounts []types.Satoshi,
 	txID string,
-	totalFee uint64) error {
-	for _, out := range outs {
-		if out.Value == 0 {
+	totalFee uint64,
+	height uint64) error {
+	amounts := make([]uint64, len(outAmounts))
+	for i, amount := range outAmounts {
+		amounts[i] = amount.Uint64()
+	}
+	// the fees paid in the block are not claimable, remove them from the coinbase outputs
+	feeShares, err := allocateCoinbaseFee(amounts, totalFee)
+	if err != nil {
+		return err
+	}
+	allocated := uint64(0)
+	for _, share := range feeShares {
+		allocated += share
+	}
+	if allocated < totalFee {
+		// a miner may claim less than the subsidy and fees, and the fee is
+		// derived from the stored input amounts, so this is no reason to
+		// stop processing blocks
+		ctx.Logger().Error("coinbase outputs are smaller than the block fees, nothing of them is claimable", "txid", txID, "total_fee", totalFee, "coinbase_amount", allocated)
+	}
+	for i, out := range outs {
+		if amounts[i] == 0 {
 			continue
 		}
-
-		entitleAmount := uint64(out.Value * 1e8)
-		if entitleAmount > totalFee {
-			entitleAmount = entitleAmount - totalFee
+		if err := checkVoutScript(txID, out); err != nil {
+			return err
 		}
+		if !voutOnNetwork(ctx, txID, out) {
+			continue
+		}
+
 		utxo := types.UTXO{
-			Txid:           txID,
-			Vout:           out.N,
-			Amount:         uint64(out.Value * 1e8),
-			EntitledAmount: entitleAmount,
-			ScriptPubKey: &types.ScriptPubKeyResult{
-				Hex:     out.ScriptPubKey.Hex,
-				Type:    out.ScriptPubKey.Type,
-				Address: out.ScriptPubKey.Address,
-			},
-		}
-		if err := s.k.Utxoes.Set(ctx, utxo.GetKey(), utxo); err != nil {
+			Txid:            txID,
+			Vout:            out.N,
+			Amount:          amounts[i],
+			EntitledAmount:  amounts[i] - feeShares[i],
+			ScriptPubKey:    scriptPubKeyFromVout(out),
+			CreatedAtHeight: height,
+			Coinbase:        true,
+		}
+		if err := s.k.SetUTXO(ctx, utxo); err != nil {
 			ctx.Logger().Error("failed to save UTXO", "key", utxo.GetKey(), "error", err)
 			return fmt.Errorf("fail to save UTXO,error: %w", err)
 		}
+		if utxo.EntitledAmount == 0 {
+			// nothing left to claim on this output, make it eligible for pruning
+			if err := s.k.markUTXOClaimed(ctx, utxo.GetKey()); err != nil {
+				return fmt.Errorf("fail to index claimed UTXO,error: %w", err)
+			}
+		}
 	}
 	return nil
 }
+
+// blockOutputAmounts returns the value of every output of every transaction
+// of a verbose block in satoshis, indexed like block.Tx and tx.Vout. It reads
+// the values from the JSON text with types.FromBTCString, as the float64
+// btcjson parses them into does not convert to satoshis reliably.
+func blockOutputAmounts(rawBlock []byte) ([][]types.Satoshi, error) {
+	var block struct {
+		Tx []struct {
+			Txid string `json:"txid"`
+			Vout []struct {
+				Value json.Number `json:"value"`
+			} `json:"vout"`
+		} `json:"tx"`
+	}
+	if err := json.Unmarshal(rawBlock, &block); err != nil {
+		return nil, err
+	}
+	amounts := make([][]types.Satoshi, len(block.Tx))
+	for i, tx := range block.Tx {
+		amounts[i] = make([]types.Satoshi, len(tx.Vout))
+		for j, out := range tx.Vout {
+			amount, err := types.FromBTCString(out.Value.String())
+			if err != nil {
+				return nil, fmt.Errorf("output %d of %s: %w", j, tx.Txid, err)
+			}
+			amounts[i][j] = amount
+		}
+	}
+	return amounts, nil
+}
+
+// allocateCoinbaseFee splits totalFee across the coinbase outputs in
+// proportion to their amounts and returns the share of each output. The
+// satoshis lost to rounding go to the outputs with the largest remainders, so
+// the shares always add up to totalFee and no share exceeds its output. A fee
+// larger than the coinbase outputs takes all of them, each share being its
+// whole output. It only fails when the output amounts overflow.
+func allocateCoinbaseFee(amounts []uint64, totalFee uint64) ([]uint64, error) {
+	shares := make([]uint64, len(amounts))
+	if totalFee == 0 {
+		return shares, nil
+	}
+	totalAmount := uint64(0)
+	for _, amount := range amounts {
+		sum, carry := bits.Add64(totalAmount, amount, 0)
+		if carry != 0 {
+			return nil, fmt.Errorf("coinbase output amounts overflow")
+		}
+		totalAmount = sum
+	}
+	if totalFee >= totalAmount {
+		copy(shares, amounts)
+		return shares, nil
+	}
+
+	allocated := uint64(0)
+	remainders := make([]uint64, len(amounts))
+	for i, amount := range amounts {
+		// totalFee*amount/totalAmount <= amount, so the quotient fits in 64 bits
+		hi, lo := bits.Mul64(totalFee, amount)
+		shares[i], remainders[i] = bits.Div64(hi, lo, totalAmount)
+		allocated += shares[i]
+	}
+	order := make([]int, len(amounts))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return remainders[order[a]] > remainders[order[b]]
+	})
+	// an output with a remainder has a share below its amount, and there are
+	// fewer satoshis left than outputs with a remainder
+	for _, i := range order {
+		if allocated == totalFee || remainders[i] == 0 {
+			break
+		}
+		shares[i]++
+		allocated++
+	}
+	if allocated != totalFee {
+		return nil, fmt.Errorf("allocated fee %d does not match total fee %d", allocated, totalFee)
+	}
+	return shares, nil
+}
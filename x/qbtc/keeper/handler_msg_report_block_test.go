@@ -2,11 +2,20 @@ package keeper_test
 
 import (
 	"compress/gzip"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
 	"os"
+	"strings"
 	"testing"
 
+	"cosmossdk.io/collections"
+	"github.com/btcq-org/qbtc/constants"
 	"github.com/btcq-org/qbtc/x/qbtc/keeper"
+	qbtctestutil "github.com/btcq-org/qbtc/x/qbtc/testutil"
 	"github.com/btcq-org/qbtc/x/qbtc/types"
+	"github.com/btcsuite/btcd/btcjson"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -80,7 +89,7 @@ func TestSetMsgReportBlock(t *testing.T) {
 				utxo, err := f.keeper.Utxoes.Get(f.ctx, coinbaseKey)
 				require.NoError(st, err)
 				require.NotNil(st, utxo)
-				require.Equal(st, utxo.EntitledAmount, uint64(313461773))
+				require.Equal(st, utxo.EntitledAmount, uint64(313461906))
 
 			},
 		},
@@ -96,7 +105,7 @@ func TestSetMsgReportBlock(t *testing.T) {
 				utxo, err := f.keeper.Utxoes.Get(f.ctx, coinbaseKey)
 				require.NoError(st, err)
 				require.NotNil(st, utxo)
-				require.Equal(st, uint64(2502676488), utxo.EntitledAmount)
+				require.Equal(st, uint64(2502676489), utxo.EntitledAmount)
 
 				key1 := "e8bd07a2b2a68965ef732d6dad74d3af16ac384aff1c92a42e1707f5bc8fb714-0"
 				utxo1, err := f.keeper.Utxoes.Get(f.ctx, key1)
@@ -132,7 +141,9 @@ func TestSetMsgReportBlock(t *testing.T) {
 				utxo, err := f.keeper.Utxoes.Get(f.ctx, coinbaseKey)
 				require.NoError(st, err)
 				require.NotNil(st, utxo)
-				require.Equal(st, uint64(2502666488), utxo.EntitledAmount)
+				require.Equal(st, uint64(2502666489), utxo.EntitledAmount)
+				require.Equal(st, uint64(300003), utxo.CreatedAtHeight)
+				require.True(st, utxo.Coinbase)
 
 				key1 := "2bda3732778da19cbf8799aceed3a6ab270948aeac85678bee013ddf3070687e-0"
 				utxo1, err := f.keeper.Utxoes.Get(f.ctx, key1)
@@ -140,6 +151,8 @@ func TestSetMsgReportBlock(t *testing.T) {
 				require.NotNil(st, utxo1)
 
 				require.Equal(st, uint64(20000000), utxo1.EntitledAmount)
+				require.Equal(st, uint64(300003), utxo1.CreatedAtHeight)
+				require.False(st, utxo1.Coinbase)
 
 				key2 := "2bda3732778da19cbf8799aceed3a6ab270948aeac85678bee013ddf3070687e-1"
 				utxo2, err := f.keeper.Utxoes.Get(f.ctx, key2)
@@ -202,7 +215,7 @@ func TestSetMsgReportBlock(t *testing.T) {
 				utxo, err := f.keeper.Utxoes.Get(f.ctx, coinbaseKey)
 				require.NoError(st, err)
 				require.NotNil(st, utxo)
-				require.Equal(st, uint64(2502666488), utxo.EntitledAmount)
+				require.Equal(st, uint64(2502666489), utxo.EntitledAmount)
 
 				key1 := "bfa3ed4869f33192946dcc03d7789d6be32aa07f083e9752fcea2a5568a9ea47-0"
 				utxo1, err := f.keeper.Utxoes.Get(f.ctx, key1)
@@ -257,30 +270,81 @@ func TestSetMsgReportBlock(t *testing.T) {
 }
 func TestSetMsgReportBlock_WithClaim(t *testing.T) {
 	f := initFixture(t)
-	f.bankKeeper.EXPECT().MintCoins(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
-	f.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 	fileContent, err := os.ReadFile("../../../testdata/block/withclaim.json")
 	assert.Nil(t, err)
-	compressedContent, err := types.GzipDeterministic(fileContent, gzip.BestCompression)
-	assert.Nil(t, err, "failed to compress block data")
-	address, err := f.GetConsensusAddress()
-	assert.Nil(t, err)
-	signerAddr, err := f.GetRandomQbtcAddress()
+	msg := reportWithClaimBlock(t, f, fileContent)
+
+	utxoAfterClaim, err := f.keeper.Utxoes.Get(f.ctx, "5c2477ad6bdd4dc5eeb9fc7a5fd95c182dad5691acfb558546735a2ac28fb5b4-0")
 	assert.NoError(t, err)
-	signature, err := f.privateKey.Sign(compressedContent)
-	assert.Nil(t, err, "failed to sign compressed data")
-	msg := &types.MsgBtcBlock{
-		Height:       700000,
-		Hash:         "0000000000000000000a025260e08314c2f60e060c283140000001976a9144eb2d3adc8a627687d62e13a56df89bc777ffc8988ac",
-		BlockContent: compressedContent,
-		Attestations: []*types.Attestation{
-			{
-				Address:   address,
-				Signature: signature,
-			},
-		},
-		Signer: signerAddr,
+	assert.Equal(t, uint64(0), utxoAfterClaim.EntitledAmount)
+	// check claimed utxo
+
+	// the processed block is recorded with its hash
+	height, err := f.keeper.GetLastProcessedBlock(f.ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, msg.Height, height)
+	hash, err := f.keeper.GetLastProcessedBlockHash(f.ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, msg.Hash, hash)
+}
+
+func TestSetMsgReportBlock_ClaimMemoCase(t *testing.T) {
+	// the memo in withclaim.json is "CLAIM:" followed by this address
+	const address = "qbtc1vcpj722fee8w82llrxv9ggh49pwe5jwz95lmgj"
+	fileContent, err := os.ReadFile("../../../testdata/block/withclaim.json")
+	require.NoError(t, err)
+	original := hex.EncodeToString([]byte("CLAIM:" + address))
+	require.Contains(t, string(fileContent), original)
+
+	tests := []struct {
+		name     string
+		prefixes []string
+		memo     string
+		claimed  bool
+	}{
+		{name: "lowercase", memo: "claim:" + address, claimed: true},
+		{name: "uppercase prefix", memo: "CLAIM:" + address, claimed: true},
+		{name: "mixed-case prefix", memo: "Claim:" + address, claimed: true},
+		{name: "uppercase memo", memo: "CLAIM:" + strings.ToUpper(address), claimed: true},
+		{name: "mixed-case address", memo: "claim:" + strings.ToUpper(address[:5]) + address[5:], claimed: false},
+		{name: "other prefix", memo: "clam:x" + address, claimed: false},
+		{name: "governance prefix", prefixes: []string{"claim:", "qclm1:"}, memo: "QCLM1:" + address, claimed: true},
+		{name: "default prefix still accepted", prefixes: []string{"claim:", "qclm1:"}, memo: "claim:" + address, claimed: true},
+		{name: "default prefix removed", prefixes: []string{"qclm1:"}, memo: "claim:" + address, claimed: false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// the memos have the same length, so only the pushed data changes;
+			// the claim transaction gets another txid with it
+			memoHex := hex.EncodeToString([]byte(tc.memo))
+			require.Len(t, memoHex, len(original))
+			var block btcjson.GetBlockVerboseTxResult
+			require.NoError(t, json.Unmarshal([]byte(strings.ReplaceAll(string(fileContent), original, memoHex)), &block))
+			content := qbtctestutil.SealBlock(t, &block)
+
+			f := initFixture(t)
+			if tc.prefixes != nil {
+				require.NoError(t, f.keeper.ClaimMemoPrefixes.Set(f.ctx, types.ClaimMemoPrefixes{Prefixes: tc.prefixes}))
+			}
+			reportWithClaimBlock(t, f, content)
+
+			utxo, err := f.keeper.Utxoes.Get(f.ctx, block.Tx[1].Txid+"-0")
+			require.NoError(t, err)
+			if tc.claimed {
+				require.Equal(t, uint64(0), utxo.EntitledAmount)
+			} else {
+				require.Equal(t, utxo.Amount, utxo.EntitledAmount)
+			}
+		})
 	}
+}
+
+// reportWithClaimBlock preloads the UTXO spent by the claim transaction in
+// withclaim.json and reports the given block content
+func reportWithClaimBlock(t *testing.T, f *fixture, fileContent []byte) *types.MsgBtcBlock {
+	t.Helper()
+	f.bankKeeper.EXPECT().MintCoins(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	f.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 	// preload utxo to be claimed
 	utxoToClaim := types.UTXO{
 		Txid:           "dbdd7837a8f7e113f6038b6cf659600538c53b7a742e2b9b1f22de3039e912ba",
@@ -288,19 +352,658 @@ func TestSetMsgReportBlock_WithClaim(t *testing.T) {
 		Amount:         88109900000,
 		EntitledAmount: 88109900000,
 		ScriptPubKey: &types.ScriptPubKeyResult{
-			Hex:     "76a9144eb2d3adc8a627687d62e13a56df89bc777ffc8988ac",
+			Hex:     "76a9141f0dd0b30ae8360683ae0d8f5f9666b56593662488ac",
 			Type:    "pubkeyhash",
 			Address: "13qCVr4a2ryEkM8fA3r85QzWFqMNV7p3nB",
 		},
 	}
 	key := utxoToClaim.GetKey()
 	assert.NoError(t, f.keeper.Utxoes.Set(f.ctx, key, utxoToClaim))
+	var block btcjson.GetBlockVerboseTxResult
+	require.NoError(t, json.Unmarshal(fileContent, &block))
+	msg, err := reportBlock(t, f, 700000, block.Hash, fileContent)
+	assert.NoError(t, err)
+	return msg
+}
+
+// reportBlock reports the block content attested by the fixture validator
+func reportBlock(t *testing.T, f *fixture, height uint64, hash string, fileContent []byte) (*types.MsgBtcBlock, error) {
+	t.Helper()
+	return reportBlockWithCodec(t, f, height, hash, fileContent, types.BlockContentCodecGzip)
+}
+
+// reportBlockWithCodec is reportBlock with the content compressed by codec
+func reportBlockWithCodec(t *testing.T, f *fixture, height uint64, hash string, fileContent []byte, codec types.BlockContentCodec) (*types.MsgBtcBlock, error) {
+	t.Helper()
+	compressedContent, err := types.CompressBlockContent(fileContent, codec)
+	require.NoError(t, err, "failed to compress block data")
+	address, err := f.GetConsensusAddress()
+	require.NoError(t, err)
+	signerAddr, err := f.GetRandomQbtcAddress()
+	require.NoError(t, err)
+	signature, err := f.privateKey.Sign(compressedContent)
+	require.NoError(t, err, "failed to sign compressed data")
+	msg := &types.MsgBtcBlock{
+		Height:       height,
+		Hash:         hash,
+		BlockContent: compressedContent,
+		Attestations: []*types.Attestation{
+			{
+				Address:   address,
+				Signature: signature,
+			},
+		},
+		Signer: signerAddr,
+	}
 	server := keeper.NewMsgServerImpl(f.keeper)
 	_, err = server.SetMsgReportBlock(f.ctx, msg)
-	assert.NoError(t, err)
+	return msg, err
+}
 
-	utxoAfterClaim, err := f.keeper.Utxoes.Get(f.ctx, "e8bd07a2b2a68965ef732d6dad74d3af16ac384aff1c92a42e1707f5bc8fb714-0")
-	assert.NoError(t, err)
-	assert.Equal(t, uint64(0), utxoAfterClaim.EntitledAmount)
-	// check claimed utxo
+func TestSetMsgReportBlock_CoinbaseFee(t *testing.T) {
+	const spentTxID = "1111111111111111111111111111111111111111111111111111111111111111"
+	p2pkh := btcjson.ScriptPubKeyResult{
+		Hex:     "76a9141f0dd0b30ae8360683ae0d8f5f9666b56593662488ac",
+		Type:    "pubkeyhash",
+		Address: "13qCVr4a2ryEkM8fA3r85QzWFqMNV7p3nB",
+	}
+	// buildBlock returns a block whose coinbase pays the given outputs and
+	// whose only other transaction spends a 1 BTC UTXO with the given fee
+	buildBlock := func(t *testing.T, coinbaseOutputs []float64, fee int64) (btcjson.GetBlockVerboseTxResult, []byte) {
+		coinbase := btcjson.TxRawResult{
+			Vin: []btcjson.Vin{{Coinbase: "03a0bb0d"}},
+		}
+		for i, value := range coinbaseOutputs {
+			out := btcjson.Vout{Value: value, N: uint32(i), ScriptPubKey: p2pkh}
+			if value == 0 {
+				out.ScriptPubKey = btcjson.ScriptPubKeyResult{Hex: "6a24aa21a9ed", Type: types.ScriptTypeNullData}
+			}
+			coinbase.Vout = append(coinbase.Vout, out)
+		}
+		tx := btcjson.TxRawResult{
+			Vin:  []btcjson.Vin{{Txid: spentTxID, Vout: 0}},
+			Vout: []btcjson.Vout{{Value: float64(1e8-fee) / 1e8, N: 0, ScriptPubKey: p2pkh}},
+		}
+		block := btcjson.GetBlockVerboseTxResult{Height: 800000, Tx: []btcjson.TxRawResult{coinbase, tx}}
+		content := qbtctestutil.SealBlock(t, &block)
+		return block, content
+	}
+
+	tests := []struct {
+		name            string
+		coinbaseOutputs []float64
+		fee             int64
+		wantErr         bool
+		wantEntitled    []uint64
+	}{
+		{
+			name:            "single output",
+			coinbaseOutputs: []float64{3.125},
+			fee:             1000,
+			wantEntitled:    []uint64{312499000},
+		},
+		{
+			name:            "fee split proportionally",
+			coinbaseOutputs: []float64{2, 0, 1, 1},
+			fee:             1000,
+			wantEntitled:    []uint64{199999500, 0, 99999750, 99999750},
+		},
+		{
+			name:            "rounding goes to the largest remainder",
+			coinbaseOutputs: []float64{2, 1, 1},
+			fee:             1001,
+			wantEntitled:    []uint64{199999499, 99999750, 99999750},
+		},
+		{
+			name:            "dust output is not charged the whole fee",
+			coinbaseOutputs: []float64{0.00000546, 3.13527508},
+			fee:             65735,
+			wantEntitled:    []uint64{546, 313461773},
+		},
+		{
+			name:            "fee larger than the coinbase",
+			coinbaseOutputs: []float64{0.00000546},
+			fee:             1000,
+			wantErr:         true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			f := initFixture(t)
+			spent := types.UTXO{
+				Txid:           spentTxID,
+				Vout:           0,
+				Amount:         1e8,
+				EntitledAmount: 1e8,
+				ScriptPubKey: &types.ScriptPubKeyResult{
+					Hex:     p2pkh.Hex,
+					Type:    p2pkh.Type,
+					Address: p2pkh.Address,
+				},
+			}
+			require.NoError(t, f.keeper.Utxoes.Set(f.ctx, spent.GetKey(), spent))
+
+			block, content := buildBlock(t, tc.coinbaseOutputs, tc.fee)
+			_, err := reportBlock(t, f, 800000, block.Hash, content)
+			if tc.wantErr {
+				require.Error(t, err)
+				// the block is rejected as a whole
+				has, err := f.keeper.Utxoes.Has(f.ctx, spent.GetKey())
+				require.NoError(t, err)
+				require.True(t, has)
+				return
+			}
+			require.NoError(t, err)
+
+			totalReduction := uint64(0)
+			for i, want := range tc.wantEntitled {
+				utxo, err := f.keeper.Utxoes.Get(f.ctx, fmt.Sprintf("%s-%d", block.Tx[0].Txid, i))
+				if tc.coinbaseOutputs[i] == 0 {
+					// zero value outputs are not stored
+					require.Error(t, err)
+					continue
+				}
+				require.NoError(t, err)
+				require.Equal(t, want, utxo.EntitledAmount, "output %d", i)
+				totalReduction += utxo.Amount - utxo.EntitledAmount
+			}
+			require.Equal(t, uint64(tc.fee), totalReduction)
+		})
+	}
+}
+
+func TestSetMsgReportBlock_PrunedInputFee(t *testing.T) {
+	const spentTxID = "1111111111111111111111111111111111111111111111111111111111111111"
+	p2pkh := btcjson.ScriptPubKeyResult{
+		Hex:     "76a9141f0dd0b30ae8360683ae0d8f5f9666b56593662488ac",
+		Type:    "pubkeyhash",
+		Address: "13qCVr4a2ryEkM8fA3r85QzWFqMNV7p3nB",
+	}
+	block := btcjson.GetBlockVerboseTxResult{
+		Height: 800000,
+		Tx: []btcjson.TxRawResult{{
+			Vin:  []btcjson.Vin{{Coinbase: "03a0bb0d"}},
+			Vout: []btcjson.Vout{{Value: 3.125, N: 0, ScriptPubKey: p2pkh}},
+		}, {
+			Vin:  []btcjson.Vin{{Txid: spentTxID, Vout: 0}},
+			Vout: []btcjson.Vout{{Value: 0.99999, N: 0, ScriptPubKey: p2pkh}},
+		}},
+	}
+	content := qbtctestutil.SealBlock(t, &block)
+
+	// the spent UTXO was claimed and pruned before the block
+	f := initFixture(t)
+	spent := types.UTXO{
+		Txid:         spentTxID,
+		Amount:       1e8,
+		ScriptPubKey: &types.ScriptPubKeyResult{Hex: p2pkh.Hex, Type: p2pkh.Type, Address: p2pkh.Address},
+	}
+	require.NoError(t, f.keeper.SetUTXO(f.ctx, spent))
+	require.NoError(t, f.keeper.ClaimedUTXOIndex.Set(f.ctx, collections.Join(int64(1), spent.GetKey())))
+	retention := constants.DefaultValues[constants.ClaimedUTXORetentionBlocks]
+	require.NoError(t, f.keeper.PruneClaimedUTXOs(sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(1+retention)))
+	has, err := f.keeper.Utxoes.Has(f.ctx, spent.GetKey())
+	require.NoError(t, err)
+	require.False(t, has)
+
+	_, err = reportBlock(t, f, 800000, block.Hash, content)
+	require.NoError(t, err)
+	// the fee of the spending transaction is still taken off the coinbase
+	utxo, err := f.keeper.Utxoes.Get(f.ctx, block.Tx[0].Txid+"-0")
+	require.NoError(t, err)
+	require.Equal(t, uint64(312500000-1000), utxo.EntitledAmount)
+	has, err = f.keeper.PrunedUTXOAmounts.Has(f.ctx, spent.GetKey())
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestSetMsgReportBlock_UnderclaimingCoinbase(t *testing.T) {
+	const spentTxID = "1111111111111111111111111111111111111111111111111111111111111111"
+	p2pkh := btcjson.ScriptPubKeyResult{
+		Hex:     "76a9141f0dd0b30ae8360683ae0d8f5f9666b56593662488ac",
+		Type:    "pubkeyhash",
+		Address: "13qCVr4a2ryEkM8fA3r85QzWFqMNV7p3nB",
+	}
+	// the miner claims less than the fee of the block's transaction
+	block := btcjson.GetBlockVerboseTxResult{
+		Height: 800000,
+		Tx: []btcjson.TxRawResult{{
+			Vin: []btcjson.Vin{{Coinbase: "03a0bb0d"}},
+			Vout: []btcjson.Vout{
+				{Value: 0.0001, N: 0, ScriptPubKey: p2pkh},
+				{Value: 0.0003, N: 1, ScriptPubKey: p2pkh},
+			},
+		}, {
+			Vin:  []btcjson.Vin{{Txid: spentTxID, Vout: 0}},
+			Vout: []btcjson.Vout{{Value: 0.5, N: 0, ScriptPubKey: p2pkh}},
+		}},
+	}
+	content := qbtctestutil.SealBlock(t, &block)
+
+	f := initFixture(t)
+	spent := types.UTXO{
+		Txid:           spentTxID,
+		Amount:         1e8,
+		EntitledAmount: 1e8,
+		ScriptPubKey:   &types.ScriptPubKeyResult{Hex: p2pkh.Hex, Type: p2pkh.Type, Address: p2pkh.Address},
+	}
+	require.NoError(t, f.keeper.SetUTXO(f.ctx, spent))
+
+	_, err := reportBlock(t, f, 800000, block.Hash, content)
+	require.NoError(t, err)
+	last, err := f.keeper.GetLastProcessedBlock(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(800000), last)
+
+	// the coinbase outputs are kept, with nothing left to claim
+	for vout := range block.Tx[0].Vout {
+		utxo, err := f.keeper.Utxoes.Get(f.ctx, fmt.Sprintf("%s-%d", block.Tx[0].Txid, vout))
+		require.NoError(t, err)
+		require.Zero(t, utxo.EntitledAmount)
+	}
+	utxo, err := f.keeper.Utxoes.Get(f.ctx, block.Tx[1].Txid+"-0")
+	require.NoError(t, err)
+	require.Equal(t, uint64(50000000), utxo.EntitledAmount)
+}
+
+func TestSetMsgReportBlock_IncrementalAttestations(t *testing.T) {
+	// more than 2/3 of four equal validators takes three of them
+	f := initFixtureWithValidators(t, 4)
+	server := keeper.NewMsgServerImpl(f.keeper)
+	const (
+		height    = 300003
+		blockHash = "000000000000000082aee4ff546c1db5e1aa5f9bfbaa0c76300a792b3e91fce7"
+	)
+	fileContent, err := os.ReadFile("../../../testdata/block/300003.json")
+	require.NoError(t, err)
+	content, err := types.GzipDeterministic(fileContent, gzip.BestCompression)
+	require.NoError(t, err)
+	signer, err := f.GetRandomQbtcAddress()
+	require.NoError(t, err)
+
+	attest := func(i int, blockContent []byte) *types.Attestation {
+		pubKey, err := f.validators[i].ConsPubKey()
+		require.NoError(t, err)
+		signature, err := f.privateKeys[i].Sign(blockContent)
+		require.NoError(t, err)
+		return &types.Attestation{Address: sdk.ConsAddress(pubKey.Address()).String(), Signature: signature}
+	}
+	report := func(blockContent []byte, attestations ...*types.Attestation) error {
+		_, err := server.SetMsgReportBlock(f.ctx, &types.MsgBtcBlock{
+			Height:       height,
+			Hash:         blockHash,
+			BlockContent: blockContent,
+			Attestations: attestations,
+			Signer:       signer,
+		})
+		return err
+	}
+	pending := func() int {
+		count := 0
+		require.NoError(t, f.keeper.PendingAttestations.Walk(f.ctx, nil, func(collections.Triple[uint64, []byte, string]) (bool, error) {
+			count++
+			return false, nil
+		}))
+		return count
+	}
+	processed := func() bool {
+		last, err := f.keeper.GetLastProcessedBlock(f.ctx)
+		require.NoError(t, err)
+		return last == height
+	}
+
+	// the first attestation is recorded without processing the block
+	require.NoError(t, report(content, attest(0, content)))
+	require.Equal(t, 1, pending())
+	require.False(t, processed())
+
+	// a report that adds nothing is rejected, whether the attestation was
+	// already recorded or does not verify
+	require.ErrorIs(t, report(content, attest(0, content)), types.ErrInsufficientAttestationPower)
+	forged := attest(1, content)
+	forged.Signature = attest(2, content).Signature
+	require.ErrorIs(t, report(content, forged), types.ErrInsufficientAttestationPower)
+	require.Equal(t, 1, pending())
+
+	// attestations of other content for the same height are kept apart
+	other, err := types.GzipDeterministic([]byte(`{"height":300003}`), gzip.BestCompression)
+	require.NoError(t, err)
+	require.NoError(t, report(other, attest(1, other), attest(2, other)))
+	require.Equal(t, 3, pending())
+	require.False(t, processed())
+
+	require.NoError(t, report(content, attest(1, content)))
+	require.Equal(t, 4, pending())
+	require.False(t, processed())
+
+	// the third validator completes the required power; the block is
+	// processed and every pending attestation up to its height is dropped
+	require.NoError(t, report(content, attest(2, content)))
+	require.True(t, processed())
+	require.Zero(t, pending())
+}
+
+func TestSetMsgReportBlock_AttestationCap(t *testing.T) {
+	f := initFixtureWithValidators(t, 4)
+	server := keeper.NewMsgServerImpl(f.keeper)
+	require.NoError(t, f.keeper.ConstOverrides.Set(f.ctx, constants.AttestationSlack.String(), 1))
+	content, err := types.GzipDeterministic([]byte(`{"height":300003}`), gzip.BestCompression)
+	require.NoError(t, err)
+	signer, err := f.GetRandomQbtcAddress()
+	require.NoError(t, err)
+
+	pubKey, err := f.validators[0].ConsPubKey()
+	require.NoError(t, err)
+	signature, err := f.privateKeys[0].Sign(content)
+	require.NoError(t, err)
+	attestation := &types.Attestation{Address: sdk.ConsAddress(pubKey.Address()).String(), Signature: signature}
+	report := func(count int) error {
+		attestations := make([]*types.Attestation, count)
+		for i := range attestations {
+			attestations[i] = attestation
+		}
+		_, err := server.SetMsgReportBlock(f.ctx, &types.MsgBtcBlock{
+			Height:       300003,
+			Hash:         "000000000000000082aee4ff546c1db5e1aa5f9bfbaa0c76300a792b3e91fce7",
+			BlockContent: content,
+			Attestations: attestations,
+			Signer:       signer,
+		})
+		return err
+	}
+
+	// four validators and a slack of one allow five attestations
+	require.ErrorContains(t, report(6), "too many attestations: 6, at most 5")
+	require.NoError(t, report(5))
+}
+
+func TestSetMsgReportBlock_AttestationGas(t *testing.T) {
+	content, err := types.GzipDeterministic([]byte(`{"height":300003}`), gzip.BestCompression)
+	require.NoError(t, err)
+	// reportGas reports one attestation to a fresh chain of four validators
+	// and returns the gas it consumed
+	reportGas := func(validatorGas, signatureGas int64) uint64 {
+		f := initFixtureWithValidators(t, 4)
+		server := keeper.NewMsgServerImpl(f.keeper)
+		require.NoError(t, f.keeper.ConstOverrides.Set(f.ctx, constants.AttestationValidatorGas.String(), validatorGas))
+		require.NoError(t, f.keeper.ConstOverrides.Set(f.ctx, constants.AttestationSignatureGas.String(), signatureGas))
+		signer, err := f.GetRandomQbtcAddress()
+		require.NoError(t, err)
+		pubKey, err := f.validators[0].ConsPubKey()
+		require.NoError(t, err)
+		signature, err := f.privateKeys[0].Sign(content)
+		require.NoError(t, err)
+
+		ctx := sdk.UnwrapSDKContext(f.ctx)
+		before := ctx.GasMeter().GasConsumed()
+		_, err = server.SetMsgReportBlock(ctx, &types.MsgBtcBlock{
+			Height:       300003,
+			Hash:         "000000000000000082aee4ff546c1db5e1aa5f9bfbaa0c76300a792b3e91fce7",
+			BlockContent: content,
+			Attestations: []*types.Attestation{{Address: sdk.ConsAddress(pubKey.Address()).String(), Signature: signature}},
+			Signer:       signer,
+		})
+		require.NoError(t, err)
+		return ctx.GasMeter().GasConsumed() - before
+	}
+
+	// four bonded validators and one verified signature
+	require.Equal(t, uint64(4*100+3000), reportGas(100, 3000)-reportGas(0, 0))
+}
+
+func TestSetMsgReportBlock_ProcessedHeightIgnored(t *testing.T) {
+	f := initFixture(t)
+	server := keeper.NewMsgServerImpl(f.keeper)
+	require.NoError(t, f.keeper.LastProcessedBlock.Set(f.ctx, 1000))
+
+	// reorgs are not handled, so another block at a processed height is
+	// ignored however deep it is
+	for _, height := range []uint64{1000, 999, 1} {
+		_, err := server.SetMsgReportBlock(f.ctx, &types.MsgBtcBlock{Height: height, Hash: "reorg"})
+		require.NoError(t, err)
+	}
+	last, err := f.keeper.GetLastProcessedBlock(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1000), last)
+}
+
+func TestSetMsgReportBlock_ZstdContent(t *testing.T) {
+	block := btcjson.GetBlockVerboseTxResult{
+		Height: 800000,
+		Tx: []btcjson.TxRawResult{{
+			Vin: []btcjson.Vin{{Coinbase: "03a0bb0d"}},
+			Vout: []btcjson.Vout{{
+				Value: 3.125,
+				N:     0,
+				ScriptPubKey: btcjson.ScriptPubKeyResult{
+					Hex:     "76a9141f0dd0b30ae8360683ae0d8f5f9666b56593662488ac",
+					Type:    "pubkeyhash",
+					Address: "13qCVr4a2ryEkM8fA3r85QzWFqMNV7p3nB",
+				},
+			}},
+		}},
+	}
+	content := qbtctestutil.SealBlock(t, &block)
+
+	f := initFixture(t)
+	msg, err := reportBlockWithCodec(t, f, 800000, block.Hash, content, types.BlockContentCodecZstd)
+	require.NoError(t, err)
+	require.Equal(t, byte(types.BlockContentCodecZstd), msg.BlockContent[0])
+
+	utxo, err := f.keeper.Utxoes.Get(f.ctx, block.Tx[0].Txid+"-0")
+	require.NoError(t, err)
+	require.Equal(t, uint64(312500000), utxo.EntitledAmount)
+}
+
+func TestSetMsgReportBlock_RejectsOutputWithoutScript(t *testing.T) {
+	block := btcjson.GetBlockVerboseTxResult{
+		Height: 800000,
+		Tx: []btcjson.TxRawResult{{
+			Vin:  []btcjson.Vin{{Coinbase: "03a0bb0d"}},
+			Vout: []btcjson.Vout{{Value: 3.125, N: 0}},
+		}},
+	}
+	content := qbtctestutil.SealBlock(t, &block)
+
+	f := initFixture(t)
+	_, err := reportBlock(t, f, 800000, block.Hash, content)
+	require.ErrorContains(t, err, "has no script data")
+	has, err := f.keeper.Utxoes.Has(f.ctx, block.Tx[0].Txid+"-0")
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestSetMsgReportBlock_SkipsOtherNetworkAddress(t *testing.T) {
+	block := btcjson.GetBlockVerboseTxResult{
+		Height: 800000,
+		Tx: []btcjson.TxRawResult{{
+			Vin: []btcjson.Vin{{Coinbase: "03a0bb0d"}},
+			Vout: []btcjson.Vout{{
+				Value: 3.125,
+				N:     0,
+				// rendered by a testnet node
+				ScriptPubKey: btcjson.ScriptPubKeyResult{
+					Hex:     "00140100000000000000000000000000000000000000",
+					Type:    "witness_v0_keyhash",
+					Address: "tb1qqyqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqkwz9qq",
+				},
+			}, {
+				Value: 0.1,
+				N:     1,
+				ScriptPubKey: btcjson.ScriptPubKeyResult{
+					Hex:     "76a9141f0dd0b30ae8360683ae0d8f5f9666b56593662488ac",
+					Type:    "pubkeyhash",
+					Address: "13qCVr4a2ryEkM8fA3r85QzWFqMNV7p3nB",
+				},
+			}},
+		}},
+	}
+	content := qbtctestutil.SealBlock(t, &block)
+
+	f := initFixture(t)
+	_, err := reportBlock(t, f, 800000, block.Hash, content)
+	require.NoError(t, err)
+	has, err := f.keeper.Utxoes.Has(f.ctx, block.Tx[0].Txid+"-0")
+	require.NoError(t, err)
+	require.False(t, has)
+	has, err = f.keeper.Utxoes.Has(f.ctx, block.Tx[0].Txid+"-1")
+	require.NoError(t, err)
+	require.True(t, has)
+}
+
+func TestSetMsgReportBlock_LegacyAddressesField(t *testing.T) {
+	block := btcjson.GetBlockVerboseTxResult{
+		Height: 800000,
+		Tx: []btcjson.TxRawResult{{
+			Vin: []btcjson.Vin{{Coinbase: "03a0bb0d"}},
+			Vout: []btcjson.Vout{{
+				Value: 3.125,
+				N:     0,
+				// nodes before Bitcoin Core 22 only fill the addresses list
+				ScriptPubKey: btcjson.ScriptPubKeyResult{
+					Hex:       "76a9141f0dd0b30ae8360683ae0d8f5f9666b56593662488ac",
+					Type:      "pubkeyhash",
+					Addresses: []string{"13qCVr4a2ryEkM8fA3r85QzWFqMNV7p3nB"},
+				},
+			}},
+		}},
+	}
+	content := qbtctestutil.SealBlock(t, &block)
+
+	f := initFixture(t)
+	_, err := reportBlock(t, f, 800000, block.Hash, content)
+	require.NoError(t, err)
+	utxo, err := f.keeper.Utxoes.Get(f.ctx, block.Tx[0].Txid+"-0")
+	require.NoError(t, err)
+	require.Equal(t, "13qCVr4a2ryEkM8fA3r85QzWFqMNV7p3nB", utxo.Address())
+}
+
+func TestSetMsgReportBlock_ExactOutputAmounts(t *testing.T) {
+	spk := btcjson.ScriptPubKeyResult{
+		Hex:     "76a9141f0dd0b30ae8360683ae0d8f5f9666b56593662488ac",
+		Type:    "pubkeyhash",
+		Address: "13qCVr4a2ryEkM8fA3r85QzWFqMNV7p3nB",
+	}
+	block := btcjson.GetBlockVerboseTxResult{
+		Height: 800000,
+		Tx: []btcjson.TxRawResult{{
+			Vin:  []btcjson.Vin{{Coinbase: "03a0bb0d"}},
+			Vout: []btcjson.Vout{{Value: 0.29, N: 0, ScriptPubKey: spk}, {Value: 0.00000546, N: 1, ScriptPubKey: spk}},
+		}},
+	}
+	content := string(qbtctestutil.SealBlock(t, &block))
+	require.Contains(t, content, `"value":0.00000546`)
+
+	f := initFixture(t)
+	// 0.29 BTC is 28999999.999999996 satoshis as a float64, and bifrost
+	// renders amounts below 1e-6 BTC with an exponent
+	_, err := reportBlock(t, f, 800000, block.Hash, []byte(strings.Replace(content, `"value":0.00000546`, `"value":5.46e-06`, 1)))
+	require.NoError(t, err)
+	for vout, want := range []uint64{29000000, 546} {
+		utxo, err := f.keeper.Utxoes.Get(f.ctx, fmt.Sprintf("%s-%d", block.Tx[0].Txid, vout))
+		require.NoError(t, err)
+		require.Equal(t, want, utxo.Amount)
+		require.Equal(t, want, utxo.EntitledAmount)
+	}
+
+	// an amount with a fraction of a satoshi rejects the block
+	f = initFixture(t)
+	_, err = reportBlock(t, f, 800000, block.Hash, []byte(strings.Replace(content, `"value":0.29`, `"value":0.123456789`, 1)))
+	require.ErrorContains(t, err, "invalid output amount")
+}
+
+func TestSetMsgReportBlock_BlockContentMismatch(t *testing.T) {
+	p2pkh := btcjson.ScriptPubKeyResult{
+		Hex:     "76a9141f0dd0b30ae8360683ae0d8f5f9666b56593662488ac",
+		Type:    "pubkeyhash",
+		Address: "13qCVr4a2ryEkM8fA3r85QzWFqMNV7p3nB",
+	}
+	// the header commits to the coinbase only
+	newBlock := func() btcjson.GetBlockVerboseTxResult {
+		block := btcjson.GetBlockVerboseTxResult{
+			Height: 800000,
+			Tx: []btcjson.TxRawResult{{
+				Vin:  []btcjson.Vin{{Coinbase: "03a0bb0d"}},
+				Vout: []btcjson.Vout{{Value: 3.125, N: 0, ScriptPubKey: p2pkh}},
+			}},
+		}
+		qbtctestutil.SealBlock(t, &block)
+		return block
+	}
+	injected := btcjson.GetBlockVerboseTxResult{
+		Tx: []btcjson.TxRawResult{{
+			Vin:  []btcjson.Vin{{Coinbase: "03a0bb0d"}},
+			Vout: []btcjson.Vout{{Value: 21, N: 0, ScriptPubKey: p2pkh}},
+		}},
+	}
+	qbtctestutil.SealBlock(t, &injected)
+
+	tests := []struct {
+		name   string
+		hash   string
+		modify func(block *btcjson.GetBlockVerboseTxResult)
+		errMsg string
+	}{
+		{
+			name:   "added transaction",
+			modify: func(block *btcjson.GetBlockVerboseTxResult) { block.Tx = append(block.Tx, injected.Tx[0]) },
+			errMsg: "merkle root mismatch",
+		},
+		{
+			name:   "header of another block",
+			hash:   "000000000000000000013c1b4c3ab27fb5d2b8cb7a4b5d57e1e6ba3b2fc00fee",
+			errMsg: "block hash mismatch",
+		},
+		{
+			name: "merkle root of another block",
+			modify: func(block *btcjson.GetBlockVerboseTxResult) {
+				block.MerkleRoot = injected.MerkleRoot
+				block.Tx = injected.Tx
+			},
+			errMsg: "block hash mismatch",
+		},
+		{
+			name:   "txid not of the raw transaction",
+			modify: func(block *btcjson.GetBlockVerboseTxResult) { block.Tx[0].Txid = injected.Tx[0].Txid },
+			errMsg: "raw transaction hashes to txid",
+		},
+		{
+			name:   "output amount not of the raw transaction",
+			modify: func(block *btcjson.GetBlockVerboseTxResult) { block.Tx[0].Vout[0].Value = 21 },
+			errMsg: "does not match the raw transaction amount",
+		},
+		{
+			name: "output script not of the raw transaction",
+			modify: func(block *btcjson.GetBlockVerboseTxResult) {
+				block.Tx[0].Vout[0].ScriptPubKey.Hex = "76a914000000000000000000000000000000000000000088ac"
+			},
+			errMsg: "script does not match the raw transaction",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			block := newBlock()
+			hash := block.Hash
+			if tc.hash != "" {
+				hash = tc.hash
+			}
+			if tc.modify != nil {
+				tc.modify(&block)
+			}
+			content, err := json.Marshal(block)
+			require.NoError(t, err)
+
+			f := initFixture(t)
+			_, err = reportBlock(t, f, 800000, hash, content)
+			require.ErrorContains(t, err, tc.errMsg)
+			last, err := f.keeper.GetLastProcessedBlock(f.ctx)
+			require.NoError(t, err)
+			require.Zero(t, last)
+
+			// the check can be turned off by param
+			require.NoError(t, f.keeper.ConstOverrides.Set(f.ctx, constants.BlockMerkleRootCheckDisabled.String(), int64(1)))
+			_, err = reportBlock(t, f, 800000, hash, content)
+			require.NoError(t, err)
+		})
+	}
 }
@@ -33,10 +33,72 @@ type Keeper struct {
 	ConstOverrides    collections.Map[string, int64]
 
 	LastProcessedBlock collections.Item[uint64]
+	// LastProcessedBlockHash is the hash of the block at LastProcessedBlock.
+	// It is unset until the first block is processed.
+	LastProcessedBlockHash collections.Item[string]
 
-	// ZK Verifying Key (stored as bytes in genesis, loaded at init)
-	// The VK is stored in genesis and registered with the zk package at InitGenesis
+	// ClaimedUTXOIndex indexes fully claimed UTXOs by the block height at which
+	// their entitlement reached zero, so they can be pruned after a retention window
+	ClaimedUTXOIndex collections.KeySet[collections.Pair[int64, string]]
+
+	// ClaimRecords records who a UTXO's entitlement was released to. Records are
+	// pruned together with the UTXO.
+	ClaimRecords collections.Map[string, types.ClaimRecord]
+
+	// ClaimMemoPrefixes is the set of memo prefixes that mark a claim
+	// transaction. It is unset until governance or genesis sets it.
+	ClaimMemoPrefixes collections.Item[types.ClaimMemoPrefixes]
+
+	// ClaimDenyList holds the account addresses that may not claim or receive
+	// claimed funds. It is empty unless governance or genesis fills it.
+	ClaimDenyList collections.KeySet[string]
+
+	// LastClaimHeight records the block height of the last claim to each
+	// recipient. It is only written while ClaimCooldownBlocks is enabled.
+	LastClaimHeight collections.Map[string, int64]
+
+	// PendingAttestations records the validators whose attestation of a block
+	// was verified by a report that did not reach the required power yet.
+	// Later reports of the same height and block content add to them. Entries
+	// are removed once a block at or above their height is processed.
+	PendingAttestations collections.KeySet[collections.Triple[uint64, []byte, string]]
+
+	// PrunedUTXOAmounts keeps the amount of each UTXO PruneClaimedUTXOs
+	// removed, keyed like Utxoes, so the fee of the transaction that spends
+	// it can still be derived. Processing that transaction removes it, so
+	// entries of claimed UTXOs that are never spent are never removed.
+	PrunedUTXOAmounts collections.Map[string, uint64]
+
+	// ClaimableUTXOIndex indexes the UTXOs that have an entitled amount left
+	// and a claimable script by their claim identifier, see
+	// types.ScriptPubKeyIdentifier, so queries find the UTXOs of an address
+	// without walking Utxoes. It is kept up to date by SetUTXO and RemoveUTXO.
+	ClaimableUTXOIndex collections.KeySet[collections.Pair[[]byte, string]]
+
+	// UTXOBackfillCursor is the key of the last UTXO the UTXO backfill
+	// visited, empty before the first. It is only set while a backfill runs,
+	// see BackfillUTXOs.
+	UTXOBackfillCursor collections.Item[string]
+
+	// UTXOStats summarizes Utxoes. It is kept up to date by SetUTXO and
+	// RemoveUTXO, which all writes to Utxoes go through, except for the
+	// genesis load with LoadUTXO, which counts a whole batch at once.
+	UTXOStats collections.Item[types.UTXOStats]
+
+	// ZkVerifyingKey is the default ZK verifying key, set at genesis and by
+	// MsgReplaceVerifyingKey. Claims are verified against the key in state,
+	// see ClaimVerifier.
 	ZkVerifyingKey collections.Item[[]byte]
+
+	// VersionVerifyingKeys holds the verifying keys of claim message versions
+	// that do not use ZkVerifyingKey, keyed by normalized message version.
+	// It lets governance add the key of a new version while claims of the
+	// older versions keep verifying.
+	VersionVerifyingKeys collections.Map[string, []byte]
+
+	// verifiers caches the verifiers built from ZkVerifyingKey and
+	// VersionVerifyingKeys by key hash
+	verifiers *verifierCache
 }
 
 func NewKeeper(
@@ -50,18 +112,31 @@ func NewKeeper(
 ) *Keeper {
 	sb := collections.NewSchemaBuilder(storeService)
 	k := &Keeper{
-		storeService:       storeService,
-		cdc:                cdc,
-		addressCodec:       addressCodec,
-		stakingKeeper:      stakingKeeper,
-		bankKeeper:         bankKeeper,
-		authority:          authority,
-		authKeeper:         authKeeper,
-		Utxoes:             collections.NewMap(sb, types.UTXOKeys, "utxoes", collections.StringKey, codec.CollValue[types.UTXO](cdc)),
-		NodePeerAddresses:  collections.NewMap(sb, types.NodePeerAddressKeys, "node_peer_addresses", collections.StringKey, collections.StringValue),
-		ConstOverrides:     collections.NewMap(sb, types.ConstOverrideKeys, "const_overrides", collections.StringKey, collections.Int64Value),
-		ZkVerifyingKey:     collections.NewItem(sb, types.ZkVerifyingKeyKey, "zk_verifying_key", collections.BytesValue),
-		LastProcessedBlock: collections.NewItem(sb, types.LastProcessedBlockKey, "last_processed_block", collections.Uint64Value),
+		storeService:           storeService,
+		cdc:                    cdc,
+		addressCodec:           addressCodec,
+		stakingKeeper:          stakingKeeper,
+		bankKeeper:             bankKeeper,
+		authority:              authority,
+		authKeeper:             authKeeper,
+		Utxoes:                 collections.NewMap(sb, types.UTXOKeys, "utxoes", collections.StringKey, codec.CollValue[types.UTXO](cdc)),
+		NodePeerAddresses:      collections.NewMap(sb, types.NodePeerAddressKeys, "node_peer_addresses", collections.StringKey, collections.StringValue),
+		ConstOverrides:         collections.NewMap(sb, types.ConstOverrideKeys, "const_overrides", collections.StringKey, collections.Int64Value),
+		ZkVerifyingKey:         collections.NewItem(sb, types.ZkVerifyingKeyKey, "zk_verifying_key", collections.BytesValue),
+		LastProcessedBlock:     collections.NewItem(sb, types.LastProcessedBlockKey, "last_processed_block", collections.Uint64Value),
+		ClaimedUTXOIndex:       collections.NewKeySet(sb, types.ClaimedUTXOIndexKeys, "claimed_utxo_index", collections.PairKeyCodec(collections.Int64Key, collections.StringKey)),
+		ClaimRecords:           collections.NewMap(sb, types.ClaimRecordKeys, "claim_records", collections.StringKey, codec.CollValue[types.ClaimRecord](cdc)),
+		LastProcessedBlockHash: collections.NewItem(sb, types.ProcessedBlockHashKey, "last_processed_block_hash", collections.StringValue),
+		ClaimMemoPrefixes:      collections.NewItem(sb, types.ClaimMemoPrefixesKey, "claim_memo_prefixes", codec.CollValue[types.ClaimMemoPrefixes](cdc)),
+		ClaimDenyList:          collections.NewKeySet(sb, types.ClaimDenyListKeys, "claim_deny_list", collections.StringKey),
+		LastClaimHeight:        collections.NewMap(sb, types.LastClaimHeightKeys, "last_claim_height", collections.StringKey, collections.Int64Value),
+		PendingAttestations:    collections.NewKeySet(sb, types.PendingAttestationKeys, "pending_attestations", collections.TripleKeyCodec(collections.Uint64Key, collections.BytesKey, collections.StringKey)),
+		VersionVerifyingKeys:   collections.NewMap(sb, types.VersionVerifyingKeyKeys, "version_verifying_keys", collections.StringKey, collections.BytesValue),
+		PrunedUTXOAmounts:      collections.NewMap(sb, types.PrunedUTXOAmountKeys, "pruned_utxo_amounts", collections.StringKey, collections.Uint64Value),
+		UTXOStats:              collections.NewItem(sb, types.UTXOStatsKey, "utxo_stats", codec.CollValue[types.UTXOStats](cdc)),
+		ClaimableUTXOIndex:     collections.NewKeySet(sb, types.ClaimableUTXOIndexKeys, "claimable_utxo_index", collections.PairKeyCodec(collections.BytesKey, collections.StringKey)),
+		UTXOBackfillCursor:     collections.NewItem(sb, types.UTXOBackfillCursorKey, "utxo_backfill_cursor", collections.StringValue),
+		verifiers:              newVerifierCache(),
 	}
 	schema, err := sb.Build()
 	if err != nil {
@@ -95,6 +170,57 @@ func (k Keeper) GetConfig(ctx sdk.Context, constName constants.ConstantName) int
 	return v
 }
 
+// GetClaimMemoPrefixes returns the memo prefixes that mark a claim
+// transaction, falling back to the default prefix when none are set.
+func (k Keeper) GetClaimMemoPrefixes(ctx context.Context) []string {
+	v, err := k.ClaimMemoPrefixes.Get(ctx)
+	if err != nil {
+		if !errors.Is(err, collections.ErrNotFound) {
+			sdk.UnwrapSDKContext(ctx).Logger().Error("failed to get claim memo prefixes", "error", err)
+		}
+		return types.DefaultClaimMemoPrefixes()
+	}
+	if len(v.Prefixes) == 0 {
+		return types.DefaultClaimMemoPrefixes()
+	}
+	return v.Prefixes
+}
+
+// GetClaimDenyList returns the addresses on the claim deny list.
+func (k Keeper) GetClaimDenyList(ctx context.Context) ([]string, error) {
+	iter, err := k.ClaimDenyList.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+	return iter.Keys()
+}
+
+// checkClaimDenyList rejects the claim when any of the addresses is on the
+// claim deny list. Entries are stored in canonical bech32 form.
+func (k Keeper) checkClaimDenyList(ctx context.Context, addresses ...sdk.AccAddress) error {
+	for _, address := range addresses {
+		denied, err := k.ClaimDenyList.Has(ctx, address.String())
+		if err != nil {
+			return err
+		}
+		if denied {
+			return types.ErrClaimDenied.Wrapf("%s may not claim", address)
+		}
+	}
+	return nil
+}
+
+// GetLastProcessedBlockHash returns the hash of the last processed block, or an
+// empty string if none has been recorded yet.
+func (k Keeper) GetLastProcessedBlockHash(ctx context.Context) (string, error) {
+	v, err := k.LastProcessedBlockHash.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return "", nil
+	}
+	return v, err
+}
+
 func (k Keeper) GetLastProcessedBlock(ctx context.Context) (uint64, error) {
 	v, err := k.LastProcessedBlock.Get(ctx)
 	// if the last processed block is not found, return 0
@@ -3,46 +3,115 @@ package keeper
 import (
 	"context"
 
+	"github.com/btcq-org/qbtc/constants"
 	"github.com/btcq-org/qbtc/x/qbtc/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // ClaimUTXO claims a UTXO.
 // It mints the coins to the recipient (or reserve module account if recipient is nil) and resets the entitled amount to 0.
+// Claims to a recipient are recorded in ClaimRecords.
 func (k Keeper) ClaimUTXO(ctx context.Context, txid string, vout uint32, recipient sdk.AccAddress) error {
-	key := getUTXOKey(txid, vout)
-	utxo, err := k.Utxoes.Get(ctx, key)
+	amount, err := k.releaseUTXO(ctx, getUTXOKey(txid, vout), recipient)
 	if err != nil {
 		return err
 	}
-	if utxo.EntitledAmount == 0 {
-		return nil
-	}
+	return k.mintClaimed(ctx, amount, recipient)
+}
 
-	// denom is set in app/config.go
-	coin := sdk.NewInt64Coin(sdk.DefaultBondDenom, int64(utxo.EntitledAmount))
-	coins := sdk.NewCoins(coin)
+// releaseUTXO resets the entitled amount of the UTXO stored under key to 0
+// and returns the amount it had, without minting it. Claims to a recipient
+// are recorded in ClaimRecords. A UTXO that is already claimed releases 0.
+// Callers must mint the released amount with mintClaimed.
+func (k Keeper) releaseUTXO(ctx context.Context, key string, recipient sdk.AccAddress) (uint64, error) {
+	utxo, err := k.Utxoes.Get(ctx, key)
+	if err != nil {
+		return 0, err
+	}
+	amount := utxo.EntitledAmount
+	if amount == 0 {
+		return 0, nil
+	}
 
-	if recipient == nil {
-		// mint the coins to the reserve module account
-		if err := k.bankKeeper.MintCoins(ctx, types.ReserveModuleName, coins); err != nil {
-			return err
-		}
-	} else {
-		// mint the coins to the module account then send to recipient
-		// Using qbtc module account for minting
-		if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
-			return err
+	if recipient != nil {
+		record := types.ClaimRecord{
+			Claimer: recipient.String(),
+			Amount:  amount,
+			Height:  sdk.UnwrapSDKContext(ctx).BlockHeight(),
 		}
-		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, coins); err != nil {
-			return err
+		if err := k.ClaimRecords.Set(ctx, key, record); err != nil {
+			return 0, err
 		}
 	}
 
 	// reset the entitled amount to 0
 	utxo.EntitledAmount = 0
-	if err := k.Utxoes.Set(ctx, key, utxo); err != nil {
+	if err := k.SetUTXO(ctx, utxo); err != nil {
+		return 0, err
+	}
+	if err := k.markUTXOClaimed(ctx, key); err != nil {
+		return 0, err
+	}
+	return amount, nil
+}
+
+// mintClaimed mints a released amount to the recipient, or to the reserve
+// module account if recipient is nil.
+func (k Keeper) mintClaimed(ctx context.Context, amount uint64, recipient sdk.AccAddress) error {
+	if amount == 0 {
+		return nil
+	}
+	// denom is set in app/config.go
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, int64(amount)))
+
+	if recipient == nil {
+		// mint the coins to the reserve module account
+		return k.bankKeeper.MintCoins(ctx, types.ReserveModuleName, coins)
+	}
+	// mint the coins to the module account then send to recipient
+	// Using qbtc module account for minting
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
 		return err
 	}
-	return nil
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, coins)
+}
+
+// isClaimedBy reports whether the UTXO stored under key was claimed by claimer.
+func (k Keeper) isClaimedBy(ctx context.Context, key string, claimer sdk.AccAddress) bool {
+	record, err := k.ClaimRecords.Get(ctx, key)
+	if err != nil {
+		return false
+	}
+	return record.Claimer == claimer.String()
+}
+
+// coinbaseMaturity decides whether coinbase UTXOs have enough confirmations
+// to be claimed, at the last processed Bitcoin block.
+type coinbaseMaturity struct {
+	tip      uint64
+	maturity uint64
+}
+
+// getCoinbaseMaturity reads the last processed block and the
+// CoinbaseMaturity param once, for checking several UTXOs.
+func (k Keeper) getCoinbaseMaturity(ctx context.Context) (coinbaseMaturity, error) {
+	tip, err := k.GetLastProcessedBlock(ctx)
+	if err != nil {
+		return coinbaseMaturity{}, err
+	}
+	maturity := k.GetConfig(sdk.UnwrapSDKContext(ctx), constants.CoinbaseMaturity)
+	return coinbaseMaturity{tip: tip, maturity: uint64(max(maturity, 0))}, nil
+}
+
+// immature reports whether utxo is a coinbase output with fewer than the
+// required confirmations. Bitcoin does not let such outputs be spent yet and
+// a reorg can still orphan them, so they are not claimable.
+func (m coinbaseMaturity) immature(utxo types.UTXO) bool {
+	if !utxo.Coinbase || m.maturity == 0 {
+		return false
+	}
+	if m.tip < utxo.CreatedAtHeight {
+		return true
+	}
+	return m.tip-utxo.CreatedAtHeight+1 < m.maturity
 }
@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"github.com/btcq-org/qbtc/constants"
+	"github.com/btcq-org/qbtc/x/qbtc/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// maxPrunedUTXOsPerBlock bounds the amount of pruning work done in a single EndBlock.
+// Anything left over is picked up in the following blocks.
+const maxPrunedUTXOsPerBlock = 1000
+
+// markUTXOClaimed records that the UTXO stored under key has no entitlement left,
+// making it eligible for pruning once the retention window has passed. A UTXO
+// the running UTXO backfill has not reached yet is indexed when it gets there.
+func (k Keeper) markUTXOClaimed(ctx context.Context, key string) error {
+	if backfilled, err := k.utxoBackfilled(ctx, key); err != nil || !backfilled {
+		return err
+	}
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	return k.ClaimedUTXOIndex.Set(ctx, collections.Join(height, key))
+}
+
+// PruneClaimedUTXOs removes fully claimed UTXOs that were claimed more than
+// ClaimedUTXORetentionBlocks blocks ago. Only the amount of a pruned UTXO is
+// kept, in PrunedUTXOAmounts, which is all block processing needs of an
+// input without entitlement, so removing them does not change claim
+// semantics or the fees deducted from coinbase outputs. Pruning is skipped
+// when ClaimedUTXOPruningDisabled is set.
+//
+// Pruning shrinks the state of a claimed UTXO rather than removing it: the
+// amount stays until the UTXO is spent on Bitcoin, so a claimed output that
+// is never spent keeps its PrunedUTXOAmounts entry for good. The store still
+// grows with the number of claimed-but-unspent UTXOs, only by a key and 8
+// bytes each instead of the full UTXO.
+func (k Keeper) PruneClaimedUTXOs(ctx sdk.Context) error {
+	if k.GetConfig(ctx, constants.ClaimedUTXOPruningDisabled) > 0 {
+		return nil
+	}
+	retention := k.GetConfig(ctx, constants.ClaimedUTXORetentionBlocks)
+	if retention < 0 {
+		return nil
+	}
+	cutoff := ctx.BlockHeight() - retention
+	if cutoff <= 0 {
+		return nil
+	}
+
+	// prune atomically, a failure leaves the store untouched
+	cacheCtx, write := ctx.CacheContext()
+
+	var toPrune []collections.Pair[int64, string]
+	err := k.ClaimedUTXOIndex.Walk(cacheCtx, collections.NewPrefixUntilPairRange[int64, string](cutoff), func(key collections.Pair[int64, string]) (bool, error) {
+		toPrune = append(toPrune, key)
+		return len(toPrune) >= maxPrunedUTXOsPerBlock, nil
+	})
+	if err != nil {
+		return fmt.Errorf("fail to walk claimed UTXO index: %w", err)
+	}
+
+	pruned := 0
+	for _, key := range toPrune {
+		utxoKey := key.K2()
+		utxo, err := k.Utxoes.Get(cacheCtx, utxoKey)
+		switch {
+		case err == nil && utxo.EntitledAmount == 0:
+			if err := k.RemoveUTXO(cacheCtx, utxoKey); err != nil {
+				return fmt.Errorf("fail to remove claimed UTXO %s: %w", utxoKey, err)
+			}
+			if err := k.PrunedUTXOAmounts.Set(cacheCtx, utxoKey, utxo.Amount); err != nil {
+				return fmt.Errorf("fail to keep the amount of claimed UTXO %s: %w", utxoKey, err)
+			}
+			pruned++
+		case err != nil && !errors.Is(err, collections.ErrNotFound):
+			return fmt.Errorf("fail to get claimed UTXO %s: %w", utxoKey, err)
+		}
+		// UTXOs that were spent on Bitcoin in the meantime are already gone;
+		// only the index entry needs to be cleaned up
+		if err := k.ClaimedUTXOIndex.Remove(cacheCtx, key); err != nil {
+			return fmt.Errorf("fail to remove claimed UTXO index %s: %w", utxoKey, err)
+		}
+		// the claim record is only needed to recognize repeated claims shortly
+		// after the claim, it goes with the index entry in either case
+		if err := k.ClaimRecords.Remove(cacheCtx, utxoKey); err != nil {
+			return fmt.Errorf("fail to remove claim record %s: %w", utxoKey, err)
+		}
+	}
+
+	write()
+
+	if pruned > 0 {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypePruneClaimedUTXOs,
+				sdk.NewAttribute(types.AttributeKeyUTXOCount, fmt.Sprintf("%d", pruned)),
+			),
+		)
+		ctx.Logger().Info("pruned claimed UTXOs", "count", pruned, "cutoff_height", cutoff)
+	}
+	return nil
+}
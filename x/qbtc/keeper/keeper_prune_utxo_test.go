@@ -0,0 +1,72 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	"github.com/btcq-org/qbtc/constants"
+	"github.com/btcq-org/qbtc/x/qbtc/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPruneClaimedUTXOs(t *testing.T) {
+	f := initFixture(t)
+	retention := constants.DefaultValues[constants.ClaimedUTXORetentionBlocks]
+
+	claimed := types.UTXO{Txid: "aa", Vout: 0, Amount: 100, EntitledAmount: 0}
+	unclaimed := types.UTXO{Txid: "bb", Vout: 0, Amount: 100, EntitledAmount: 100}
+	recent := types.UTXO{Txid: "cc", Vout: 1, Amount: 100, EntitledAmount: 0}
+
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	for _, utxo := range []types.UTXO{claimed, unclaimed, recent} {
+		require.NoError(t, f.keeper.Utxoes.Set(ctx, utxo.GetKey(), utxo))
+	}
+	require.NoError(t, f.keeper.ClaimedUTXOIndex.Set(ctx, collections.Join(int64(10), claimed.GetKey())))
+	require.NoError(t, f.keeper.ClaimedUTXOIndex.Set(ctx, collections.Join(int64(20), recent.GetKey())))
+	// index entry for a UTXO that has since been spent on Bitcoin
+	require.NoError(t, f.keeper.ClaimedUTXOIndex.Set(ctx, collections.Join(int64(10), "dd-0")))
+
+	// nothing is old enough yet
+	ctx = ctx.WithBlockHeight(10 + retention - 1)
+	require.NoError(t, f.keeper.PruneClaimedUTXOs(ctx))
+	has, err := f.keeper.Utxoes.Has(ctx, claimed.GetKey())
+	require.NoError(t, err)
+	require.True(t, has)
+
+	// pruning disabled keeps everything
+	require.NoError(t, f.keeper.ConstOverrides.Set(ctx, constants.ClaimedUTXOPruningDisabled.String(), 1))
+	ctx = ctx.WithBlockHeight(10 + retention)
+	require.NoError(t, f.keeper.PruneClaimedUTXOs(ctx))
+	has, err = f.keeper.Utxoes.Has(ctx, claimed.GetKey())
+	require.NoError(t, err)
+	require.True(t, has)
+
+	// once enabled, only the UTXO claimed past the retention window is pruned
+	require.NoError(t, f.keeper.ConstOverrides.Set(ctx, constants.ClaimedUTXOPruningDisabled.String(), 0))
+	require.NoError(t, f.keeper.PruneClaimedUTXOs(ctx))
+
+	has, err = f.keeper.Utxoes.Has(ctx, claimed.GetKey())
+	require.NoError(t, err)
+	require.False(t, has)
+	// its amount is kept for the transaction that spends it
+	amount, err := f.keeper.PrunedUTXOAmounts.Get(ctx, claimed.GetKey())
+	require.NoError(t, err)
+	require.Equal(t, claimed.Amount, amount)
+	has, err = f.keeper.Utxoes.Has(ctx, unclaimed.GetKey())
+	require.NoError(t, err)
+	require.True(t, has)
+	has, err = f.keeper.Utxoes.Has(ctx, recent.GetKey())
+	require.NoError(t, err)
+	require.True(t, has)
+
+	has, err = f.keeper.ClaimedUTXOIndex.Has(ctx, collections.Join(int64(10), "dd-0"))
+	require.NoError(t, err)
+	require.False(t, has)
+	has, err = f.keeper.PrunedUTXOAmounts.Has(ctx, "dd-0")
+	require.NoError(t, err)
+	require.False(t, has)
+	has, err = f.keeper.ClaimedUTXOIndex.Has(ctx, collections.Join(int64(20), recent.GetKey()))
+	require.NoError(t, err)
+	require.True(t, has)
+}
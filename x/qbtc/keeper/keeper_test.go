@@ -32,12 +32,21 @@ type fixture struct {
 	validatorAddressCodec address.Codec
 	validator             stakingtypes.Validator
 	privateKey            mldsa.PrivKey
+	validators            []stakingtypes.Validator
+	privateKeys           []mldsa.PrivKey
 	stakingKeeper         *qbtctestutil.MockStakingKeeper
 	bankKeeper            *qbtctestutil.MockBankKeeper
 	authKeeper            *qbtctestutil.MockAuthKeeper
 }
 
 func initFixture(t *testing.T) *fixture {
+	t.Helper()
+	return initFixtureWithValidators(t, 1)
+}
+
+// initFixtureWithValidators creates a fixture whose bonded validator set has n
+// validators of equal power. validator and privateKey are the first of them.
+func initFixtureWithValidators(t *testing.T, n int) *fixture {
 	t.Helper()
 	sdk.GetConfig().SetBech32PrefixForAccount(common.AccountAddressPrefix, common.AccountAddressPrefix+sdk.PrefixPublic)
 	sdk.GetConfig().SetBech32PrefixForValidator(common.AccountAddressPrefix+sdk.PrefixValidator, common.AccountAddressPrefix+sdk.PrefixPublic)
@@ -51,19 +60,23 @@ func initFixture(t *testing.T) *fixture {
 	ctrl := gomock.NewController(t)
 	stakingKeeper := qbtctestutil.NewMockStakingKeeper(ctrl)
 
-	privateKey := mldsa.GenPrivKey()
-	pubKey := privateKey.PubKey()
-	pKey, err := codec.FromCmtPubKeyInterface(pubKey)
-	assert.NoError(t, err)
-	validator, err := stakingtypes.NewValidator("", pKey, stakingtypes.Description{})
-	assert.NoError(t, err)
-	validator.Status = stakingtypes.Bonded
-	validator.Tokens = math.NewInt(1000000000)
+	validators := make([]stakingtypes.Validator, n)
+	privateKeys := make([]mldsa.PrivKey, n)
+	for i := range validators {
+		privateKeys[i] = mldsa.GenPrivKey()
+		pKey, err := codec.FromCmtPubKeyInterface(privateKeys[i].PubKey())
+		assert.NoError(t, err)
+		validators[i], err = stakingtypes.NewValidator("", pKey, stakingtypes.Description{})
+		assert.NoError(t, err)
+		validators[i].Status = stakingtypes.Bonded
+		validators[i].Tokens = math.NewInt(1000000000)
+	}
+	validator, privateKey := validators[0], privateKeys[0]
 
-	stakingKeeper.EXPECT().GetLastTotalPower(gomock.Any()).AnyTimes().Return(math.NewInt(1000000), nil)
+	stakingKeeper.EXPECT().GetLastTotalPower(gomock.Any()).AnyTimes().Return(math.NewInt(int64(n)*1000000), nil)
 	stakingKeeper.EXPECT().GetValidator(gomock.Any(), gomock.Any()).AnyTimes().Return(validator, nil)
 	stakingKeeper.EXPECT().PowerReduction(gomock.Any()).AnyTimes().Return(math.NewInt(1000))
-	stakingKeeper.EXPECT().GetBondedValidatorsByPower(gomock.Any()).AnyTimes().Return([]stakingtypes.Validator{validator}, nil)
+	stakingKeeper.EXPECT().GetBondedValidatorsByPower(gomock.Any()).AnyTimes().Return(validators, nil)
 
 	bankKeeper := qbtctestutil.NewMockBankKeeper(ctrl)
 	authKeeper := qbtctestutil.NewMockAuthKeeper(ctrl)
@@ -83,6 +96,8 @@ func initFixture(t *testing.T) *fixture {
 		addressCodec:          addressCodec,
 		validator:             validator,
 		privateKey:            privateKey,
+		validators:            validators,
+		privateKeys:           privateKeys,
 		validatorAddressCodec: validatorAddressCodec,
 		stakingKeeper:         stakingKeeper,
 		bankKeeper:            bankKeeper,
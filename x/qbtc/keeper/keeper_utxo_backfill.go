@@ -0,0 +1,122 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"github.com/btcq-org/qbtc/constants"
+	"github.com/btcq-org/qbtc/x/qbtc/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// startUTXOBackfill makes BackfillUTXOs visit every stored UTXO again, from
+// the first key on. Store migrations start it instead of walking the UTXO
+// set themselves, which is far too large to walk in the upgrade block.
+// UTXOStats is counted anew by the backfill, so it starts out empty.
+func (k Keeper) startUTXOBackfill(ctx context.Context) error {
+	if err := k.UTXOStats.Set(ctx, types.UTXOStats{}); err != nil {
+		return err
+	}
+	return k.UTXOBackfillCursor.Set(ctx, "")
+}
+
+// utxoBackfilled reports whether the UTXO stored under key has been visited by
+// the running UTXO backfill, which is true of every UTXO when none runs. The
+// state the backfill derives from a UTXO is left to it until it got there,
+// so a write to a UTXO it has not reached is not accounted for twice.
+func (k Keeper) utxoBackfilled(ctx context.Context, key string) (bool, error) {
+	cursor, err := k.UTXOBackfillCursor.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return key <= cursor, nil
+}
+
+// BackfillUTXOs runs the next batch of the UTXO backfill a store migration
+// started, visiting up to UTXOBackfillBatchSize UTXOs in key order. Each
+// visited UTXO is added to UTXOStats and, if it can still be claimed, to
+// ClaimableUTXOIndex. One that is fully claimed is indexed in
+// ClaimedUTXOIndex at the current height. The backfill ends once
+// the last UTXO is visited; it does nothing when none runs.
+func (k Keeper) BackfillUTXOs(ctx sdk.Context) error {
+	cursor, err := k.UTXOBackfillCursor.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("fail to get UTXO backfill cursor: %w", err)
+	}
+	batchSize := k.GetConfig(ctx, constants.UTXOBackfillBatchSize)
+	if batchSize <= 0 {
+		return nil
+	}
+
+	// backfill atomically, a failure leaves the store untouched
+	cacheCtx, write := ctx.CacheContext()
+
+	var ranger collections.Ranger[string]
+	if cursor != "" {
+		ranger = new(collections.Range[string]).StartExclusive(cursor)
+	}
+	var batch []collections.KeyValue[string, types.UTXO]
+	err = k.Utxoes.Walk(cacheCtx, ranger, func(key string, utxo types.UTXO) (bool, error) {
+		batch = append(batch, collections.KeyValue[string, types.UTXO]{Key: key, Value: utxo})
+		return int64(len(batch)) >= batchSize, nil
+	})
+	if err != nil {
+		return fmt.Errorf("fail to walk UTXOs: %w", err)
+	}
+
+	stats, err := k.GetUTXOStats(cacheCtx)
+	if err != nil {
+		return fmt.Errorf("fail to get UTXO stats: %w", err)
+	}
+	for _, kv := range batch {
+		stats.Count++
+		stats.UnclaimedAmount += kv.Value.EntitledAmount
+		if indexKey, ok := claimableIndexKey(kv.Value); ok {
+			if err := k.ClaimableUTXOIndex.Set(cacheCtx, indexKey); err != nil {
+				return fmt.Errorf("fail to index claimable UTXO %s: %w", kv.Key, err)
+			}
+		}
+		if kv.Value.EntitledAmount == 0 {
+			if err := k.ClaimedUTXOIndex.Set(cacheCtx, collections.Join(ctx.BlockHeight(), kv.Key)); err != nil {
+				return fmt.Errorf("fail to index claimed UTXO %s: %w", kv.Key, err)
+			}
+		}
+	}
+
+	if err := k.UTXOStats.Set(cacheCtx, stats); err != nil {
+		return fmt.Errorf("fail to set UTXO stats: %w", err)
+	}
+
+	if int64(len(batch)) < batchSize {
+		if err := k.UTXOBackfillCursor.Remove(cacheCtx); err != nil {
+			return fmt.Errorf("fail to remove UTXO backfill cursor: %w", err)
+		}
+		ctx.Logger().Info("UTXO backfill done", "count", stats.Count, "unclaimed_amount", stats.UnclaimedAmount)
+	} else if err := k.UTXOBackfillCursor.Set(cacheCtx, batch[len(batch)-1].Key); err != nil {
+		return fmt.Errorf("fail to set UTXO backfill cursor: %w", err)
+	}
+
+	write()
+	return nil
+}
+
+// checkClaimableUTXOIndex returns ErrUTXOBackfillRunning while a UTXO backfill
+// runs, as ClaimableUTXOIndex misses the UTXOs it has not reached yet.
+func (k Keeper) checkClaimableUTXOIndex(ctx context.Context) error {
+	running, err := k.UTXOBackfillCursor.Has(ctx)
+	if err != nil {
+		return err
+	}
+	if running {
+		return types.ErrUTXOBackfillRunning.Wrap("UTXOs are still being indexed after an upgrade, retry later")
+	}
+	return nil
+}
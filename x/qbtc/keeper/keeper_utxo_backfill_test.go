@@ -0,0 +1,92 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	"github.com/btcq-org/qbtc/constants"
+	"github.com/btcq-org/qbtc/x/qbtc/keeper"
+	"github.com/btcq-org/qbtc/x/qbtc/types"
+	"github.com/btcq-org/qbtc/x/qbtc/zk"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+// runUTXOBackfill runs the UTXO backfill until it is done.
+func runUTXOBackfill(t *testing.T, f *fixture, ctx sdk.Context) {
+	t.Helper()
+	for {
+		running, err := f.keeper.UTXOBackfillCursor.Has(ctx)
+		require.NoError(t, err)
+		if !running {
+			return
+		}
+		require.NoError(t, f.keeper.BackfillUTXOs(ctx))
+	}
+}
+
+func TestBackfillUTXOs(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(500)
+	require.NoError(t, f.keeper.ConstOverrides.Set(ctx, constants.UTXOBackfillBatchSize.String(), 2))
+
+	// nothing happens while no backfill runs
+	claimed := types.UTXO{Txid: "aa", Vout: 0, Amount: 100}
+	require.NoError(t, f.keeper.Utxoes.Set(ctx, claimed.GetKey(), claimed))
+	require.NoError(t, f.keeper.BackfillUTXOs(ctx))
+	has, err := f.keeper.ClaimedUTXOIndex.Has(ctx, collections.Join(int64(500), claimed.GetKey()))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	hash := [20]byte{1}
+	address, err := zk.Hash160ToP2PKHAddress(hash)
+	require.NoError(t, err)
+	for _, utxo := range []types.UTXO{
+		{Txid: "bb", Vout: 0, Amount: 100, EntitledAmount: 100},
+		{Txid: "cc", Vout: 0, Amount: 100, EntitledAmount: 100, ScriptPubKey: &types.ScriptPubKeyResult{Address: address}},
+		{Txid: "dd", Vout: 0, Amount: 100, EntitledAmount: 100},
+	} {
+		require.NoError(t, f.keeper.Utxoes.Set(ctx, utxo.GetKey(), utxo))
+	}
+	m := keeper.NewMigrator(f.keeper)
+	require.NoError(t, m.Migrate1to2(ctx))
+
+	// one batch per block
+	require.NoError(t, f.keeper.BackfillUTXOs(ctx))
+	cursor, err := f.keeper.UTXOBackfillCursor.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "bb-0", cursor)
+	has, err = f.keeper.ClaimedUTXOIndex.Has(ctx, collections.Join(int64(500), claimed.GetKey()))
+	require.NoError(t, err)
+	require.True(t, has)
+
+	// a claim of a UTXO the backfill has not reached is indexed when it
+	// gets there, and one it has passed right away
+	f.bankKeeper.EXPECT().MintCoins(gomock.Any(), types.ReserveModuleName, gomock.Any()).Return(nil).Times(2)
+	require.NoError(t, f.keeper.ClaimUTXO(ctx.WithBlockHeight(501), "bb", 0, nil))
+	require.NoError(t, f.keeper.ClaimUTXO(ctx.WithBlockHeight(501), "dd", 0, nil))
+	has, err = f.keeper.ClaimedUTXOIndex.Has(ctx, collections.Join(int64(501), "bb-0"))
+	require.NoError(t, err)
+	require.True(t, has)
+	has, err = f.keeper.ClaimedUTXOIndex.Has(ctx, collections.Join(int64(501), "dd-0"))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	runUTXOBackfill(t, f, ctx.WithBlockHeight(502))
+	has, err = f.keeper.ClaimedUTXOIndex.Has(ctx, collections.Join(int64(502), "dd-0"))
+	require.NoError(t, err)
+	require.True(t, has)
+	has, err = f.keeper.ClaimedUTXOIndex.Has(ctx, collections.Join(int64(502), "cc-0"))
+	require.NoError(t, err)
+	require.False(t, has)
+	has, err = f.keeper.ClaimableUTXOIndex.Has(ctx, collections.Join(hash[:], "cc-0"))
+	require.NoError(t, err)
+	require.True(t, has)
+
+	// the claims are counted once in the stats, whichever side of the
+	// backfill they were on
+	stats, err := f.keeper.GetUTXOStats(ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.UTXOStats{Count: 4, UnclaimedAmount: 100}, stats)
+}
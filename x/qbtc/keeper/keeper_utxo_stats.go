@@ -0,0 +1,175 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"github.com/btcq-org/qbtc/x/qbtc/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetUTXO stores utxo under its key, updates its entry in ClaimableUTXOIndex
+// and updates UTXOStats with the difference to the UTXO it replaces, if any.
+// Every write to Utxoes goes through SetUTXO or RemoveUTXO, or LoadUTXO at
+// genesis, so the index and UTXOStats always match the stored set, except for
+// the UTXOs a running UTXO backfill has not reached yet: those are indexed and
+// counted by the backfill.
+func (k Keeper) SetUTXO(ctx context.Context, utxo types.UTXO) error {
+	key := utxo.GetKey()
+	existing, err := k.Utxoes.Get(ctx, key)
+	replaced := err == nil
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	if err := k.Utxoes.Set(ctx, key, utxo); err != nil {
+		return err
+	}
+	if replaced {
+		if indexKey, ok := claimableIndexKey(existing); ok {
+			if err := k.ClaimableUTXOIndex.Remove(ctx, indexKey); err != nil {
+				return err
+			}
+		}
+	}
+	if indexKey, ok := claimableIndexKey(utxo); ok {
+		if err := k.ClaimableUTXOIndex.Set(ctx, indexKey); err != nil {
+			return err
+		}
+	}
+
+	if backfilled, err := k.utxoBackfilled(ctx, key); err != nil || !backfilled {
+		return err
+	}
+	stats, err := k.GetUTXOStats(ctx)
+	if err != nil {
+		return err
+	}
+	if replaced {
+		subtractUTXOStats(&stats, existing)
+	}
+	stats.Count++
+	stats.UnclaimedAmount += utxo.EntitledAmount
+	return k.UTXOStats.Set(ctx, stats)
+}
+
+// LoadUTXO stores a UTXO of the initial UTXO set loaded at genesis together
+// with its ClaimableUTXOIndex entry, and adds it to stats. Unlike SetUTXO it
+// neither reads the UTXO it replaces nor writes UTXOStats, which would add
+// three store operations to each of the millions of initial UTXOs: utxo must
+// not be stored yet, and the caller sets UTXOStats to stats once the whole
+// batch is loaded.
+func (k Keeper) LoadUTXO(ctx context.Context, utxo types.UTXO, stats *types.UTXOStats) error {
+	if err := k.Utxoes.Set(ctx, utxo.GetKey(), utxo); err != nil {
+		return err
+	}
+	if indexKey, ok := claimableIndexKey(utxo); ok {
+		if err := k.ClaimableUTXOIndex.Set(ctx, indexKey); err != nil {
+			return err
+		}
+	}
+	stats.Count++
+	stats.UnclaimedAmount += utxo.EntitledAmount
+	return nil
+}
+
+// RemoveUTXO removes the UTXO stored under key and its entry in
+// ClaimableUTXOIndex, and subtracts it from UTXOStats unless a running UTXO
+// backfill has not counted it yet. Removing a missing UTXO does nothing.
+func (k Keeper) RemoveUTXO(ctx context.Context, key string) error {
+	existing, err := k.Utxoes.Get(ctx, key)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if err := k.Utxoes.Remove(ctx, key); err != nil {
+		return err
+	}
+	if indexKey, ok := claimableIndexKey(existing); ok {
+		if err := k.ClaimableUTXOIndex.Remove(ctx, indexKey); err != nil {
+			return err
+		}
+	}
+
+	if backfilled, err := k.utxoBackfilled(ctx, key); err != nil || !backfilled {
+		return err
+	}
+	stats, err := k.GetUTXOStats(ctx)
+	if err != nil {
+		return err
+	}
+	subtractUTXOStats(&stats, existing)
+	return k.UTXOStats.Set(ctx, stats)
+}
+
+// claimableIndexKey returns the ClaimableUTXOIndex entry of utxo, or false if
+// it has no entitled amount left or no claimable script.
+func claimableIndexKey(utxo types.UTXO) (collections.Pair[[]byte, string], bool) {
+	if utxo.EntitledAmount == 0 || utxo.ScriptPubKey == nil {
+		return collections.Pair[[]byte, string]{}, false
+	}
+	_, identifier, err := types.ScriptPubKeyIdentifier(utxo.ScriptPubKey)
+	if err != nil {
+		return collections.Pair[[]byte, string]{}, false
+	}
+	return collections.Join(identifier, utxo.GetKey()), true
+}
+
+// walkClaimableUTXOs calls fn with each mature UTXO in ClaimableUTXOIndex
+// under identifier, until fn returns true. It fails with
+// ErrUTXOBackfillRunning while the index is still being backfilled.
+func (k Keeper) walkClaimableUTXOs(ctx context.Context, identifier []byte, fn func(utxo types.UTXO) bool) error {
+	if err := k.checkClaimableUTXOIndex(ctx); err != nil {
+		return err
+	}
+	maturity, err := k.getCoinbaseMaturity(ctx)
+	if err != nil {
+		return err
+	}
+	ranger := collections.NewPrefixedPairRange[[]byte, string](identifier)
+	return k.ClaimableUTXOIndex.Walk(ctx, ranger, func(indexKey collections.Pair[[]byte, string]) (bool, error) {
+		utxo, err := k.Utxoes.Get(ctx, indexKey.K2())
+		if err != nil {
+			return true, fmt.Errorf("fail to get indexed UTXO %s: %w", indexKey.K2(), err)
+		}
+		if maturity.immature(utxo) {
+			return false, nil
+		}
+		return fn(utxo), nil
+	})
+}
+
+// subtractUTXOStats removes utxo from stats, stopping at zero.
+func subtractUTXOStats(stats *types.UTXOStats, utxo types.UTXO) {
+	if stats.Count > 0 {
+		stats.Count--
+	}
+	stats.UnclaimedAmount -= min(stats.UnclaimedAmount, utxo.EntitledAmount)
+}
+
+// GetUTXOStats returns the summary of the stored UTXO set, which is empty
+// before the first UTXO is stored.
+func (k Keeper) GetUTXOStats(ctx context.Context) (types.UTXOStats, error) {
+	stats, err := k.UTXOStats.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.UTXOStats{}, nil
+	}
+	return stats, err
+}
+
+// EmitUTXOTelemetry exports the size of the UTXO set and the satoshis still
+// entitled to claims as gauges. Their growth rate is left to the metrics
+// backend, e.g. deriv() in Prometheus.
+func (k Keeper) EmitUTXOTelemetry(ctx sdk.Context) {
+	stats, err := k.GetUTXOStats(ctx)
+	if err != nil {
+		ctx.Logger().Error("failed to get UTXO stats", "error", err)
+		return
+	}
+	telemetry.SetGauge(float32(stats.Count), types.ModuleName, "utxo_count")
+	telemetry.SetGauge(float32(stats.UnclaimedAmount), types.ModuleName, "unclaimed_entitled_sats")
+}
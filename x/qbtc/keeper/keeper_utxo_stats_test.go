@@ -0,0 +1,71 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	"github.com/btcq-org/qbtc/x/qbtc/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUTXOStats(t *testing.T) {
+	f := initFixture(t)
+	requireStats := func(count, unclaimed uint64) {
+		t.Helper()
+		stats, err := f.keeper.GetUTXOStats(f.ctx)
+		require.NoError(t, err)
+		require.Equal(t, types.UTXOStats{Count: count, UnclaimedAmount: unclaimed}, stats)
+	}
+	requireStats(0, 0)
+
+	first := types.UTXO{Txid: "aa", Vout: 0, Amount: 100, EntitledAmount: 100}
+	second := types.UTXO{Txid: "bb", Vout: 1, Amount: 300, EntitledAmount: 250}
+	require.NoError(t, f.keeper.SetUTXO(f.ctx, first))
+	require.NoError(t, f.keeper.SetUTXO(f.ctx, second))
+	requireStats(2, 350)
+
+	// a claim replaces the UTXO, keeping the count
+	f.bankKeeper.EXPECT().MintCoins(gomock.Any(), types.ReserveModuleName, gomock.Any()).Return(nil)
+	require.NoError(t, f.keeper.ClaimUTXO(f.ctx, first.Txid, first.Vout, nil))
+	requireStats(2, 250)
+
+	require.NoError(t, f.keeper.RemoveUTXO(f.ctx, second.GetKey()))
+	requireStats(1, 0)
+
+	// removing a missing UTXO changes nothing
+	require.NoError(t, f.keeper.RemoveUTXO(f.ctx, second.GetKey()))
+	requireStats(1, 0)
+}
+
+func TestLoadUTXO(t *testing.T) {
+	f := initFixture(t)
+	spk := &types.ScriptPubKeyResult{Address: bitcoinAddressFromHash([20]byte{1})}
+	_, identifier, err := types.ScriptPubKeyIdentifier(spk)
+	require.NoError(t, err)
+
+	require.NoError(t, f.keeper.SetUTXO(f.ctx, types.UTXO{Txid: "aa", Vout: 0, Amount: 100, EntitledAmount: 100}))
+	stats, err := f.keeper.GetUTXOStats(f.ctx)
+	require.NoError(t, err)
+
+	claimable := types.UTXO{Txid: "bb", Vout: 0, Amount: 300, EntitledAmount: 300, ScriptPubKey: spk}
+	claimed := types.UTXO{Txid: "cc", Vout: 1, Amount: 50, EntitledAmount: 0, ScriptPubKey: spk}
+	require.NoError(t, f.keeper.LoadUTXO(f.ctx, claimable, &stats))
+	require.NoError(t, f.keeper.LoadUTXO(f.ctx, claimed, &stats))
+	require.Equal(t, types.UTXOStats{Count: 3, UnclaimedAmount: 400}, stats)
+
+	// the stored stats are left to the caller
+	stored, err := f.keeper.GetUTXOStats(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.UTXOStats{Count: 1, UnclaimedAmount: 100}, stored)
+
+	got, err := f.keeper.Utxoes.Get(f.ctx, claimable.GetKey())
+	require.NoError(t, err)
+	require.Equal(t, claimable, got)
+	has, err := f.keeper.ClaimableUTXOIndex.Has(f.ctx, collections.Join(identifier, claimable.GetKey()))
+	require.NoError(t, err)
+	require.True(t, has)
+	has, err = f.keeper.ClaimableUTXOIndex.Has(f.ctx, collections.Join(identifier, claimed.GetKey()))
+	require.NoError(t, err)
+	require.False(t, has)
+}
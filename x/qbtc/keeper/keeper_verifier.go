@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sync"
+
+	"cosmossdk.io/collections"
+	"github.com/btcq-org/qbtc/x/qbtc/types"
+	"github.com/btcq-org/qbtc/x/qbtc/zk"
+)
+
+// ClaimVerifier returns the verifier for claims of the given claim message
+// version: the one built from the version's own key in VersionVerifyingKeys
+// if there is one, the one built from ZkVerifyingKey otherwise. Both keys are
+// read from the state of ctx on every call, so a key change only takes effect
+// once it is committed, and is gone again if its transaction is rolled back.
+// It fails with ErrClaimsNotEnabled while no key is stored.
+func (k Keeper) ClaimVerifier(ctx context.Context, messageVersion string) (*zk.Verifier, error) {
+	version := zk.NormalizeClaimMessageVersion(messageVersion)
+	vkBytes, err := k.claimVerifyingKey(ctx, version)
+	if err != nil {
+		return nil, err
+	}
+	verifier, err := k.verifiers.get(vkBytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize ZK verifier of %s: %w", version, err)
+	}
+	return verifier, nil
+}
+
+// claimVerifyingKey returns the serialized verifying key ClaimVerifier builds
+// the verifier of the normalized message version from.
+func (k Keeper) claimVerifyingKey(ctx context.Context, version string) ([]byte, error) {
+	vkBytes, err := k.VersionVerifyingKeys.Get(ctx, version)
+	if errors.Is(err, collections.ErrNotFound) {
+		vkBytes, err = k.ZkVerifyingKey.Get(ctx)
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, types.ErrClaimsNotEnabled.Wrap("no ZK verifying key in state")
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read ZK verifying key of %s: %w", version, err)
+	}
+	return vkBytes, nil
+}
+
+// shortVKFingerprint returns the zk.ShortVerifyingKeyFingerprint of vkBytes in
+// hex, the form claims declare it in.
+func shortVKFingerprint(vkBytes []byte) string {
+	fingerprint := zk.ShortVerifyingKeyFingerprint(vkBytes)
+	return hex.EncodeToString(fingerprint[:])
+}
+
+// maxCachedVerifiers bounds verifierCache. The chain holds a default key and
+// a few version keys at a time, so the cache only overflows after many
+// rotations, and then simply starts over.
+const maxCachedVerifiers = 16
+
+// verifierCache maps the SHA256 of a serialized verifying key to the verifier
+// built from it, so the keys read from state are only deserialized the first
+// time they are seen. It holds no state of its own: which key applies is
+// always decided by the caller's ctx.
+type verifierCache struct {
+	mu        sync.Mutex
+	verifiers map[[sha256.Size]byte]*zk.Verifier
+}
+
+func newVerifierCache() *verifierCache {
+	return &verifierCache{verifiers: make(map[[sha256.Size]byte]*zk.Verifier)}
+}
+
+// get returns the verifier for the key vkBytes.
+func (c *verifierCache) get(vkBytes []byte) (*zk.Verifier, error) {
+	vkHash := sha256.Sum256(vkBytes)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if verifier, ok := c.verifiers[vkHash]; ok {
+		return verifier, nil
+	}
+	verifier, err := zk.NewVerifierFromBytes(vkBytes)
+	if err != nil {
+		return nil, err
+	}
+	if len(c.verifiers) >= maxCachedVerifiers {
+		clear(c.verifiers)
+	}
+	c.verifiers[vkHash] = verifier
+	return verifier, nil
+}
@@ -0,0 +1,103 @@
+package keeper_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/btcq-org/qbtc/x/qbtc/types"
+	"github.com/btcq-org/qbtc/x/qbtc/zk"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClaimVerifier(t *testing.T) {
+	t.Run("no verifying key stored", func(t *testing.T) {
+		f := initFixture(t)
+		_, err := f.keeper.ClaimVerifier(f.ctx, "")
+		require.ErrorIs(t, err, types.ErrClaimsNotEnabled)
+	})
+
+	t.Run("invalid verifying key stored", func(t *testing.T) {
+		f := initFixture(t)
+		require.NoError(t, f.keeper.ZkVerifyingKey.Set(f.ctx, make([]byte, 2048)))
+		_, err := f.keeper.ClaimVerifier(f.ctx, "")
+		require.ErrorContains(t, err, "failed to initialize ZK verifier")
+	})
+
+	t.Run("verifying key stored", func(t *testing.T) {
+		if testing.Short() {
+			t.Skip("skipping ZK setup in short mode")
+		}
+		setup, err := zk.SetupWithOptions(zk.TestSetupOptions())
+		require.NoError(t, err)
+		vkBytes, err := zk.SerializeVerifyingKey(setup.VerifyingKey)
+		require.NoError(t, err)
+
+		f := initFixture(t)
+		require.NoError(t, f.keeper.ZkVerifyingKey.Set(f.ctx, vkBytes))
+		verifier, err := f.keeper.ClaimVerifier(f.ctx, "")
+		require.NoError(t, err)
+		// versions without a key of their own share the default verifier
+		v2, err := f.keeper.ClaimVerifier(f.ctx, zk.ClaimMessageVersionV2)
+		require.NoError(t, err)
+		require.Same(t, verifier, v2)
+
+		// a key change only applies to the state it was written to, so one
+		// that is rolled back leaves the committed key in effect
+		cacheCtx, _ := sdk.UnwrapSDKContext(f.ctx).CacheContext()
+		require.NoError(t, f.keeper.ZkVerifyingKey.Set(cacheCtx, make([]byte, 2048)))
+		_, err = f.keeper.ClaimVerifier(cacheCtx, "")
+		require.Error(t, err)
+		again, err := f.keeper.ClaimVerifier(f.ctx, "")
+		require.NoError(t, err)
+		require.Same(t, verifier, again)
+	})
+}
+
+// TestClaimVerifierConcurrent builds and verifies through the claim verifier
+// from many goroutines at once; run it with -race.
+func TestClaimVerifierConcurrent(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping ZK setup in short mode")
+	}
+	setup, err := zk.SetupWithOptions(zk.TestSetupOptions())
+	require.NoError(t, err)
+	vkBytes, err := zk.SerializeVerifyingKey(setup.VerifyingKey)
+	require.NoError(t, err)
+
+	f := initFixture(t)
+	require.NoError(t, f.keeper.ZkVerifyingKey.Set(f.ctx, vkBytes))
+	require.NoError(t, f.keeper.VersionVerifyingKeys.Set(f.ctx, zk.ClaimMessageVersionV2, vkBytes))
+
+	const workers, rounds = 4, 3
+	var wg sync.WaitGroup
+	verifiers := make(chan *zk.Verifier, 2*workers*rounds)
+	errs := make(chan error, 2*workers*rounds)
+	for range workers {
+		for _, version := range []string{"", zk.ClaimMessageVersionV2} {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for range rounds {
+					verifier, err := f.keeper.ClaimVerifier(f.ctx, version)
+					if err != nil {
+						errs <- err
+						continue
+					}
+					verifiers <- verifier
+				}
+			}()
+		}
+	}
+	wg.Wait()
+	close(verifiers)
+	close(errs)
+	for err := range errs {
+		require.NoError(t, err)
+	}
+	// both versions use the same key, so every call shares one verifier
+	first := <-verifiers
+	for verifier := range verifiers {
+		require.Same(t, first, verifier)
+	}
+}
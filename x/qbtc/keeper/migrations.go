@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrator runs the in-place store migrations of the qbtc module.
+type Migrator struct {
+	keeper *Keeper
+}
+
+// NewMigrator returns a Migrator for the given keeper.
+func NewMigrator(keeper *Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the store from consensus version 1 to 2. It starts
+// the UTXO backfill, which EndBlock runs in batches, to derive the state that
+// version 2 keeps for every UTXO from the ones stored before:
+//   - fully claimed UTXOs are indexed in ClaimedUTXOIndex, so pruning picks
+//     them up once the retention window has passed
+//   - UTXOs with an entitled amount left are indexed in ClaimableUTXOIndex
+//   - every UTXO is counted in UTXOStats
+//
+// From then on SetUTXO and RemoveUTXO keep that state up to date. Until the
+// backfill is done the stats only cover the UTXOs it visited, and the
+// queries reading ClaimableUTXOIndex fail with ErrUTXOBackfillRunning.
+//
+// Stored UTXOs predate CreatedAtHeight and decode with a height of zero,
+// meaning unknown. The other collections added in version 2 (claim records,
+// pending attestations, the claim deny list, memo prefixes, the last claim
+// heights, version verifying keys, pruned UTXO amounts) all treat a
+// missing entry as empty or default, so none of them needs to be
+// initialized.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	if err := m.keeper.startUTXOBackfill(ctx); err != nil {
+		return fmt.Errorf("fail to start UTXO backfill: %w", err)
+	}
+	return nil
+}
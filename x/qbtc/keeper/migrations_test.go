@@ -0,0 +1,60 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/btcq-org/qbtc/x/qbtc/keeper"
+	"github.com/btcq-org/qbtc/x/qbtc/types"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(500)
+
+	// UTXOs as written by version 1: no creation height, and a fully claimed
+	// one that predates the claimed UTXO index
+	unindexed := types.UTXO{Txid: "aa", Vout: 0, Amount: 100, EntitledAmount: 0}
+	indexed := types.UTXO{Txid: "bb", Vout: 1, Amount: 100, EntitledAmount: 0}
+	unclaimed := types.UTXO{Txid: "cc", Vout: 0, Amount: 100, EntitledAmount: 100}
+	for _, utxo := range []types.UTXO{unindexed, indexed, unclaimed} {
+		require.NoError(t, f.keeper.Utxoes.Set(ctx, utxo.GetKey(), utxo))
+	}
+	require.NoError(t, f.keeper.ClaimedUTXOIndex.Set(ctx, collections.Join(int64(20), indexed.GetKey())))
+
+	// the migration only starts the backfill, which indexes and counts them
+	m := keeper.NewMigrator(f.keeper)
+	require.NoError(t, m.Migrate1to2(ctx))
+	runUTXOBackfill(t, f, ctx)
+
+	var entries []collections.Pair[int64, string]
+	require.NoError(t, f.keeper.ClaimedUTXOIndex.Walk(ctx, nil, func(key collections.Pair[int64, string]) (bool, error) {
+		entries = append(entries, key)
+		return false, nil
+	}))
+	// indexing a UTXO a second time only adds an entry that pruning drops
+	require.Equal(t, []collections.Pair[int64, string]{
+		collections.Join(int64(20), indexed.GetKey()),
+		collections.Join(int64(500), unindexed.GetKey()),
+		collections.Join(int64(500), indexed.GetKey()),
+	}, entries)
+
+	// existing UTXOs are left as they were
+	got, err := f.keeper.Utxoes.Get(ctx, unindexed.GetKey())
+	require.NoError(t, err)
+	require.Equal(t, unindexed, got)
+	require.Zero(t, got.CreatedAtHeight)
+
+	stats, err := f.keeper.GetUTXOStats(ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.UTXOStats{Count: 3, UnclaimedAmount: 100}, stats)
+
+	// later writes build on the migrated stats
+	require.NoError(t, f.keeper.RemoveUTXO(ctx, unclaimed.GetKey()))
+	stats, err = f.keeper.GetUTXOStats(ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.UTXOStats{Count: 2}, stats)
+}
@@ -43,7 +43,15 @@ func (qs queryServer) AllParams(ctx context.Context, req *types.QueryAllParamsRe
 		}
 		p.Value = overriddenValue
 	}
-	return &types.QueryAllParamsResponse{Params: params}, nil
+	denyList, err := qs.k.GetClaimDenyList(sdkCtx)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryAllParamsResponse{
+		Params:            params,
+		ClaimMemoPrefixes: qs.k.GetClaimMemoPrefixes(sdkCtx),
+		ClaimDenyList:     denyList,
+	}, nil
 }
 
 // Params returns the value of a specific parameter in the qbtc module.
@@ -77,5 +85,9 @@ func (qs queryServer) LastProcessedBlock(ctx context.Context, req *types.QueryLa
 	if err != nil {
 		return nil, err
 	}
-	return &types.QueryLastProcessedBlockResponse{Height: height}, nil
+	hash, err := qs.k.GetLastProcessedBlockHash(sdkCtx)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryLastProcessedBlockResponse{Height: height, Hash: hash}, nil
 }
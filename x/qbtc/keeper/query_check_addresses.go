@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/btcq-org/qbtc/x/qbtc/types"
+	se "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// maxCheckAddresses bounds the number of addresses a single CheckAddresses
+// query checks, each of which reads its UTXOs from ClaimableUTXOIndex.
+const maxCheckAddresses = 1000
+
+// CheckAddresses reports for each address whether any of its UTXOs still has
+// an entitled amount, and how much is left to claim in total, so a wallet
+// checking the addresses derived from its xpub needs one query. Like
+// HasClaimable it reads ClaimableUTXOIndex, and fails with
+// ErrUTXOBackfillRunning while the index is backfilled after an upgrade.
+//
+// As a claim does, the check matches UTXOs by identifier, so the P2PKH and
+// P2WPKH addresses of one key both report the UTXOs of either. Addresses of
+// a type that can't be claimed are reported with an unclaimable reason
+// rather than failing the query.
+func (qs queryServer) CheckAddresses(ctx context.Context, req *types.QueryCheckAddressesRequest) (*types.QueryCheckAddressesResponse, error) {
+	if req == nil {
+		return nil, se.ErrInvalidRequest.Wrap("empty request")
+	}
+	if len(req.Addresses) > maxCheckAddresses {
+		return nil, se.ErrInvalidRequest.Wrapf("too many addresses: %d (max %d)", len(req.Addresses), maxCheckAddresses)
+	}
+
+	results := make([]types.AddressClaimable, len(req.Addresses))
+	// results indexes by identifier, as several addresses can share one
+	byIdentifier := make(map[string][]int, len(req.Addresses))
+	for i, address := range req.Addresses {
+		if address == "" {
+			return nil, se.ErrInvalidRequest.Wrapf("addresses[%d]: address is required", i)
+		}
+		addressType, identifier, err := types.AddressIdentifier(address)
+		results[i] = types.AddressClaimable{Address: address, AddressType: addressType.String()}
+		if err != nil {
+			results[i].UnclaimableReason = err.Error()
+			continue
+		}
+		byIdentifier[string(identifier)] = append(byIdentifier[string(identifier)], i)
+	}
+	if len(byIdentifier) == 0 {
+		return &types.QueryCheckAddressesResponse{Results: results}, nil
+	}
+
+	for identifier, indexes := range byIdentifier {
+		var amount uint64
+		err := qs.k.walkClaimableUTXOs(ctx, []byte(identifier), func(utxo types.UTXO) bool {
+			amount += utxo.EntitledAmount
+			return false
+		})
+		if err != nil {
+			return nil, err
+		}
+		if amount == 0 {
+			continue
+		}
+		for _, i := range indexes {
+			results[i].Claimable = true
+			results[i].EntitledAmount = amount
+		}
+	}
+	return &types.QueryCheckAddressesResponse{Results: results}, nil
+}
@@ -0,0 +1,67 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/btcq-org/qbtc/x/qbtc/keeper"
+	"github.com/btcq-org/qbtc/x/qbtc/types"
+	"github.com/btcq-org/qbtc/x/qbtc/zk"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryCheckAddresses(t *testing.T) {
+	f := initFixture(t)
+	queryClient := keeper.NewQueryServerImpl(f.keeper)
+
+	claimable := [20]byte{1}
+	claimed := [20]byte{2}
+	empty := [20]byte{3}
+	p2pkh := func(hash [20]byte) string {
+		address, err := zk.Hash160ToP2PKHAddress(hash)
+		require.NoError(t, err)
+		return address
+	}
+	for i, tc := range []struct {
+		hash     [20]byte
+		entitled uint64
+	}{
+		{claimed, 0},
+		{claimable, 100},
+		{claimable, 50},
+	} {
+		utxo := types.UTXO{
+			Txid:           "aa",
+			Vout:           uint32(i),
+			Amount:         100,
+			EntitledAmount: tc.entitled,
+			ScriptPubKey:   &types.ScriptPubKeyResult{Address: p2pkh(tc.hash)},
+		}
+		require.NoError(t, f.keeper.SetUTXO(f.ctx, utxo))
+	}
+
+	// the P2WPKH address of the claimable key
+	const claimableP2WPKH = "bc1qqyqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqugekmn"
+	const p2sh = "3J98t1WpEZ73CNmQviecrnyiWrnqRhWNLy"
+	resp, err := queryClient.CheckAddresses(f.ctx, &types.QueryCheckAddressesRequest{
+		Addresses: []string{p2pkh(claimable), claimableP2WPKH, p2pkh(claimed), p2pkh(empty), p2sh},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Results, 5)
+	require.Equal(t, types.AddressClaimable{Address: p2pkh(claimable), AddressType: "p2pkh", Claimable: true, EntitledAmount: 150}, resp.Results[0])
+	require.Equal(t, types.AddressClaimable{Address: claimableP2WPKH, AddressType: "p2wpkh", Claimable: true, EntitledAmount: 150}, resp.Results[1])
+	require.Equal(t, types.AddressClaimable{Address: p2pkh(claimed), AddressType: "p2pkh"}, resp.Results[2])
+	require.Equal(t, types.AddressClaimable{Address: p2pkh(empty), AddressType: "p2pkh"}, resp.Results[3])
+	require.Equal(t, p2sh, resp.Results[4].Address)
+	require.False(t, resp.Results[4].Claimable)
+	require.Contains(t, resp.Results[4].UnclaimableReason, "P2SH")
+
+	_, err = queryClient.CheckAddresses(f.ctx, &types.QueryCheckAddressesRequest{Addresses: []string{p2pkh(claimable), ""}})
+	require.ErrorContains(t, err, "addresses[1]: address is required")
+	_, err = queryClient.CheckAddresses(f.ctx, &types.QueryCheckAddressesRequest{Addresses: make([]string, 1001)})
+	require.ErrorContains(t, err, "too many addresses")
+
+	// the index misses the UTXOs a running backfill has not reached yet
+	require.NoError(t, f.keeper.UTXOBackfillCursor.Set(f.ctx, ""))
+	_, err = queryClient.CheckAddresses(f.ctx, &types.QueryCheckAddressesRequest{Addresses: []string{p2pkh(claimable)}})
+	require.ErrorIs(t, err, types.ErrUTXOBackfillRunning)
+}
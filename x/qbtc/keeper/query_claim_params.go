@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"github.com/btcq-org/qbtc/constants"
+	"github.com/btcq-org/qbtc/x/qbtc/types"
+	"github.com/btcq-org/qbtc/x/qbtc/zk"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	se "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ClaimParams returns the parameters a client needs to build a claim, so a
+// wallet can bootstrap the claim flow from one call and notice when it was
+// built for a message version or verifying key the chain no longer uses. The
+// fingerprint of each version is the one of the key ClaimVerifier picks for
+// it, in the form a claim declares it in.
+func (qs queryServer) ClaimParams(ctx context.Context, req *types.QueryClaimParamsRequest) (*types.QueryClaimParamsResponse, error) {
+	if req == nil {
+		return nil, se.ErrInvalidRequest.Wrap("empty request")
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	resp := &types.QueryClaimParamsResponse{
+		ChainId:                  sdkCtx.ChainID(),
+		MessageVersion:           zk.ClaimMessageVersion,
+		SupportedMessageVersions: zk.SupportedClaimMessageVersions(),
+		SupportedCircuitTypes:    zk.SupportedCircuitTypes(),
+		ClaimExpiryRequired:      qs.k.GetConfig(sdkCtx, constants.ClaimExpiryRequired) > 0,
+		MaxClaimExpiryBlocks:     uint64(max(qs.k.GetConfig(sdkCtx, constants.MaxClaimExpiryBlocks), 0)),
+	}
+	for _, version := range resp.SupportedMessageVersions {
+		vkBytes, err := qs.k.claimVerifyingKey(ctx, version)
+		if errors.Is(err, types.ErrClaimsNotEnabled) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		fingerprint := shortVKFingerprint(vkBytes)
+		resp.VersionVkFingerprints = append(resp.VersionVkFingerprints, types.VersionVkFingerprint{
+			MessageVersion: version,
+			VkFingerprint:  fingerprint,
+		})
+		if version == resp.MessageVersion {
+			resp.VkFingerprint = fingerprint
+		}
+	}
+	return resp, nil
+}
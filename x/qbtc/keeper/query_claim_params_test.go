@@ -0,0 +1,60 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/btcq-org/qbtc/constants"
+	"github.com/btcq-org/qbtc/x/qbtc/keeper"
+	"github.com/btcq-org/qbtc/x/qbtc/types"
+	"github.com/btcq-org/qbtc/x/qbtc/zk"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryClaimParams(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithChainID("qbtc-test-1")
+
+	_, err := keeper.NewQueryServerImpl(f.keeper).ClaimParams(ctx, nil)
+	require.Error(t, err)
+
+	resp, err := keeper.NewQueryServerImpl(f.keeper).ClaimParams(ctx, &types.QueryClaimParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, "qbtc-test-1", resp.ChainId)
+	require.Equal(t, zk.ClaimMessageVersion, resp.MessageVersion)
+	require.Contains(t, resp.SupportedMessageVersions, zk.ClaimMessageVersion)
+	require.IsIncreasing(t, resp.SupportedMessageVersions)
+	require.Equal(t, []string{zk.CircuitTypeECDSA}, resp.SupportedCircuitTypes)
+	require.Empty(t, resp.VkFingerprint)
+	require.Empty(t, resp.VersionVkFingerprints)
+	require.False(t, resp.ClaimExpiryRequired)
+	require.Zero(t, resp.MaxClaimExpiryBlocks)
+
+	require.NoError(t, f.keeper.ConstOverrides.Set(ctx, constants.ClaimExpiryRequired.String(), int64(1)))
+	require.NoError(t, f.keeper.ConstOverrides.Set(ctx, constants.MaxClaimExpiryBlocks.String(), int64(14400)))
+	resp, err = keeper.NewQueryServerImpl(f.keeper).ClaimParams(ctx, &types.QueryClaimParamsRequest{})
+	require.NoError(t, err)
+	require.True(t, resp.ClaimExpiryRequired)
+	require.Equal(t, uint64(14400), resp.MaxClaimExpiryBlocks)
+
+	// the fingerprints are the short ones of the keys in state, in the form
+	// a claim declares them in
+	short := func(vkBytes []byte) string {
+		return zk.VerifyingKeyFingerprint(vkBytes)[:2*zk.VKFingerprintSize]
+	}
+	vkBytes := []byte("verifying key")
+	versionKey := []byte("version verifying key")
+	require.NoError(t, f.keeper.ZkVerifyingKey.Set(ctx, vkBytes))
+	require.NoError(t, f.keeper.VersionVerifyingKeys.Set(ctx, zk.ClaimMessageVersionV2, versionKey))
+	resp, err = keeper.NewQueryServerImpl(f.keeper).ClaimParams(ctx, &types.QueryClaimParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, short(vkBytes), resp.VkFingerprint)
+	require.Len(t, resp.VersionVkFingerprints, len(resp.SupportedMessageVersions))
+	for i, version := range resp.SupportedMessageVersions {
+		want := short(vkBytes)
+		if version == zk.ClaimMessageVersionV2 {
+			want = short(versionKey)
+		}
+		require.Equal(t, types.VersionVkFingerprint{MessageVersion: version, VkFingerprint: want}, resp.VersionVkFingerprints[i])
+	}
+}
@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/btcq-org/qbtc/x/qbtc/types"
+	se "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// HasClaimable reports whether any UTXO paying to the given address hash still
+// has an entitled amount. It only reads the UTXOs indexed under the hash in
+// ClaimableUTXOIndex, and stops at the first mature one. While the index is
+// backfilled after an upgrade it fails with ErrUTXOBackfillRunning.
+func (qs queryServer) HasClaimable(ctx context.Context, req *types.QueryHasClaimableRequest) (*types.QueryHasClaimableResponse, error) {
+	if req == nil {
+		return nil, se.ErrInvalidRequest.Wrap("empty request")
+	}
+	if len(req.AddressHash) != types.Hash160Length && len(req.AddressHash) != 32 {
+		return nil, se.ErrInvalidRequest.Wrapf("address_hash must be 20 or 32 bytes, got %d", len(req.AddressHash))
+	}
+
+	var found bool
+	err := qs.k.walkClaimableUTXOs(ctx, req.AddressHash, func(types.UTXO) bool {
+		found = true
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryHasClaimableResponse{HasClaimable: found}, nil
+}
@@ -0,0 +1,56 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/btcq-org/qbtc/x/qbtc/keeper"
+	"github.com/btcq-org/qbtc/x/qbtc/types"
+	"github.com/btcq-org/qbtc/x/qbtc/zk"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryHasClaimable(t *testing.T) {
+	f := initFixture(t)
+	queryClient := keeper.NewQueryServerImpl(f.keeper)
+
+	claimable := [20]byte{1}
+	claimed := [20]byte{2}
+	empty := [20]byte{3}
+	for i, tc := range []struct {
+		hash     [20]byte
+		entitled uint64
+	}{
+		{claimed, 0},
+		{claimable, 0},
+		{claimable, 100},
+	} {
+		address, err := zk.Hash160ToP2PKHAddress(tc.hash)
+		require.NoError(t, err)
+		utxo := types.UTXO{
+			Txid:           "aa",
+			Vout:           uint32(i),
+			Amount:         100,
+			EntitledAmount: tc.entitled,
+			ScriptPubKey:   &types.ScriptPubKeyResult{Address: address},
+		}
+		require.NoError(t, f.keeper.SetUTXO(f.ctx, utxo))
+	}
+
+	resp, err := queryClient.HasClaimable(f.ctx, &types.QueryHasClaimableRequest{AddressHash: claimable[:]})
+	require.NoError(t, err)
+	require.True(t, resp.HasClaimable)
+
+	for _, hash := range [][20]byte{claimed, empty} {
+		resp, err = queryClient.HasClaimable(f.ctx, &types.QueryHasClaimableRequest{AddressHash: hash[:]})
+		require.NoError(t, err)
+		require.False(t, resp.HasClaimable)
+	}
+
+	_, err = queryClient.HasClaimable(f.ctx, &types.QueryHasClaimableRequest{AddressHash: []byte{1, 2, 3}})
+	require.Error(t, err)
+
+	// the index misses the UTXOs a running backfill has not reached yet
+	require.NoError(t, f.keeper.UTXOBackfillCursor.Set(f.ctx, ""))
+	_, err = queryClient.HasClaimable(f.ctx, &types.QueryHasClaimableRequest{AddressHash: claimable[:]})
+	require.ErrorIs(t, err, types.ErrUTXOBackfillRunning)
+}
@@ -12,10 +12,25 @@ func TestQueryLastProcessedBlock(t *testing.T) {
 	f := initFixture(t)
 	queryClient := keeper.NewQueryServerImpl(f.keeper)
 
-	err := f.keeper.LastProcessedBlock.Set(f.ctx, 100)
+	// Nothing processed yet
+	resp, err := queryClient.LastProcessedBlock(f.ctx, &types.QueryLastProcessedBlockRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), resp.Height)
+	require.Empty(t, resp.Hash)
+
+	// Height recorded before block hashes were stored
+	err = f.keeper.LastProcessedBlock.Set(f.ctx, 100)
 	require.NoError(t, err)
 	height, err := queryClient.LastProcessedBlock(f.ctx, &types.QueryLastProcessedBlockRequest{})
 	require.NoError(t, err)
 	require.NotNil(t, height)
 	require.Equal(t, uint64(100), height.Height)
+	require.Empty(t, height.Hash)
+
+	const hash = "00000000000000000002a7c4c1e48d76c5a37902165a270156b7a8d72728a054"
+	require.NoError(t, f.keeper.LastProcessedBlockHash.Set(f.ctx, hash))
+	resp, err = queryClient.LastProcessedBlock(f.ctx, &types.QueryLastProcessedBlockRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(100), resp.Height)
+	require.Equal(t, hash, resp.Hash)
 }
@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"cosmossdk.io/collections"
+	"github.com/btcq-org/qbtc/x/qbtc/types"
+	"github.com/btcq-org/qbtc/x/qbtc/zk"
+	se "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// UTXO returns the UTXO identified by txid and vout. A UTXO that is not in the
+// store is reported with Found=false rather than an error. The response also
+// tells how the chain classifies the output script and which circuit a claim
+// proof for it needs, so a wallet can explain why a claim skips the UTXO.
+func (qs queryServer) UTXO(ctx context.Context, req *types.QueryUTXORequest) (*types.QueryUTXOResponse, error) {
+	if req == nil {
+		return nil, se.ErrInvalidRequest.Wrap("empty request")
+	}
+	if req.Txid == "" {
+		return nil, se.ErrInvalidRequest.Wrap("txid is required")
+	}
+	utxo, err := qs.k.Utxoes.Get(ctx, getUTXOKey(req.Txid, req.Vout))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return &types.QueryUTXOResponse{Found: false}, nil
+		}
+		return nil, err
+	}
+	resp := &types.QueryUTXOResponse{Utxo: utxo, Found: true}
+	addressType, _, err := types.ScriptPubKeyIdentifier(utxo.ScriptPubKey)
+	resp.AddressType = addressType.String()
+	if err != nil {
+		resp.UnclaimableReason = err.Error()
+		return resp, nil
+	}
+	resp.CircuitType = zk.CircuitTypeForAddressType(addressType)
+	resp.CircuitSupported = slices.Contains(zk.SupportedCircuitTypes(), resp.CircuitType)
+	if !resp.CircuitSupported {
+		resp.UnclaimableReason = fmt.Sprintf("no circuit for %s outputs is supported yet", addressType)
+	}
+	return resp, nil
+}
+
+// maxUTXOsByKeys bounds the number of UTXOs a single UTXOsByKeys query looks up.
+const maxUTXOsByKeys = 200
+
+// UTXOsByKeys returns the requested UTXOs that are in the store, in request
+// order; unknown ones are left out. With ClaimableOnly it also leaves out
+// UTXOs whose entitled amount is claimed already and coinbase outputs that
+// are not mature yet. It does not check which
+// address the UTXOs pay to, so the result can still hold UTXOs a proof for
+// one address can't claim.
+func (qs queryServer) UTXOsByKeys(ctx context.Context, req *types.QueryUTXOsByKeysRequest) (*types.QueryUTXOsByKeysResponse, error) {
+	if req == nil {
+		return nil, se.ErrInvalidRequest.Wrap("empty request")
+	}
+	if len(req.Utxos) > maxUTXOsByKeys {
+		return nil, se.ErrInvalidRequest.Wrapf("too many UTXOs: %d (max %d)", len(req.Utxos), maxUTXOsByKeys)
+	}
+	maturity, err := qs.k.getCoinbaseMaturity(ctx)
+	if err != nil {
+		return nil, err
+	}
+	utxos := make([]types.UTXO, 0, len(req.Utxos))
+	for i, ref := range req.Utxos {
+		if ref.Txid == "" {
+			return nil, se.ErrInvalidRequest.Wrapf("utxos[%d]: txid is required", i)
+		}
+		utxo, err := qs.k.Utxoes.Get(ctx, getUTXOKey(ref.Txid, ref.Vout))
+		if errors.Is(err, collections.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if req.ClaimableOnly && (utxo.EntitledAmount == 0 || maturity.immature(utxo)) {
+			continue
+		}
+		utxos = append(utxos, utxo)
+	}
+	return &types.QueryUTXOsByKeysResponse{Utxos: utxos}, nil
+}
@@ -0,0 +1,123 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/btcq-org/qbtc/x/qbtc/keeper"
+	"github.com/btcq-org/qbtc/x/qbtc/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryUTXO(t *testing.T) {
+	f := initFixture(t)
+	queryClient := keeper.NewQueryServerImpl(f.keeper)
+
+	utxo := types.UTXO{Txid: "aa", Vout: 1, Amount: 100, EntitledAmount: 100}
+	require.NoError(t, f.keeper.Utxoes.Set(f.ctx, utxo.GetKey(), utxo))
+
+	resp, err := queryClient.UTXO(f.ctx, &types.QueryUTXORequest{Txid: "aa", Vout: 1})
+	require.NoError(t, err)
+	require.True(t, resp.Found)
+	require.Equal(t, utxo, resp.Utxo)
+	// without a script the output can't be claimed
+	require.Equal(t, "unknown", resp.AddressType)
+	require.Empty(t, resp.CircuitType)
+	require.NotEmpty(t, resp.UnclaimableReason)
+
+	resp, err = queryClient.UTXO(f.ctx, &types.QueryUTXORequest{Txid: "aa", Vout: 2})
+	require.NoError(t, err)
+	require.False(t, resp.Found)
+
+	_, err = queryClient.UTXO(f.ctx, &types.QueryUTXORequest{})
+	require.Error(t, err)
+}
+
+func TestQueryUTXO_ClaimRequirements(t *testing.T) {
+	f := initFixture(t)
+	queryClient := keeper.NewQueryServerImpl(f.keeper)
+
+	tests := []struct {
+		name             string
+		scriptHex        string
+		addressType      string
+		circuitType      string
+		circuitSupported bool
+	}{
+		{
+			name:             "p2wpkh",
+			scriptHex:        "0014751e76e8199196d454941c45d1b3a323f1433bd6",
+			addressType:      "p2wpkh",
+			circuitType:      "ecdsa",
+			circuitSupported: true,
+		},
+		{
+			name:             "p2pkh",
+			scriptHex:        "76a914751e76e8199196d454941c45d1b3a323f1433bd688ac",
+			addressType:      "p2pkh",
+			circuitType:      "ecdsa",
+			circuitSupported: true,
+		},
+		{
+			name:        "p2wsh",
+			scriptHex:   "00201863143c14c5166804bd19203356da136c985678cd4d27a1b8c6329604903262",
+			addressType: "p2wsh",
+			circuitType: "p2wsh",
+		},
+	}
+	for i, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			utxo := types.UTXO{Txid: "dd", Vout: uint32(i), Amount: 100, EntitledAmount: 100, ScriptPubKey: &types.ScriptPubKeyResult{Hex: tc.scriptHex}}
+			require.NoError(t, f.keeper.Utxoes.Set(f.ctx, utxo.GetKey(), utxo))
+
+			resp, err := queryClient.UTXO(f.ctx, &types.QueryUTXORequest{Txid: "dd", Vout: uint32(i)})
+			require.NoError(t, err)
+			require.Equal(t, tc.addressType, resp.AddressType)
+			require.Equal(t, tc.circuitType, resp.CircuitType)
+			require.Equal(t, tc.circuitSupported, resp.CircuitSupported)
+			require.Equal(t, tc.circuitSupported, resp.UnclaimableReason == "")
+		})
+	}
+}
+
+func TestQueryUTXOsByKeys(t *testing.T) {
+	f := initFixture(t)
+	queryClient := keeper.NewQueryServerImpl(f.keeper)
+
+	claimable := types.UTXO{Txid: "aa", Vout: 0, Amount: 100, EntitledAmount: 100}
+	claimed := types.UTXO{Txid: "aa", Vout: 1, Amount: 200, EntitledAmount: 0}
+	require.NoError(t, f.keeper.Utxoes.Set(f.ctx, claimable.GetKey(), claimable))
+	require.NoError(t, f.keeper.Utxoes.Set(f.ctx, claimed.GetKey(), claimed))
+
+	refs := []types.UTXORef{
+		{Txid: "aa", Vout: 1},
+		{Txid: "bb", Vout: 0}, // unknown
+		{Txid: "aa", Vout: 0},
+	}
+
+	resp, err := queryClient.UTXOsByKeys(f.ctx, &types.QueryUTXOsByKeysRequest{Utxos: refs})
+	require.NoError(t, err)
+	require.Equal(t, []types.UTXO{claimed, claimable}, resp.Utxos)
+
+	resp, err = queryClient.UTXOsByKeys(f.ctx, &types.QueryUTXOsByKeysRequest{Utxos: refs, ClaimableOnly: true})
+	require.NoError(t, err)
+	require.Equal(t, []types.UTXO{claimable}, resp.Utxos)
+
+	// a coinbase output is only claimable after CoinbaseMaturity confirmations
+	coinbase := types.UTXO{Txid: "cc", Vout: 0, Amount: 300, EntitledAmount: 300, CreatedAtHeight: 1000, Coinbase: true}
+	require.NoError(t, f.keeper.Utxoes.Set(f.ctx, coinbase.GetKey(), coinbase))
+	coinbaseRef := []types.UTXORef{{Txid: "cc", Vout: 0}}
+	require.NoError(t, f.keeper.LastProcessedBlock.Set(f.ctx, 1098))
+	resp, err = queryClient.UTXOsByKeys(f.ctx, &types.QueryUTXOsByKeysRequest{Utxos: coinbaseRef, ClaimableOnly: true})
+	require.NoError(t, err)
+	require.Empty(t, resp.Utxos)
+	require.NoError(t, f.keeper.LastProcessedBlock.Set(f.ctx, 1099))
+	resp, err = queryClient.UTXOsByKeys(f.ctx, &types.QueryUTXOsByKeysRequest{Utxos: coinbaseRef, ClaimableOnly: true})
+	require.NoError(t, err)
+	require.Equal(t, []types.UTXO{coinbase}, resp.Utxos)
+
+	_, err = queryClient.UTXOsByKeys(f.ctx, &types.QueryUTXOsByKeysRequest{Utxos: []types.UTXORef{{Vout: 1}}})
+	require.Error(t, err)
+
+	_, err = queryClient.UTXOsByKeys(f.ctx, &types.QueryUTXOsByKeysRequest{Utxos: make([]types.UTXORef, 201)})
+	require.Error(t, err)
+}
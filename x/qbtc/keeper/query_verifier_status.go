@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"github.com/btcq-org/qbtc/x/qbtc/types"
+	"github.com/btcq-org/qbtc/x/qbtc/zk"
+	se "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// VerifierStatus reports whether a ZK verifying key is stored, i.e. whether
+// claims can be verified, and the short fingerprints of the default key and
+// of the keys of message versions that have one, in the form claims declare
+// them in. Claims are verified against the keys in state, so every node
+// answers the same.
+func (qs queryServer) VerifierStatus(ctx context.Context, req *types.QueryVerifierStatusRequest) (*types.QueryVerifierStatusResponse, error) {
+	if req == nil {
+		return nil, se.ErrInvalidRequest.Wrap("empty request")
+	}
+	resp := &types.QueryVerifierStatusResponse{MessageVersion: zk.ClaimMessageVersion}
+	err := qs.k.VersionVerifyingKeys.Walk(ctx, nil, func(version string, vkBytes []byte) (bool, error) {
+		resp.VersionVkFingerprints = append(resp.VersionVkFingerprints, types.VersionVkFingerprint{
+			MessageVersion: version,
+			VkFingerprint:  shortVKFingerprint(vkBytes),
+		})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	vkBytes, err := qs.k.ZkVerifyingKey.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return resp, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	resp.Initialized = true
+	resp.VkFingerprint = shortVKFingerprint(vkBytes)
+	return resp, nil
+}
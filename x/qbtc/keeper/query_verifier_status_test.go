@@ -0,0 +1,42 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/btcq-org/qbtc/x/qbtc/keeper"
+	"github.com/btcq-org/qbtc/x/qbtc/types"
+	"github.com/btcq-org/qbtc/x/qbtc/zk"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryVerifierStatus(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping ZK setup in short mode")
+	}
+	setup, err := zk.SetupWithOptions(zk.TestSetupOptions())
+	require.NoError(t, err)
+	vkBytes, err := zk.SerializeVerifyingKey(setup.VerifyingKey)
+	require.NoError(t, err)
+
+	f := initFixture(t)
+	require.NoError(t, f.keeper.ZkVerifyingKey.Set(f.ctx, vkBytes))
+
+	resp, err := keeper.NewQueryServerImpl(f.keeper).VerifierStatus(f.ctx, &types.QueryVerifierStatusRequest{})
+	require.NoError(t, err)
+	require.True(t, resp.Initialized)
+	// the fingerprint has the form a claim declares it in
+	require.Equal(t, zk.VerifyingKeyFingerprint(vkBytes)[:2*zk.VKFingerprintSize], resp.VkFingerprint)
+	require.Equal(t, zk.ClaimMessageVersion, resp.MessageVersion)
+	require.Empty(t, resp.VersionVkFingerprints)
+
+	// versions with a key of their own are listed with its fingerprint
+	versionKey := append([]byte{}, vkBytes...)
+	versionKey[len(versionKey)-1] ^= 1
+	require.NoError(t, f.keeper.VersionVerifyingKeys.Set(f.ctx, zk.ClaimMessageVersionV2, versionKey))
+	resp, err = keeper.NewQueryServerImpl(f.keeper).VerifierStatus(f.ctx, &types.QueryVerifierStatusRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.VersionVkFingerprint{{
+		MessageVersion: zk.ClaimMessageVersionV2,
+		VkFingerprint:  zk.VerifyingKeyFingerprint(versionKey)[:2*zk.VKFingerprintSize],
+	}}, resp.VersionVkFingerprints)
+}
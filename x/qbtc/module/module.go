@@ -88,6 +88,17 @@ func (am AppModule) RegisterServices(registrar grpc.ServiceRegistrar) error {
 	types.RegisterMsgServer(registrar, keeper.NewMsgServerImpl(am.keeper))
 	types.RegisterQueryServer(registrar, keeper.NewQueryServerImpl(am.keeper))
 
+	// The module manager passes its configurator, which also registers the
+	// store migrations run by upgrades. Without it an upgrade would skip them.
+	cfg, ok := registrar.(module.Configurator)
+	if !ok {
+		return fmt.Errorf("%s services must be registered with a module configurator, got %T", types.ModuleName, registrar)
+	}
+	m := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		return fmt.Errorf("failed to register %s migration from version 1 to 2: %w", types.ModuleName, err)
+	}
+
 	return nil
 }
 
@@ -144,7 +155,7 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, _ codec.JSONCodec) json.RawMe
 // ConsensusVersion is a sequence number for state-breaking change of the module.
 // It should be incremented on each consensus-breaking change introduced by the module.
 // To avoid wrong/empty versions, the initial version should be set to 1.
-func (AppModule) ConsensusVersion() uint64 { return 1 }
+func (AppModule) ConsensusVersion() uint64 { return 2 }
 
 // BeginBlock contains the logic that is automatically triggered at the beginning of each block.
 // The begin block implementation is optional.
@@ -168,5 +179,18 @@ func (am AppModule) EndBlock(ctx context.Context) error {
 		return err
 	}
 
+	// Prune fully claimed UTXOs past the retention window
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if err := am.keeper.PruneClaimedUTXOs(sdkCtx); err != nil {
+		sdkCtx.Logger().Error("failed to prune claimed UTXOs", "error", err)
+	}
+
+	// Derive the state added by store migrations for the UTXOs stored before
+	if err := am.keeper.BackfillUTXOs(sdkCtx); err != nil {
+		sdkCtx.Logger().Error("failed to backfill UTXOs", "error", err)
+	}
+
+	am.keeper.EmitUTXOTelemetry(sdkCtx)
+
 	return nil
 }
@@ -148,6 +148,12 @@ func (ul *UtxoLoader) LoadUtxosFromChunkFile(ctx sdk.Context, k *keeper.Keeper,
 	}
 	defer f.Close()
 	bufReader := bufio.NewReader(f)
+	// count the stats in memory and write them once; writing them per UTXO
+	// would double the store operations of the load
+	stats, err := k.GetUTXOStats(ctx)
+	if err != nil {
+		return err
+	}
 	for {
 		utxoBytes, err := ul.readUtxo(bufReader)
 		if err != nil {
@@ -164,11 +170,11 @@ func (ul *UtxoLoader) LoadUtxosFromChunkFile(ctx sdk.Context, k *keeper.Keeper,
 		if utxo.Txid == "4a5e1e4baab89f3a32518a88c31bc87f618f76673e2cc77ab2127b7afdeda33b" {
 			utxo.EntitledAmount = 0
 		}
-		err = k.Utxoes.Set(ctx, utxo.GetKey(), utxo)
+		err = k.LoadUTXO(ctx, utxo, &stats)
 		if err != nil {
 			return err
 		}
 	}
 
-	return nil
+	return k.UTXOStats.Set(ctx, stats)
 }
@@ -0,0 +1,88 @@
+package testutil
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+
+	"github.com/btcq-org/qbtc/x/qbtc/types"
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/stretchr/testify/require"
+)
+
+// SealBlock makes a verbose block built by a test pass the block content
+// check, and returns its JSON as bifrost reports it. The raw hex, txid and
+// hash of each transaction are rebuilt from its verbose fields, then the
+// merkle root and the hash of the block are filled in. Blocks without bits
+// get the difficulty of the genesis block. A transaction spending another one
+// of the block must refer to it by the txid it is sealed with.
+func SealBlock(t testing.TB, block *btcjson.GetBlockVerboseTxResult) []byte {
+	t.Helper()
+	txids := make([]string, len(block.Tx))
+	for i := range block.Tx {
+		tx := &block.Tx[i]
+		msgTx := rawTxFromVerbose(t, *tx)
+		var buf bytes.Buffer
+		require.NoError(t, msgTx.Serialize(&buf))
+		tx.Hex = hex.EncodeToString(buf.Bytes())
+		tx.Txid = msgTx.TxHash().String()
+		tx.Hash = msgTx.WitnessHash().String()
+		txids[i] = tx.Txid
+	}
+	root, _, err := types.BlockMerkleRoot(txids)
+	require.NoError(t, err)
+	block.MerkleRoot = root
+	if block.Bits == "" {
+		block.Bits = "1d00ffff"
+	}
+	block.Hash, err = types.BlockHeaderHash(*block)
+	require.NoError(t, err)
+
+	content, err := json.Marshal(block)
+	require.NoError(t, err)
+	return content
+}
+
+// rawTxFromVerbose builds the raw transaction the verbose fields of tx
+// describe.
+func rawTxFromVerbose(t testing.TB, tx btcjson.TxRawResult) *wire.MsgTx {
+	t.Helper()
+	msgTx := wire.NewMsgTx(int32(tx.Version))
+	msgTx.LockTime = tx.LockTime
+	for _, in := range tx.Vin {
+		var txIn *wire.TxIn
+		if in.IsCoinBase() {
+			script, err := hex.DecodeString(in.Coinbase)
+			require.NoError(t, err)
+			txIn = wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{}, wire.MaxPrevOutIndex), script, nil)
+		} else {
+			prev, err := chainhash.NewHashFromStr(in.Txid)
+			require.NoError(t, err)
+			var script []byte
+			if in.ScriptSig != nil {
+				script, err = hex.DecodeString(in.ScriptSig.Hex)
+				require.NoError(t, err)
+			}
+			txIn = wire.NewTxIn(wire.NewOutPoint(prev, in.Vout), script, nil)
+		}
+		for _, item := range in.Witness {
+			data, err := hex.DecodeString(item)
+			require.NoError(t, err)
+			txIn.Witness = append(txIn.Witness, data)
+		}
+		txIn.Sequence = in.Sequence
+		msgTx.AddTxIn(txIn)
+	}
+	for _, out := range tx.Vout {
+		amount, err := btcutil.NewAmount(out.Value)
+		require.NoError(t, err)
+		script, err := hex.DecodeString(out.ScriptPubKey.Hex)
+		require.NoError(t, err)
+		msgTx.AddTxOut(wire.NewTxOut(int64(amount), script))
+	}
+	return msgTx
+}
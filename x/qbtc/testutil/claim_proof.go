@@ -0,0 +1,46 @@
+package testutil
+
+import (
+	"testing"
+
+	"github.com/btcq-org/qbtc/x/qbtc/zk"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	"github.com/stretchr/testify/require"
+)
+
+// GenerateClaimProof proves that privKey signed the default claim message
+// binding its address to claimer on chainID. It returns the proof bytes and
+// the public inputs a MsgClaimWithProof must declare for it.
+func GenerateClaimProof(t testing.TB, prover *zk.Prover, privKey *btcec.PrivateKey, claimer, chainID string) ([]byte, zk.VerificationParams) {
+	t.Helper()
+	return GenerateClaimProofForParams(t, prover, privKey, zk.VerificationParams{
+		QBTCAddressHash: zk.HashBTCQAddress(claimer),
+		ChainID:         zk.ComputeChainIDHash(chainID),
+		FullChainIDHash: zk.ComputeFullChainIDHash(chainID),
+	})
+}
+
+// GenerateClaimProofForParams is GenerateClaimProof for any claim message
+// version and signature scheme. params names the version, scheme and the
+// fields the version binds (address type, UTXO set commitment, expiry
+// height); the address hash and message hash are filled in from privKey.
+func GenerateClaimProofForParams(t testing.TB, prover *zk.Prover, privKey *btcec.PrivateKey, params zk.VerificationParams) ([]byte, zk.VerificationParams) {
+	t.Helper()
+	pubKey := privKey.PubKey().SerializeCompressed()
+
+	var err error
+	params.AddressHash, err = zk.PublicKeyToAddressHash(pubKey)
+	require.NoError(t, err)
+	params.MessageHash, err = zk.ComputeSignedMessageForParams(params)
+	require.NoError(t, err)
+
+	// header || R || S, so the scalars need no DER parsing
+	compact := ecdsa.SignCompact(privKey, params.MessageHash[:], true)
+	proofParams, err := zk.ProofParamsFromSignature(compact[1:33], compact[33:65], pubKey, params)
+	require.NoError(t, err)
+
+	proof, err := prover.GenerateProof(proofParams)
+	require.NoError(t, err, "proof generation should succeed")
+	return proof, params
+}
@@ -0,0 +1,130 @@
+package types
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"strings"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+// BlockContentCodec identifies how the BlockContent of a block report is
+// compressed.
+//
+// gzip content is sent unframed, exactly as before codecs were introduced, so
+// existing attestations and older nodes keep working. Every other codec is
+// framed with its codec byte in front of the compressed payload. A gzip stream
+// always starts with 0x1f 0x8b, which never collides with a codec byte.
+type BlockContentCodec byte
+
+const (
+	BlockContentCodecGzip BlockContentCodec = 0
+	BlockContentCodecZstd BlockContentCodec = 1
+)
+
+// maxDecodedBlockContentSize bounds the memory a single block report may
+// expand to, whichever codec it uses. Verbose block JSON for a full 4 MB block
+// stays well below it.
+const maxDecodedBlockContentSize = 256 << 20
+
+var gzipMagic = []byte{0x1f, 0x8b}
+
+func (c BlockContentCodec) String() string {
+	switch c {
+	case BlockContentCodecGzip:
+		return "gzip"
+	case BlockContentCodecZstd:
+		return "zstd"
+	default:
+		return fmt.Sprintf("unknown(%d)", byte(c))
+	}
+}
+
+// ParseBlockContentCodec parses a codec name as used in the bifrost config.
+// An empty name selects gzip.
+func ParseBlockContentCodec(name string) (BlockContentCodec, error) {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "", "gzip":
+		return BlockContentCodecGzip, nil
+	case "zstd":
+		return BlockContentCodecZstd, nil
+	default:
+		return 0, fmt.Errorf("unknown block content codec %q, expected gzip or zstd", name)
+	}
+}
+
+// CompressBlockContent compresses raw block content with the given codec.
+// The output is deterministic, so validators attesting to the same block sign
+// the same bytes as long as they use the same codec.
+func CompressBlockContent(data []byte, codec BlockContentCodec) ([]byte, error) {
+	switch codec {
+	case BlockContentCodecGzip:
+		return GzipDeterministic(data, gzip.BestCompression)
+	case BlockContentCodecZstd:
+		compressed, err := ZstdDeterministic(data)
+		if err != nil {
+			return nil, err
+		}
+		return append([]byte{byte(codec)}, compressed...), nil
+	default:
+		return nil, fmt.Errorf("unsupported block content codec %s", codec)
+	}
+}
+
+// DecompressBlockContent returns the raw content of a block report, whichever
+// codec it was compressed with.
+func DecompressBlockContent(data []byte) ([]byte, error) {
+	if len(data) == 0 {
+		return data, nil
+	}
+	if bytes.HasPrefix(data, gzipMagic) {
+		return GzipUnzip(data)
+	}
+	switch codec := BlockContentCodec(data[0]); codec {
+	case BlockContentCodecGzip:
+		return GzipUnzip(data[1:])
+	case BlockContentCodecZstd:
+		return ZstdUnzip(data[1:])
+	default:
+		return nil, fmt.Errorf("unsupported block content codec %s", codec)
+	}
+}
+
+// ZstdDeterministic compresses data into a single zstd frame.
+//
+// The encoder runs single-threaded with a fixed level and a checksum, which
+// makes the output a pure function of the input for a given version of the
+// zstd package. Bump that dependency in lockstep across validators.
+func ZstdDeterministic(data []byte) ([]byte, error) {
+	enc, err := zstd.NewWriter(nil,
+		zstd.WithEncoderConcurrency(1),
+		zstd.WithEncoderLevel(zstd.SpeedBestCompression),
+		zstd.WithEncoderCRC(true),
+		zstd.WithSingleSegment(true),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = enc.Close()
+	}()
+	return enc.EncodeAll(data, nil), nil
+}
+
+// ZstdUnzip decompresses zstd-compressed bytes and returns raw bytes.
+func ZstdUnzip(data []byte) ([]byte, error) {
+	dec, err := zstd.NewReader(nil,
+		zstd.WithDecoderConcurrency(1),
+		zstd.WithDecoderMaxMemory(maxDecodedBlockContentSize),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("zstd new reader: %w", err)
+	}
+	defer dec.Close()
+	out, err := dec.DecodeAll(data, nil)
+	if err != nil {
+		return nil, fmt.Errorf("zstd read: %w", err)
+	}
+	return out, nil
+}
@@ -0,0 +1,93 @@
+package types
+
+import (
+	"bytes"
+	"compress/gzip"
+	"strings"
+	"testing"
+)
+
+func TestZstdDeterministic_SameOutputAndRoundTrip(t *testing.T) {
+	data := []byte(strings.Repeat(`{"txid":"aa11","vout":[{"value":1.5}]}`, 64))
+
+	a, err := ZstdDeterministic(data)
+	if err != nil {
+		t.Fatalf("first compress returned error: %v", err)
+	}
+	b, err := ZstdDeterministic(data)
+	if err != nil {
+		t.Fatalf("second compress returned error: %v", err)
+	}
+	if !bytes.Equal(a, b) {
+		t.Fatalf("outputs differ for same input: len(a)=%d len(b)=%d", len(a), len(b))
+	}
+	if len(a) >= len(data) {
+		t.Fatalf("zstd did not compress: %d >= %d", len(a), len(data))
+	}
+
+	out, err := ZstdUnzip(a)
+	if err != nil {
+		t.Fatalf("ZstdUnzip failed: %v", err)
+	}
+	if !bytes.Equal(out, data) {
+		t.Fatalf("round-trip mismatch")
+	}
+}
+
+func TestCompressBlockContent_Framing(t *testing.T) {
+	data := []byte("example block content for codec framing")
+
+	// gzip stays unframed so it matches what bifrost has always signed
+	gz, err := CompressBlockContent(data, BlockContentCodecGzip)
+	if err != nil {
+		t.Fatalf("gzip compress returned error: %v", err)
+	}
+	legacy, err := GzipDeterministic(data, gzip.BestCompression)
+	if err != nil {
+		t.Fatalf("GzipDeterministic returned error: %v", err)
+	}
+	if !bytes.Equal(gz, legacy) {
+		t.Fatalf("gzip content is not the legacy encoding")
+	}
+
+	zs, err := CompressBlockContent(data, BlockContentCodecZstd)
+	if err != nil {
+		t.Fatalf("zstd compress returned error: %v", err)
+	}
+	if zs[0] != byte(BlockContentCodecZstd) {
+		t.Fatalf("zstd content starts with %#x, want the codec byte", zs[0])
+	}
+
+	framedGzip := append([]byte{byte(BlockContentCodecGzip)}, legacy...)
+	for name, content := range map[string][]byte{"gzip": gz, "framed gzip": framedGzip, "zstd": zs} {
+		out, err := DecompressBlockContent(content)
+		if err != nil {
+			t.Fatalf("%s: DecompressBlockContent failed: %v", name, err)
+		}
+		if !bytes.Equal(out, data) {
+			t.Fatalf("%s: round-trip mismatch", name)
+		}
+	}
+
+	if _, err := DecompressBlockContent([]byte{0x7f, 0x00}); err == nil || !strings.Contains(err.Error(), "unsupported block content codec") {
+		t.Fatalf("expected unsupported codec error, got %v", err)
+	}
+	if _, err := CompressBlockContent(data, BlockContentCodec(9)); err == nil {
+		t.Fatalf("expected error for unknown codec")
+	}
+}
+
+func TestParseBlockContentCodec(t *testing.T) {
+	for name, want := range map[string]BlockContentCodec{"": BlockContentCodecGzip, "gzip": BlockContentCodecGzip, "ZSTD": BlockContentCodecZstd} {
+		got, err := ParseBlockContentCodec(name)
+		if err != nil {
+			t.Fatalf("%q: unexpected error: %v", name, err)
+		}
+		if got != want {
+			t.Fatalf("%q: got %s, want %s", name, got, want)
+		}
+	}
+	if _, err := ParseBlockContentCodec("brotli"); err == nil {
+		t.Fatalf("expected error for unknown codec")
+	}
+}
@@ -0,0 +1,188 @@
+package types
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+)
+
+// BlockMerkleRoot computes the merkle root of a block from the txids of its
+// transactions, in block order and in the byte-reversed hex bitcoind reports.
+// The root is returned in the same form as the merkleroot field of a block.
+//
+// A tree where two equal hashes are paired is reported as mutated: a block
+// whose transactions end in a duplicated run hashes to the same root as the
+// block without it (CVE-2012-2459), so the root does not bind the list.
+func BlockMerkleRoot(txids []string) (root string, mutated bool, err error) {
+	if len(txids) == 0 {
+		return "", false, fmt.Errorf("block has no transactions")
+	}
+	level := make([]chainhash.Hash, len(txids))
+	for i, txid := range txids {
+		hash, err := parseHash("txid", txid)
+		if err != nil {
+			return "", false, err
+		}
+		level[i] = hash
+	}
+
+	var buf [chainhash.HashSize * 2]byte
+	for len(level) > 1 {
+		next := make([]chainhash.Hash, 0, (len(level)+1)/2)
+		for i := 0; i < len(level); i += 2 {
+			right := level[i]
+			if i+1 < len(level) {
+				right = level[i+1]
+				if right == level[i] {
+					mutated = true
+				}
+			}
+			copy(buf[:chainhash.HashSize], level[i][:])
+			copy(buf[chainhash.HashSize:], right[:])
+			next = append(next, chainhash.DoubleHashH(buf[:]))
+		}
+		level = next
+	}
+	return level[0].String(), mutated, nil
+}
+
+// VerifyBlockMerkleRoot checks that the txids hash into merkleRoot, so the
+// transactions of a reported block are the ones its header commits to.
+func VerifyBlockMerkleRoot(merkleRoot string, txids []string) error {
+	root, mutated, err := BlockMerkleRoot(txids)
+	if err != nil {
+		return err
+	}
+	if mutated {
+		return fmt.Errorf("block transactions form a mutated merkle tree")
+	}
+	if !strings.EqualFold(root, merkleRoot) {
+		return fmt.Errorf("merkle root mismatch: transactions hash to %s, header has %s", root, merkleRoot)
+	}
+	return nil
+}
+
+// VerifyBlockContent checks that a verbose block is the block with the given
+// hash, so a report can't alter the content of the block it attests. Its
+// header fields must hash to hash, and the transactions decoded from their raw
+// hex must hash into its merkle root. The verbose fields the block processing
+// reads must match the raw transactions: the txid, the outpoints the inputs
+// spend, and the script and amount of the outputs. amounts are the output
+// amounts of the block, indexed like block.Tx and tx.Vout.
+func VerifyBlockContent(hash string, block btcjson.GetBlockVerboseTxResult, amounts [][]Satoshi) error {
+	headerHash, err := BlockHeaderHash(block)
+	if err != nil {
+		return err
+	}
+	if !strings.EqualFold(headerHash, hash) {
+		return fmt.Errorf("block hash mismatch: header hashes to %s, reported %s", headerHash, hash)
+	}
+	if len(amounts) != len(block.Tx) {
+		return fmt.Errorf("block has %d transactions, got amounts for %d", len(block.Tx), len(amounts))
+	}
+	txids := make([]string, len(block.Tx))
+	for i, tx := range block.Tx {
+		txid, err := verifyRawTx(tx, amounts[i])
+		if err != nil {
+			return fmt.Errorf("transaction %d (%s): %w", i, tx.Txid, err)
+		}
+		txids[i] = txid
+	}
+	return VerifyBlockMerkleRoot(block.MerkleRoot, txids)
+}
+
+// BlockHeaderHash returns the hash of the 80-byte header built from the
+// header fields of a verbose block, in the byte-reversed hex bitcoind reports.
+func BlockHeaderHash(block btcjson.GetBlockVerboseTxResult) (string, error) {
+	header := wire.BlockHeader{
+		Version:   block.Version,
+		Timestamp: time.Unix(block.Time, 0),
+		Nonce:     block.Nonce,
+	}
+	// only the genesis block has no previous block
+	if block.PreviousHash != "" {
+		prev, err := parseHash("previous block hash", block.PreviousHash)
+		if err != nil {
+			return "", err
+		}
+		header.PrevBlock = prev
+	}
+	merkleRoot, err := parseHash("merkle root", block.MerkleRoot)
+	if err != nil {
+		return "", err
+	}
+	header.MerkleRoot = merkleRoot
+	bits, err := strconv.ParseUint(block.Bits, 16, 32)
+	if err != nil {
+		return "", fmt.Errorf("invalid bits %q: %w", block.Bits, err)
+	}
+	header.Bits = uint32(bits)
+	return header.BlockHash().String(), nil
+}
+
+// verifyRawTx checks that the verbose fields of tx match its raw hex and
+// returns the txid the raw transaction hashes to.
+func verifyRawTx(tx btcjson.TxRawResult, amounts []Satoshi) (string, error) {
+	raw, err := hex.DecodeString(tx.Hex)
+	if err != nil {
+		return "", fmt.Errorf("invalid raw transaction hex: %w", err)
+	}
+	var msgTx wire.MsgTx
+	if err := msgTx.Deserialize(bytes.NewReader(raw)); err != nil {
+		return "", fmt.Errorf("invalid raw transaction: %w", err)
+	}
+	txid := msgTx.TxHash().String()
+	if !strings.EqualFold(txid, tx.Txid) {
+		return "", fmt.Errorf("raw transaction hashes to txid %s", txid)
+	}
+
+	if len(tx.Vin) != len(msgTx.TxIn) {
+		return "", fmt.Errorf("%d inputs, raw transaction has %d", len(tx.Vin), len(msgTx.TxIn))
+	}
+	for j, in := range tx.Vin {
+		prev := msgTx.TxIn[j].PreviousOutPoint
+		isCoinbase := prev.Index == wire.MaxPrevOutIndex && prev.Hash == (chainhash.Hash{})
+		if in.IsCoinBase() != isCoinbase {
+			return "", fmt.Errorf("input %d: coinbase does not match the raw transaction", j)
+		}
+		if !isCoinbase && (!strings.EqualFold(in.Txid, prev.Hash.String()) || in.Vout != prev.Index) {
+			return "", fmt.Errorf("input %d: raw transaction spends %s", j, prev)
+		}
+	}
+
+	if len(tx.Vout) != len(msgTx.TxOut) || len(amounts) != len(msgTx.TxOut) {
+		return "", fmt.Errorf("%d outputs, raw transaction has %d", len(tx.Vout), len(msgTx.TxOut))
+	}
+	for j, out := range tx.Vout {
+		txOut := msgTx.TxOut[j]
+		if out.N != uint32(j) {
+			return "", fmt.Errorf("output %d is numbered %d", j, out.N)
+		}
+		if !strings.EqualFold(out.ScriptPubKey.Hex, hex.EncodeToString(txOut.PkScript)) {
+			return "", fmt.Errorf("output %d: script does not match the raw transaction", j)
+		}
+		if txOut.Value < 0 || amounts[j].Uint64() != uint64(txOut.Value) {
+			return "", fmt.Errorf("output %d: amount %d does not match the raw transaction amount %d", j, amounts[j].Uint64(), txOut.Value)
+		}
+	}
+	return txid, nil
+}
+
+// parseHash parses a hash in the byte-reversed hex bitcoind reports.
+func parseHash(name, s string) (chainhash.Hash, error) {
+	if len(s) != chainhash.MaxHashStringSize {
+		return chainhash.Hash{}, fmt.Errorf("invalid %s %q: expected %d hex characters", name, s, chainhash.MaxHashStringSize)
+	}
+	hash, err := chainhash.NewHashFromStr(s)
+	if err != nil {
+		return chainhash.Hash{}, fmt.Errorf("invalid %s %q: %w", name, s, err)
+	}
+	return *hash, nil
+}
@@ -0,0 +1,122 @@
+package types
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyBlockMerkleRoot(t *testing.T) {
+	content, err := os.ReadFile("../../../testdata/block/300003.json")
+	require.NoError(t, err)
+	var block struct {
+		MerkleRoot string `json:"merkleroot"`
+		Tx         []struct {
+			Txid string `json:"txid"`
+		} `json:"tx"`
+	}
+	require.NoError(t, json.Unmarshal(content, &block))
+	txids := make([]string, len(block.Tx))
+	for i, tx := range block.Tx {
+		txids[i] = tx.Txid
+	}
+	// an odd number of transactions, so the last hash is paired with itself
+	require.Len(t, txids, 173)
+	require.NoError(t, VerifyBlockMerkleRoot(block.MerkleRoot, txids))
+
+	// omitted, injected and reordered transactions
+	require.ErrorContains(t, VerifyBlockMerkleRoot(block.MerkleRoot, txids[:172]), "merkle root mismatch")
+	injected := append(append([]string{}, txids...), "1111111111111111111111111111111111111111111111111111111111111111")
+	require.ErrorContains(t, VerifyBlockMerkleRoot(block.MerkleRoot, injected), "merkle root mismatch")
+	reordered := append([]string{}, txids...)
+	reordered[1], reordered[2] = reordered[2], reordered[1]
+	require.ErrorContains(t, VerifyBlockMerkleRoot(block.MerkleRoot, reordered), "merkle root mismatch")
+
+	// repeating the last transaction keeps the root but is caught as mutated
+	duplicated := append(append([]string{}, txids...), txids[172])
+	root, mutated, err := BlockMerkleRoot(duplicated)
+	require.NoError(t, err)
+	require.True(t, mutated)
+	require.Equal(t, block.MerkleRoot, root)
+	require.ErrorContains(t, VerifyBlockMerkleRoot(block.MerkleRoot, duplicated), "mutated merkle tree")
+}
+
+func TestBlockMerkleRoot_SingleTransaction(t *testing.T) {
+	const txid = "4a5e1e4baab89f3a32518a88c31bc87f618f76673e2cc77ab2127b7afdeda33b"
+	root, mutated, err := BlockMerkleRoot([]string{txid})
+	require.NoError(t, err)
+	require.False(t, mutated)
+	require.Equal(t, txid, root)
+}
+
+func TestBlockMerkleRoot_InvalidTxids(t *testing.T) {
+	_, _, err := BlockMerkleRoot(nil)
+	require.ErrorContains(t, err, "no transactions")
+	_, _, err = BlockMerkleRoot([]string{"abcd"})
+	require.ErrorContains(t, err, "invalid txid")
+	_, _, err = BlockMerkleRoot([]string{"zz5e1e4baab89f3a32518a88c31bc87f618f76673e2cc77ab2127b7afdeda33b"})
+	require.ErrorContains(t, err, "invalid txid")
+}
+
+func TestVerifyBlockContent(t *testing.T) {
+	content, err := os.ReadFile("../../../testdata/block/300003.json")
+	require.NoError(t, err)
+	var block btcjson.GetBlockVerboseTxResult
+	require.NoError(t, json.Unmarshal(content, &block))
+	var values struct {
+		Tx []struct {
+			Vout []struct {
+				Value json.Number `json:"value"`
+			} `json:"vout"`
+		} `json:"tx"`
+	}
+	require.NoError(t, json.Unmarshal(content, &values))
+	amounts := make([][]Satoshi, len(values.Tx))
+	for i, tx := range values.Tx {
+		for _, out := range tx.Vout {
+			amount, err := FromBTCString(out.Value.String())
+			require.NoError(t, err)
+			amounts[i] = append(amounts[i], amount)
+		}
+	}
+	const hash = "000000000000000082aee4ff546c1db5e1aa5f9bfbaa0c76300a792b3e91fce7"
+	require.NoError(t, VerifyBlockContent(hash, block, amounts))
+
+	// the header of another block
+	require.ErrorContains(t, VerifyBlockContent(block.PreviousHash, block, amounts), "block hash mismatch")
+	other := block
+	other.Nonce++
+	require.ErrorContains(t, VerifyBlockContent(hash, other, amounts), "block hash mismatch")
+
+	// verbose fields that differ from the raw transaction
+	tamper := func(modify func(tx *btcjson.TxRawResult)) btcjson.GetBlockVerboseTxResult {
+		tampered := block
+		tampered.Tx = append([]btcjson.TxRawResult{}, block.Tx...)
+		tx := tampered.Tx[1]
+		tx.Vin = append([]btcjson.Vin{}, tx.Vin...)
+		tx.Vout = append([]btcjson.Vout{}, tx.Vout...)
+		modify(&tx)
+		tampered.Tx[1] = tx
+		return tampered
+	}
+	require.ErrorContains(t, VerifyBlockContent(hash, tamper(func(tx *btcjson.TxRawResult) {
+		tx.Txid = block.Tx[2].Txid
+	}), amounts), "raw transaction hashes to txid")
+	require.ErrorContains(t, VerifyBlockContent(hash, tamper(func(tx *btcjson.TxRawResult) {
+		tx.Vin[0].Vout++
+	}), amounts), "input 0: raw transaction spends")
+	require.ErrorContains(t, VerifyBlockContent(hash, tamper(func(tx *btcjson.TxRawResult) {
+		tx.Vout[0].ScriptPubKey.Hex = block.Tx[2].Vout[0].ScriptPubKey.Hex
+	}), amounts), "output 0: script does not match")
+	require.ErrorContains(t, VerifyBlockContent(hash, tamper(func(tx *btcjson.TxRawResult) {
+		tx.Vout = tx.Vout[:len(tx.Vout)-1]
+	}), amounts), "outputs, raw transaction has")
+
+	inflated := append([][]Satoshi{}, amounts...)
+	inflated[1] = append([]Satoshi{}, amounts[1]...)
+	inflated[1][0]++
+	require.ErrorContains(t, VerifyBlockContent(hash, block, inflated), "output 0: amount")
+}
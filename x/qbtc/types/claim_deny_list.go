@@ -0,0 +1,24 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ValidateClaimDenyList checks that every entry is a valid account address and
+// that none is listed twice.
+func ValidateClaimDenyList(addresses []string) error {
+	seen := make(map[string]bool, len(addresses))
+	for _, address := range addresses {
+		acc, err := sdk.AccAddressFromBech32(address)
+		if err != nil {
+			return fmt.Errorf("invalid address %q: %w", address, err)
+		}
+		if seen[acc.String()] {
+			return fmt.Errorf("duplicate address %s", address)
+		}
+		seen[acc.String()] = true
+	}
+	return nil
+}
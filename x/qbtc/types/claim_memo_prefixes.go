@@ -0,0 +1,48 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DefaultClaimMemoPrefix is the claim memo prefix used until governance sets
+// the accepted prefixes.
+const DefaultClaimMemoPrefix = "claim:"
+
+// MaxClaimMemoPrefixLength bounds a claim memo prefix, so the prefix and a
+// qbtc address still fit in an 80-byte OP_RETURN output.
+const MaxClaimMemoPrefixLength = 16
+
+// DefaultClaimMemoPrefixes returns the claim memo prefixes accepted by default.
+func DefaultClaimMemoPrefixes() []string {
+	return []string{DefaultClaimMemoPrefix}
+}
+
+// ValidateClaimMemoPrefixes checks that the set of claim memo prefixes is
+// usable. Prefixes are matched case-insensitively, so two prefixes that only
+// differ in case are duplicates.
+func ValidateClaimMemoPrefixes(prefixes []string) error {
+	if len(prefixes) == 0 {
+		return fmt.Errorf("at least one claim memo prefix is required")
+	}
+	seen := make(map[string]bool, len(prefixes))
+	for _, prefix := range prefixes {
+		if prefix == "" {
+			return fmt.Errorf("claim memo prefix cannot be empty")
+		}
+		if len(prefix) > MaxClaimMemoPrefixLength {
+			return fmt.Errorf("claim memo prefix %q is longer than %d bytes", prefix, MaxClaimMemoPrefixLength)
+		}
+		for _, c := range prefix {
+			if c <= ' ' || c > '~' {
+				return fmt.Errorf("claim memo prefix %q must be printable ASCII without spaces", prefix)
+			}
+		}
+		key := strings.ToLower(prefix)
+		if seen[key] {
+			return fmt.Errorf("duplicate claim memo prefix %q", prefix)
+		}
+		seen[key] = true
+	}
+	return nil
+}
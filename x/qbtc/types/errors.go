@@ -8,5 +8,18 @@ import (
 
 // x/qbtc module sentinel errors
 var (
-	ErrInvalidSigner = errors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrInvalidSigner                = errors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrInsufficientAttestationPower = errors.Register(ModuleName, 1101, "insufficient attestation power")
+	ErrClaimDenied                  = errors.Register(ModuleName, 1102, "address is on the claim deny list")
+	ErrClaimCooldown                = errors.Register(ModuleName, 1103, "claim cooldown has not elapsed")
+	ErrValidatorNotFound            = errors.Register(ModuleName, 1104, "validator not found")
+	ErrValidatorJailed              = errors.Register(ModuleName, 1105, "validator is jailed")
+	ErrValidatorNotBonded           = errors.Register(ModuleName, 1106, "validator is not bonded")
+	ErrVerifyingKeyMismatch         = errors.Register(ModuleName, 1107, "proof was generated against a different verifying key")
+	ErrFeePayerAccountNotFound      = errors.Register(ModuleName, 1108, "fee payer account does not exist")
+	ErrNoClaimableUTXOs             = errors.Register(ModuleName, 1109, "no claimable UTXOs")
+	ErrClaimsNotEnabled             = errors.Register(ModuleName, 1110, "claims are not yet enabled; ZK setup not finalized")
+	ErrClaimExpired                 = errors.Register(ModuleName, 1111, "claim has expired")
+	ErrNoUTXOsSpecified             = errors.Register(ModuleName, 1112, "no UTXOs specified")
+	ErrUTXOBackfillRunning          = errors.Register(ModuleName, 1113, "UTXO backfill is running")
 )
@@ -0,0 +1,768 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: qbtc/qbtc/v1/event_claim_succeeded.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EventClaimSucceeded is emitted by MsgClaimWithProof for every claim that
+// releases at least one UTXO, giving indexers a stable schema for claims.
+type EventClaimSucceeded struct {
+	// The address that signed the claim transaction
+	Claimer string `protobuf:"bytes,1,opt,name=claimer,proto3" json:"claimer,omitempty"`
+	// The address that received the claimed tokens: the destination if one was
+	// set, the claimer otherwise
+	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// The Bitcoin address the proof is for
+	BtcAddress string `protobuf:"bytes,3,opt,name=btc_address,json=btcAddress,proto3" json:"btc_address,omitempty"`
+	// Hex-encoded hash the address commits to, the Hash160 of the key for
+	// single-key address types, the witness program for P2WSH
+	BtcAddressHash string `protobuf:"bytes,4,opt,name=btc_address_hash,json=btcAddressHash,proto3" json:"btc_address_hash,omitempty"`
+	// The total amount minted to the recipient
+	Amount uint64 `protobuf:"varint,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	// The number of UTXOs released
+	UtxosClaimed uint32 `protobuf:"varint,6,opt,name=utxos_claimed,json=utxosClaimed,proto3" json:"utxos_claimed,omitempty"`
+	// The number of UTXOs skipped, broken down in skip_reasons
+	UtxosSkipped uint32            `protobuf:"varint,7,opt,name=utxos_skipped,json=utxosSkipped,proto3" json:"utxos_skipped,omitempty"`
+	SkipReasons  []ClaimSkipReason `protobuf:"bytes,8,rep,name=skip_reasons,json=skipReasons,proto3" json:"skip_reasons"`
+	// The circuit the proof was verified against, e.g. "ecdsa"
+	CircuitType string `protobuf:"bytes,9,opt,name=circuit_type,json=circuitType,proto3" json:"circuit_type,omitempty"`
+	// The claim message version the proof was generated for
+	MessageVersion string `protobuf:"bytes,10,opt,name=message_version,json=messageVersion,proto3" json:"message_version,omitempty"`
+}
+
+func (m *EventClaimSucceeded) Reset()         { *m = EventClaimSucceeded{} }
+func (m *EventClaimSucceeded) String() string { return proto.CompactTextString(m) }
+func (*EventClaimSucceeded) ProtoMessage()    {}
+func (*EventClaimSucceeded) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a655b603b38f555, []int{0}
+}
+func (m *EventClaimSucceeded) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventClaimSucceeded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventClaimSucceeded.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventClaimSucceeded) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventClaimSucceeded.Merge(m, src)
+}
+func (m *EventClaimSucceeded) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventClaimSucceeded) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventClaimSucceeded.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventClaimSucceeded proto.InternalMessageInfo
+
+func (m *EventClaimSucceeded) GetClaimer() string {
+	if m != nil {
+		return m.Claimer
+	}
+	return ""
+}
+
+func (m *EventClaimSucceeded) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *EventClaimSucceeded) GetBtcAddress() string {
+	if m != nil {
+		return m.BtcAddress
+	}
+	return ""
+}
+
+func (m *EventClaimSucceeded) GetBtcAddressHash() string {
+	if m != nil {
+		return m.BtcAddressHash
+	}
+	return ""
+}
+
+func (m *EventClaimSucceeded) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *EventClaimSucceeded) GetUtxosClaimed() uint32 {
+	if m != nil {
+		return m.UtxosClaimed
+	}
+	return 0
+}
+
+func (m *EventClaimSucceeded) GetUtxosSkipped() uint32 {
+	if m != nil {
+		return m.UtxosSkipped
+	}
+	return 0
+}
+
+func (m *EventClaimSucceeded) GetSkipReasons() []ClaimSkipReason {
+	if m != nil {
+		return m.SkipReasons
+	}
+	return nil
+}
+
+func (m *EventClaimSucceeded) GetCircuitType() string {
+	if m != nil {
+		return m.CircuitType
+	}
+	return ""
+}
+
+func (m *EventClaimSucceeded) GetMessageVersion() string {
+	if m != nil {
+		return m.MessageVersion
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*EventClaimSucceeded)(nil), "qbtc.qbtc.v1.EventClaimSucceeded")
+}
+
+func init() {
+	proto.RegisterFile("qbtc/qbtc/v1/event_claim_succeeded.proto", fileDescriptor_7a655b603b38f555)
+}
+
+var fileDescriptor_7a655b603b38f555 = []byte{
+	// 402 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x91, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0xc7, 0x1b, 0x5a, 0x3a, 0xea, 0x76, 0x80, 0x0c, 0x42, 0xd6, 0x04, 0x59, 0x01, 0xa1, 0xe5,
+	0x42, 0xa2, 0xc1, 0x07, 0x40, 0x6c, 0x02, 0x71, 0xce, 0x10, 0x07, 0x2e, 0x56, 0xe2, 0x3c, 0x12,
+	0x6b, 0x24, 0xf6, 0xfc, 0x9c, 0xb0, 0x7d, 0x09, 0xc4, 0xc7, 0xda, 0x71, 0x47, 0x4e, 0x08, 0xb5,
+	0x5f, 0x04, 0xc5, 0x4e, 0x69, 0x77, 0x71, 0xe2, 0xdf, 0xff, 0x67, 0x3f, 0x3d, 0x3f, 0x12, 0x5d,
+	0xe4, 0x56, 0x24, 0x6e, 0xe9, 0x8e, 0x13, 0xe8, 0xa0, 0xb1, 0x5c, 0x7c, 0xcf, 0x64, 0xcd, 0xb1,
+	0x15, 0x02, 0xa0, 0x80, 0x22, 0xd6, 0x46, 0x59, 0x45, 0x17, 0xbd, 0x14, 0xbb, 0xa5, 0x3b, 0x3e,
+	0x78, 0x5c, 0xaa, 0x52, 0xb9, 0x20, 0xe9, 0xff, 0xbc, 0x73, 0x70, 0x74, 0xeb, 0xb6, 0x1a, 0xcb,
+	0xe1, 0xae, 0x1f, 0xd2, 0x56, 0x5c, 0x1b, 0xa5, 0xbe, 0x79, 0xf1, 0xc5, 0xcf, 0x31, 0x79, 0xf4,
+	0xa1, 0x2f, 0x76, 0xda, 0xe7, 0x67, 0x9b, 0x52, 0x94, 0x91, 0x3d, 0x77, 0x02, 0x0c, 0x0b, 0x96,
+	0x41, 0x34, 0x4b, 0x37, 0x5b, 0xfa, 0x94, 0xcc, 0x0c, 0x08, 0xa9, 0x25, 0x34, 0x96, 0xdd, 0x71,
+	0xd9, 0x16, 0xd0, 0x43, 0x32, 0xcf, 0xad, 0xe0, 0x59, 0x51, 0x18, 0x40, 0x64, 0x63, 0x97, 0x93,
+	0xdc, 0x8a, 0xf7, 0x9e, 0xd0, 0x88, 0x3c, 0xdc, 0x11, 0x78, 0x95, 0x61, 0xc5, 0x26, 0xce, 0xba,
+	0xbf, 0xb5, 0x3e, 0x65, 0x58, 0xd1, 0x27, 0x64, 0x9a, 0xd5, 0xaa, 0x6d, 0x2c, 0xbb, 0xbb, 0x0c,
+	0xa2, 0x49, 0x3a, 0xec, 0xe8, 0x4b, 0xb2, 0xdf, 0xda, 0x4b, 0x85, 0xbe, 0x25, 0x28, 0xd8, 0x74,
+	0x19, 0x44, 0xfb, 0xe9, 0xc2, 0xc1, 0x53, 0xcf, 0xb6, 0x12, 0x9e, 0x4b, 0xad, 0xa1, 0x60, 0x7b,
+	0x3b, 0xd2, 0x99, 0x67, 0xf4, 0x23, 0x59, 0xf4, 0x31, 0x37, 0x90, 0xa1, 0x6a, 0x90, 0xdd, 0x5b,
+	0x8e, 0xa3, 0xf9, 0x9b, 0x67, 0xf1, 0xee, 0x03, 0xc7, 0xfe, 0x61, 0xce, 0xa5, 0x4e, 0x9d, 0x75,
+	0x32, 0xb9, 0xfe, 0x73, 0x38, 0x4a, 0xe7, 0xf8, 0x9f, 0x20, 0x7d, 0x4e, 0x16, 0x42, 0x1a, 0xd1,
+	0x4a, 0xcb, 0xed, 0x95, 0x06, 0x36, 0x73, 0xfd, 0xcc, 0x07, 0xf6, 0xf9, 0x4a, 0x03, 0x3d, 0x22,
+	0x0f, 0x6a, 0x40, 0xcc, 0x4a, 0xe0, 0x1d, 0x18, 0x94, 0xaa, 0x61, 0xc4, 0x77, 0x3d, 0xe0, 0x2f,
+	0x9e, 0x9e, 0xbc, 0xbb, 0x5e, 0x85, 0xc1, 0xcd, 0x2a, 0x0c, 0xfe, 0xae, 0xc2, 0xe0, 0xd7, 0x3a,
+	0x1c, 0xdd, 0xac, 0xc3, 0xd1, 0xef, 0x75, 0x38, 0xfa, 0xfa, 0xaa, 0x94, 0xb6, 0x6a, 0xf3, 0x58,
+	0xa8, 0x3a, 0xc9, 0xad, 0xb8, 0x78, 0xad, 0x4c, 0xe9, 0x47, 0x7c, 0xe9, 0x3f, 0x7d, 0x6d, 0xcc,
+	0xa7, 0x6e, 0xb0, 0x6f, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0x57, 0x41, 0x59, 0xf5, 0x51, 0x02,
+	0x00, 0x00,
+}
+
+func (m *EventClaimSucceeded) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventClaimSucceeded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventClaimSucceeded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MessageVersion) > 0 {
+		i -= len(m.MessageVersion)
+		copy(dAtA[i:], m.MessageVersion)
+		i = encodeVarintEventClaimSucceeded(dAtA, i, uint64(len(m.MessageVersion)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.CircuitType) > 0 {
+		i -= len(m.CircuitType)
+		copy(dAtA[i:], m.CircuitType)
+		i = encodeVarintEventClaimSucceeded(dAtA, i, uint64(len(m.CircuitType)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.SkipReasons) > 0 {
+		for iNdEx := len(m.SkipReasons) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SkipReasons[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEventClaimSucceeded(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.UtxosSkipped != 0 {
+		i = encodeVarintEventClaimSucceeded(dAtA, i, uint64(m.UtxosSkipped))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.UtxosClaimed != 0 {
+		i = encodeVarintEventClaimSucceeded(dAtA, i, uint64(m.UtxosClaimed))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Amount != 0 {
+		i = encodeVarintEventClaimSucceeded(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.BtcAddressHash) > 0 {
+		i -= len(m.BtcAddressHash)
+		copy(dAtA[i:], m.BtcAddressHash)
+		i = encodeVarintEventClaimSucceeded(dAtA, i, uint64(len(m.BtcAddressHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.BtcAddress) > 0 {
+		i -= len(m.BtcAddress)
+		copy(dAtA[i:], m.BtcAddress)
+		i = encodeVarintEventClaimSucceeded(dAtA, i, uint64(len(m.BtcAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintEventClaimSucceeded(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Claimer) > 0 {
+		i -= len(m.Claimer)
+		copy(dAtA[i:], m.Claimer)
+		i = encodeVarintEventClaimSucceeded(dAtA, i, uint64(len(m.Claimer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEventClaimSucceeded(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEventClaimSucceeded(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventClaimSucceeded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Claimer)
+	if l > 0 {
+		n += 1 + l + sovEventClaimSucceeded(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovEventClaimSucceeded(uint64(l))
+	}
+	l = len(m.BtcAddress)
+	if l > 0 {
+		n += 1 + l + sovEventClaimSucceeded(uint64(l))
+	}
+	l = len(m.BtcAddressHash)
+	if l > 0 {
+		n += 1 + l + sovEventClaimSucceeded(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovEventClaimSucceeded(uint64(m.Amount))
+	}
+	if m.UtxosClaimed != 0 {
+		n += 1 + sovEventClaimSucceeded(uint64(m.UtxosClaimed))
+	}
+	if m.UtxosSkipped != 0 {
+		n += 1 + sovEventClaimSucceeded(uint64(m.UtxosSkipped))
+	}
+	if len(m.SkipReasons) > 0 {
+		for _, e := range m.SkipReasons {
+			l = e.Size()
+			n += 1 + l + sovEventClaimSucceeded(uint64(l))
+		}
+	}
+	l = len(m.CircuitType)
+	if l > 0 {
+		n += 1 + l + sovEventClaimSucceeded(uint64(l))
+	}
+	l = len(m.MessageVersion)
+	if l > 0 {
+		n += 1 + l + sovEventClaimSucceeded(uint64(l))
+	}
+	return n
+}
+
+func sovEventClaimSucceeded(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEventClaimSucceeded(x uint64) (n int) {
+	return sovEventClaimSucceeded(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventClaimSucceeded) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEventClaimSucceeded
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventClaimSucceeded: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventClaimSucceeded: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventClaimSucceeded
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEventClaimSucceeded
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEventClaimSucceeded
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Claimer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventClaimSucceeded
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEventClaimSucceeded
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEventClaimSucceeded
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventClaimSucceeded
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEventClaimSucceeded
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEventClaimSucceeded
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BtcAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcAddressHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventClaimSucceeded
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEventClaimSucceeded
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEventClaimSucceeded
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BtcAddressHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventClaimSucceeded
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UtxosClaimed", wireType)
+			}
+			m.UtxosClaimed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventClaimSucceeded
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UtxosClaimed |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UtxosSkipped", wireType)
+			}
+			m.UtxosSkipped = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventClaimSucceeded
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UtxosSkipped |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkipReasons", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventClaimSucceeded
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEventClaimSucceeded
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEventClaimSucceeded
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SkipReasons = append(m.SkipReasons, ClaimSkipReason{})
+			if err := m.SkipReasons[len(m.SkipReasons)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventClaimSucceeded
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEventClaimSucceeded
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEventClaimSucceeded
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CircuitType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventClaimSucceeded
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEventClaimSucceeded
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEventClaimSucceeded
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEventClaimSucceeded(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEventClaimSucceeded
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEventClaimSucceeded(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEventClaimSucceeded
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEventClaimSucceeded
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEventClaimSucceeded
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEventClaimSucceeded
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEventClaimSucceeded
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEventClaimSucceeded
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEventClaimSucceeded        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEventClaimSucceeded          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEventClaimSucceeded = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,35 @@
+package types
+
+import (
+	"compress/gzip"
+	"testing"
+)
+
+// FuzzGzipUnzip tests gzip decompression of block content with random inputs.
+func FuzzGzipUnzip(f *testing.F) {
+	valid, err := GzipDeterministic([]byte(`{"hash":"00000000","height":1}`), gzip.BestCompression)
+	if err != nil {
+		f.Fatal(err)
+	}
+	// Add seed corpus
+	f.Add([]byte{})
+	f.Add(valid)
+	f.Add(valid[:len(valid)-4]) // missing size trailer
+	f.Add(valid[:10])           // header only
+	f.Add([]byte{0x1f, 0x8b})   // magic only
+	corrupted := append([]byte(nil), valid...)
+	corrupted[len(corrupted)/2] ^= 0xff
+	f.Add(corrupted)
+	f.Add([]byte("not a gzip stream"))
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		// Should never panic, nor expand past the limit
+		out, err := GzipUnzip(data)
+		if err != nil {
+			return
+		}
+		if len(out) > maxDecodedBlockContentSize {
+			t.Fatalf("decoded %d bytes, limit is %d", len(out), maxDecodedBlockContentSize)
+		}
+	})
+}
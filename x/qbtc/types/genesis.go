@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 
+	"github.com/btcq-org/qbtc/x/qbtc/zk"
 	"github.com/consensys/gnark-crypto/ecc"
 	"github.com/consensys/gnark/backend/plonk"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -40,6 +41,16 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	if len(gs.ClaimMemoPrefixes) > 0 {
+		if err := ValidateClaimMemoPrefixes(gs.ClaimMemoPrefixes); err != nil {
+			return fmt.Errorf("invalid claim_memo_prefixes: %w", err)
+		}
+	}
+
+	if err := ValidateClaimDenyList(gs.ClaimDenyList); err != nil {
+		return fmt.Errorf("invalid claim_deny_list: %w", err)
+	}
+
 	// Validate ZK verifying key if present
 	if len(gs.ZkVerifyingKey) > 0 {
 		if err := ValidateVerifyingKey(gs.ZkVerifyingKey); err != nil {
@@ -47,6 +58,21 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	versions := make(map[string]bool, len(gs.VersionVerifyingKeys))
+	for i, entry := range gs.VersionVerifyingKeys {
+		if !zk.IsSupportedClaimMessageVersion(entry.MessageVersion) {
+			return fmt.Errorf("version_verifying_keys[%d]: unsupported message version %q", i, entry.MessageVersion)
+		}
+		version := zk.NormalizeClaimMessageVersion(entry.MessageVersion)
+		if versions[version] {
+			return fmt.Errorf("version_verifying_keys[%d]: duplicate message version %s", i, version)
+		}
+		versions[version] = true
+		if err := ValidateVerifyingKey(entry.VerifyingKey); err != nil {
+			return fmt.Errorf("version_verifying_keys[%d]: invalid verifying key: %w", i, err)
+		}
+	}
+
 	return nil
 }
 
@@ -87,6 +87,15 @@ type GenesisState struct {
 	// All nodes use this same VK for proof verification.
 	ZkVerifyingKey   []byte `protobuf:"bytes,5,opt,name=zk_verifying_key,json=zkVerifyingKey,proto3" json:"zk_verifying_key"`
 	BtcInitialHeight uint64 `protobuf:"varint,6,opt,name=btc_initial_height,json=btcInitialHeight,proto3" json:"btc_initial_height,omitempty"`
+	// The OP_RETURN memo prefixes that mark a claim transaction. When empty the
+	// default prefix "claim:" is used.
+	ClaimMemoPrefixes []string `protobuf:"bytes,7,rep,name=claim_memo_prefixes,json=claimMemoPrefixes,proto3" json:"claim_memo_prefixes,omitempty"`
+	// Account addresses that may not claim or receive claimed funds. Empty by
+	// default.
+	ClaimDenyList []string `protobuf:"bytes,8,rep,name=claim_deny_list,json=claimDenyList,proto3" json:"claim_deny_list,omitempty"`
+	// Verifying keys for single claim message versions. Claims of any other
+	// version are verified with zk_verifying_key.
+	VersionVerifyingKeys []VersionVerifyingKey `protobuf:"bytes,9,rep,name=version_verifying_keys,json=versionVerifyingKeys,proto3" json:"version_verifying_keys"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -164,6 +173,81 @@ func (m *GenesisState) GetBtcInitialHeight() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetClaimMemoPrefixes() []string {
+	if m != nil {
+		return m.ClaimMemoPrefixes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetClaimDenyList() []string {
+	if m != nil {
+		return m.ClaimDenyList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetVersionVerifyingKeys() []VersionVerifyingKey {
+	if m != nil {
+		return m.VersionVerifyingKeys
+	}
+	return nil
+}
+
+// VersionVerifyingKey is the PLONK verifying key for the claims of one claim
+// message version.
+type VersionVerifyingKey struct {
+	MessageVersion string `protobuf:"bytes,1,opt,name=message_version,json=messageVersion,proto3" json:"message_version,omitempty"`
+	VerifyingKey   []byte `protobuf:"bytes,2,opt,name=verifying_key,json=verifyingKey,proto3" json:"verifying_key,omitempty"`
+}
+
+func (m *VersionVerifyingKey) Reset()         { *m = VersionVerifyingKey{} }
+func (m *VersionVerifyingKey) String() string { return proto.CompactTextString(m) }
+func (*VersionVerifyingKey) ProtoMessage()    {}
+func (*VersionVerifyingKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8307623358d2b26a, []int{2}
+}
+func (m *VersionVerifyingKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VersionVerifyingKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VersionVerifyingKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VersionVerifyingKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VersionVerifyingKey.Merge(m, src)
+}
+func (m *VersionVerifyingKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *VersionVerifyingKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_VersionVerifyingKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VersionVerifyingKey proto.InternalMessageInfo
+
+func (m *VersionVerifyingKey) GetMessageVersion() string {
+	if m != nil {
+		return m.MessageVersion
+	}
+	return ""
+}
+
+func (m *VersionVerifyingKey) GetVerifyingKey() []byte {
+	if m != nil {
+		return m.VerifyingKey
+	}
+	return nil
+}
+
 type GenesisPeerAddress struct {
 	Validator   string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
 	PeerAddress string `protobuf:"bytes,2,opt,name=peer_address,json=peerAddress,proto3" json:"peer_address,omitempty"`
@@ -173,7 +257,7 @@ func (m *GenesisPeerAddress) Reset()         { *m = GenesisPeerAddress{} }
 func (m *GenesisPeerAddress) String() string { return proto.CompactTextString(m) }
 func (*GenesisPeerAddress) ProtoMessage()    {}
 func (*GenesisPeerAddress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8307623358d2b26a, []int{2}
+	return fileDescriptor_8307623358d2b26a, []int{3}
 }
 func (m *GenesisPeerAddress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -219,43 +303,51 @@ func (m *GenesisPeerAddress) GetPeerAddress() string {
 func init() {
 	proto.RegisterType((*Mimir)(nil), "qbtc.qbtc.v1.mimir")
 	proto.RegisterType((*GenesisState)(nil), "qbtc.qbtc.v1.GenesisState")
+	proto.RegisterType((*VersionVerifyingKey)(nil), "qbtc.qbtc.v1.VersionVerifyingKey")
 	proto.RegisterType((*GenesisPeerAddress)(nil), "qbtc.qbtc.v1.GenesisPeerAddress")
 }
 
 func init() { proto.RegisterFile("qbtc/qbtc/v1/genesis.proto", fileDescriptor_8307623358d2b26a) }
 
 var fileDescriptor_8307623358d2b26a = []byte{
-	// 469 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x52, 0x4f, 0x8f, 0x93, 0x40,
-	0x1c, 0x2d, 0x4b, 0xdb, 0xa4, 0xd3, 0x6e, 0xad, 0xe3, 0x1e, 0x48, 0xb3, 0x01, 0xd2, 0x44, 0x25,
-	0x51, 0x21, 0x5d, 0x3f, 0x80, 0x91, 0x8b, 0x1a, 0x0f, 0x36, 0xf8, 0x27, 0x66, 0x2f, 0x64, 0xa0,
-	0xb3, 0x74, 0xd2, 0x52, 0xe8, 0xcc, 0x94, 0x2c, 0xfb, 0x11, 0x3c, 0xf9, 0xb1, 0xf6, 0xb8, 0x47,
-	0x4f, 0xc4, 0xd0, 0xdb, 0x7e, 0x0a, 0xc3, 0x0c, 0x1b, 0xc1, 0x7a, 0xf9, 0xf5, 0xd7, 0xf7, 0xde,
-	0xbc, 0x1f, 0xf3, 0xe6, 0x07, 0xa6, 0xbb, 0x80, 0x87, 0x8e, 0x28, 0xd9, 0xdc, 0x89, 0xf0, 0x16,
-	0x33, 0xc2, 0xec, 0x94, 0x26, 0x3c, 0x81, 0xa3, 0x0a, 0xb6, 0x45, 0xc9, 0xe6, 0xd3, 0xc7, 0x28,
-	0x26, 0xdb, 0xc4, 0x11, 0x55, 0x0a, 0xa6, 0x67, 0x51, 0x12, 0x25, 0xa2, 0x75, 0xaa, 0xae, 0x46,
-	0xcf, 0x5b, 0x96, 0x3c, 0x4f, 0xb1, 0xbf, 0xe7, 0xd7, 0x0f, 0xac, 0xd1, 0x62, 0x77, 0x7b, 0x4c,
-	0x73, 0x3f, 0x45, 0x14, 0xc5, 0xf5, 0xd4, 0x99, 0x03, 0x7a, 0x31, 0x89, 0x09, 0x85, 0x13, 0xa0,
-	0xae, 0x71, 0xae, 0x29, 0xa6, 0x62, 0x0d, 0xbc, 0xaa, 0x85, 0x67, 0xa0, 0x97, 0xa1, 0xcd, 0x1e,
-	0x6b, 0x27, 0xa6, 0x62, 0xa9, 0x9e, 0xfc, 0x33, 0xfb, 0xa1, 0x82, 0xd1, 0x3b, 0xf9, 0xe1, 0x9f,
-	0x39, 0xe2, 0x18, 0xce, 0x41, 0x5f, 0x38, 0x30, 0x4d, 0x31, 0x55, 0x6b, 0x78, 0xf1, 0xc4, 0x6e,
-	0x5e, 0xc4, 0x16, 0x9c, 0xdb, 0xbd, 0x2d, 0x8c, 0x8e, 0x57, 0x0b, 0x61, 0x0a, 0xc6, 0x29, 0xc6,
-	0xd4, 0x47, 0xcb, 0x25, 0xc5, 0x8c, 0x61, 0xa6, 0x9d, 0x88, 0xa3, 0x66, 0xfb, 0x68, 0x3d, 0x66,
-	0x81, 0x31, 0x7d, 0x2b, 0x95, 0xee, 0xf3, 0xca, 0xa7, 0x2c, 0x8c, 0xd3, 0x06, 0x88, 0xd9, 0x7d,
-	0x61, 0xfc, 0x63, 0xe8, 0x9d, 0xa6, 0x4d, 0x01, 0xb4, 0x40, 0xaf, 0x4a, 0x85, 0x69, 0xaa, 0x18,
-	0x04, 0xdb, 0x83, 0xbe, 0x7e, 0xf9, 0xfe, 0xc9, 0x93, 0x02, 0xf8, 0x02, 0xf4, 0x65, 0x40, 0x5a,
-	0xf7, 0x7f, 0xd7, 0x59, 0x54, 0x9c, 0x57, 0x4b, 0xe0, 0x02, 0x4c, 0x6e, 0xd6, 0x7e, 0x86, 0x29,
-	0xb9, 0xca, 0xc9, 0x36, 0xf2, 0xab, 0x04, 0x7b, 0xa6, 0x62, 0x8d, 0xdc, 0x67, 0x65, 0x61, 0x8c,
-	0x2f, 0xd7, 0xdf, 0x1e, 0xa8, 0x8f, 0x38, 0xbf, 0x2f, 0x8c, 0x23, 0xb5, 0x37, 0xbe, 0x69, 0x69,
-	0xe0, 0x4b, 0x00, 0x03, 0x1e, 0xfa, 0x64, 0x4b, 0x38, 0x41, 0x1b, 0x7f, 0x85, 0x49, 0xb4, 0xe2,
-	0x5a, 0xdf, 0x54, 0xac, 0xae, 0x37, 0x09, 0x78, 0xf8, 0x41, 0x12, 0xef, 0x05, 0x3e, 0xbb, 0x02,
-	0xf0, 0x38, 0x24, 0x78, 0x0e, 0x06, 0x19, 0xda, 0x90, 0x25, 0xe2, 0x09, 0xad, 0x1f, 0xf4, 0x2f,
-	0x00, 0x2f, 0xc0, 0xa8, 0x99, 0x95, 0x78, 0xdd, 0x81, 0xfb, 0xa8, 0x2c, 0x8c, 0x61, 0xc3, 0xc4,
-	0x1b, 0x36, 0x02, 0x74, 0xdf, 0xdc, 0x96, 0xba, 0x72, 0x57, 0xea, 0xca, 0xef, 0x52, 0x57, 0x7e,
-	0x1e, 0xf4, 0xce, 0xdd, 0x41, 0xef, 0xfc, 0x3a, 0xe8, 0x9d, 0xcb, 0xa7, 0x11, 0xe1, 0xab, 0x7d,
-	0x60, 0x87, 0x49, 0xec, 0x04, 0x3c, 0xdc, 0xbd, 0x4a, 0x68, 0x24, 0xf7, 0xed, 0x5a, 0xfe, 0x54,
-	0x1b, 0xc9, 0x82, 0xbe, 0xd8, 0xb6, 0xd7, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0xe0, 0x5e, 0x0a,
-	0x1c, 0x01, 0x03, 0x00, 0x00,
+	// 590 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x53, 0xdd, 0x4e, 0xd4, 0x40,
+	0x18, 0xdd, 0xb2, 0x3f, 0xba, 0xc3, 0xee, 0x02, 0x03, 0x31, 0x0d, 0x21, 0xdb, 0xba, 0x46, 0x68,
+	0xa2, 0xb6, 0x01, 0x1f, 0xc0, 0xb8, 0x31, 0x51, 0xa3, 0xc6, 0x4d, 0x55, 0x62, 0x48, 0x4c, 0xd3,
+	0x76, 0x3f, 0xca, 0x84, 0xed, 0x0f, 0x33, 0xb3, 0x0d, 0xe5, 0x29, 0x7c, 0x25, 0xef, 0xb8, 0xe4,
+	0xd2, 0xab, 0xc6, 0x94, 0x3b, 0x9e, 0xc2, 0x74, 0x3a, 0xc4, 0x56, 0xb8, 0x99, 0x9d, 0x3d, 0xe7,
+	0x7c, 0xe7, 0x9b, 0x39, 0xfd, 0x06, 0x6d, 0x9f, 0x79, 0xdc, 0xb7, 0xc4, 0x92, 0xee, 0x5b, 0x01,
+	0x44, 0xc0, 0x08, 0x33, 0x13, 0x1a, 0xf3, 0x18, 0x0f, 0x4a, 0xd8, 0x14, 0x4b, 0xba, 0xbf, 0xbd,
+	0xe1, 0x86, 0x24, 0x8a, 0x2d, 0xb1, 0x56, 0x82, 0xed, 0xad, 0x20, 0x0e, 0x62, 0xb1, 0xb5, 0xca,
+	0x9d, 0x44, 0x77, 0x1a, 0x96, 0x3c, 0x4b, 0xc0, 0x59, 0xf2, 0xf3, 0x5b, 0x56, 0x6b, 0xb0, 0x67,
+	0x4b, 0xa0, 0x99, 0x93, 0xb8, 0xd4, 0x0d, 0x65, 0xd7, 0x89, 0x85, 0xba, 0x21, 0x09, 0x09, 0xc5,
+	0xeb, 0xa8, 0x7d, 0x0a, 0x99, 0xaa, 0xe8, 0x8a, 0xd1, 0xb7, 0xcb, 0x2d, 0xde, 0x42, 0xdd, 0xd4,
+	0x5d, 0x2c, 0x41, 0x5d, 0xd1, 0x15, 0xa3, 0x6d, 0x57, 0x7f, 0x26, 0xbf, 0x3a, 0x68, 0xf0, 0xb6,
+	0x3a, 0xf8, 0x17, 0xee, 0x72, 0xc0, 0xfb, 0xa8, 0x27, 0x1c, 0x98, 0xaa, 0xe8, 0x6d, 0x63, 0xf5,
+	0x60, 0xd3, 0xac, 0x5f, 0xc4, 0x14, 0xdc, 0xb4, 0x73, 0x99, 0x6b, 0x2d, 0x5b, 0x0a, 0x71, 0x82,
+	0x46, 0x09, 0x00, 0x75, 0xdc, 0xf9, 0x9c, 0x02, 0x63, 0xc0, 0xd4, 0x15, 0x51, 0xaa, 0x37, 0x4b,
+	0x65, 0x9b, 0x19, 0x00, 0x7d, 0x5d, 0x29, 0xa7, 0x7b, 0xa5, 0x4f, 0x91, 0x6b, 0xc3, 0x1a, 0x08,
+	0xec, 0x26, 0xd7, 0xfe, 0x33, 0xb4, 0x87, 0x49, 0x5d, 0x80, 0x0d, 0xd4, 0x2d, 0x53, 0x61, 0x6a,
+	0x5b, 0x34, 0xc2, 0xcd, 0x46, 0xdf, 0xbe, 0x7e, 0xff, 0x6c, 0x57, 0x02, 0xfc, 0x0c, 0xf5, 0xaa,
+	0x80, 0xd4, 0xce, 0x7d, 0xd7, 0x99, 0x95, 0x9c, 0x2d, 0x25, 0x78, 0x86, 0xd6, 0x2f, 0x4e, 0x9d,
+	0x14, 0x28, 0x39, 0xce, 0x48, 0x14, 0x38, 0x65, 0x82, 0x5d, 0x5d, 0x31, 0x06, 0xd3, 0xdd, 0x22,
+	0xd7, 0x46, 0x47, 0xa7, 0x87, 0xb7, 0xd4, 0x07, 0xc8, 0x6e, 0x72, 0xed, 0x8e, 0xda, 0x1e, 0x5d,
+	0x34, 0x34, 0xf8, 0x39, 0xc2, 0x1e, 0xf7, 0x1d, 0x12, 0x11, 0x4e, 0xdc, 0x85, 0x73, 0x02, 0x24,
+	0x38, 0xe1, 0x6a, 0x4f, 0x57, 0x8c, 0x8e, 0xbd, 0xee, 0x71, 0xff, 0x7d, 0x45, 0xbc, 0x13, 0x38,
+	0x36, 0xd1, 0xa6, 0xbf, 0x70, 0x49, 0xe8, 0x84, 0x10, 0xc6, 0x4e, 0x42, 0xe1, 0x98, 0x9c, 0x03,
+	0x53, 0x1f, 0xe8, 0x6d, 0xa3, 0x6f, 0x6f, 0x08, 0xea, 0x13, 0x84, 0xf1, 0x4c, 0x12, 0x78, 0x17,
+	0xad, 0x55, 0xfa, 0x39, 0x44, 0x99, 0xb3, 0x20, 0x8c, 0xab, 0x0f, 0x85, 0x76, 0x28, 0xe0, 0x37,
+	0x10, 0x65, 0x1f, 0x09, 0xe3, 0xf8, 0x07, 0x7a, 0x94, 0x02, 0x65, 0x24, 0x8e, 0x9a, 0xc7, 0x65,
+	0x6a, 0x5f, 0x84, 0xf2, 0xb8, 0x19, 0xca, 0x61, 0xa5, 0xad, 0x5f, 0x44, 0x7e, 0xf1, 0xad, 0xf4,
+	0x2e, 0xc5, 0x26, 0x3e, 0xda, 0xbc, 0xa7, 0x04, 0xef, 0xa1, 0xb5, 0x10, 0x18, 0x73, 0x03, 0x70,
+	0x64, 0x99, 0x1c, 0xc7, 0x91, 0x84, 0x65, 0x11, 0x7e, 0x82, 0x86, 0xcd, 0xcc, 0xcb, 0x09, 0x1d,
+	0xd8, 0x83, 0xb4, 0xe6, 0x36, 0x39, 0x46, 0xf8, 0xee, 0x00, 0xe1, 0x1d, 0xd4, 0x4f, 0xdd, 0x05,
+	0x99, 0xbb, 0x3c, 0xa6, 0xd2, 0xfd, 0x1f, 0x80, 0x0f, 0xd0, 0xa0, 0x3e, 0x47, 0xc2, 0xb7, 0x3f,
+	0x5d, 0x2b, 0x72, 0x6d, 0xb5, 0x66, 0x62, 0xaf, 0xd6, 0x86, 0x6b, 0xfa, 0xea, 0xb2, 0x18, 0x2b,
+	0x57, 0xc5, 0x58, 0xf9, 0x53, 0x8c, 0x95, 0x9f, 0xd7, 0xe3, 0xd6, 0xd5, 0xf5, 0xb8, 0xf5, 0xfb,
+	0x7a, 0xdc, 0x3a, 0x7a, 0x1a, 0x10, 0x7e, 0xb2, 0xf4, 0x4c, 0x3f, 0x0e, 0x2d, 0x8f, 0xfb, 0x67,
+	0x2f, 0x62, 0x1a, 0x54, 0x6f, 0xf1, 0xbc, 0xfa, 0x29, 0x5f, 0x2b, 0xf3, 0x7a, 0xe2, 0x25, 0xbe,
+	0xfc, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x22, 0xca, 0x3b, 0x3f, 0x1d, 0x04, 0x00, 0x00,
 }
 
 func (m *Mimir) Marshal() (dAtA []byte, err error) {
@@ -313,6 +405,38 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.VersionVerifyingKeys) > 0 {
+		for iNdEx := len(m.VersionVerifyingKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VersionVerifyingKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.ClaimDenyList) > 0 {
+		for iNdEx := len(m.ClaimDenyList) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ClaimDenyList[iNdEx])
+			copy(dAtA[i:], m.ClaimDenyList[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.ClaimDenyList[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.ClaimMemoPrefixes) > 0 {
+		for iNdEx := len(m.ClaimMemoPrefixes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ClaimMemoPrefixes[iNdEx])
+			copy(dAtA[i:], m.ClaimMemoPrefixes[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.ClaimMemoPrefixes[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if m.BtcInitialHeight != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.BtcInitialHeight))
 		i--
@@ -384,6 +508,43 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *VersionVerifyingKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VersionVerifyingKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VersionVerifyingKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VerifyingKey) > 0 {
+		i -= len(m.VerifyingKey)
+		copy(dAtA[i:], m.VerifyingKey)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.VerifyingKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MessageVersion) > 0 {
+		i -= len(m.MessageVersion)
+		copy(dAtA[i:], m.MessageVersion)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.MessageVersion)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GenesisPeerAddress) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -485,6 +646,41 @@ func (m *GenesisState) Size() (n int) {
 	if m.BtcInitialHeight != 0 {
 		n += 1 + sovGenesis(uint64(m.BtcInitialHeight))
 	}
+	if len(m.ClaimMemoPrefixes) > 0 {
+		for _, s := range m.ClaimMemoPrefixes {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ClaimDenyList) > 0 {
+		for _, s := range m.ClaimDenyList {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VersionVerifyingKeys) > 0 {
+		for _, e := range m.VersionVerifyingKeys {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *VersionVerifyingKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MessageVersion)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.VerifyingKey)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -830,6 +1026,220 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimMemoPrefixes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimMemoPrefixes = append(m.ClaimMemoPrefixes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimDenyList", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimDenyList = append(m.ClaimDenyList, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionVerifyingKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VersionVerifyingKeys = append(m.VersionVerifyingKeys, VersionVerifyingKey{})
+			if err := m.VersionVerifyingKeys[len(m.VersionVerifyingKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VersionVerifyingKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VersionVerifyingKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VersionVerifyingKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifyingKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerifyingKey = append(m.VerifyingKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.VerifyingKey == nil {
+				m.VerifyingKey = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
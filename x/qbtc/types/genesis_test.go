@@ -25,6 +25,29 @@ func TestGenesisState_Validate(t *testing.T) {
 			genState: &types.GenesisState{},
 			valid:    true,
 		},
+		{
+			desc: "valid claim memo prefixes",
+			genState: &types.GenesisState{
+				ClaimMemoPrefixes: []string{"claim:", "claim2:"},
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicate claim memo prefixes",
+			genState: &types.GenesisState{
+				ClaimMemoPrefixes: []string{"claim:", "Claim:"},
+			},
+			valid:  false,
+			errMsg: "duplicate claim memo prefix",
+		},
+		{
+			desc: "invalid claim deny list address",
+			genState: &types.GenesisState{
+				ClaimDenyList: []string{"not-an-address"},
+			},
+			valid:  false,
+			errMsg: "invalid claim_deny_list",
+		},
 		{
 			desc: "invalid VK - too small",
 			genState: &types.GenesisState{
@@ -41,6 +64,36 @@ func TestGenesisState_Validate(t *testing.T) {
 			valid:  false,
 			errMsg: "failed to deserialize verifying key",
 		},
+		{
+			desc: "version VK - unsupported message version",
+			genState: &types.GenesisState{
+				VersionVerifyingKeys: []types.VersionVerifyingKey{{MessageVersion: "qbtc-claim-v99"}},
+			},
+			valid:  false,
+			errMsg: "unsupported message version",
+		},
+		{
+			desc: "version VK - duplicate message version",
+			genState: &types.GenesisState{
+				VersionVerifyingKeys: []types.VersionVerifyingKey{
+					{MessageVersion: ""},
+					{MessageVersion: "qbtc-claim-v1"},
+				},
+			},
+			valid:  false,
+			errMsg: "duplicate message version",
+		},
+		{
+			desc: "version VK - malformed",
+			genState: &types.GenesisState{
+				VersionVerifyingKeys: []types.VersionVerifyingKey{{
+					MessageVersion: "qbtc-claim-v2",
+					VerifyingKey:   make([]byte, types.MinVerifyingKeySize+100),
+				}},
+			},
+			valid:  false,
+			errMsg: "failed to deserialize verifying key",
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -31,6 +31,51 @@ var (
 
 	// LastProcessedBlockKey stores the last processed block height
 	LastProcessedBlockKey = collections.NewPrefix("last_processed_block")
+
+	// ProcessedBlockHashKey stores the hash of the last processed block. It does
+	// not extend LastProcessedBlockKey, as collection prefixes must not overlap
+	ProcessedBlockHashKey = collections.NewPrefix("processed_block_hash")
+
+	// ClaimedUTXOIndexKeys is the prefix for the index of fully claimed UTXOs by the block height they were claimed at
+	ClaimedUTXOIndexKeys = collections.NewPrefix("claimed_utxo_index")
+
+	// ClaimRecordKeys is the prefix for the record of who claimed a UTXO, keyed by UTXO key
+	ClaimRecordKeys = collections.NewPrefix("claim_record")
+
+	// ClaimMemoPrefixesKey stores the governance-set claim memo prefixes
+	ClaimMemoPrefixesKey = collections.NewPrefix("claim_memo_prefixes")
+
+	// ClaimDenyListKeys is the prefix for the governance-set claim deny list, keyed by account address
+	ClaimDenyListKeys = collections.NewPrefix("claim_deny_list")
+
+	// LastClaimHeightKeys is the prefix for the block height of the last claim to a recipient, keyed by account address
+	LastClaimHeightKeys = collections.NewPrefix("last_claim_height")
+
+	// PendingAttestationKeys is the prefix for the validators that attested a
+	// block not processed yet, keyed by height, block content digest and
+	// validator consensus address
+	PendingAttestationKeys = collections.NewPrefix("pending_attestation")
+
+	// VersionVerifyingKeyKeys is the prefix for the verifying keys of single
+	// claim message versions, keyed by normalized message version
+	VersionVerifyingKeyKeys = collections.NewPrefix("version_verifying_key")
+
+	// PrunedUTXOAmountKeys is the prefix for the amounts of pruned claimed
+	// UTXOs that were not spent yet, keyed by UTXO key
+	PrunedUTXOAmountKeys = collections.NewPrefix("pruned_utxo_amount")
+
+	// ClaimableUTXOIndexKeys is the prefix for the index of the UTXOs with an
+	// entitled amount left, keyed by claim identifier and UTXO key
+	ClaimableUTXOIndexKeys = collections.NewPrefix("claimable_utxo_index")
+
+	// UTXOBackfillCursorKey stores the key of the last UTXO the UTXO backfill
+	// visited. It does not start with "utxo", as collection prefixes must not
+	// overlap
+	UTXOBackfillCursorKey = collections.NewPrefix("backfill_utxo_cursor")
+
+	// UTXOStatsKey stores the summary of the UTXO set. It does not start with
+	// "utxo", as collection prefixes must not overlap
+	UTXOStatsKey = collections.NewPrefix("stats_utxo_set")
 )
 
 const (
@@ -43,4 +88,22 @@ const (
 	AttributeKeyUTXOCount  = "utxo_count"
 	AttributeKeyGovClaimer = "gov_claimer"
 	AttributeUtxos         = "utxos"
+
+	EventTypePruneClaimedUTXOs = "prune_claimed_utxos"
+
+	EventTypeReplaceVerifyingKey = "replace_verifying_key"
+	AttributeKeyVerifyingKeyHash = "verifying_key_hash"
+	AttributeKeyMessageVersion   = "message_version"
+
+	EventTypeSetClaimMemoPrefixes = "set_claim_memo_prefixes"
+	AttributeKeyClaimMemoPrefixes = "claim_memo_prefixes"
+
+	EventTypeUpdateClaimDenyList = "update_claim_deny_list"
+	AttributeKeyDenied           = "denied"
+	AttributeKeyAllowed          = "allowed"
+
+	EventTypeBlockAttestationPending = "block_attestation_pending"
+	AttributeKeyBlockHeight          = "block_height"
+	AttributeKeyBlockHash            = "block_hash"
+	AttributeKeyNewAttesters         = "new_attesters"
 )
@@ -4,6 +4,7 @@ import (
 	"encoding/hex"
 	"fmt"
 
+	"github.com/btcq-org/qbtc/x/qbtc/zk"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	se "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -27,6 +28,30 @@ const MaxTxIDLength = 64
 // This limit prevents DoS attacks via oversized batches while allowing efficient bulk claims.
 const MaxBatchClaimUTXOs = 50
 
+// Reasons a claim skips one of its UTXOs, as reported in
+// MsgClaimWithProofResponse.SkipReasons.
+const (
+	SkipReasonNotFound         = "not_found"
+	SkipReasonAlreadyClaimed   = "already_claimed"
+	SkipReasonNotClaimable     = "not_claimable"
+	SkipReasonImmatureCoinbase = "immature_coinbase"
+	SkipReasonWrongAddress     = "wrong_address"
+	SkipReasonWrongAddressType = "wrong_address_type"
+)
+
+// ClaimSkipReasons lists the skip reasons in the order they are reported.
+var ClaimSkipReasons = []string{
+	SkipReasonNotFound,
+	SkipReasonAlreadyClaimed,
+	SkipReasonNotClaimable,
+	SkipReasonImmatureCoinbase,
+	SkipReasonWrongAddress,
+	SkipReasonWrongAddressType,
+}
+
+// MaxMessageVersionLength is the maximum length of the claim message version string.
+const MaxMessageVersionLength = 32
+
 // ValidateBasic performs basic validation of the MsgClaimWithProof message.
 // This is called before the message reaches the handler and is critical
 // for preventing DoS attacks and rejecting obviously invalid messages early.
@@ -42,9 +67,18 @@ func (m *MsgClaimWithProof) ValidateBasic() error {
 		return se.ErrInvalidAddress.Wrapf("invalid claimer address: %v", err)
 	}
 
-	// Validate at least one UTXO is provided
+	// Validate the destination address format if one is given
+	if m.Destination != "" {
+		if _, err := sdk.AccAddressFromBech32(m.Destination); err != nil {
+			return se.ErrInvalidAddress.Wrapf("invalid destination address: %v", err)
+		}
+	}
+
+	// Validate at least one UTXO is provided. An empty list has its own error
+	// so it is not mistaken for ErrNoClaimableUTXOs, which the handler only
+	// returns after verifying the proof
 	if len(m.Utxos) == 0 {
-		return se.ErrInvalidRequest.Wrap("at least one UTXO is required")
+		return ErrNoUTXOsSpecified.Wrap("at least one UTXO is required")
 	}
 
 	// Validate batch size limit
@@ -113,5 +147,74 @@ func (m *MsgClaimWithProof) ValidateBasic() error {
 	if _, err := hex.DecodeString(m.QbtcAddressHash); err != nil {
 		return se.ErrInvalidRequest.Wrapf("qbtc_address_hash is not valid hex: %v", err)
 	}
+	if len(m.MessageVersion) > MaxMessageVersionLength {
+		return se.ErrInvalidRequest.Wrapf("message_version too long: %d characters (max %d)", len(m.MessageVersion), MaxMessageVersionLength)
+	}
+	// The expiry height is part of the signed message only for versions
+	// binding one; anywhere else it would look like an expiry the proof lacks
+	if zk.ClaimMessageBindsExpiry(m.MessageVersion) {
+		if m.ExpiryHeight == 0 {
+			return se.ErrInvalidRequest.Wrapf("expiry_height is required for message version %s", m.MessageVersion)
+		}
+	} else if m.ExpiryHeight != 0 {
+		return se.ErrInvalidRequest.Wrapf("expiry_height is only used by message versions that bind an expiry")
+	}
+	if zk.ClaimMessageSaltsDestination(m.MessageVersion) {
+		if len(m.DestinationSalt) != 2*zk.DestinationSaltSize {
+			return se.ErrInvalidRequest.Wrapf("destination_salt must be %d hex characters for message version %s, got %d", 2*zk.DestinationSaltSize, m.MessageVersion, len(m.DestinationSalt))
+		}
+		if _, err := hex.DecodeString(m.DestinationSalt); err != nil {
+			return se.ErrInvalidRequest.Wrapf("destination_salt is not valid hex: %v", err)
+		}
+	} else if m.DestinationSalt != "" {
+		return se.ErrInvalidRequest.Wrapf("destination_salt is only used by message versions that bind a salted destination")
+	}
+	if !zk.IsSupportedSignatureScheme(m.SignatureScheme) {
+		return se.ErrInvalidRequest.Wrapf("unsupported signature_scheme %q", m.SignatureScheme)
+	}
+	if m.VkFingerprint != "" {
+		if len(m.VkFingerprint) != 2*zk.VKFingerprintSize {
+			return se.ErrInvalidRequest.Wrapf("vk_fingerprint must be %d hex characters, got %d", 2*zk.VKFingerprintSize, len(m.VkFingerprint))
+		}
+		if _, err := hex.DecodeString(m.VkFingerprint); err != nil {
+			return se.ErrInvalidRequest.Wrapf("vk_fingerprint is not valid hex: %v", err)
+		}
+	}
 	return nil
 }
+
+// Recipient returns the bech32 address that receives the claimed tokens and
+// that the proof must be bound to: the destination if set, the claimer otherwise.
+func (m *MsgClaimWithProof) Recipient() string {
+	if m.Destination != "" {
+		return m.Destination
+	}
+	return m.Claimer
+}
+
+// RecipientBinding returns the QBTCAddressHash the proof must be bound to:
+// the salted commitment to the recipient for message versions binding one,
+// HashBTCQAddress of the recipient otherwise.
+func (m *MsgClaimWithProof) RecipientBinding() ([32]byte, error) {
+	if !zk.ClaimMessageSaltsDestination(m.MessageVersion) {
+		return zk.HashBTCQAddress(m.Recipient()), nil
+	}
+	var salt [zk.DestinationSaltSize]byte
+	decoded, err := hex.DecodeString(m.DestinationSalt)
+	if err != nil || len(decoded) != len(salt) {
+		return [32]byte{}, fmt.Errorf("destination_salt must be %d hex-encoded bytes", len(salt))
+	}
+	copy(salt[:], decoded)
+	return zk.CommitBTCQAddress(m.Recipient(), salt), nil
+}
+
+// UTXOSetCommitment returns the commitment to the message's UTXO references
+// that claim message versions binding the UTXO set sign over. The order of the
+// references does not matter.
+func (m *MsgClaimWithProof) UTXOSetCommitment() ([32]byte, error) {
+	outpoints := make([]zk.Outpoint, len(m.Utxos))
+	for i, utxo := range m.Utxos {
+		outpoints[i] = zk.Outpoint{Txid: utxo.Txid, Vout: utxo.Vout}
+	}
+	return zk.ComputeUTXOSetCommitment(outpoints)
+}
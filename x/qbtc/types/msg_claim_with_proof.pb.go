@@ -86,7 +86,8 @@ func (m *UTXORef) GetVout() uint32 {
 // be claimed; others are skipped. Maximum 50 UTXOs can be claimed in a single
 // batch to prevent DoS.
 type MsgClaimWithProof struct {
-	// The address on the qbtc chain that will receive the claimed tokens
+	// The address that signs the transaction. It also receives the claimed
+	// tokens unless destination is set.
 	Claimer string `protobuf:"bytes,1,opt,name=claimer,proto3" json:"claimer,omitempty"`
 	// The UTXOs to claim. Only those matching the proven Bitcoin address will
 	// be claimed; others are skipped. Maximum 50 UTXOs per batch.
@@ -100,6 +101,32 @@ type MsgClaimWithProof struct {
 	AddressHash string `protobuf:"bytes,5,opt,name=address_hash,json=addressHash,proto3" json:"address_hash,omitempty"`
 	// hex encoded qbtc address hash
 	QbtcAddressHash string `protobuf:"bytes,6,opt,name=qbtc_address_hash,json=qbtcAddressHash,proto3" json:"qbtc_address_hash,omitempty"`
+	// Version of the claim message the proof was generated for. Empty selects
+	// the default version (qbtc-claim-v1).
+	MessageVersion string `protobuf:"bytes,7,opt,name=message_version,json=messageVersion,proto3" json:"message_version,omitempty"`
+	// How the claim message was signed. Empty or "raw" means the key signed the
+	// claim message directly; "bip137" means it produced a Bitcoin Signed
+	// Message over the hex encoding of the claim message, and message_hash is
+	// the digest of that signed message.
+	SignatureScheme string `protobuf:"bytes,8,opt,name=signature_scheme,json=signatureScheme,proto3" json:"signature_scheme,omitempty"`
+	// Optional address that receives the claimed tokens. The proof must be
+	// bound to it through qbtc_address_hash. Empty means the claimer, so a
+	// claimer can relay a proof bound to someone else's address without being
+	// able to redirect the funds.
+	Destination string `protobuf:"bytes,9,opt,name=destination,proto3" json:"destination,omitempty"`
+	// Optional hex short fingerprint (first 8 bytes of the SHA256) of the
+	// verifying key the proof was generated against. When set, a claim proven
+	// against another key than the active one is rejected before verification.
+	VkFingerprint string `protobuf:"bytes,10,opt,name=vk_fingerprint,json=vkFingerprint,proto3" json:"vk_fingerprint,omitempty"`
+	// Last qbtc block height the claim is valid at, for message versions that
+	// bind an expiry (qbtc-claim-v5 and later). Must be 0 for other versions.
+	ExpiryHeight uint64 `protobuf:"varint,11,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+	// Hex-encoded 32-byte salt of the destination commitment, for message
+	// versions that bind a salted destination (qbtc-claim-v6). The proof is
+	// bound to SHA256("qbtc-destination-commitment" || salt || recipient), so
+	// the recipient is only revealed by this claim. Must be empty for other
+	// versions.
+	DestinationSalt string `protobuf:"bytes,12,opt,name=destination_salt,json=destinationSalt,proto3" json:"destination_salt,omitempty"`
 }
 
 func (m *MsgClaimWithProof) Reset()         { *m = MsgClaimWithProof{} }
@@ -177,6 +204,48 @@ func (m *MsgClaimWithProof) GetQbtcAddressHash() string {
 	return ""
 }
 
+func (m *MsgClaimWithProof) GetMessageVersion() string {
+	if m != nil {
+		return m.MessageVersion
+	}
+	return ""
+}
+
+func (m *MsgClaimWithProof) GetSignatureScheme() string {
+	if m != nil {
+		return m.SignatureScheme
+	}
+	return ""
+}
+
+func (m *MsgClaimWithProof) GetDestination() string {
+	if m != nil {
+		return m.Destination
+	}
+	return ""
+}
+
+func (m *MsgClaimWithProof) GetVkFingerprint() string {
+	if m != nil {
+		return m.VkFingerprint
+	}
+	return ""
+}
+
+func (m *MsgClaimWithProof) GetExpiryHeight() uint64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
+func (m *MsgClaimWithProof) GetDestinationSalt() string {
+	if m != nil {
+		return m.DestinationSalt
+	}
+	return ""
+}
+
 // MsgClaimWithProofResponse is the response for a successful batch claim.
 type MsgClaimWithProofResponse struct {
 	// The total amount of tokens claimed across all UTXOs
@@ -185,6 +254,15 @@ type MsgClaimWithProofResponse struct {
 	UtxosClaimed uint32 `protobuf:"varint,2,opt,name=utxos_claimed,json=utxosClaimed,proto3" json:"utxos_claimed,omitempty"`
 	// The number of UTXOs skipped (not matching the proven address)
 	UtxosSkipped uint32 `protobuf:"varint,3,opt,name=utxos_skipped,json=utxosSkipped,proto3" json:"utxos_skipped,omitempty"`
+	// The number of UTXOs that were already claimed by this claimer earlier,
+	// e.g. when the same claim transaction is broadcast twice
+	UtxosAlreadyClaimed uint32 `protobuf:"varint,4,opt,name=utxos_already_claimed,json=utxosAlreadyClaimed,proto3" json:"utxos_already_claimed,omitempty"`
+	// Explains why nothing was claimed when the claim succeeds without releasing
+	// any UTXO; empty otherwise
+	Reason string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Breaks utxos_skipped down by reason, in a fixed order. Reasons no UTXO was
+	// skipped for are left out.
+	SkipReasons []ClaimSkipReason `protobuf:"bytes,6,rep,name=skip_reasons,json=skipReasons,proto3" json:"skip_reasons"`
 }
 
 func (m *MsgClaimWithProofResponse) Reset()         { *m = MsgClaimWithProofResponse{} }
@@ -241,10 +319,88 @@ func (m *MsgClaimWithProofResponse) GetUtxosSkipped() uint32 {
 	return 0
 }
 
+func (m *MsgClaimWithProofResponse) GetUtxosAlreadyClaimed() uint32 {
+	if m != nil {
+		return m.UtxosAlreadyClaimed
+	}
+	return 0
+}
+
+func (m *MsgClaimWithProofResponse) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *MsgClaimWithProofResponse) GetSkipReasons() []ClaimSkipReason {
+	if m != nil {
+		return m.SkipReasons
+	}
+	return nil
+}
+
+// ClaimSkipReason counts the UTXOs of a claim skipped for one reason.
+type ClaimSkipReason struct {
+	// One of not_found, already_claimed, not_claimable, wrong_address and
+	// wrong_address_type
+	Reason string `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	// The number of UTXOs skipped for the reason
+	Count uint32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *ClaimSkipReason) Reset()         { *m = ClaimSkipReason{} }
+func (m *ClaimSkipReason) String() string { return proto.CompactTextString(m) }
+func (*ClaimSkipReason) ProtoMessage()    {}
+func (*ClaimSkipReason) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf71fdfb6b1ac5fe, []int{3}
+}
+func (m *ClaimSkipReason) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClaimSkipReason) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClaimSkipReason.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClaimSkipReason) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClaimSkipReason.Merge(m, src)
+}
+func (m *ClaimSkipReason) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClaimSkipReason) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClaimSkipReason.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClaimSkipReason proto.InternalMessageInfo
+
+func (m *ClaimSkipReason) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *ClaimSkipReason) GetCount() uint32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*UTXORef)(nil), "qbtc.qbtc.v1.UTXORef")
 	proto.RegisterType((*MsgClaimWithProof)(nil), "qbtc.qbtc.v1.MsgClaimWithProof")
 	proto.RegisterType((*MsgClaimWithProofResponse)(nil), "qbtc.qbtc.v1.MsgClaimWithProofResponse")
+	proto.RegisterType((*ClaimSkipReason)(nil), "qbtc.qbtc.v1.ClaimSkipReason")
 }
 
 func init() {
@@ -252,35 +408,47 @@ func init() {
 }
 
 var fileDescriptor_bf71fdfb6b1ac5fe = []byte{
-	// 436 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x92, 0xbd, 0x8e, 0xd3, 0x40,
-	0x10, 0xc7, 0xed, 0x9c, 0x73, 0x27, 0xf6, 0x72, 0x42, 0x59, 0x05, 0x30, 0x57, 0x98, 0x10, 0x84,
-	0x2e, 0x8a, 0x84, 0x8d, 0xa1, 0xa3, 0x41, 0x77, 0xd7, 0xd0, 0x20, 0x90, 0x01, 0x81, 0x68, 0xac,
-	0xb5, 0xbd, 0x67, 0x5b, 0xc4, 0x19, 0xc7, 0xbb, 0x0e, 0xa1, 0xa5, 0xa4, 0x82, 0x8a, 0xd7, 0xc8,
-	0x63, 0xa4, 0x4c, 0x49, 0x85, 0x50, 0x52, 0xe4, 0x35, 0xd0, 0xce, 0x3a, 0x7c, 0x28, 0xcd, 0x7a,
-	0xfc, 0xff, 0xff, 0x34, 0x3b, 0x1f, 0x4b, 0xce, 0xa6, 0x91, 0x8c, 0x3d, 0x3c, 0x66, 0xbe, 0x57,
-	0x88, 0x34, 0x8c, 0xc7, 0x2c, 0x2f, 0xc2, 0x8f, 0xb9, 0xcc, 0xc2, 0xb2, 0x02, 0xb8, 0x72, 0xcb,
-	0x0a, 0x24, 0xd0, 0x8e, 0x62, 0x5c, 0x3c, 0x66, 0xfe, 0x69, 0x97, 0x15, 0xf9, 0x04, 0x3c, 0x3c,
-	0x35, 0x70, 0x7a, 0x2b, 0x06, 0x51, 0x80, 0x50, 0x39, 0x9a, 0x54, 0x8d, 0xd1, 0x4b, 0x21, 0x05,
-	0x0c, 0x3d, 0x15, 0x69, 0x75, 0xe0, 0x93, 0xa3, 0x37, 0xaf, 0xdf, 0xbd, 0x08, 0xf8, 0x15, 0xa5,
-	0xc4, 0x92, 0xf3, 0x3c, 0xb1, 0xcd, 0xbe, 0x39, 0xbc, 0x16, 0x60, 0xac, 0xb4, 0x19, 0xd4, 0xd2,
-	0x6e, 0xf5, 0xcd, 0xe1, 0x49, 0x80, 0xf1, 0xe0, 0x5b, 0x8b, 0x74, 0x9f, 0x8b, 0xf4, 0x52, 0x15,
-	0xf8, 0x36, 0x97, 0xd9, 0x4b, 0x55, 0x1e, 0xb5, 0xc9, 0x11, 0x96, 0xcc, 0xab, 0x26, 0xc1, 0xee,
-	0x97, 0xfa, 0xa4, 0x5d, 0xcb, 0x39, 0x08, 0xbb, 0xd5, 0x3f, 0x18, 0x1e, 0x3f, 0xba, 0xe1, 0xfe,
-	0xdb, 0x82, 0xdb, 0xdc, 0x7e, 0x61, 0x2d, 0x7f, 0xde, 0x31, 0x02, 0x4d, 0xd2, 0x1e, 0x69, 0x63,
-	0xd3, 0xf6, 0x01, 0xa6, 0xd2, 0x3f, 0xf4, 0x2e, 0xe9, 0x14, 0x5c, 0x08, 0x96, 0xf2, 0x30, 0x63,
-	0x22, 0xb3, 0x2d, 0x34, 0x8f, 0x1b, 0xed, 0x19, 0x13, 0x99, 0x42, 0x58, 0x92, 0x54, 0x5c, 0x08,
-	0x8d, 0xb4, 0x35, 0xd2, 0x68, 0x88, 0x8c, 0x48, 0x57, 0xdd, 0x1d, 0xfe, 0xc7, 0x1d, 0x22, 0x77,
-	0x5d, 0x19, 0xe7, 0x7f, 0xd9, 0x27, 0x67, 0x9f, 0xb7, 0x8b, 0xd1, 0xae, 0x91, 0x2f, 0xdb, 0xc5,
-	0xe8, 0x26, 0xae, 0x68, 0xaf, 0xfb, 0xc1, 0x77, 0x93, 0xdc, 0xde, 0x53, 0x03, 0x2e, 0x4a, 0x98,
-	0x08, 0x4e, 0x1f, 0x92, 0x9e, 0x04, 0xc9, 0xc6, 0x21, 0x2b, 0xa0, 0x9e, 0x48, 0xbd, 0x5b, 0xae,
-	0x27, 0x6d, 0x05, 0x14, 0xbd, 0x73, 0xb4, 0x2e, 0xb5, 0x43, 0xef, 0x91, 0x13, 0x9c, 0xc4, 0x1f,
-	0x54, 0x2f, 0xa0, 0x83, 0xe2, 0x1e, 0x24, 0x3e, 0xe4, 0x65, 0xc9, 0x13, 0x9c, 0xd6, 0x0e, 0x7a,
-	0xa5, 0xb5, 0x8b, 0xa7, 0xcb, 0xb5, 0x63, 0xae, 0xd6, 0x8e, 0xf9, 0x6b, 0xed, 0x98, 0x5f, 0x37,
-	0x8e, 0xb1, 0xda, 0x38, 0xc6, 0x8f, 0x8d, 0x63, 0xbc, 0xbf, 0x9f, 0xe6, 0x32, 0xab, 0x23, 0x37,
-	0x86, 0xc2, 0x8b, 0x64, 0x3c, 0x7d, 0x00, 0x55, 0xaa, 0x9f, 0xe0, 0x5c, 0x7f, 0xe4, 0xa7, 0x92,
-	0x8b, 0xe8, 0x10, 0x1f, 0xca, 0xe3, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xa9, 0x90, 0x3e, 0x34,
-	0xa3, 0x02, 0x00, 0x00,
+	// 634 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x94, 0x4d, 0x4f, 0x1b, 0x3d,
+	0x10, 0xc7, 0xb3, 0xe4, 0x85, 0x07, 0x27, 0xc0, 0x83, 0x1b, 0xa8, 0x8b, 0xd4, 0x34, 0x4d, 0x85,
+	0x48, 0x91, 0x9a, 0x34, 0xf4, 0xd6, 0x0b, 0x02, 0x24, 0xc4, 0xa5, 0x6a, 0xb5, 0xf4, 0x4d, 0xbd,
+	0xac, 0x9c, 0x8d, 0xd9, 0xb5, 0xc8, 0xae, 0x97, 0x1d, 0x27, 0x0d, 0xd7, 0x1e, 0x7b, 0xea, 0xf7,
+	0xe8, 0x85, 0x8f, 0xc1, 0x91, 0x63, 0x4f, 0x55, 0x05, 0x07, 0xbe, 0x45, 0x55, 0x79, 0xbc, 0x49,
+	0x97, 0x72, 0x71, 0x3c, 0xff, 0xf9, 0xe9, 0x3f, 0x63, 0x7b, 0xb2, 0x64, 0xf3, 0xb4, 0xaf, 0xfd,
+	0x2e, 0x2e, 0xe3, 0x5e, 0x37, 0x82, 0xc0, 0xf3, 0x87, 0x5c, 0x46, 0xde, 0x67, 0xa9, 0x43, 0x2f,
+	0x49, 0x95, 0x3a, 0xee, 0x24, 0xa9, 0xd2, 0x8a, 0xd6, 0x0c, 0xd3, 0xc1, 0x65, 0xdc, 0x5b, 0x5f,
+	0xe1, 0x91, 0x8c, 0x55, 0x17, 0x57, 0x0b, 0xac, 0xdf, 0xf7, 0x15, 0x44, 0x0a, 0x8c, 0x47, 0x66,
+	0x95, 0x25, 0xea, 0x81, 0x0a, 0x14, 0x6e, 0xbb, 0x66, 0x67, 0xd5, 0x56, 0x8f, 0xcc, 0xbf, 0x7b,
+	0xfb, 0xf1, 0xb5, 0x2b, 0x8e, 0x29, 0x25, 0x25, 0x3d, 0x91, 0x03, 0xe6, 0x34, 0x9d, 0xf6, 0x82,
+	0x8b, 0x7b, 0xa3, 0x8d, 0xd5, 0x48, 0xb3, 0xb9, 0xa6, 0xd3, 0x5e, 0x74, 0x71, 0xdf, 0xfa, 0x5d,
+	0x24, 0x2b, 0xaf, 0x20, 0xd8, 0x37, 0x0d, 0x7e, 0x90, 0x3a, 0x7c, 0x63, 0xda, 0xa3, 0x8c, 0xcc,
+	0x63, 0xcb, 0x22, 0xcd, 0x0c, 0xa6, 0x21, 0xed, 0x91, 0xf2, 0x48, 0x4f, 0x14, 0xb0, 0xb9, 0x66,
+	0xb1, 0x5d, 0xdd, 0x5e, 0xed, 0xe4, 0x8f, 0xd0, 0xc9, 0xaa, 0xef, 0x95, 0x2e, 0x7e, 0x3e, 0x2a,
+	0xb8, 0x96, 0xa4, 0x75, 0x52, 0xc6, 0x43, 0xb3, 0x22, 0x5a, 0xd9, 0x80, 0x3e, 0x26, 0xb5, 0x48,
+	0x00, 0xf0, 0x40, 0x78, 0x21, 0x87, 0x90, 0x95, 0x30, 0x59, 0xcd, 0xb4, 0x43, 0x0e, 0xa1, 0x41,
+	0xf8, 0x60, 0x90, 0x0a, 0x00, 0x8b, 0x94, 0x2d, 0x92, 0x69, 0x88, 0x6c, 0x91, 0x15, 0x53, 0xdb,
+	0xbb, 0xc5, 0x55, 0x90, 0x5b, 0x36, 0x89, 0xdd, 0x1c, 0xbb, 0x49, 0x96, 0xa7, 0x15, 0xc7, 0x22,
+	0x05, 0xa9, 0x62, 0x36, 0x8f, 0xe4, 0x52, 0x26, 0xbf, 0xb7, 0x2a, 0x7d, 0x4a, 0xfe, 0x07, 0x19,
+	0xc4, 0x5c, 0x8f, 0x52, 0xe1, 0x81, 0x1f, 0x8a, 0x48, 0xb0, 0xff, 0xac, 0xe7, 0x4c, 0x3f, 0x42,
+	0x99, 0x36, 0x49, 0x75, 0x20, 0x40, 0xcb, 0x98, 0x6b, 0xe3, 0xb7, 0x60, 0x3b, 0xcc, 0x49, 0x74,
+	0x83, 0x2c, 0x8d, 0x4f, 0xbc, 0x63, 0x19, 0x07, 0x22, 0x4d, 0x52, 0x19, 0x6b, 0x46, 0x10, 0x5a,
+	0x1c, 0x9f, 0x1c, 0xfc, 0x15, 0xe9, 0x13, 0xb2, 0x28, 0x26, 0x89, 0x4c, 0xcf, 0xbc, 0x50, 0xc8,
+	0x20, 0xd4, 0xac, 0xda, 0x74, 0xda, 0x25, 0xb7, 0x66, 0xc5, 0x43, 0xd4, 0x4c, 0x63, 0x39, 0x6b,
+	0x0f, 0xf8, 0x50, 0xb3, 0x9a, 0x6d, 0x2c, 0xa7, 0x1f, 0xf1, 0xa1, 0x7e, 0xb9, 0xf9, 0xe5, 0xe6,
+	0x7c, 0x6b, 0xfa, 0x6a, 0x5f, 0x6f, 0xce, 0xb7, 0xd6, 0x70, 0x1e, 0xef, 0x3c, 0x75, 0xeb, 0xfb,
+	0x1c, 0x79, 0x70, 0x47, 0x75, 0x05, 0x24, 0x2a, 0x06, 0x41, 0x9f, 0x93, 0xba, 0x56, 0x9a, 0x0f,
+	0x3d, 0x1e, 0xa9, 0x51, 0xac, 0xed, 0x20, 0x0b, 0x3b, 0x56, 0x25, 0x97, 0x62, 0x6e, 0x17, 0x53,
+	0xfb, 0x36, 0x63, 0x0e, 0x82, 0xcf, 0x3e, 0x43, 0xed, 0xb4, 0xd5, 0x50, 0xbc, 0x03, 0xc1, 0x89,
+	0x4c, 0x12, 0x31, 0x60, 0xc5, 0x1c, 0x74, 0x64, 0x35, 0xba, 0x4d, 0x56, 0x2d, 0xc4, 0x87, 0xa9,
+	0xe0, 0x83, 0xb3, 0x99, 0x63, 0x09, 0xe1, 0x7b, 0x98, 0xdc, 0xb5, 0xb9, 0xa9, 0xf1, 0x1a, 0xa9,
+	0xa4, 0x82, 0x83, 0x8a, 0xb3, 0x61, 0xc9, 0x22, 0x7a, 0x40, 0x6a, 0xa6, 0x94, 0x67, 0x43, 0x60,
+	0x15, 0x9c, 0xde, 0x87, 0xb7, 0xa7, 0x17, 0x4d, 0x4c, 0x75, 0x17, 0xa9, 0x6c, 0x8a, 0xab, 0x30,
+	0x53, 0xa0, 0xb5, 0x43, 0x96, 0xff, 0xa1, 0x72, 0x25, 0x9d, 0x5b, 0x25, 0xeb, 0xa4, 0xec, 0x9b,
+	0x8b, 0xc9, 0x2e, 0xc0, 0x06, 0x7b, 0x3b, 0x17, 0x57, 0x0d, 0xe7, 0xf2, 0xaa, 0xe1, 0xfc, 0xba,
+	0x6a, 0x38, 0xdf, 0xae, 0x1b, 0x85, 0xcb, 0xeb, 0x46, 0xe1, 0xc7, 0x75, 0xa3, 0xf0, 0x69, 0x23,
+	0x90, 0x3a, 0x1c, 0xf5, 0x3b, 0xbe, 0x8a, 0xba, 0x7d, 0xed, 0x9f, 0x3e, 0x53, 0x69, 0x60, 0x3f,
+	0x22, 0x13, 0xfb, 0xa3, 0xcf, 0x12, 0x01, 0xfd, 0x0a, 0xfe, 0xd5, 0x5f, 0xfc, 0x09, 0x00, 0x00,
+	0xff, 0xff, 0xdf, 0x54, 0x7d, 0x73, 0x65, 0x04, 0x00, 0x00,
 }
 
 func (m *UTXORef) Marshal() (dAtA []byte, err error) {
@@ -338,6 +506,46 @@ func (m *MsgClaimWithProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DestinationSalt) > 0 {
+		i -= len(m.DestinationSalt)
+		copy(dAtA[i:], m.DestinationSalt)
+		i = encodeVarintMsgClaimWithProof(dAtA, i, uint64(len(m.DestinationSalt)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintMsgClaimWithProof(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.VkFingerprint) > 0 {
+		i -= len(m.VkFingerprint)
+		copy(dAtA[i:], m.VkFingerprint)
+		i = encodeVarintMsgClaimWithProof(dAtA, i, uint64(len(m.VkFingerprint)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Destination) > 0 {
+		i -= len(m.Destination)
+		copy(dAtA[i:], m.Destination)
+		i = encodeVarintMsgClaimWithProof(dAtA, i, uint64(len(m.Destination)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.SignatureScheme) > 0 {
+		i -= len(m.SignatureScheme)
+		copy(dAtA[i:], m.SignatureScheme)
+		i = encodeVarintMsgClaimWithProof(dAtA, i, uint64(len(m.SignatureScheme)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.MessageVersion) > 0 {
+		i -= len(m.MessageVersion)
+		copy(dAtA[i:], m.MessageVersion)
+		i = encodeVarintMsgClaimWithProof(dAtA, i, uint64(len(m.MessageVersion)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if len(m.QbtcAddressHash) > 0 {
 		i -= len(m.QbtcAddressHash)
 		copy(dAtA[i:], m.QbtcAddressHash)
@@ -410,6 +618,32 @@ func (m *MsgClaimWithProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if len(m.SkipReasons) > 0 {
+		for iNdEx := len(m.SkipReasons) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SkipReasons[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMsgClaimWithProof(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintMsgClaimWithProof(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.UtxosAlreadyClaimed != 0 {
+		i = encodeVarintMsgClaimWithProof(dAtA, i, uint64(m.UtxosAlreadyClaimed))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.UtxosSkipped != 0 {
 		i = encodeVarintMsgClaimWithProof(dAtA, i, uint64(m.UtxosSkipped))
 		i--
@@ -428,6 +662,41 @@ func (m *MsgClaimWithProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *ClaimSkipReason) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClaimSkipReason) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClaimSkipReason) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintMsgClaimWithProof(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintMsgClaimWithProof(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMsgClaimWithProof(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMsgClaimWithProof(v)
 	base := offset
@@ -487,6 +756,29 @@ func (m *MsgClaimWithProof) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMsgClaimWithProof(uint64(l))
 	}
+	l = len(m.MessageVersion)
+	if l > 0 {
+		n += 1 + l + sovMsgClaimWithProof(uint64(l))
+	}
+	l = len(m.SignatureScheme)
+	if l > 0 {
+		n += 1 + l + sovMsgClaimWithProof(uint64(l))
+	}
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovMsgClaimWithProof(uint64(l))
+	}
+	l = len(m.VkFingerprint)
+	if l > 0 {
+		n += 1 + l + sovMsgClaimWithProof(uint64(l))
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovMsgClaimWithProof(uint64(m.ExpiryHeight))
+	}
+	l = len(m.DestinationSalt)
+	if l > 0 {
+		n += 1 + l + sovMsgClaimWithProof(uint64(l))
+	}
 	return n
 }
 
@@ -505,6 +797,35 @@ func (m *MsgClaimWithProofResponse) Size() (n int) {
 	if m.UtxosSkipped != 0 {
 		n += 1 + sovMsgClaimWithProof(uint64(m.UtxosSkipped))
 	}
+	if m.UtxosAlreadyClaimed != 0 {
+		n += 1 + sovMsgClaimWithProof(uint64(m.UtxosAlreadyClaimed))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovMsgClaimWithProof(uint64(l))
+	}
+	if len(m.SkipReasons) > 0 {
+		for _, e := range m.SkipReasons {
+			l = e.Size()
+			n += 1 + l + sovMsgClaimWithProof(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ClaimSkipReason) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovMsgClaimWithProof(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovMsgClaimWithProof(uint64(m.Count))
+	}
 	return n
 }
 
@@ -838,6 +1159,185 @@ func (m *MsgClaimWithProof) Unmarshal(dAtA []byte) error {
 			}
 			m.QbtcAddressHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgClaimWithProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgClaimWithProof
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgClaimWithProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignatureScheme", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgClaimWithProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgClaimWithProof
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgClaimWithProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignatureScheme = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgClaimWithProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgClaimWithProof
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgClaimWithProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destination = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VkFingerprint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgClaimWithProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgClaimWithProof
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgClaimWithProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VkFingerprint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgClaimWithProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationSalt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgClaimWithProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgClaimWithProof
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgClaimWithProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationSalt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgClaimWithProof(dAtA[iNdEx:])
@@ -945,6 +1445,192 @@ func (m *MsgClaimWithProofResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UtxosAlreadyClaimed", wireType)
+			}
+			m.UtxosAlreadyClaimed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgClaimWithProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UtxosAlreadyClaimed |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgClaimWithProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgClaimWithProof
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgClaimWithProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkipReasons", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgClaimWithProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgClaimWithProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgClaimWithProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SkipReasons = append(m.SkipReasons, ClaimSkipReason{})
+			if err := m.SkipReasons[len(m.SkipReasons)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgClaimWithProof(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgClaimWithProof
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClaimSkipReason) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgClaimWithProof
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClaimSkipReason: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClaimSkipReason: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgClaimWithProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgClaimWithProof
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgClaimWithProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgClaimWithProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgClaimWithProof(dAtA[iNdEx:])
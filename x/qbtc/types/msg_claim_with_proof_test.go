@@ -1,13 +1,16 @@
 package types
 
 import (
+	"bytes"
 	"crypto/rand"
 	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/btcq-org/qbtc/common"
+	"github.com/btcq-org/qbtc/x/qbtc/zk"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
@@ -157,6 +160,37 @@ func TestMsgClaimWithProof_ValidateBasic(t *testing.T) {
 			expectErr: true,
 			errMsg:    "invalid claimer address",
 		},
+		{
+			name: "valid message - explicit destination",
+			msg: &MsgClaimWithProof{
+				Claimer:     validBech32Address,
+				Destination: validBech32Address,
+				Utxos: []UTXORef{
+					{Txid: validBitcoinTxID, Vout: 0},
+				},
+				MessageHash:     makeValidMessageHash(),
+				AddressHash:     makeValidAddressHash(),
+				QbtcAddressHash: makeValidQBTCAddressHash(),
+				Proof:           makeValidProof(),
+			},
+			expectErr: false,
+		},
+		{
+			name: "invalid destination address format",
+			msg: &MsgClaimWithProof{
+				Claimer:     validBech32Address,
+				Destination: "not-a-valid-bech32",
+				Utxos: []UTXORef{
+					{Txid: validBitcoinTxID, Vout: 0},
+				},
+				MessageHash:     makeValidMessageHash(),
+				AddressHash:     makeValidAddressHash(),
+				QbtcAddressHash: makeValidQBTCAddressHash(),
+				Proof:           makeValidProof(),
+			},
+			expectErr: true,
+			errMsg:    "invalid destination address",
+		},
 		{
 			name: "no UTXOs provided",
 			msg: &MsgClaimWithProof{
@@ -170,6 +204,18 @@ func TestMsgClaimWithProof_ValidateBasic(t *testing.T) {
 			expectErr: true,
 			errMsg:    "at least one UTXO is required",
 		},
+		{
+			name: "nil UTXOs",
+			msg: &MsgClaimWithProof{
+				Claimer:         validBech32Address,
+				MessageHash:     makeValidMessageHash(),
+				AddressHash:     makeValidAddressHash(),
+				QbtcAddressHash: makeValidQBTCAddressHash(),
+				Proof:           makeValidProof(),
+			},
+			expectErr: true,
+			errMsg:    "no UTXOs specified",
+		},
 		{
 			name: "too many UTXOs",
 			msg: &MsgClaimWithProof{
@@ -316,6 +362,133 @@ func TestMsgClaimWithProof_ValidateBasic(t *testing.T) {
 			expectErr: true,
 			errMsg:    "qbtc_address_hash is required",
 		},
+		{
+			name: "valid message - bip137 signature scheme",
+			msg: &MsgClaimWithProof{
+				Claimer: validBech32Address,
+				Utxos: []UTXORef{
+					{Txid: validBitcoinTxID, Vout: 0},
+				},
+				MessageHash:     makeValidMessageHash(),
+				AddressHash:     makeValidAddressHash(),
+				QbtcAddressHash: makeValidQBTCAddressHash(),
+				Proof:           makeValidProof(),
+				SignatureScheme: "bip137",
+			},
+			expectErr: false,
+		},
+		{
+			name: "unsupported signature scheme",
+			msg: &MsgClaimWithProof{
+				Claimer: validBech32Address,
+				Utxos: []UTXORef{
+					{Txid: validBitcoinTxID, Vout: 0},
+				},
+				MessageHash:     makeValidMessageHash(),
+				AddressHash:     makeValidAddressHash(),
+				QbtcAddressHash: makeValidQBTCAddressHash(),
+				Proof:           makeValidProof(),
+				SignatureScheme: "bip322",
+			},
+			expectErr: true,
+			errMsg:    "unsupported signature_scheme",
+		},
+		{
+			name: "valid message - vk fingerprint",
+			msg: &MsgClaimWithProof{
+				Claimer: validBech32Address,
+				Utxos: []UTXORef{
+					{Txid: validBitcoinTxID, Vout: 0},
+				},
+				MessageHash:     makeValidMessageHash(),
+				AddressHash:     makeValidAddressHash(),
+				QbtcAddressHash: makeValidQBTCAddressHash(),
+				Proof:           makeValidProof(),
+				VkFingerprint:   "0123456789abcdef",
+			},
+			expectErr: false,
+		},
+		{
+			name: "vk fingerprint of the wrong length",
+			msg: &MsgClaimWithProof{
+				Claimer: validBech32Address,
+				Utxos: []UTXORef{
+					{Txid: validBitcoinTxID, Vout: 0},
+				},
+				MessageHash:     makeValidMessageHash(),
+				AddressHash:     makeValidAddressHash(),
+				QbtcAddressHash: makeValidQBTCAddressHash(),
+				Proof:           makeValidProof(),
+				VkFingerprint:   "0123456789abcdef0123456789abcdef",
+			},
+			expectErr: true,
+			errMsg:    "vk_fingerprint must be 16 hex characters",
+		},
+		{
+			name: "vk fingerprint not hex",
+			msg: &MsgClaimWithProof{
+				Claimer: validBech32Address,
+				Utxos: []UTXORef{
+					{Txid: validBitcoinTxID, Vout: 0},
+				},
+				MessageHash:     makeValidMessageHash(),
+				AddressHash:     makeValidAddressHash(),
+				QbtcAddressHash: makeValidQBTCAddressHash(),
+				Proof:           makeValidProof(),
+				VkFingerprint:   "0123456789abcdeg",
+			},
+			expectErr: true,
+			errMsg:    "vk_fingerprint is not valid hex",
+		},
+		{
+			name: "valid message - expiry height",
+			msg: &MsgClaimWithProof{
+				Claimer: validBech32Address,
+				Utxos: []UTXORef{
+					{Txid: validBitcoinTxID, Vout: 0},
+				},
+				MessageHash:     makeValidMessageHash(),
+				AddressHash:     makeValidAddressHash(),
+				QbtcAddressHash: makeValidQBTCAddressHash(),
+				Proof:           makeValidProof(),
+				MessageVersion:  "qbtc-claim-v5",
+				ExpiryHeight:    1000,
+			},
+			expectErr: false,
+		},
+		{
+			name: "expiry height missing for a version binding it",
+			msg: &MsgClaimWithProof{
+				Claimer: validBech32Address,
+				Utxos: []UTXORef{
+					{Txid: validBitcoinTxID, Vout: 0},
+				},
+				MessageHash:     makeValidMessageHash(),
+				AddressHash:     makeValidAddressHash(),
+				QbtcAddressHash: makeValidQBTCAddressHash(),
+				Proof:           makeValidProof(),
+				MessageVersion:  "qbtc-claim-v5",
+			},
+			expectErr: true,
+			errMsg:    "expiry_height is required",
+		},
+		{
+			name: "expiry height for a version not binding it",
+			msg: &MsgClaimWithProof{
+				Claimer: validBech32Address,
+				Utxos: []UTXORef{
+					{Txid: validBitcoinTxID, Vout: 0},
+				},
+				MessageHash:     makeValidMessageHash(),
+				AddressHash:     makeValidAddressHash(),
+				QbtcAddressHash: makeValidQBTCAddressHash(),
+				Proof:           makeValidProof(),
+				MessageVersion:  "qbtc-claim-v3",
+				ExpiryHeight:    1000,
+			},
+			expectErr: true,
+			errMsg:    "expiry_height is only used",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -330,3 +503,55 @@ func TestMsgClaimWithProof_ValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgClaimWithProof_ValidateBasic_NoUTXOsError(t *testing.T) {
+	sdk.GetConfig().SetBech32PrefixForAccount(common.AccountAddressPrefix, common.AccountAddressPrefix+sdk.PrefixPublic)
+	msg := &MsgClaimWithProof{
+		Claimer:         validBech32Address,
+		Utxos:           []UTXORef{},
+		MessageHash:     makeValidMessageHash(),
+		AddressHash:     makeValidAddressHash(),
+		QbtcAddressHash: makeValidQBTCAddressHash(),
+		Proof:           makeValidProof(),
+	}
+	err := msg.ValidateBasic()
+	require.ErrorIs(t, err, ErrNoUTXOsSpecified)
+	require.NotErrorIs(t, err, ErrNoClaimableUTXOs)
+}
+
+func TestMsgClaimWithProof_DestinationSalt(t *testing.T) {
+	sdk.GetConfig().SetBech32PrefixForAccount(common.AccountAddressPrefix, common.AccountAddressPrefix+sdk.PrefixPublic)
+	salt := bytes.Repeat([]byte{0xab}, zk.DestinationSaltSize)
+	newMsg := func(version, destinationSalt string) *MsgClaimWithProof {
+		msg := &MsgClaimWithProof{
+			Claimer:         validBech32Address,
+			Utxos:           makeValidUTXORefs(1),
+			MessageHash:     makeValidMessageHash(),
+			AddressHash:     makeValidAddressHash(),
+			QbtcAddressHash: makeValidQBTCAddressHash(),
+			Proof:           makeValidProof(),
+			MessageVersion:  version,
+			DestinationSalt: destinationSalt,
+		}
+		// v6 binds an expiry like v5
+		if zk.ClaimMessageBindsExpiry(version) {
+			msg.ExpiryHeight = 1000
+		}
+		return msg
+	}
+
+	msg := newMsg(zk.ClaimMessageVersionV6, hex.EncodeToString(salt))
+	require.NoError(t, msg.ValidateBasic())
+	binding, err := msg.RecipientBinding()
+	require.NoError(t, err)
+	require.Equal(t, zk.CommitBTCQAddress(validBech32Address, [zk.DestinationSaltSize]byte(salt)), binding)
+
+	// other versions bind the plain address hash
+	binding, err = newMsg("", "").RecipientBinding()
+	require.NoError(t, err)
+	require.Equal(t, zk.HashBTCQAddress(validBech32Address), binding)
+
+	require.ErrorContains(t, newMsg(zk.ClaimMessageVersionV6, "").ValidateBasic(), "destination_salt must be 64 hex characters")
+	require.ErrorContains(t, newMsg(zk.ClaimMessageVersionV6, strings.Repeat("zz", 32)).ValidateBasic(), "destination_salt is not valid hex")
+	require.ErrorContains(t, newMsg(zk.ClaimMessageVersionV3, hex.EncodeToString(salt)).ValidateBasic(), "only used by message versions that bind a salted destination")
+}
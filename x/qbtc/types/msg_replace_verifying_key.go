@@ -0,0 +1,36 @@
+package types
+
+import (
+	"github.com/btcq-org/qbtc/x/qbtc/zk"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ sdk.Msg              = &MsgReplaceVerifyingKey{}
+	_ sdk.HasValidateBasic = &MsgReplaceVerifyingKey{}
+)
+
+func NewMsgReplaceVerifyingKey(authority string, verifyingKey []byte) *MsgReplaceVerifyingKey {
+	return &MsgReplaceVerifyingKey{
+		Authority:    authority,
+		VerifyingKey: verifyingKey,
+	}
+}
+
+func (m *MsgReplaceVerifyingKey) ValidateBasic() error {
+	if m.Authority == "" {
+		return sdkerrors.ErrInvalidAddress.Wrap("authority cannot be empty")
+	}
+	if m.MessageVersion != "" && !zk.IsSupportedClaimMessageVersion(m.MessageVersion) {
+		return sdkerrors.ErrInvalidRequest.Wrapf("unsupported message_version %q", m.MessageVersion)
+	}
+	// only a version's own key can be removed, the default key always stays
+	if m.MessageVersion != "" && len(m.VerifyingKey) == 0 {
+		return nil
+	}
+	if err := ValidateVerifyingKey(m.VerifyingKey); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("invalid verifying key: %v", err)
+	}
+	return nil
+}
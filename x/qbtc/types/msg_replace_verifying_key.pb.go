@@ -0,0 +1,439 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: qbtc/qbtc/v1/msg_replace_verifying_key.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgReplaceVerifyingKey replaces the PLONK verifying key used to verify
+// claim proofs. This message can only be executed by the governance authority.
+type MsgReplaceVerifyingKey struct {
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// verifying_key is the serialized PLONK verifying key.
+	VerifyingKey []byte `protobuf:"bytes,2,opt,name=verifying_key,json=verifyingKey,proto3" json:"verifying_key,omitempty"`
+	// message_version, when set, replaces only the key that verifies claims of
+	// that claim message version, instead of the default key used by every
+	// version without a key of its own. An empty verifying_key then removes the
+	// version's key, so its claims fall back to the default key.
+	MessageVersion string `protobuf:"bytes,3,opt,name=message_version,json=messageVersion,proto3" json:"message_version,omitempty"`
+}
+
+func (m *MsgReplaceVerifyingKey) Reset()         { *m = MsgReplaceVerifyingKey{} }
+func (m *MsgReplaceVerifyingKey) String() string { return proto.CompactTextString(m) }
+func (*MsgReplaceVerifyingKey) ProtoMessage()    {}
+func (*MsgReplaceVerifyingKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b6ecadfb3e08186, []int{0}
+}
+func (m *MsgReplaceVerifyingKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReplaceVerifyingKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReplaceVerifyingKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReplaceVerifyingKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReplaceVerifyingKey.Merge(m, src)
+}
+func (m *MsgReplaceVerifyingKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReplaceVerifyingKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReplaceVerifyingKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReplaceVerifyingKey proto.InternalMessageInfo
+
+func (m *MsgReplaceVerifyingKey) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgReplaceVerifyingKey) GetVerifyingKey() []byte {
+	if m != nil {
+		return m.VerifyingKey
+	}
+	return nil
+}
+
+func (m *MsgReplaceVerifyingKey) GetMessageVersion() string {
+	if m != nil {
+		return m.MessageVersion
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*MsgReplaceVerifyingKey)(nil), "qbtc.qbtc.v1.MsgReplaceVerifyingKey")
+}
+
+func init() {
+	proto.RegisterFile("qbtc/qbtc/v1/msg_replace_verifying_key.proto", fileDescriptor_6b6ecadfb3e08186)
+}
+
+var fileDescriptor_6b6ecadfb3e08186 = []byte{
+	// 298 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x29, 0x4c, 0x2a, 0x49,
+	0xd6, 0x07, 0x13, 0x65, 0x86, 0xfa, 0xb9, 0xc5, 0xe9, 0xf1, 0x45, 0xa9, 0x05, 0x39, 0x89, 0xc9,
+	0xa9, 0xf1, 0x65, 0xa9, 0x45, 0x99, 0x69, 0x95, 0x99, 0x79, 0xe9, 0xf1, 0xd9, 0xa9, 0x95, 0x7a,
+	0x05, 0x45, 0xf9, 0x25, 0xf9, 0x42, 0x3c, 0x20, 0x85, 0x7a, 0x60, 0xa2, 0xcc, 0x50, 0x4a, 0x30,
+	0x31, 0x37, 0x33, 0x2f, 0x5f, 0x1f, 0x4c, 0x42, 0x14, 0x48, 0x89, 0x27, 0xe7, 0x17, 0xe7, 0xe6,
+	0x17, 0x83, 0x0c, 0x82, 0x9a, 0x07, 0x95, 0x90, 0x84, 0x48, 0xc4, 0x83, 0x79, 0xfa, 0x10, 0x0e,
+	0x44, 0x4a, 0xe9, 0x1c, 0x23, 0x97, 0x98, 0x6f, 0x71, 0x7a, 0x10, 0xc4, 0xde, 0x30, 0x98, 0xb5,
+	0xde, 0xa9, 0x95, 0x42, 0x66, 0x5c, 0x9c, 0x89, 0xa5, 0x25, 0x19, 0xf9, 0x45, 0x99, 0x25, 0x95,
+	0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0x9c, 0x4e, 0x12, 0x97, 0xb6, 0xe8, 0x8a, 0x40, 0xf5, 0x3b, 0xa6,
+	0xa4, 0x14, 0xa5, 0x16, 0x17, 0x07, 0x97, 0x14, 0x65, 0xe6, 0xa5, 0x07, 0x21, 0x94, 0x0a, 0x29,
+	0x73, 0xf1, 0xa2, 0x38, 0x5f, 0x82, 0x49, 0x81, 0x51, 0x83, 0x27, 0x88, 0xa7, 0x0c, 0xd9, 0x70,
+	0x75, 0x2e, 0xfe, 0xdc, 0xd4, 0xe2, 0xe2, 0xc4, 0x74, 0xb0, 0x5f, 0x8b, 0x33, 0xf3, 0xf3, 0x24,
+	0x98, 0x41, 0x56, 0x04, 0xf1, 0x41, 0x85, 0xc3, 0x20, 0xa2, 0x56, 0x7a, 0x4d, 0xcf, 0x37, 0x68,
+	0x21, 0x4c, 0xef, 0x7a, 0xbe, 0x41, 0x4b, 0x1a, 0x1c, 0x62, 0xd8, 0x5d, 0xed, 0x64, 0x7f, 0xe2,
+	0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70,
+	0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0xaa, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49,
+	0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x49, 0x25, 0xc9, 0x85, 0xba, 0xf9, 0x45, 0xe9, 0x90, 0xc0, 0xaf,
+	0x80, 0x50, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0xe0, 0x80, 0x31, 0x06, 0x04, 0x00, 0x00,
+	0xff, 0xff, 0xe5, 0x76, 0xc6, 0x60, 0x9d, 0x01, 0x00, 0x00,
+}
+
+func (m *MsgReplaceVerifyingKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReplaceVerifyingKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReplaceVerifyingKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MessageVersion) > 0 {
+		i -= len(m.MessageVersion)
+		copy(dAtA[i:], m.MessageVersion)
+		i = encodeVarintMsgReplaceVerifyingKey(dAtA, i, uint64(len(m.MessageVersion)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.VerifyingKey) > 0 {
+		i -= len(m.VerifyingKey)
+		copy(dAtA[i:], m.VerifyingKey)
+		i = encodeVarintMsgReplaceVerifyingKey(dAtA, i, uint64(len(m.VerifyingKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintMsgReplaceVerifyingKey(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMsgReplaceVerifyingKey(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMsgReplaceVerifyingKey(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgReplaceVerifyingKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovMsgReplaceVerifyingKey(uint64(l))
+	}
+	l = len(m.VerifyingKey)
+	if l > 0 {
+		n += 1 + l + sovMsgReplaceVerifyingKey(uint64(l))
+	}
+	l = len(m.MessageVersion)
+	if l > 0 {
+		n += 1 + l + sovMsgReplaceVerifyingKey(uint64(l))
+	}
+	return n
+}
+
+func sovMsgReplaceVerifyingKey(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMsgReplaceVerifyingKey(x uint64) (n int) {
+	return sovMsgReplaceVerifyingKey(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgReplaceVerifyingKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgReplaceVerifyingKey
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReplaceVerifyingKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReplaceVerifyingKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgReplaceVerifyingKey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgReplaceVerifyingKey
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgReplaceVerifyingKey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifyingKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgReplaceVerifyingKey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgReplaceVerifyingKey
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgReplaceVerifyingKey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerifyingKey = append(m.VerifyingKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.VerifyingKey == nil {
+				m.VerifyingKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgReplaceVerifyingKey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgReplaceVerifyingKey
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgReplaceVerifyingKey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgReplaceVerifyingKey(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgReplaceVerifyingKey
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipMsgReplaceVerifyingKey(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowMsgReplaceVerifyingKey
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMsgReplaceVerifyingKey
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMsgReplaceVerifyingKey
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthMsgReplaceVerifyingKey
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupMsgReplaceVerifyingKey
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthMsgReplaceVerifyingKey
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthMsgReplaceVerifyingKey        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowMsgReplaceVerifyingKey          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupMsgReplaceVerifyingKey = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,28 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ sdk.Msg              = &MsgSetClaimMemoPrefixes{}
+	_ sdk.HasValidateBasic = &MsgSetClaimMemoPrefixes{}
+)
+
+func NewMsgSetClaimMemoPrefixes(authority string, prefixes []string) *MsgSetClaimMemoPrefixes {
+	return &MsgSetClaimMemoPrefixes{
+		Authority: authority,
+		Prefixes:  prefixes,
+	}
+}
+
+func (m *MsgSetClaimMemoPrefixes) ValidateBasic() error {
+	if m.Authority == "" {
+		return sdkerrors.ErrInvalidAddress.Wrap("authority cannot be empty")
+	}
+	if err := ValidateClaimMemoPrefixes(m.Prefixes); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,385 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: qbtc/qbtc/v1/msg_set_claim_memo_prefixes.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgSetClaimMemoPrefixes replaces the set of OP_RETURN memo prefixes that mark
+// a Bitcoin transaction as a claim. This message can only be executed by the
+// governance authority.
+type MsgSetClaimMemoPrefixes struct {
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// prefixes are matched case-insensitively against the start of the memo.
+	Prefixes []string `protobuf:"bytes,2,rep,name=prefixes,proto3" json:"prefixes,omitempty"`
+}
+
+func (m *MsgSetClaimMemoPrefixes) Reset()         { *m = MsgSetClaimMemoPrefixes{} }
+func (m *MsgSetClaimMemoPrefixes) String() string { return proto.CompactTextString(m) }
+func (*MsgSetClaimMemoPrefixes) ProtoMessage()    {}
+func (*MsgSetClaimMemoPrefixes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82a542d476424c7d, []int{0}
+}
+func (m *MsgSetClaimMemoPrefixes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetClaimMemoPrefixes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetClaimMemoPrefixes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetClaimMemoPrefixes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetClaimMemoPrefixes.Merge(m, src)
+}
+func (m *MsgSetClaimMemoPrefixes) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetClaimMemoPrefixes) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetClaimMemoPrefixes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetClaimMemoPrefixes proto.InternalMessageInfo
+
+func (m *MsgSetClaimMemoPrefixes) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetClaimMemoPrefixes) GetPrefixes() []string {
+	if m != nil {
+		return m.Prefixes
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*MsgSetClaimMemoPrefixes)(nil), "qbtc.qbtc.v1.MsgSetClaimMemoPrefixes")
+}
+
+func init() {
+	proto.RegisterFile("qbtc/qbtc/v1/msg_set_claim_memo_prefixes.proto", fileDescriptor_82a542d476424c7d)
+}
+
+var fileDescriptor_82a542d476424c7d = []byte{
+	// 272 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x2b, 0x4c, 0x2a, 0x49,
+	0xd6, 0x07, 0x13, 0x65, 0x86, 0xfa, 0xb9, 0xc5, 0xe9, 0xf1, 0xc5, 0xa9, 0x25, 0xf1, 0xc9, 0x39,
+	0x89, 0x99, 0xb9, 0xf1, 0xb9, 0xa9, 0xb9, 0xf9, 0xf1, 0x05, 0x45, 0xa9, 0x69, 0x99, 0x15, 0xa9,
+	0xc5, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9, 0x42, 0x3c, 0x20, 0xa5, 0x60, 0x4d, 0x7a, 0x65, 0x86,
+	0x52, 0x82, 0x89, 0xb9, 0x99, 0x79, 0xf9, 0xfa, 0x60, 0x12, 0xa2, 0x40, 0x4a, 0x3c, 0x39, 0xbf,
+	0x38, 0x37, 0xbf, 0x18, 0x64, 0x14, 0xd4, 0x44, 0xa8, 0x84, 0x24, 0x44, 0x22, 0x1e, 0xcc, 0xd3,
+	0x87, 0x70, 0x20, 0x52, 0x4a, 0xf3, 0x18, 0xb9, 0xc4, 0x7d, 0x8b, 0xd3, 0x83, 0x53, 0x4b, 0x9c,
+	0x41, 0x16, 0xfb, 0xa6, 0xe6, 0xe6, 0x07, 0x40, 0xad, 0x15, 0x32, 0xe3, 0xe2, 0x4c, 0x2c, 0x2d,
+	0xc9, 0xc8, 0x2f, 0xca, 0x2c, 0xa9, 0x94, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x74, 0x92, 0xb8, 0xb4,
+	0x45, 0x57, 0x04, 0x6a, 0x80, 0x63, 0x4a, 0x4a, 0x51, 0x6a, 0x71, 0x71, 0x70, 0x49, 0x51, 0x66,
+	0x5e, 0x7a, 0x10, 0x42, 0xa9, 0x90, 0x14, 0x17, 0x07, 0xcc, 0xe9, 0x12, 0x4c, 0x0a, 0xcc, 0x1a,
+	0x9c, 0x41, 0x70, 0xbe, 0x95, 0x7e, 0xd3, 0xf3, 0x0d, 0x5a, 0x08, 0xb5, 0x5d, 0xcf, 0x37, 0x68,
+	0xc9, 0x80, 0x83, 0x00, 0x87, 0x23, 0x9c, 0xec, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e,
+	0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58,
+	0x8e, 0x21, 0x4a, 0x35, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x3f, 0xa9,
+	0x24, 0xb9, 0x50, 0x37, 0xbf, 0x28, 0x1d, 0x12, 0x9c, 0x15, 0x10, 0xaa, 0xa4, 0xb2, 0x20, 0xb5,
+	0x38, 0x89, 0x0d, 0xec, 0x51, 0x63, 0xc0, 0x00, 0x86, 0x1c, 0x3a, 0x58, 0x6f, 0x01, 0x00, 0x00,
+}
+
+func (m *MsgSetClaimMemoPrefixes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetClaimMemoPrefixes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetClaimMemoPrefixes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Prefixes) > 0 {
+		for iNdEx := len(m.Prefixes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Prefixes[iNdEx])
+			copy(dAtA[i:], m.Prefixes[iNdEx])
+			i = encodeVarintMsgSetClaimMemoPrefixes(dAtA, i, uint64(len(m.Prefixes[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintMsgSetClaimMemoPrefixes(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMsgSetClaimMemoPrefixes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMsgSetClaimMemoPrefixes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSetClaimMemoPrefixes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovMsgSetClaimMemoPrefixes(uint64(l))
+	}
+	if len(m.Prefixes) > 0 {
+		for _, s := range m.Prefixes {
+			l = len(s)
+			n += 1 + l + sovMsgSetClaimMemoPrefixes(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovMsgSetClaimMemoPrefixes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMsgSetClaimMemoPrefixes(x uint64) (n int) {
+	return sovMsgSetClaimMemoPrefixes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSetClaimMemoPrefixes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgSetClaimMemoPrefixes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetClaimMemoPrefixes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetClaimMemoPrefixes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgSetClaimMemoPrefixes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgSetClaimMemoPrefixes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgSetClaimMemoPrefixes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefixes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgSetClaimMemoPrefixes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgSetClaimMemoPrefixes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgSetClaimMemoPrefixes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prefixes = append(m.Prefixes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgSetClaimMemoPrefixes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgSetClaimMemoPrefixes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipMsgSetClaimMemoPrefixes(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowMsgSetClaimMemoPrefixes
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMsgSetClaimMemoPrefixes
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMsgSetClaimMemoPrefixes
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthMsgSetClaimMemoPrefixes
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupMsgSetClaimMemoPrefixes
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthMsgSetClaimMemoPrefixes
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthMsgSetClaimMemoPrefixes        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowMsgSetClaimMemoPrefixes          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupMsgSetClaimMemoPrefixes = fmt.Errorf("proto: unexpected end of group")
+)
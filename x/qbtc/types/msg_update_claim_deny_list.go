@@ -0,0 +1,33 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ sdk.Msg              = &MsgUpdateClaimDenyList{}
+	_ sdk.HasValidateBasic = &MsgUpdateClaimDenyList{}
+)
+
+func NewMsgUpdateClaimDenyList(authority string, add, remove []string) *MsgUpdateClaimDenyList {
+	return &MsgUpdateClaimDenyList{
+		Authority: authority,
+		Add:       add,
+		Remove:    remove,
+	}
+}
+
+func (m *MsgUpdateClaimDenyList) ValidateBasic() error {
+	if m.Authority == "" {
+		return sdkerrors.ErrInvalidAddress.Wrap("authority cannot be empty")
+	}
+	if len(m.Add) == 0 && len(m.Remove) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("nothing to add or remove")
+	}
+	// an address may not be both added and removed in the same update
+	if err := ValidateClaimDenyList(append(append([]string{}, m.Add...), m.Remove...)); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	return nil
+}
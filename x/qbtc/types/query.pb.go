@@ -32,36 +32,53 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 func init() { proto.RegisterFile("qbtc/qbtc/v1/query.proto", fileDescriptor_73732787b77b750d) }
 
 var fileDescriptor_73732787b77b750d = []byte{
-	// 454 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x4f, 0x8b, 0xd3, 0x40,
-	0x18, 0x87, 0x1b, 0x61, 0x17, 0x0c, 0xc2, 0xb2, 0xc3, 0xca, 0x4a, 0xd9, 0xcd, 0xfa, 0xa7, 0x55,
-	0x5c, 0x76, 0x33, 0x44, 0x3f, 0x80, 0x74, 0xcf, 0x22, 0xd5, 0xa3, 0x97, 0x30, 0x49, 0x5e, 0x62,
-	0xe8, 0x24, 0x6f, 0x32, 0x33, 0x2d, 0x96, 0xd2, 0x8b, 0x9f, 0x40, 0x10, 0xc4, 0x8b, 0x5f, 0xc4,
-	0x4f, 0xe0, 0xb1, 0xe0, 0xc5, 0xa3, 0xb4, 0x7e, 0x10, 0xe9, 0xcc, 0xa4, 0xd8, 0x36, 0x5b, 0x7a,
-	0x99, 0x0c, 0x33, 0xcf, 0x3b, 0xbf, 0x27, 0x6f, 0x26, 0xee, 0x83, 0x2a, 0x52, 0x31, 0xd5, 0xc3,
-	0x28, 0xa0, 0xd5, 0x10, 0xc4, 0xd8, 0x2f, 0x05, 0x2a, 0x24, 0xf7, 0x96, 0x8b, 0xbe, 0x1e, 0x46,
-	0x41, 0xfb, 0x98, 0xe5, 0x59, 0x81, 0x54, 0x8f, 0x06, 0x68, 0x5f, 0xc6, 0x28, 0x73, 0x94, 0x34,
-	0x62, 0x12, 0x4c, 0x25, 0x1d, 0x05, 0x11, 0x28, 0x16, 0xd0, 0x92, 0xa5, 0x59, 0xc1, 0x54, 0x86,
-	0x85, 0x65, 0x4f, 0x52, 0x4c, 0x51, 0x4f, 0xe9, 0x72, 0x66, 0x57, 0xcf, 0x52, 0xc4, 0x94, 0x03,
-	0x65, 0x65, 0x46, 0x59, 0x51, 0xa0, 0xd2, 0x25, 0xd2, 0xee, 0x76, 0xb7, 0xd5, 0xc2, 0x12, 0x40,
-	0x84, 0x2c, 0x49, 0x04, 0xc8, 0x1a, 0xbb, 0x68, 0xc2, 0x98, 0x60, 0x79, 0x0d, 0x3c, 0x6b, 0x00,
-	0x38, 0x93, 0x2a, 0x2c, 0x05, 0xc6, 0x20, 0x25, 0x24, 0x06, 0x7c, 0xf1, 0xe3, 0xc0, 0x3d, 0x78,
-	0xbb, 0xdc, 0x26, 0x5f, 0x1d, 0xf7, 0xe8, 0x0d, 0x26, 0xd0, 0x07, 0x10, 0x3d, 0x93, 0x46, 0x9e,
-	0xfb, 0xff, 0x37, 0xc4, 0xd7, 0xe0, 0x06, 0xf3, 0x0e, 0xaa, 0x21, 0x48, 0xd5, 0xbe, 0xdc, 0x07,
-	0x95, 0x25, 0x16, 0x12, 0x1e, 0x5f, 0x7d, 0xfa, 0xf5, 0xf7, 0xcb, 0x9d, 0xa7, 0xa4, 0xb3, 0x32,
-	0x2c, 0x30, 0x81, 0xb5, 0x17, 0xa5, 0x13, 0x3b, 0x99, 0x92, 0xef, 0x8e, 0x7b, 0xd2, 0xe3, 0x7c,
-	0xe3, 0x30, 0x90, 0xc4, 0x6f, 0x88, 0x6c, 0x02, 0x6b, 0x45, 0xba, 0x37, 0x6f, 0x3d, 0x3b, 0xda,
-	0xd3, 0x23, 0x67, 0xb7, 0x7b, 0x82, 0x24, 0xdf, 0x1c, 0x97, 0xbc, 0x66, 0x52, 0xf5, 0xeb, 0xd6,
-	0xde, 0x70, 0x8c, 0x07, 0xe4, 0xaa, 0x21, 0x6d, 0x1b, 0xab, 0xdd, 0xae, 0xf7, 0xa4, 0xad, 0x59,
-	0x57, 0x9b, 0x5d, 0x90, 0xf3, 0x95, 0xd9, 0xfa, 0xd7, 0x0d, 0x23, 0xed, 0xc0, 0xdd, 0xc3, 0xbe,
-	0xbe, 0x16, 0xe4, 0x61, 0xc3, 0xf9, 0x66, 0xab, 0x36, 0x78, 0xb4, 0x83, 0xb0, 0xa9, 0xe7, 0x3a,
-	0xf5, 0x94, 0xdc, 0x5f, 0xa5, 0x9a, 0x4b, 0x47, 0x27, 0x03, 0x18, 0x4f, 0x09, 0xba, 0x77, 0x7b,
-	0x9c, 0xdb, 0xc0, 0x27, 0xcd, 0xcd, 0x5e, 0xcf, 0xec, 0xec, 0x86, 0x6c, 0xec, 0xa9, 0x8e, 0x3d,
-	0x26, 0x47, 0x1b, 0xb1, 0x37, 0xaf, 0x7e, 0xce, 0x3d, 0x67, 0x36, 0xf7, 0x9c, 0x3f, 0x73, 0xcf,
-	0xf9, 0xbc, 0xf0, 0x5a, 0xb3, 0x85, 0xd7, 0xfa, 0xbd, 0xf0, 0x5a, 0xef, 0xbb, 0x69, 0xa6, 0x3e,
-	0x0c, 0x23, 0x3f, 0xc6, 0x9c, 0x46, 0x2a, 0xae, 0xae, 0x51, 0xa4, 0xa6, 0xfa, 0xa3, 0x79, 0xa8,
-	0x71, 0x09, 0x32, 0x3a, 0xd4, 0x3f, 0xc1, 0xcb, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x11, 0xa7,
-	0x12, 0xc5, 0x12, 0x04, 0x00, 0x00,
+	// 721 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x95, 0x4d, 0x6b, 0xdb, 0x4a,
+	0x14, 0x86, 0xa3, 0xcb, 0xbd, 0x81, 0xab, 0x84, 0x84, 0x0c, 0x69, 0x13, 0x9c, 0x58, 0xf9, 0xb4,
+	0x93, 0x98, 0xc4, 0x83, 0xdb, 0x5d, 0x36, 0x25, 0xc9, 0xa6, 0xd0, 0xd2, 0xa6, 0x9f, 0x94, 0x6e,
+	0xc4, 0x48, 0x3e, 0x95, 0x85, 0x65, 0x8d, 0xac, 0x19, 0x9b, 0x08, 0x63, 0x0a, 0xdd, 0x14, 0xba,
+	0x28, 0x85, 0x42, 0xe9, 0xa6, 0xff, 0xa7, 0xab, 0x12, 0xe8, 0xa6, 0xcb, 0x92, 0xf4, 0x87, 0x14,
+	0x8d, 0x46, 0x8a, 0xe5, 0x8c, 0x1d, 0x6f, 0x64, 0xe1, 0xf3, 0xe8, 0xbc, 0x8f, 0xa4, 0x99, 0x23,
+	0x7d, 0xb9, 0x6d, 0x71, 0x1b, 0x8b, 0x43, 0xb7, 0x86, 0xdb, 0x1d, 0x08, 0xa3, 0x6a, 0x10, 0x52,
+	0x4e, 0xd1, 0x6c, 0xfc, 0x67, 0x55, 0x1c, 0xba, 0xb5, 0xc2, 0x02, 0x69, 0xb9, 0x3e, 0xc5, 0xe2,
+	0x98, 0x00, 0x85, 0x8a, 0x4d, 0x59, 0x8b, 0x32, 0x6c, 0x11, 0x06, 0xc9, 0x95, 0xb8, 0x5b, 0xb3,
+	0x80, 0x93, 0x1a, 0x0e, 0x88, 0xe3, 0xfa, 0x84, 0xbb, 0xd4, 0x97, 0xec, 0xa2, 0x43, 0x1d, 0x2a,
+	0x4e, 0x71, 0x7c, 0x26, 0xff, 0x5d, 0x75, 0x28, 0x75, 0x3c, 0xc0, 0x24, 0x70, 0x31, 0xf1, 0x7d,
+	0xca, 0xc5, 0x25, 0x4c, 0x56, 0x4b, 0xd7, 0xd5, 0xcc, 0x00, 0x20, 0x34, 0x49, 0xbd, 0x1e, 0x02,
+	0x4b, 0xb1, 0x35, 0x15, 0x46, 0x42, 0xd2, 0x4a, 0x81, 0x1d, 0x05, 0xe0, 0x11, 0xc6, 0xcd, 0x20,
+	0xa4, 0x36, 0x30, 0x06, 0x75, 0x09, 0x16, 0x15, 0x60, 0x87, 0x9f, 0xa5, 0xb6, 0x65, 0x45, 0xb9,
+	0x41, 0x98, 0x69, 0x7b, 0xc4, 0x6d, 0x11, 0xcb, 0x03, 0xc9, 0xed, 0x2a, 0xb8, 0x2e, 0x84, 0xee,
+	0x1b, 0x17, 0x42, 0x93, 0x71, 0xc2, 0x3b, 0xe3, 0xee, 0x50, 0x74, 0xcb, 0xdf, 0x40, 0x79, 0x84,
+	0x17, 0x33, 0xad, 0xc8, 0x6c, 0x42, 0xc4, 0xc6, 0x04, 0xdb, 0x0d, 0xb0, 0x9b, 0xe9, 0x13, 0x03,
+	0x49, 0xde, 0xf9, 0x31, 0xa3, 0xff, 0xf7, 0x24, 0xae, 0xa3, 0x2f, 0x9a, 0x3e, 0xff, 0x88, 0xd6,
+	0xe1, 0x14, 0x20, 0x3c, 0x4a, 0x28, 0xb4, 0x57, 0x1d, 0x7c, 0xf5, 0x55, 0x01, 0x0e, 0x31, 0x4f,
+	0xa1, 0xdd, 0x01, 0xc6, 0x0b, 0x95, 0x49, 0x50, 0x16, 0x50, 0x9f, 0xc1, 0xe6, 0xfe, 0xbb, 0x9f,
+	0x7f, 0x3e, 0xff, 0x53, 0x46, 0xdb, 0x99, 0xa2, 0x4f, 0xeb, 0x90, 0x7b, 0xa5, 0xb8, 0x27, 0x4f,
+	0xfa, 0xe8, 0x9b, 0xa6, 0x2f, 0x1e, 0x79, 0xde, 0x50, 0x33, 0x60, 0xa8, 0xaa, 0x88, 0x54, 0x81,
+	0xa9, 0x22, 0x9e, 0x98, 0x97, 0x9e, 0xdb, 0xc2, 0xd3, 0x40, 0xab, 0xa3, 0x3d, 0x81, 0xa1, 0xaf,
+	0x9a, 0x8e, 0x1e, 0x12, 0xc6, 0x4f, 0xd3, 0x45, 0x74, 0xec, 0x51, 0xbb, 0x89, 0xf6, 0x15, 0x69,
+	0xd7, 0xb1, 0xd4, 0xed, 0x60, 0x42, 0x5a, 0x9a, 0x95, 0x84, 0xd9, 0x1a, 0x2a, 0x66, 0x66, 0xf9,
+	0x75, 0x6c, 0x5a, 0xc2, 0xc1, 0xd3, 0xa7, 0x4f, 0xc5, 0xfa, 0x41, 0xeb, 0x8a, 0xfe, 0x49, 0x29,
+	0x35, 0xd8, 0x18, 0x43, 0xc8, 0xd4, 0xa2, 0x48, 0x5d, 0x42, 0xb7, 0xb2, 0xd4, 0x64, 0x75, 0xe2,
+	0x5e, 0x13, 0xa2, 0x3e, 0xa2, 0xfa, 0xff, 0x47, 0x9e, 0x27, 0x03, 0xb7, 0xd4, 0x0f, 0x3b, 0x9f,
+	0xb9, 0x3d, 0x1e, 0x92, 0xb1, 0x4b, 0x22, 0x76, 0x01, 0xcd, 0x0f, 0xc5, 0x22, 0x4f, 0xff, 0xf7,
+	0xc5, 0xf3, 0x57, 0x8f, 0x91, 0xa1, 0x68, 0x13, 0x17, 0xd2, 0x98, 0xb5, 0x91, 0x75, 0x99, 0xb0,
+	0x25, 0x12, 0x8a, 0x68, 0x25, 0x4b, 0x88, 0x77, 0x15, 0xee, 0xf1, 0x33, 0xb7, 0xde, 0xc7, 0xbd,
+	0x2e, 0xed, 0xf0, 0x3e, 0x7a, 0xab, 0xcf, 0xc4, 0x17, 0xb1, 0xe3, 0xe8, 0x01, 0x44, 0x0c, 0x95,
+	0x46, 0x34, 0x95, 0xf5, 0x34, 0xbb, 0x7c, 0x13, 0x26, 0x15, 0x36, 0x84, 0xc2, 0xca, 0xe6, 0xed,
+	0x9c, 0x42, 0xb6, 0xb1, 0x0f, 0xb5, 0x0a, 0xfa, 0xa8, 0xe9, 0xb3, 0xf7, 0x09, 0x3b, 0x49, 0xa7,
+	0x0c, 0x52, 0xf5, 0x1e, 0x04, 0x52, 0x87, 0x9d, 0x1b, 0x39, 0x29, 0x71, 0x20, 0x24, 0x76, 0x50,
+	0x29, 0x93, 0xc8, 0x8d, 0xb5, 0x6c, 0x53, 0xc6, 0xd3, 0xae, 0xd1, 0x47, 0x1f, 0x34, 0x7d, 0xee,
+	0x24, 0x1e, 0x2b, 0x57, 0x7b, 0x72, 0x57, 0x11, 0x95, 0x47, 0x52, 0xa9, 0xbd, 0x09, 0xc8, 0xfc,
+	0xeb, 0x39, 0xd4, 0x2a, 0x9b, 0xcb, 0x99, 0xd9, 0xd0, 0x3c, 0x43, 0xef, 0x35, 0x7d, 0xee, 0xa5,
+	0x1c, 0xae, 0xcf, 0xc4, 0x6c, 0x55, 0xca, 0xe4, 0x91, 0x71, 0x32, 0xc3, 0xa4, 0x94, 0x59, 0x17,
+	0x32, 0x05, 0x74, 0x65, 0x32, 0x34, 0xd2, 0x51, 0x4f, 0x9f, 0x11, 0x8f, 0x56, 0xee, 0x04, 0xd5,
+	0x42, 0x19, 0xa8, 0x8f, 0x5b, 0x28, 0x39, 0x6c, 0xe4, 0x26, 0x1c, 0xfc, 0x50, 0x1c, 0xdf, 0xfb,
+	0x7e, 0x61, 0x68, 0xe7, 0x17, 0x86, 0xf6, 0xfb, 0xc2, 0xd0, 0x3e, 0x5d, 0x1a, 0x53, 0xe7, 0x97,
+	0xc6, 0xd4, 0xaf, 0x4b, 0x63, 0xea, 0x75, 0xc9, 0x71, 0x79, 0xa3, 0x63, 0x55, 0x6d, 0xda, 0xc2,
+	0x16, 0xb7, 0xdb, 0x07, 0x34, 0x74, 0x92, 0x1e, 0x67, 0xc9, 0x0f, 0x8f, 0x02, 0x60, 0xd6, 0xb4,
+	0xf8, 0x30, 0xdc, 0xfd, 0x1b, 0x00, 0x00, 0xff, 0xff, 0xd5, 0xd3, 0x43, 0x3f, 0x10, 0x08, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -85,6 +102,24 @@ type QueryClient interface {
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// AllParams returns all parameters in the qbtc module.
 	AllParams(ctx context.Context, in *QueryAllParamsRequest, opts ...grpc.CallOption) (*QueryAllParamsResponse, error)
+	// UTXO returns a single UTXO by transaction id and output index.
+	UTXO(ctx context.Context, in *QueryUTXORequest, opts ...grpc.CallOption) (*QueryUTXOResponse, error)
+	// UTXOsByKeys returns the UTXOs among a list of (txid, vout) that are in
+	// the store, optionally only those that are still claimable.
+	UTXOsByKeys(ctx context.Context, in *QueryUTXOsByKeysRequest, opts ...grpc.CallOption) (*QueryUTXOsByKeysResponse, error)
+	// HasClaimable reports whether a Bitcoin address has any UTXO left to
+	// claim. It stops at the first match instead of summing the balance.
+	HasClaimable(ctx context.Context, in *QueryHasClaimableRequest, opts ...grpc.CallOption) (*QueryHasClaimableResponse, error)
+	// CheckAddresses reports the claimable balance of each of a list of
+	// Bitcoin addresses, walking the UTXO set once for the whole list.
+	CheckAddresses(ctx context.Context, in *QueryCheckAddressesRequest, opts ...grpc.CallOption) (*QueryCheckAddressesResponse, error)
+	// VerifierStatus reports whether a ZK verifying key is stored and the
+	// fingerprint of the default key.
+	VerifierStatus(ctx context.Context, in *QueryVerifierStatusRequest, opts ...grpc.CallOption) (*QueryVerifierStatusResponse, error)
+	// ClaimParams returns what a client needs to build a claim: the chain id,
+	// the claim message versions, the circuit types and the verifying key
+	// fingerprint.
+	ClaimParams(ctx context.Context, in *QueryClaimParamsRequest, opts ...grpc.CallOption) (*QueryClaimParamsResponse, error)
 }
 
 type queryClient struct {
@@ -140,6 +175,60 @@ func (c *queryClient) AllParams(ctx context.Context, in *QueryAllParamsRequest,
 	return out, nil
 }
 
+func (c *queryClient) UTXO(ctx context.Context, in *QueryUTXORequest, opts ...grpc.CallOption) (*QueryUTXOResponse, error) {
+	out := new(QueryUTXOResponse)
+	err := c.cc.Invoke(ctx, "/qbtc.qbtc.v1.Query/UTXO", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UTXOsByKeys(ctx context.Context, in *QueryUTXOsByKeysRequest, opts ...grpc.CallOption) (*QueryUTXOsByKeysResponse, error) {
+	out := new(QueryUTXOsByKeysResponse)
+	err := c.cc.Invoke(ctx, "/qbtc.qbtc.v1.Query/UTXOsByKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) HasClaimable(ctx context.Context, in *QueryHasClaimableRequest, opts ...grpc.CallOption) (*QueryHasClaimableResponse, error) {
+	out := new(QueryHasClaimableResponse)
+	err := c.cc.Invoke(ctx, "/qbtc.qbtc.v1.Query/HasClaimable", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CheckAddresses(ctx context.Context, in *QueryCheckAddressesRequest, opts ...grpc.CallOption) (*QueryCheckAddressesResponse, error) {
+	out := new(QueryCheckAddressesResponse)
+	err := c.cc.Invoke(ctx, "/qbtc.qbtc.v1.Query/CheckAddresses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VerifierStatus(ctx context.Context, in *QueryVerifierStatusRequest, opts ...grpc.CallOption) (*QueryVerifierStatusResponse, error) {
+	out := new(QueryVerifierStatusResponse)
+	err := c.cc.Invoke(ctx, "/qbtc.qbtc.v1.Query/VerifierStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ClaimParams(ctx context.Context, in *QueryClaimParamsRequest, opts ...grpc.CallOption) (*QueryClaimParamsResponse, error) {
+	out := new(QueryClaimParamsResponse)
+	err := c.cc.Invoke(ctx, "/qbtc.qbtc.v1.Query/ClaimParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// NodePeerAddress returns the peer address of the node.
@@ -151,6 +240,24 @@ type QueryServer interface {
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// AllParams returns all parameters in the qbtc module.
 	AllParams(context.Context, *QueryAllParamsRequest) (*QueryAllParamsResponse, error)
+	// UTXO returns a single UTXO by transaction id and output index.
+	UTXO(context.Context, *QueryUTXORequest) (*QueryUTXOResponse, error)
+	// UTXOsByKeys returns the UTXOs among a list of (txid, vout) that are in
+	// the store, optionally only those that are still claimable.
+	UTXOsByKeys(context.Context, *QueryUTXOsByKeysRequest) (*QueryUTXOsByKeysResponse, error)
+	// HasClaimable reports whether a Bitcoin address has any UTXO left to
+	// claim. It stops at the first match instead of summing the balance.
+	HasClaimable(context.Context, *QueryHasClaimableRequest) (*QueryHasClaimableResponse, error)
+	// CheckAddresses reports the claimable balance of each of a list of
+	// Bitcoin addresses, walking the UTXO set once for the whole list.
+	CheckAddresses(context.Context, *QueryCheckAddressesRequest) (*QueryCheckAddressesResponse, error)
+	// VerifierStatus reports whether a ZK verifying key is stored and the
+	// fingerprint of the default key.
+	VerifierStatus(context.Context, *QueryVerifierStatusRequest) (*QueryVerifierStatusResponse, error)
+	// ClaimParams returns what a client needs to build a claim: the chain id,
+	// the claim message versions, the circuit types and the verifying key
+	// fingerprint.
+	ClaimParams(context.Context, *QueryClaimParamsRequest) (*QueryClaimParamsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -172,6 +279,24 @@ func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsReq
 func (*UnimplementedQueryServer) AllParams(ctx context.Context, req *QueryAllParamsRequest) (*QueryAllParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllParams not implemented")
 }
+func (*UnimplementedQueryServer) UTXO(ctx context.Context, req *QueryUTXORequest) (*QueryUTXOResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UTXO not implemented")
+}
+func (*UnimplementedQueryServer) UTXOsByKeys(ctx context.Context, req *QueryUTXOsByKeysRequest) (*QueryUTXOsByKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UTXOsByKeys not implemented")
+}
+func (*UnimplementedQueryServer) HasClaimable(ctx context.Context, req *QueryHasClaimableRequest) (*QueryHasClaimableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HasClaimable not implemented")
+}
+func (*UnimplementedQueryServer) CheckAddresses(ctx context.Context, req *QueryCheckAddressesRequest) (*QueryCheckAddressesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckAddresses not implemented")
+}
+func (*UnimplementedQueryServer) VerifierStatus(ctx context.Context, req *QueryVerifierStatusRequest) (*QueryVerifierStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifierStatus not implemented")
+}
+func (*UnimplementedQueryServer) ClaimParams(ctx context.Context, req *QueryClaimParamsRequest) (*QueryClaimParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimParams not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -267,6 +392,114 @@ func _Query_AllParams_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_UTXO_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUTXORequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UTXO(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/qbtc.qbtc.v1.Query/UTXO",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UTXO(ctx, req.(*QueryUTXORequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UTXOsByKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUTXOsByKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UTXOsByKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/qbtc.qbtc.v1.Query/UTXOsByKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UTXOsByKeys(ctx, req.(*QueryUTXOsByKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HasClaimable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHasClaimableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HasClaimable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/qbtc.qbtc.v1.Query/HasClaimable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HasClaimable(ctx, req.(*QueryHasClaimableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CheckAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCheckAddressesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CheckAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/qbtc.qbtc.v1.Query/CheckAddresses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CheckAddresses(ctx, req.(*QueryCheckAddressesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VerifierStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVerifierStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VerifierStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/qbtc.qbtc.v1.Query/VerifierStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VerifierStatus(ctx, req.(*QueryVerifierStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ClaimParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClaimParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClaimParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/qbtc.qbtc.v1.Query/ClaimParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClaimParams(ctx, req.(*QueryClaimParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "qbtc.qbtc.v1.Query",
@@ -292,6 +525,30 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AllParams",
 			Handler:    _Query_AllParams_Handler,
 		},
+		{
+			MethodName: "UTXO",
+			Handler:    _Query_UTXO_Handler,
+		},
+		{
+			MethodName: "UTXOsByKeys",
+			Handler:    _Query_UTXOsByKeys_Handler,
+		},
+		{
+			MethodName: "HasClaimable",
+			Handler:    _Query_HasClaimable_Handler,
+		},
+		{
+			MethodName: "CheckAddresses",
+			Handler:    _Query_CheckAddresses_Handler,
+		},
+		{
+			MethodName: "VerifierStatus",
+			Handler:    _Query_VerifierStatus_Handler,
+		},
+		{
+			MethodName: "ClaimParams",
+			Handler:    _Query_ClaimParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "qbtc/qbtc/v1/query.proto",
@@ -0,0 +1,881 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: qbtc/qbtc/v1/query_check_addresses.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryCheckAddressesRequest is the request type for the Query/CheckAddresses
+// RPC method.
+type QueryCheckAddressesRequest struct {
+	// addresses are the mainnet Bitcoin addresses to check, e.g. the addresses
+	// a wallet derived from its xpub.
+	Addresses []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+}
+
+func (m *QueryCheckAddressesRequest) Reset()         { *m = QueryCheckAddressesRequest{} }
+func (m *QueryCheckAddressesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCheckAddressesRequest) ProtoMessage()    {}
+func (*QueryCheckAddressesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_150c2e30f1f92709, []int{0}
+}
+func (m *QueryCheckAddressesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCheckAddressesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCheckAddressesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCheckAddressesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCheckAddressesRequest.Merge(m, src)
+}
+func (m *QueryCheckAddressesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCheckAddressesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCheckAddressesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCheckAddressesRequest proto.InternalMessageInfo
+
+func (m *QueryCheckAddressesRequest) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+// AddressClaimable is the claimable balance of one Bitcoin address.
+type AddressClaimable struct {
+	// address is the address as given in the request.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// address_type is how the chain classifies the address, e.g. "p2pkh" or
+	// "p2wsh"; "unknown" if it can't be claimed.
+	AddressType string `protobuf:"bytes,2,opt,name=address_type,json=addressType,proto3" json:"address_type,omitempty"`
+	// claimable reports whether at least one UTXO of the address still has an
+	// entitled amount.
+	Claimable bool `protobuf:"varint,3,opt,name=claimable,proto3" json:"claimable,omitempty"`
+	// entitled_amount is the sum of the entitled amounts left on the UTXOs of
+	// the address.
+	EntitledAmount uint64 `protobuf:"varint,4,opt,name=entitled_amount,json=entitledAmount,proto3" json:"entitled_amount,omitempty"`
+	// unclaimable_reason explains why no UTXO can be claimed for the address,
+	// e.g. an address type without claim support; empty if one can.
+	UnclaimableReason string `protobuf:"bytes,5,opt,name=unclaimable_reason,json=unclaimableReason,proto3" json:"unclaimable_reason,omitempty"`
+}
+
+func (m *AddressClaimable) Reset()         { *m = AddressClaimable{} }
+func (m *AddressClaimable) String() string { return proto.CompactTextString(m) }
+func (*AddressClaimable) ProtoMessage()    {}
+func (*AddressClaimable) Descriptor() ([]byte, []int) {
+	return fileDescriptor_150c2e30f1f92709, []int{1}
+}
+func (m *AddressClaimable) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddressClaimable) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddressClaimable.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AddressClaimable) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddressClaimable.Merge(m, src)
+}
+func (m *AddressClaimable) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddressClaimable) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddressClaimable.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddressClaimable proto.InternalMessageInfo
+
+func (m *AddressClaimable) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *AddressClaimable) GetAddressType() string {
+	if m != nil {
+		return m.AddressType
+	}
+	return ""
+}
+
+func (m *AddressClaimable) GetClaimable() bool {
+	if m != nil {
+		return m.Claimable
+	}
+	return false
+}
+
+func (m *AddressClaimable) GetEntitledAmount() uint64 {
+	if m != nil {
+		return m.EntitledAmount
+	}
+	return 0
+}
+
+func (m *AddressClaimable) GetUnclaimableReason() string {
+	if m != nil {
+		return m.UnclaimableReason
+	}
+	return ""
+}
+
+// QueryCheckAddressesResponse is the response type for the
+// Query/CheckAddresses RPC method.
+type QueryCheckAddressesResponse struct {
+	// results hold one entry per requested address, in request order.
+	Results []AddressClaimable `protobuf:"bytes,1,rep,name=results,proto3" json:"results"`
+}
+
+func (m *QueryCheckAddressesResponse) Reset()         { *m = QueryCheckAddressesResponse{} }
+func (m *QueryCheckAddressesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCheckAddressesResponse) ProtoMessage()    {}
+func (*QueryCheckAddressesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_150c2e30f1f92709, []int{2}
+}
+func (m *QueryCheckAddressesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCheckAddressesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCheckAddressesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCheckAddressesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCheckAddressesResponse.Merge(m, src)
+}
+func (m *QueryCheckAddressesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCheckAddressesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCheckAddressesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCheckAddressesResponse proto.InternalMessageInfo
+
+func (m *QueryCheckAddressesResponse) GetResults() []AddressClaimable {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryCheckAddressesRequest)(nil), "qbtc.qbtc.v1.QueryCheckAddressesRequest")
+	proto.RegisterType((*AddressClaimable)(nil), "qbtc.qbtc.v1.AddressClaimable")
+	proto.RegisterType((*QueryCheckAddressesResponse)(nil), "qbtc.qbtc.v1.QueryCheckAddressesResponse")
+}
+
+func init() {
+	proto.RegisterFile("qbtc/qbtc/v1/query_check_addresses.proto", fileDescriptor_150c2e30f1f92709)
+}
+
+var fileDescriptor_150c2e30f1f92709 = []byte{
+	// 363 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0x41, 0x4f, 0xab, 0x40,
+	0x10, 0xc7, 0xd9, 0xd7, 0xbe, 0xd7, 0xc7, 0xb6, 0x79, 0xcf, 0x6e, 0x3c, 0x90, 0x6a, 0x10, 0x9b,
+	0x18, 0x89, 0x49, 0x21, 0xd5, 0x9b, 0x17, 0xd3, 0xf6, 0x13, 0x48, 0x3c, 0x79, 0x21, 0x40, 0x27,
+	0x94, 0x08, 0x2c, 0xb0, 0x4b, 0x63, 0xbf, 0x85, 0x1f, 0xc3, 0xa3, 0x5f, 0xc2, 0xa4, 0xc7, 0x1e,
+	0x3d, 0x19, 0xd3, 0x1e, 0xfc, 0x1a, 0x86, 0x05, 0xda, 0xc6, 0x78, 0x99, 0x9d, 0xfd, 0xcd, 0xcc,
+	0x7f, 0x92, 0xff, 0x60, 0x3d, 0x75, 0xb9, 0x67, 0x8a, 0x30, 0x1f, 0x9a, 0x69, 0x0e, 0xd9, 0xc2,
+	0xf6, 0x66, 0xe0, 0x3d, 0xd8, 0xce, 0x74, 0x9a, 0x01, 0x63, 0xc0, 0x8c, 0x24, 0xa3, 0x9c, 0x92,
+	0x4e, 0xd1, 0x64, 0x88, 0x30, 0x1f, 0xf6, 0xba, 0x4e, 0x14, 0xc4, 0xd4, 0x14, 0xb1, 0x6c, 0xe8,
+	0x1d, 0xfa, 0xd4, 0xa7, 0x22, 0x35, 0x8b, 0xac, 0xa4, 0xfd, 0x6b, 0xdc, 0xbb, 0x2d, 0x54, 0x27,
+	0x85, 0xe8, 0xa8, 0xd6, 0xb4, 0x20, 0xcd, 0x81, 0x71, 0x72, 0x8c, 0xe5, 0xed, 0x1e, 0x05, 0x69,
+	0x0d, 0x5d, 0xb6, 0x76, 0xa0, 0xff, 0x8a, 0xf0, 0x41, 0x35, 0x32, 0x09, 0x9d, 0x20, 0x72, 0xdc,
+	0x10, 0x88, 0x82, 0x5b, 0x55, 0x87, 0x82, 0x34, 0xa4, 0xcb, 0x56, 0xfd, 0x25, 0xa7, 0xb8, 0x53,
+	0xa5, 0x36, 0x5f, 0x24, 0xa0, 0xfc, 0x12, 0xe5, 0x76, 0xc5, 0xee, 0x16, 0x09, 0x14, 0xfb, 0xbc,
+	0x5a, 0x49, 0x69, 0x68, 0x48, 0xff, 0x6b, 0xed, 0x00, 0x39, 0xc7, 0xff, 0x21, 0xe6, 0x01, 0x0f,
+	0x61, 0x6a, 0x3b, 0x11, 0xcd, 0x63, 0xae, 0x34, 0x35, 0xa4, 0x37, 0xad, 0x7f, 0x35, 0x1e, 0x09,
+	0x4a, 0x06, 0x98, 0xe4, 0xf1, 0x76, 0xce, 0xce, 0xc0, 0x61, 0x34, 0x56, 0x7e, 0x8b, 0x7d, 0xdd,
+	0xbd, 0x8a, 0x25, 0x0a, 0x7d, 0x17, 0x1f, 0xfd, 0xe8, 0x01, 0x4b, 0x68, 0xcc, 0x80, 0x4c, 0x70,
+	0x2b, 0x03, 0x96, 0x87, 0xbc, 0xb4, 0xa0, 0x7d, 0xa9, 0x1a, 0xfb, 0x5e, 0x1b, 0xdf, 0x2d, 0x18,
+	0xcb, 0xcb, 0xf7, 0x13, 0xe9, 0xf9, 0xf3, 0xe5, 0x02, 0x59, 0xf5, 0xe4, 0xf8, 0x66, 0xb9, 0x56,
+	0xd1, 0x6a, 0xad, 0xa2, 0x8f, 0xb5, 0x8a, 0x9e, 0x36, 0xaa, 0xb4, 0xda, 0xa8, 0xd2, 0xdb, 0x46,
+	0x95, 0xee, 0xcf, 0xfc, 0x80, 0xcf, 0x72, 0xd7, 0xf0, 0x68, 0x64, 0xba, 0xdc, 0x4b, 0x07, 0x34,
+	0xf3, 0xcb, 0x8b, 0x3f, 0x96, 0x4f, 0x61, 0x16, 0x73, 0xff, 0x88, 0x7b, 0x5d, 0x7d, 0x05, 0x00,
+	0x00, 0xff, 0xff, 0xa1, 0xfb, 0x32, 0x3b, 0x12, 0x02, 0x00, 0x00,
+}
+
+func (m *QueryCheckAddressesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCheckAddressesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCheckAddressesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintQueryCheckAddresses(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddressClaimable) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddressClaimable) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddressClaimable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UnclaimableReason) > 0 {
+		i -= len(m.UnclaimableReason)
+		copy(dAtA[i:], m.UnclaimableReason)
+		i = encodeVarintQueryCheckAddresses(dAtA, i, uint64(len(m.UnclaimableReason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.EntitledAmount != 0 {
+		i = encodeVarintQueryCheckAddresses(dAtA, i, uint64(m.EntitledAmount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Claimable {
+		i--
+		if m.Claimable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.AddressType) > 0 {
+		i -= len(m.AddressType)
+		copy(dAtA[i:], m.AddressType)
+		i = encodeVarintQueryCheckAddresses(dAtA, i, uint64(len(m.AddressType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQueryCheckAddresses(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCheckAddressesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCheckAddressesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCheckAddressesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQueryCheckAddresses(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQueryCheckAddresses(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQueryCheckAddresses(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryCheckAddressesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovQueryCheckAddresses(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AddressClaimable) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQueryCheckAddresses(uint64(l))
+	}
+	l = len(m.AddressType)
+	if l > 0 {
+		n += 1 + l + sovQueryCheckAddresses(uint64(l))
+	}
+	if m.Claimable {
+		n += 2
+	}
+	if m.EntitledAmount != 0 {
+		n += 1 + sovQueryCheckAddresses(uint64(m.EntitledAmount))
+	}
+	l = len(m.UnclaimableReason)
+	if l > 0 {
+		n += 1 + l + sovQueryCheckAddresses(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCheckAddressesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovQueryCheckAddresses(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQueryCheckAddresses(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQueryCheckAddresses(x uint64) (n int) {
+	return sovQueryCheckAddresses(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryCheckAddressesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueryCheckAddresses
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCheckAddressesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCheckAddressesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryCheckAddresses
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryCheckAddresses
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryCheckAddresses
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueryCheckAddresses(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueryCheckAddresses
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddressClaimable) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueryCheckAddresses
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddressClaimable: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddressClaimable: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryCheckAddresses
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryCheckAddresses
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryCheckAddresses
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryCheckAddresses
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryCheckAddresses
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryCheckAddresses
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddressType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryCheckAddresses
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Claimable = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntitledAmount", wireType)
+			}
+			m.EntitledAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryCheckAddresses
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EntitledAmount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnclaimableReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryCheckAddresses
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryCheckAddresses
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryCheckAddresses
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnclaimableReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueryCheckAddresses(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueryCheckAddresses
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCheckAddressesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueryCheckAddresses
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCheckAddressesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCheckAddressesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryCheckAddresses
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueryCheckAddresses
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryCheckAddresses
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, AddressClaimable{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueryCheckAddresses(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueryCheckAddresses
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQueryCheckAddresses(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQueryCheckAddresses
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQueryCheckAddresses
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQueryCheckAddresses
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQueryCheckAddresses
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQueryCheckAddresses
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQueryCheckAddresses
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQueryCheckAddresses        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQueryCheckAddresses          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQueryCheckAddresses = fmt.Errorf("proto: unexpected end of group")
+)
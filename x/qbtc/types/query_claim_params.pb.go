@@ -0,0 +1,826 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: qbtc/qbtc/v1/query_claim_params.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryClaimParamsRequest is the request type for the Query/ClaimParams RPC
+// method.
+type QueryClaimParamsRequest struct {
+}
+
+func (m *QueryClaimParamsRequest) Reset()         { *m = QueryClaimParamsRequest{} }
+func (m *QueryClaimParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryClaimParamsRequest) ProtoMessage()    {}
+func (*QueryClaimParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_299da535e3af4ea2, []int{0}
+}
+func (m *QueryClaimParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClaimParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClaimParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClaimParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClaimParamsRequest.Merge(m, src)
+}
+func (m *QueryClaimParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClaimParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClaimParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClaimParamsRequest proto.InternalMessageInfo
+
+// QueryClaimParamsResponse is the response type for the Query/ClaimParams RPC
+// method.
+type QueryClaimParamsResponse struct {
+	// chain_id is the chain id claim messages commit to, see
+	// zk.ComputeChainIDHash.
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// message_version is the claim message version used when a claim does not
+	// name one.
+	MessageVersion string `protobuf:"bytes,2,opt,name=message_version,json=messageVersion,proto3" json:"message_version,omitempty"`
+	// supported_message_versions lists every claim message version the chain
+	// accepts, sorted.
+	SupportedMessageVersions []string `protobuf:"bytes,3,rep,name=supported_message_versions,json=supportedMessageVersions,proto3" json:"supported_message_versions,omitempty"`
+	// supported_circuit_types lists the circuit types claims are verified
+	// against.
+	SupportedCircuitTypes []string `protobuf:"bytes,4,rep,name=supported_circuit_types,json=supportedCircuitTypes,proto3" json:"supported_circuit_types,omitempty"`
+	// vk_fingerprint is the short fingerprint of the verifying key claims of
+	// the default message version are verified against, in the form
+	// MsgClaimWithProof.vk_fingerprint takes, empty when no key is stored.
+	VkFingerprint string `protobuf:"bytes,5,opt,name=vk_fingerprint,json=vkFingerprint,proto3" json:"vk_fingerprint,omitempty"`
+	// claim_expiry_required is set when the chain only accepts claims whose
+	// message version binds an expiry height (qbtc-claim-v5 and later).
+	ClaimExpiryRequired bool `protobuf:"varint,6,opt,name=claim_expiry_required,json=claimExpiryRequired,proto3" json:"claim_expiry_required,omitempty"`
+	// max_claim_expiry_blocks is how far past the current block height a claim's
+	// expiry height may be, 0 when it is not limited.
+	MaxClaimExpiryBlocks uint64 `protobuf:"varint,7,opt,name=max_claim_expiry_blocks,json=maxClaimExpiryBlocks,proto3" json:"max_claim_expiry_blocks,omitempty"`
+	// version_vk_fingerprints lists the short fingerprint of the verifying key
+	// claims of each supported message version are verified against, in the
+	// order of supported_message_versions. Versions without a key are left
+	// out.
+	VersionVkFingerprints []VersionVkFingerprint `protobuf:"bytes,8,rep,name=version_vk_fingerprints,json=versionVkFingerprints,proto3" json:"version_vk_fingerprints"`
+}
+
+func (m *QueryClaimParamsResponse) Reset()         { *m = QueryClaimParamsResponse{} }
+func (m *QueryClaimParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryClaimParamsResponse) ProtoMessage()    {}
+func (*QueryClaimParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_299da535e3af4ea2, []int{1}
+}
+func (m *QueryClaimParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClaimParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClaimParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClaimParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClaimParamsResponse.Merge(m, src)
+}
+func (m *QueryClaimParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClaimParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClaimParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClaimParamsResponse proto.InternalMessageInfo
+
+func (m *QueryClaimParamsResponse) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *QueryClaimParamsResponse) GetMessageVersion() string {
+	if m != nil {
+		return m.MessageVersion
+	}
+	return ""
+}
+
+func (m *QueryClaimParamsResponse) GetSupportedMessageVersions() []string {
+	if m != nil {
+		return m.SupportedMessageVersions
+	}
+	return nil
+}
+
+func (m *QueryClaimParamsResponse) GetSupportedCircuitTypes() []string {
+	if m != nil {
+		return m.SupportedCircuitTypes
+	}
+	return nil
+}
+
+func (m *QueryClaimParamsResponse) GetVkFingerprint() string {
+	if m != nil {
+		return m.VkFingerprint
+	}
+	return ""
+}
+
+func (m *QueryClaimParamsResponse) GetClaimExpiryRequired() bool {
+	if m != nil {
+		return m.ClaimExpiryRequired
+	}
+	return false
+}
+
+func (m *QueryClaimParamsResponse) GetMaxClaimExpiryBlocks() uint64 {
+	if m != nil {
+		return m.MaxClaimExpiryBlocks
+	}
+	return 0
+}
+
+func (m *QueryClaimParamsResponse) GetVersionVkFingerprints() []VersionVkFingerprint {
+	if m != nil {
+		return m.VersionVkFingerprints
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryClaimParamsRequest)(nil), "qbtc.qbtc.v1.QueryClaimParamsRequest")
+	proto.RegisterType((*QueryClaimParamsResponse)(nil), "qbtc.qbtc.v1.QueryClaimParamsResponse")
+}
+
+func init() {
+	proto.RegisterFile("qbtc/qbtc/v1/query_claim_params.proto", fileDescriptor_299da535e3af4ea2)
+}
+
+var fileDescriptor_299da535e3af4ea2 = []byte{
+	// 430 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0xc7, 0x1b, 0x5a, 0xb6, 0xce, 0xc0, 0x90, 0xcc, 0xaa, 0x7a, 0x3d, 0x84, 0xa8, 0x52, 0x45,
+	0x2e, 0x24, 0xda, 0x10, 0x9c, 0x90, 0x90, 0x5a, 0x81, 0xc4, 0x01, 0x09, 0x22, 0xb4, 0x03, 0x17,
+	0xe3, 0x38, 0x5e, 0x66, 0x75, 0x89, 0x1d, 0xdb, 0x89, 0xd2, 0x6f, 0xc1, 0xc7, 0xea, 0x71, 0x47,
+	0x4e, 0x08, 0xb5, 0x5f, 0x04, 0xc5, 0x89, 0xd6, 0x95, 0xed, 0xe2, 0x24, 0xef, 0xf7, 0xff, 0xbf,
+	0xf7, 0xf2, 0xfc, 0xc0, 0xac, 0x88, 0x0d, 0x0d, 0xed, 0x51, 0x9d, 0x85, 0x45, 0xc9, 0xd4, 0x0a,
+	0xd3, 0x6b, 0xc2, 0x33, 0x2c, 0x89, 0x22, 0x99, 0x0e, 0xa4, 0x12, 0x46, 0xc0, 0xa7, 0x8d, 0x22,
+	0xb0, 0x47, 0x75, 0x36, 0x39, 0x49, 0x45, 0x2a, 0x2c, 0x08, 0x9b, 0xb7, 0x56, 0x33, 0xf1, 0x1f,
+	0x48, 0x55, 0x31, 0xc5, 0x2f, 0x39, 0x53, 0x58, 0x1b, 0x62, 0xca, 0x2e, 0xdb, 0xf4, 0x14, 0x8c,
+	0xbf, 0x35, 0x78, 0xd1, 0x14, 0xfa, 0x6a, 0xeb, 0x44, 0xac, 0x28, 0x99, 0x36, 0xd3, 0x75, 0x1f,
+	0xa0, 0xfb, 0x4c, 0x4b, 0x91, 0x6b, 0x06, 0x4f, 0xc1, 0x90, 0x5e, 0x11, 0x9e, 0x63, 0x9e, 0x20,
+	0xc7, 0x73, 0xfc, 0xa3, 0xe8, 0xd0, 0x7e, 0x7f, 0x4e, 0xe0, 0x2b, 0xf0, 0x3c, 0x63, 0x5a, 0x93,
+	0x94, 0x35, 0x35, 0x35, 0x17, 0x39, 0x7a, 0x64, 0x15, 0xc7, 0x5d, 0xf8, 0xa2, 0x8d, 0xc2, 0xf7,
+	0x60, 0xa2, 0x4b, 0x29, 0x85, 0x32, 0x2c, 0xc1, 0xff, 0x59, 0x34, 0xea, 0x7b, 0x7d, 0xff, 0x28,
+	0x42, 0xb7, 0x8a, 0x2f, 0x7b, 0x66, 0x0d, 0xdf, 0x81, 0xf1, 0xce, 0x4d, 0xb9, 0xa2, 0x25, 0x37,
+	0xd8, 0xac, 0x24, 0xd3, 0x68, 0x60, 0xad, 0xa3, 0x5b, 0xbc, 0x68, 0xe9, 0xf7, 0x06, 0xc2, 0x19,
+	0x38, 0xae, 0x96, 0xf8, 0x92, 0xe7, 0x29, 0x53, 0x52, 0xf1, 0xdc, 0xa0, 0xc7, 0xb6, 0xbb, 0x67,
+	0xd5, 0xf2, 0xd3, 0x2e, 0x08, 0xcf, 0xc1, 0xa8, 0x1d, 0x3e, 0xab, 0x25, 0x57, 0x2b, 0xac, 0x58,
+	0x51, 0x72, 0xc5, 0x12, 0x74, 0xe0, 0x39, 0xfe, 0x30, 0x7a, 0x61, 0xe1, 0x47, 0xcb, 0xa2, 0x0e,
+	0xc1, 0xb7, 0x60, 0x9c, 0x91, 0x1a, 0xef, 0xf9, 0xe2, 0x6b, 0x41, 0x97, 0x1a, 0x1d, 0x7a, 0x8e,
+	0x3f, 0x88, 0x4e, 0x32, 0x52, 0x2f, 0x76, 0xc6, 0xb9, 0x65, 0xf0, 0x27, 0x18, 0x77, 0x7f, 0x8d,
+	0xf7, 0x3b, 0xd3, 0x68, 0xe8, 0xf5, 0xfd, 0x27, 0xe7, 0xd3, 0xe0, 0xee, 0x9d, 0x07, 0xdd, 0x08,
+	0x2e, 0xee, 0xf6, 0x3b, 0x1f, 0xac, 0xff, 0xbc, 0xec, 0x45, 0xa3, 0xea, 0x01, 0xa6, 0xe7, 0x1f,
+	0xd6, 0x1b, 0xd7, 0xb9, 0xd9, 0xb8, 0xce, 0xdf, 0x8d, 0xeb, 0xfc, 0xda, 0xba, 0xbd, 0x9b, 0xad,
+	0xdb, 0xfb, 0xbd, 0x75, 0x7b, 0x3f, 0x66, 0x29, 0x37, 0x57, 0x65, 0x1c, 0x50, 0x91, 0x85, 0xb1,
+	0xa1, 0xc5, 0x6b, 0xa1, 0xd2, 0x76, 0x71, 0xea, 0xf6, 0x61, 0x27, 0x1a, 0x1f, 0xd8, 0x6d, 0x79,
+	0xf3, 0x2f, 0x00, 0x00, 0xff, 0xff, 0xbf, 0x8d, 0x58, 0x37, 0xa4, 0x02, 0x00, 0x00,
+}
+
+func (m *QueryClaimParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClaimParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClaimParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClaimParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClaimParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClaimParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VersionVkFingerprints) > 0 {
+		for iNdEx := len(m.VersionVkFingerprints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VersionVkFingerprints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQueryClaimParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.MaxClaimExpiryBlocks != 0 {
+		i = encodeVarintQueryClaimParams(dAtA, i, uint64(m.MaxClaimExpiryBlocks))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ClaimExpiryRequired {
+		i--
+		if m.ClaimExpiryRequired {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.VkFingerprint) > 0 {
+		i -= len(m.VkFingerprint)
+		copy(dAtA[i:], m.VkFingerprint)
+		i = encodeVarintQueryClaimParams(dAtA, i, uint64(len(m.VkFingerprint)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.SupportedCircuitTypes) > 0 {
+		for iNdEx := len(m.SupportedCircuitTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SupportedCircuitTypes[iNdEx])
+			copy(dAtA[i:], m.SupportedCircuitTypes[iNdEx])
+			i = encodeVarintQueryClaimParams(dAtA, i, uint64(len(m.SupportedCircuitTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.SupportedMessageVersions) > 0 {
+		for iNdEx := len(m.SupportedMessageVersions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SupportedMessageVersions[iNdEx])
+			copy(dAtA[i:], m.SupportedMessageVersions[iNdEx])
+			i = encodeVarintQueryClaimParams(dAtA, i, uint64(len(m.SupportedMessageVersions[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.MessageVersion) > 0 {
+		i -= len(m.MessageVersion)
+		copy(dAtA[i:], m.MessageVersion)
+		i = encodeVarintQueryClaimParams(dAtA, i, uint64(len(m.MessageVersion)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQueryClaimParams(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQueryClaimParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQueryClaimParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryClaimParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryClaimParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQueryClaimParams(uint64(l))
+	}
+	l = len(m.MessageVersion)
+	if l > 0 {
+		n += 1 + l + sovQueryClaimParams(uint64(l))
+	}
+	if len(m.SupportedMessageVersions) > 0 {
+		for _, s := range m.SupportedMessageVersions {
+			l = len(s)
+			n += 1 + l + sovQueryClaimParams(uint64(l))
+		}
+	}
+	if len(m.SupportedCircuitTypes) > 0 {
+		for _, s := range m.SupportedCircuitTypes {
+			l = len(s)
+			n += 1 + l + sovQueryClaimParams(uint64(l))
+		}
+	}
+	l = len(m.VkFingerprint)
+	if l > 0 {
+		n += 1 + l + sovQueryClaimParams(uint64(l))
+	}
+	if m.ClaimExpiryRequired {
+		n += 2
+	}
+	if m.MaxClaimExpiryBlocks != 0 {
+		n += 1 + sovQueryClaimParams(uint64(m.MaxClaimExpiryBlocks))
+	}
+	if len(m.VersionVkFingerprints) > 0 {
+		for _, e := range m.VersionVkFingerprints {
+			l = e.Size()
+			n += 1 + l + sovQueryClaimParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQueryClaimParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQueryClaimParams(x uint64) (n int) {
+	return sovQueryClaimParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryClaimParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueryClaimParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClaimParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClaimParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueryClaimParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueryClaimParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClaimParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueryClaimParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClaimParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClaimParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryClaimParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryClaimParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryClaimParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryClaimParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryClaimParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryClaimParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupportedMessageVersions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryClaimParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryClaimParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryClaimParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SupportedMessageVersions = append(m.SupportedMessageVersions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupportedCircuitTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryClaimParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryClaimParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryClaimParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SupportedCircuitTypes = append(m.SupportedCircuitTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VkFingerprint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryClaimParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryClaimParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryClaimParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VkFingerprint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimExpiryRequired", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryClaimParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ClaimExpiryRequired = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxClaimExpiryBlocks", wireType)
+			}
+			m.MaxClaimExpiryBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryClaimParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxClaimExpiryBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionVkFingerprints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryClaimParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueryClaimParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryClaimParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VersionVkFingerprints = append(m.VersionVkFingerprints, VersionVkFingerprint{})
+			if err := m.VersionVkFingerprints[len(m.VersionVkFingerprints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueryClaimParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueryClaimParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQueryClaimParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQueryClaimParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQueryClaimParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQueryClaimParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQueryClaimParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQueryClaimParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQueryClaimParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQueryClaimParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQueryClaimParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQueryClaimParams = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,491 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: qbtc/qbtc/v1/query_has_claimable.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryHasClaimableRequest is the request type for the Query/HasClaimable RPC
+// method.
+type QueryHasClaimableRequest struct {
+	// address_hash is the script commitment of the Bitcoin address: the 20-byte
+	// Hash160 of the public key, or the 32-byte witness program for P2WSH.
+	AddressHash []byte `protobuf:"bytes,1,opt,name=address_hash,json=addressHash,proto3" json:"address_hash,omitempty"`
+}
+
+func (m *QueryHasClaimableRequest) Reset()         { *m = QueryHasClaimableRequest{} }
+func (m *QueryHasClaimableRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHasClaimableRequest) ProtoMessage()    {}
+func (*QueryHasClaimableRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44f86e592fac80d5, []int{0}
+}
+func (m *QueryHasClaimableRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHasClaimableRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHasClaimableRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHasClaimableRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHasClaimableRequest.Merge(m, src)
+}
+func (m *QueryHasClaimableRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHasClaimableRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHasClaimableRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHasClaimableRequest proto.InternalMessageInfo
+
+func (m *QueryHasClaimableRequest) GetAddressHash() []byte {
+	if m != nil {
+		return m.AddressHash
+	}
+	return nil
+}
+
+// QueryHasClaimableResponse is the response type for the Query/HasClaimable
+// RPC method.
+type QueryHasClaimableResponse struct {
+	// has_claimable reports whether at least one UTXO of the address still has
+	// an entitled amount.
+	HasClaimable bool `protobuf:"varint,1,opt,name=has_claimable,json=hasClaimable,proto3" json:"has_claimable,omitempty"`
+}
+
+func (m *QueryHasClaimableResponse) Reset()         { *m = QueryHasClaimableResponse{} }
+func (m *QueryHasClaimableResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHasClaimableResponse) ProtoMessage()    {}
+func (*QueryHasClaimableResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44f86e592fac80d5, []int{1}
+}
+func (m *QueryHasClaimableResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHasClaimableResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHasClaimableResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHasClaimableResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHasClaimableResponse.Merge(m, src)
+}
+func (m *QueryHasClaimableResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHasClaimableResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHasClaimableResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHasClaimableResponse proto.InternalMessageInfo
+
+func (m *QueryHasClaimableResponse) GetHasClaimable() bool {
+	if m != nil {
+		return m.HasClaimable
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*QueryHasClaimableRequest)(nil), "qbtc.qbtc.v1.QueryHasClaimableRequest")
+	proto.RegisterType((*QueryHasClaimableResponse)(nil), "qbtc.qbtc.v1.QueryHasClaimableResponse")
+}
+
+func init() {
+	proto.RegisterFile("qbtc/qbtc/v1/query_has_claimable.proto", fileDescriptor_44f86e592fac80d5)
+}
+
+var fileDescriptor_44f86e592fac80d5 = []byte{
+	// 217 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2b, 0x4c, 0x2a, 0x49,
+	0xd6, 0x07, 0x13, 0x65, 0x86, 0xfa, 0x85, 0xa5, 0xa9, 0x45, 0x95, 0xf1, 0x19, 0x89, 0xc5, 0xf1,
+	0xc9, 0x39, 0x89, 0x99, 0xb9, 0x89, 0x49, 0x39, 0xa9, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9, 0x42,
+	0x3c, 0x20, 0x25, 0x7a, 0x60, 0xa2, 0xcc, 0x50, 0x4a, 0x24, 0x3d, 0x3f, 0x3d, 0x1f, 0x2c, 0xa1,
+	0x0f, 0x62, 0x41, 0xd4, 0x28, 0xd9, 0x72, 0x49, 0x04, 0x82, 0x0c, 0xf0, 0x48, 0x2c, 0x76, 0x86,
+	0x69, 0x0f, 0x4a, 0x2d, 0x2c, 0x4d, 0x2d, 0x2e, 0x11, 0x52, 0xe4, 0xe2, 0x49, 0x4c, 0x49, 0x29,
+	0x4a, 0x2d, 0x2e, 0x06, 0x19, 0x9f, 0x21, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x13, 0xc4, 0x0d, 0x15,
+	0xf3, 0x48, 0x2c, 0xce, 0x50, 0x72, 0xe0, 0x92, 0xc4, 0xa2, 0xbd, 0xb8, 0x20, 0x3f, 0xaf, 0x38,
+	0x55, 0x48, 0x99, 0x8b, 0x17, 0xc5, 0x59, 0x60, 0x03, 0x38, 0x82, 0x78, 0x32, 0x90, 0x14, 0x3b,
+	0xd9, 0x9f, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e,
+	0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x6a, 0x7a, 0x66, 0x49,
+	0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x7e, 0x52, 0x49, 0x72, 0xa1, 0x6e, 0x7e, 0x51, 0x3a,
+	0xc4, 0xd7, 0x15, 0x10, 0xaa, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0xec, 0x11, 0x63, 0xc0,
+	0x00, 0x01, 0xd5, 0x69, 0xa4, 0x16, 0x01, 0x00, 0x00,
+}
+
+func (m *QueryHasClaimableRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHasClaimableRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHasClaimableRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AddressHash) > 0 {
+		i -= len(m.AddressHash)
+		copy(dAtA[i:], m.AddressHash)
+		i = encodeVarintQueryHasClaimable(dAtA, i, uint64(len(m.AddressHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHasClaimableResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHasClaimableResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHasClaimableResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HasClaimable {
+		i--
+		if m.HasClaimable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQueryHasClaimable(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQueryHasClaimable(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryHasClaimableRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AddressHash)
+	if l > 0 {
+		n += 1 + l + sovQueryHasClaimable(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHasClaimableResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HasClaimable {
+		n += 2
+	}
+	return n
+}
+
+func sovQueryHasClaimable(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQueryHasClaimable(x uint64) (n int) {
+	return sovQueryHasClaimable(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryHasClaimableRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueryHasClaimable
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHasClaimableRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHasClaimableRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryHasClaimable
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQueryHasClaimable
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryHasClaimable
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddressHash = append(m.AddressHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.AddressHash == nil {
+				m.AddressHash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueryHasClaimable(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueryHasClaimable
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHasClaimableResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueryHasClaimable
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHasClaimableResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHasClaimableResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasClaimable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryHasClaimable
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasClaimable = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueryHasClaimable(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueryHasClaimable
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQueryHasClaimable(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQueryHasClaimable
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQueryHasClaimable
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQueryHasClaimable
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQueryHasClaimable
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQueryHasClaimable
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQueryHasClaimable
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQueryHasClaimable        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQueryHasClaimable          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQueryHasClaimable = fmt.Errorf("proto: unexpected end of group")
+)
@@ -23,7 +23,8 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
-// QueryLastProcessedBlockRequest is the request type for the Query/LastProcessedBlock RPC method.
+// QueryLastProcessedBlockRequest is the request type for the
+// Query/LastProcessedBlock RPC method.
 type QueryLastProcessedBlockRequest struct {
 }
 
@@ -60,9 +61,14 @@ func (m *QueryLastProcessedBlockRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryLastProcessedBlockRequest proto.InternalMessageInfo
 
-// QueryLastProcessedBlockResponse is the response type for the Query/LastProcessedBlock RPC method.
+// QueryLastProcessedBlockResponse is the response type for the
+// Query/LastProcessedBlock RPC method.
 type QueryLastProcessedBlockResponse struct {
+	// Height of the last Bitcoin block the chain has processed
 	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// Hash of that block; empty if no block has been processed since the hash
+	// started being recorded
+	Hash string `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
 }
 
 func (m *QueryLastProcessedBlockResponse) Reset()         { *m = QueryLastProcessedBlockResponse{} }
@@ -105,6 +111,13 @@ func (m *QueryLastProcessedBlockResponse) GetHeight() uint64 {
 	return 0
 }
 
+func (m *QueryLastProcessedBlockResponse) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*QueryLastProcessedBlockRequest)(nil), "qbtc.qbtc.v1.QueryLastProcessedBlockRequest")
 	proto.RegisterType((*QueryLastProcessedBlockResponse)(nil), "qbtc.qbtc.v1.QueryLastProcessedBlockResponse")
@@ -115,20 +128,21 @@ func init() {
 }
 
 var fileDescriptor_0428d4a91167c006 = []byte{
-	// 204 bytes of a gzipped FileDescriptorProto
+	// 220 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2f, 0x4c, 0x2a, 0x49,
 	0xd6, 0x07, 0x13, 0x65, 0x86, 0xfa, 0x85, 0xa5, 0xa9, 0x45, 0x95, 0xf1, 0x39, 0x89, 0xc5, 0x25,
 	0xf1, 0x05, 0x45, 0xf9, 0xc9, 0xa9, 0xc5, 0xc5, 0xa9, 0x29, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9,
 	0x42, 0x3c, 0x20, 0x35, 0x7a, 0x60, 0xa2, 0xcc, 0x50, 0x4a, 0x24, 0x3d, 0x3f, 0x3d, 0x1f, 0x2c,
 	0xa1, 0x0f, 0x62, 0x41, 0xd4, 0x28, 0x29, 0x70, 0xc9, 0x05, 0x82, 0x4c, 0xf0, 0x49, 0x2c, 0x2e,
 	0x09, 0x80, 0xe9, 0x77, 0xca, 0xc9, 0x4f, 0xce, 0x0e, 0x4a, 0x2d, 0x2c, 0x4d, 0x2d, 0x2e, 0x51,
-	0xb2, 0xe4, 0x92, 0xc7, 0xa9, 0xa2, 0xb8, 0x20, 0x3f, 0xaf, 0x38, 0x55, 0x48, 0x8c, 0x8b, 0x2d,
-	0x23, 0x35, 0x33, 0x3d, 0xa3, 0x44, 0x82, 0x51, 0x81, 0x51, 0x83, 0x25, 0x08, 0xca, 0x73, 0xb2,
-	0x3f, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96,
-	0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xd5, 0xf4, 0xcc, 0x92, 0x8c,
-	0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0xfd, 0xa4, 0x92, 0xe4, 0x42, 0xdd, 0xfc, 0xa2, 0x74, 0x88,
-	0x97, 0x2a, 0x20, 0x54, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8, 0x91, 0xc6, 0x80, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0xc6, 0xc1, 0x5f, 0x22, 0xf3, 0x00, 0x00, 0x00,
+	0xf2, 0xe5, 0x92, 0xc7, 0xa9, 0xa2, 0xb8, 0x20, 0x3f, 0xaf, 0x38, 0x55, 0x48, 0x8c, 0x8b, 0x2d,
+	0x23, 0x35, 0x33, 0x3d, 0xa3, 0x44, 0x82, 0x51, 0x81, 0x51, 0x83, 0x25, 0x08, 0xca, 0x13, 0x12,
+	0xe2, 0x62, 0xc9, 0x48, 0x2c, 0xce, 0x90, 0x60, 0x52, 0x60, 0xd4, 0xe0, 0x0c, 0x02, 0xb3, 0x9d,
+	0xec, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f,
+	0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x35, 0x3d, 0xb3, 0x24,
+	0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x3f, 0xa9, 0x24, 0xb9, 0x50, 0x37, 0xbf, 0x28, 0x1d,
+	0xe2, 0xcd, 0x0a, 0x08, 0x55, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x76, 0xb8, 0x31, 0x20,
+	0x00, 0x00, 0xff, 0xff, 0x9d, 0xf5, 0x9a, 0xee, 0x07, 0x01, 0x00, 0x00,
 }
 
 func (m *QueryLastProcessedBlockRequest) Marshal() (dAtA []byte, err error) {
@@ -174,6 +188,13 @@ func (m *QueryLastProcessedBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	_ = i
 	var l int
 	_ = l
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintQueryLastProcessed(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.Height != 0 {
 		i = encodeVarintQueryLastProcessed(dAtA, i, uint64(m.Height))
 		i--
@@ -211,6 +232,10 @@ func (m *QueryLastProcessedBlockResponse) Size() (n int) {
 	if m.Height != 0 {
 		n += 1 + sovQueryLastProcessed(uint64(m.Height))
 	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovQueryLastProcessed(uint64(l))
+	}
 	return n
 }
 
@@ -318,6 +343,38 @@ func (m *QueryLastProcessedBlockResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryLastProcessed
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryLastProcessed
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryLastProcessed
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQueryLastProcessed(dAtA[iNdEx:])
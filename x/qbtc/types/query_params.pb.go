@@ -203,6 +203,10 @@ var xxx_messageInfo_QueryAllParamsRequest proto.InternalMessageInfo
 
 type QueryAllParamsResponse struct {
 	Params []*Param `protobuf:"bytes,1,rep,name=params,proto3" json:"params,omitempty"`
+	// The OP_RETURN memo prefixes that mark a claim transaction
+	ClaimMemoPrefixes []string `protobuf:"bytes,2,rep,name=claim_memo_prefixes,json=claimMemoPrefixes,proto3" json:"claim_memo_prefixes,omitempty"`
+	// The account addresses that may not claim
+	ClaimDenyList []string `protobuf:"bytes,3,rep,name=claim_deny_list,json=claimDenyList,proto3" json:"claim_deny_list,omitempty"`
 }
 
 func (m *QueryAllParamsResponse) Reset()         { *m = QueryAllParamsResponse{} }
@@ -245,6 +249,20 @@ func (m *QueryAllParamsResponse) GetParams() []*Param {
 	return nil
 }
 
+func (m *QueryAllParamsResponse) GetClaimMemoPrefixes() []string {
+	if m != nil {
+		return m.ClaimMemoPrefixes
+	}
+	return nil
+}
+
+func (m *QueryAllParamsResponse) GetClaimDenyList() []string {
+	if m != nil {
+		return m.ClaimDenyList
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Param)(nil), "qbtc.qbtc.v1.Param")
 	proto.RegisterType((*QueryParamsRequest)(nil), "qbtc.qbtc.v1.QueryParamsRequest")
@@ -256,24 +274,28 @@ func init() {
 func init() { proto.RegisterFile("qbtc/qbtc/v1/query_params.proto", fileDescriptor_e5a3b1d9b4ddf026) }
 
 var fileDescriptor_e5a3b1d9b4ddf026 = []byte{
-	// 264 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2f, 0x4c, 0x2a, 0x49,
-	0xd6, 0x07, 0x13, 0x65, 0x86, 0xfa, 0x85, 0xa5, 0xa9, 0x45, 0x95, 0xf1, 0x05, 0x89, 0x45, 0x89,
-	0xb9, 0xc5, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9, 0x42, 0x3c, 0x20, 0x39, 0x3d, 0x30, 0x51, 0x66,
-	0x28, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x96, 0xd0, 0x07, 0xb1, 0x20, 0x6a, 0x94, 0xf4, 0xb9,
-	0x58, 0x03, 0x40, 0x7a, 0x84, 0x04, 0xb8, 0x98, 0xb3, 0x53, 0x2b, 0x25, 0x18, 0x15, 0x18, 0x35,
-	0x38, 0x83, 0x40, 0x4c, 0x21, 0x11, 0x2e, 0xd6, 0xb2, 0xc4, 0x9c, 0xd2, 0x54, 0x09, 0x26, 0x05,
-	0x46, 0x0d, 0xe6, 0x20, 0x08, 0x47, 0x49, 0x8d, 0x4b, 0x28, 0x10, 0x64, 0x15, 0x58, 0x57, 0x71,
-	0x50, 0x6a, 0x61, 0x69, 0x6a, 0x71, 0x09, 0xa6, 0x6e, 0x25, 0x07, 0x2e, 0x61, 0x14, 0x75, 0xc5,
-	0x05, 0xf9, 0x79, 0xc5, 0xa9, 0x42, 0x9a, 0x5c, 0xac, 0x60, 0x37, 0x82, 0x95, 0x72, 0x1b, 0x09,
-	0xeb, 0x21, 0xbb, 0x51, 0x0f, 0xac, 0x38, 0x08, 0xa2, 0x42, 0x49, 0x9c, 0x4b, 0x14, 0x6c, 0x82,
-	0x63, 0x4e, 0x0e, 0x8a, 0x65, 0x4a, 0xae, 0x5c, 0x62, 0xe8, 0x12, 0x50, 0xd3, 0xb5, 0xb9, 0xd8,
-	0x20, 0x21, 0x20, 0xc1, 0xa8, 0xc0, 0x8c, 0xcb, 0x78, 0xa8, 0x12, 0x27, 0xfb, 0x13, 0x8f, 0xe4,
-	0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f,
-	0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x52, 0x4d, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b,
-	0xce, 0xcf, 0xd5, 0x4f, 0x2a, 0x49, 0x2e, 0xd4, 0xcd, 0x2f, 0x4a, 0x87, 0x04, 0x74, 0x05, 0x84,
-	0x2a, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0x07, 0xa1, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff,
-	0x24, 0x11, 0x75, 0x5c, 0x89, 0x01, 0x00, 0x00,
+	// 330 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xcf, 0x4e, 0x83, 0x40,
+	0x10, 0xc6, 0xbb, 0x25, 0x6d, 0xd2, 0x55, 0xa3, 0x6e, 0xab, 0x12, 0x0f, 0x48, 0x48, 0x6c, 0x6a,
+	0x8c, 0x90, 0xea, 0x03, 0xf8, 0x27, 0x1e, 0x35, 0xa9, 0x1c, 0xbd, 0x10, 0xc0, 0x11, 0x89, 0xd0,
+	0x05, 0x76, 0x69, 0xca, 0x5b, 0xf8, 0x02, 0xbe, 0x8f, 0xc7, 0x1e, 0x3d, 0x9a, 0xf6, 0x45, 0x0c,
+	0xb3, 0x1c, 0xac, 0xc6, 0xcb, 0xec, 0xec, 0x7e, 0xbf, 0xf9, 0x66, 0xb2, 0x43, 0x8f, 0xf2, 0x40,
+	0x86, 0x0e, 0x86, 0xd9, 0xd8, 0xc9, 0x4b, 0x28, 0x2a, 0x2f, 0xf3, 0x0b, 0x3f, 0x15, 0x76, 0x56,
+	0x70, 0xc9, 0xd9, 0x66, 0xad, 0xd9, 0x18, 0x66, 0xe3, 0xc3, 0x41, 0xc4, 0x23, 0x8e, 0x82, 0x53,
+	0x67, 0x8a, 0xb1, 0x1c, 0xda, 0x99, 0xd4, 0x35, 0x6c, 0x87, 0x6a, 0xaf, 0x50, 0xe9, 0xc4, 0x24,
+	0xa3, 0x9e, 0x5b, 0xa7, 0x6c, 0x40, 0x3b, 0x33, 0x3f, 0x29, 0x41, 0x6f, 0x9b, 0x64, 0xa4, 0xb9,
+	0xea, 0x62, 0x0d, 0x29, 0x7b, 0xa8, 0x5b, 0x61, 0x95, 0x70, 0x21, 0x2f, 0x41, 0xc8, 0xbf, 0xd5,
+	0xd6, 0x15, 0xed, 0xaf, 0x71, 0x22, 0xe3, 0x53, 0x01, 0xec, 0x84, 0x76, 0x70, 0x46, 0x44, 0x37,
+	0xce, 0xfb, 0xf6, 0xcf, 0x19, 0x6d, 0x84, 0x5d, 0x45, 0x58, 0x07, 0x74, 0x0f, 0x1d, 0xae, 0x93,
+	0x64, 0xad, 0x99, 0xf5, 0x4e, 0xe8, 0xfe, 0x6f, 0xa5, 0xb1, 0x3f, 0xa5, 0x5d, 0xf5, 0x05, 0x3a,
+	0x31, 0xb5, 0xff, 0xfc, 0x1b, 0x84, 0xd9, 0xb4, 0x1f, 0x26, 0x7e, 0x9c, 0x7a, 0x29, 0xa4, 0xdc,
+	0xcb, 0x0a, 0x78, 0x8e, 0xe7, 0x20, 0xf4, 0xb6, 0xa9, 0x8d, 0x7a, 0xee, 0x2e, 0x4a, 0xf7, 0x90,
+	0xf2, 0x49, 0x23, 0xb0, 0x21, 0xdd, 0x56, 0xfc, 0x13, 0x4c, 0x2b, 0x2f, 0x89, 0x85, 0xd4, 0x35,
+	0x64, 0xb7, 0xf0, 0xf9, 0x16, 0xa6, 0xd5, 0x5d, 0x2c, 0xe4, 0xcd, 0xe5, 0xc7, 0xd2, 0x20, 0x8b,
+	0xa5, 0x41, 0xbe, 0x96, 0x06, 0x79, 0x5b, 0x19, 0xad, 0xc5, 0xca, 0x68, 0x7d, 0xae, 0x8c, 0xd6,
+	0xe3, 0x71, 0x14, 0xcb, 0x97, 0x32, 0xb0, 0x43, 0x9e, 0x3a, 0x81, 0x0c, 0xf3, 0x33, 0x5e, 0x44,
+	0x6a, 0x83, 0x73, 0x75, 0xc8, 0x2a, 0x03, 0x11, 0x74, 0x71, 0x37, 0x17, 0xdf, 0x01, 0x00, 0x00,
+	0xff, 0xff, 0xe5, 0xda, 0xc9, 0xba, 0xe2, 0x01, 0x00, 0x00,
 }
 
 func (m *Param) Marshal() (dAtA []byte, err error) {
@@ -419,6 +441,24 @@ func (m *QueryAllParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.ClaimDenyList) > 0 {
+		for iNdEx := len(m.ClaimDenyList) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ClaimDenyList[iNdEx])
+			copy(dAtA[i:], m.ClaimDenyList[iNdEx])
+			i = encodeVarintQueryParams(dAtA, i, uint64(len(m.ClaimDenyList[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ClaimMemoPrefixes) > 0 {
+		for iNdEx := len(m.ClaimMemoPrefixes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ClaimMemoPrefixes[iNdEx])
+			copy(dAtA[i:], m.ClaimMemoPrefixes[iNdEx])
+			i = encodeVarintQueryParams(dAtA, i, uint64(len(m.ClaimMemoPrefixes[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Params) > 0 {
 		for iNdEx := len(m.Params) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -510,6 +550,18 @@ func (m *QueryAllParamsResponse) Size() (n int) {
 			n += 1 + l + sovQueryParams(uint64(l))
 		}
 	}
+	if len(m.ClaimMemoPrefixes) > 0 {
+		for _, s := range m.ClaimMemoPrefixes {
+			l = len(s)
+			n += 1 + l + sovQueryParams(uint64(l))
+		}
+	}
+	if len(m.ClaimDenyList) > 0 {
+		for _, s := range m.ClaimDenyList {
+			l = len(s)
+			n += 1 + l + sovQueryParams(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -901,6 +953,70 @@ func (m *QueryAllParamsResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimMemoPrefixes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimMemoPrefixes = append(m.ClaimMemoPrefixes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimDenyList", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimDenyList = append(m.ClaimDenyList, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQueryParams(dAtA[iNdEx:])
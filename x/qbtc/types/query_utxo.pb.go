@@ -0,0 +1,786 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: qbtc/qbtc/v1/query_utxo.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryUTXORequest is the request type for the Query/UTXO RPC method.
+type QueryUTXORequest struct {
+	// txid is the Bitcoin transaction id of the output.
+	Txid string `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
+	// vout is the output index within the transaction.
+	Vout uint32 `protobuf:"varint,2,opt,name=vout,proto3" json:"vout,omitempty"`
+}
+
+func (m *QueryUTXORequest) Reset()         { *m = QueryUTXORequest{} }
+func (m *QueryUTXORequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUTXORequest) ProtoMessage()    {}
+func (*QueryUTXORequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91cbbf8dfd8cd254, []int{0}
+}
+func (m *QueryUTXORequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUTXORequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUTXORequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUTXORequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUTXORequest.Merge(m, src)
+}
+func (m *QueryUTXORequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUTXORequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUTXORequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUTXORequest proto.InternalMessageInfo
+
+func (m *QueryUTXORequest) GetTxid() string {
+	if m != nil {
+		return m.Txid
+	}
+	return ""
+}
+
+func (m *QueryUTXORequest) GetVout() uint32 {
+	if m != nil {
+		return m.Vout
+	}
+	return 0
+}
+
+// QueryUTXOResponse is the response type for the Query/UTXO RPC method.
+type QueryUTXOResponse struct {
+	// utxo is the stored UTXO, empty when found is false.
+	Utxo UTXO `protobuf:"bytes,1,opt,name=utxo,proto3" json:"utxo"`
+	// found reports whether the UTXO exists in the store.
+	Found bool `protobuf:"varint,2,opt,name=found,proto3" json:"found,omitempty"`
+	// address_type is how the chain classified the output script when matching
+	// claims against it, e.g. "p2pkh" or "p2wsh"; "unknown" if it can't be
+	// claimed.
+	AddressType string `protobuf:"bytes,3,opt,name=address_type,json=addressType,proto3" json:"address_type,omitempty"`
+	// circuit_type is the circuit a claim proof for the output must come from,
+	// empty if it can't be claimed.
+	CircuitType string `protobuf:"bytes,4,opt,name=circuit_type,json=circuitType,proto3" json:"circuit_type,omitempty"`
+	// circuit_supported reports whether the chain verifies proofs of
+	// circuit_type yet.
+	CircuitSupported bool `protobuf:"varint,5,opt,name=circuit_supported,json=circuitSupported,proto3" json:"circuit_supported,omitempty"`
+	// unclaimable_reason explains why no proof can claim the output, empty if
+	// one can.
+	UnclaimableReason string `protobuf:"bytes,6,opt,name=unclaimable_reason,json=unclaimableReason,proto3" json:"unclaimable_reason,omitempty"`
+}
+
+func (m *QueryUTXOResponse) Reset()         { *m = QueryUTXOResponse{} }
+func (m *QueryUTXOResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUTXOResponse) ProtoMessage()    {}
+func (*QueryUTXOResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91cbbf8dfd8cd254, []int{1}
+}
+func (m *QueryUTXOResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUTXOResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUTXOResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUTXOResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUTXOResponse.Merge(m, src)
+}
+func (m *QueryUTXOResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUTXOResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUTXOResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUTXOResponse proto.InternalMessageInfo
+
+func (m *QueryUTXOResponse) GetUtxo() UTXO {
+	if m != nil {
+		return m.Utxo
+	}
+	return UTXO{}
+}
+
+func (m *QueryUTXOResponse) GetFound() bool {
+	if m != nil {
+		return m.Found
+	}
+	return false
+}
+
+func (m *QueryUTXOResponse) GetAddressType() string {
+	if m != nil {
+		return m.AddressType
+	}
+	return ""
+}
+
+func (m *QueryUTXOResponse) GetCircuitType() string {
+	if m != nil {
+		return m.CircuitType
+	}
+	return ""
+}
+
+func (m *QueryUTXOResponse) GetCircuitSupported() bool {
+	if m != nil {
+		return m.CircuitSupported
+	}
+	return false
+}
+
+func (m *QueryUTXOResponse) GetUnclaimableReason() string {
+	if m != nil {
+		return m.UnclaimableReason
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*QueryUTXORequest)(nil), "qbtc.qbtc.v1.QueryUTXORequest")
+	proto.RegisterType((*QueryUTXOResponse)(nil), "qbtc.qbtc.v1.QueryUTXOResponse")
+}
+
+func init() { proto.RegisterFile("qbtc/qbtc/v1/query_utxo.proto", fileDescriptor_91cbbf8dfd8cd254) }
+
+var fileDescriptor_91cbbf8dfd8cd254 = []byte{
+	// 358 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x91, 0xcf, 0x4a, 0xeb, 0x40,
+	0x18, 0xc5, 0x33, 0xf7, 0xb6, 0xe5, 0x76, 0xda, 0x0b, 0xcd, 0xd0, 0x45, 0x28, 0xf7, 0xc6, 0x5a,
+	0x10, 0x8a, 0xd2, 0x84, 0xea, 0xce, 0x8d, 0xd0, 0x17, 0x10, 0x63, 0x05, 0x71, 0x53, 0xf2, 0x67,
+	0x8c, 0x81, 0x36, 0x93, 0xcc, 0x9f, 0xd2, 0xbe, 0x85, 0x8f, 0xe1, 0xd2, 0xc7, 0xe8, 0xb2, 0x4b,
+	0x57, 0x22, 0xed, 0xc2, 0xb7, 0x10, 0x99, 0x2f, 0x29, 0xc4, 0xcd, 0x97, 0x2f, 0xe7, 0xfc, 0xe6,
+	0xcc, 0x81, 0xc1, 0xff, 0xf3, 0x40, 0x86, 0x2e, 0x8c, 0xe5, 0xd8, 0xcd, 0x15, 0xe5, 0xeb, 0x99,
+	0x92, 0x2b, 0xe6, 0x64, 0x9c, 0x49, 0x46, 0xda, 0xda, 0x71, 0x60, 0x2c, 0xc7, 0x3d, 0xd3, 0x5f,
+	0x24, 0x29, 0x73, 0x61, 0x16, 0x40, 0xaf, 0x1b, 0xb3, 0x98, 0xc1, 0xea, 0xea, 0xad, 0x54, 0xff,
+	0xfd, 0x48, 0x95, 0xeb, 0x8c, 0x56, 0x42, 0x07, 0x97, 0xb8, 0x73, 0xa3, 0x2f, 0xba, 0x9b, 0xde,
+	0x5f, 0x7b, 0x34, 0x57, 0x54, 0x48, 0x42, 0x70, 0x4d, 0xae, 0x92, 0xc8, 0x42, 0x7d, 0x34, 0x6c,
+	0x7a, 0xb0, 0x6b, 0x6d, 0xc9, 0x94, 0xb4, 0x7e, 0xf5, 0xd1, 0xf0, 0xaf, 0x07, 0xfb, 0xe0, 0x0b,
+	0x61, 0xb3, 0x72, 0x58, 0x64, 0x2c, 0x15, 0x94, 0x8c, 0x71, 0x4d, 0xe7, 0xc3, 0xe9, 0xd6, 0x39,
+	0x71, 0xaa, 0xad, 0x1d, 0x4d, 0x4e, 0x9a, 0x9b, 0xf7, 0x23, 0xe3, 0xe5, 0xf3, 0xf5, 0x14, 0x79,
+	0x80, 0x92, 0x2e, 0xae, 0x3f, 0x32, 0x95, 0x46, 0x90, 0xfe, 0xc7, 0x2b, 0x7e, 0xc8, 0x31, 0x6e,
+	0xfb, 0x51, 0xc4, 0xa9, 0x10, 0x33, 0xdd, 0xda, 0xfa, 0x0d, 0x75, 0x5a, 0xa5, 0x36, 0x5d, 0x67,
+	0x54, 0x23, 0x61, 0xc2, 0x43, 0x95, 0xc8, 0x02, 0xa9, 0x15, 0x48, 0xa9, 0x01, 0x72, 0x86, 0xcd,
+	0x03, 0x22, 0x54, 0x96, 0x31, 0x2e, 0x69, 0x64, 0xd5, 0xe1, 0x9e, 0x4e, 0x69, 0xdc, 0x1e, 0x74,
+	0x32, 0xc2, 0x44, 0xa5, 0xe1, 0xdc, 0x4f, 0x16, 0x7e, 0x30, 0xa7, 0x33, 0x4e, 0x7d, 0xc1, 0x52,
+	0xab, 0x01, 0xa9, 0x66, 0xc5, 0xf1, 0xc0, 0x98, 0x5c, 0x6d, 0x76, 0x36, 0xda, 0xee, 0x6c, 0xf4,
+	0xb1, 0xb3, 0xd1, 0xf3, 0xde, 0x36, 0xb6, 0x7b, 0xdb, 0x78, 0xdb, 0xdb, 0xc6, 0xc3, 0x49, 0x9c,
+	0xc8, 0x27, 0x15, 0x38, 0x21, 0x5b, 0xb8, 0x81, 0x0c, 0xf3, 0x11, 0xe3, 0x71, 0xf1, 0x06, 0xab,
+	0xe2, 0xa3, 0xeb, 0x8a, 0xa0, 0x01, 0x8f, 0x70, 0xf1, 0x1d, 0x00, 0x00, 0xff, 0xff, 0xc8, 0x53,
+	0x83, 0x6b, 0xfa, 0x01, 0x00, 0x00,
+}
+
+func (m *QueryUTXORequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUTXORequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUTXORequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Vout != 0 {
+		i = encodeVarintQueryUtxo(dAtA, i, uint64(m.Vout))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Txid) > 0 {
+		i -= len(m.Txid)
+		copy(dAtA[i:], m.Txid)
+		i = encodeVarintQueryUtxo(dAtA, i, uint64(len(m.Txid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUTXOResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUTXOResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUTXOResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UnclaimableReason) > 0 {
+		i -= len(m.UnclaimableReason)
+		copy(dAtA[i:], m.UnclaimableReason)
+		i = encodeVarintQueryUtxo(dAtA, i, uint64(len(m.UnclaimableReason)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.CircuitSupported {
+		i--
+		if m.CircuitSupported {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.CircuitType) > 0 {
+		i -= len(m.CircuitType)
+		copy(dAtA[i:], m.CircuitType)
+		i = encodeVarintQueryUtxo(dAtA, i, uint64(len(m.CircuitType)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.AddressType) > 0 {
+		i -= len(m.AddressType)
+		copy(dAtA[i:], m.AddressType)
+		i = encodeVarintQueryUtxo(dAtA, i, uint64(len(m.AddressType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Found {
+		i--
+		if m.Found {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Utxo.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQueryUtxo(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQueryUtxo(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQueryUtxo(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryUTXORequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Txid)
+	if l > 0 {
+		n += 1 + l + sovQueryUtxo(uint64(l))
+	}
+	if m.Vout != 0 {
+		n += 1 + sovQueryUtxo(uint64(m.Vout))
+	}
+	return n
+}
+
+func (m *QueryUTXOResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Utxo.Size()
+	n += 1 + l + sovQueryUtxo(uint64(l))
+	if m.Found {
+		n += 2
+	}
+	l = len(m.AddressType)
+	if l > 0 {
+		n += 1 + l + sovQueryUtxo(uint64(l))
+	}
+	l = len(m.CircuitType)
+	if l > 0 {
+		n += 1 + l + sovQueryUtxo(uint64(l))
+	}
+	if m.CircuitSupported {
+		n += 2
+	}
+	l = len(m.UnclaimableReason)
+	if l > 0 {
+		n += 1 + l + sovQueryUtxo(uint64(l))
+	}
+	return n
+}
+
+func sovQueryUtxo(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQueryUtxo(x uint64) (n int) {
+	return sovQueryUtxo(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryUTXORequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueryUtxo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUTXORequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUTXORequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryUtxo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryUtxo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryUtxo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Txid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vout", wireType)
+			}
+			m.Vout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryUtxo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Vout |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueryUtxo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueryUtxo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUTXOResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueryUtxo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUTXOResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUTXOResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Utxo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryUtxo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueryUtxo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryUtxo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Utxo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Found", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryUtxo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Found = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryUtxo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryUtxo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryUtxo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddressType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryUtxo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryUtxo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryUtxo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CircuitType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitSupported", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryUtxo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CircuitSupported = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnclaimableReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryUtxo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryUtxo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryUtxo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnclaimableReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueryUtxo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueryUtxo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQueryUtxo(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQueryUtxo
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQueryUtxo
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQueryUtxo
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQueryUtxo
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQueryUtxo
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQueryUtxo
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQueryUtxo        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQueryUtxo          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQueryUtxo = fmt.Errorf("proto: unexpected end of group")
+)
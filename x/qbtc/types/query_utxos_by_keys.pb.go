@@ -0,0 +1,570 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: qbtc/qbtc/v1/query_utxos_by_keys.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryUTXOsByKeysRequest is the request type for the Query/UTXOsByKeys RPC
+// method.
+type QueryUTXOsByKeysRequest struct {
+	// utxos are the outputs to look up.
+	Utxos []UTXORef `protobuf:"bytes,1,rep,name=utxos,proto3" json:"utxos"`
+	// claimable_only omits UTXOs without an entitled amount left and coinbase
+	// outputs that are not mature yet. It does not check which address the
+	// UTXOs pay to; the claim proof does that.
+	ClaimableOnly bool `protobuf:"varint,2,opt,name=claimable_only,json=claimableOnly,proto3" json:"claimable_only,omitempty"`
+}
+
+func (m *QueryUTXOsByKeysRequest) Reset()         { *m = QueryUTXOsByKeysRequest{} }
+func (m *QueryUTXOsByKeysRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUTXOsByKeysRequest) ProtoMessage()    {}
+func (*QueryUTXOsByKeysRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88d5bee49da964ec, []int{0}
+}
+func (m *QueryUTXOsByKeysRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUTXOsByKeysRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUTXOsByKeysRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUTXOsByKeysRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUTXOsByKeysRequest.Merge(m, src)
+}
+func (m *QueryUTXOsByKeysRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUTXOsByKeysRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUTXOsByKeysRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUTXOsByKeysRequest proto.InternalMessageInfo
+
+func (m *QueryUTXOsByKeysRequest) GetUtxos() []UTXORef {
+	if m != nil {
+		return m.Utxos
+	}
+	return nil
+}
+
+func (m *QueryUTXOsByKeysRequest) GetClaimableOnly() bool {
+	if m != nil {
+		return m.ClaimableOnly
+	}
+	return false
+}
+
+// QueryUTXOsByKeysResponse is the response type for the Query/UTXOsByKeys RPC
+// method.
+type QueryUTXOsByKeysResponse struct {
+	// utxos are the requested UTXOs that are in the store, in request order.
+	Utxos []UTXO `protobuf:"bytes,1,rep,name=utxos,proto3" json:"utxos"`
+}
+
+func (m *QueryUTXOsByKeysResponse) Reset()         { *m = QueryUTXOsByKeysResponse{} }
+func (m *QueryUTXOsByKeysResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUTXOsByKeysResponse) ProtoMessage()    {}
+func (*QueryUTXOsByKeysResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88d5bee49da964ec, []int{1}
+}
+func (m *QueryUTXOsByKeysResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUTXOsByKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUTXOsByKeysResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUTXOsByKeysResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUTXOsByKeysResponse.Merge(m, src)
+}
+func (m *QueryUTXOsByKeysResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUTXOsByKeysResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUTXOsByKeysResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUTXOsByKeysResponse proto.InternalMessageInfo
+
+func (m *QueryUTXOsByKeysResponse) GetUtxos() []UTXO {
+	if m != nil {
+		return m.Utxos
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryUTXOsByKeysRequest)(nil), "qbtc.qbtc.v1.QueryUTXOsByKeysRequest")
+	proto.RegisterType((*QueryUTXOsByKeysResponse)(nil), "qbtc.qbtc.v1.QueryUTXOsByKeysResponse")
+}
+
+func init() {
+	proto.RegisterFile("qbtc/qbtc/v1/query_utxos_by_keys.proto", fileDescriptor_88d5bee49da964ec)
+}
+
+var fileDescriptor_88d5bee49da964ec = []byte{
+	// 312 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x90, 0xcf, 0x4a, 0xf3, 0x40,
+	0x14, 0xc5, 0x33, 0xdf, 0x87, 0xa2, 0xf1, 0x0f, 0x18, 0x14, 0x4b, 0x91, 0xb1, 0x14, 0xaa, 0x45,
+	0x30, 0xa1, 0x16, 0xdc, 0x0a, 0xdd, 0xba, 0x28, 0x06, 0x05, 0x71, 0x33, 0x64, 0xc2, 0x34, 0x0d,
+	0x26, 0xb9, 0x49, 0x66, 0x52, 0x33, 0x6f, 0xe1, 0x63, 0xb8, 0xf4, 0x31, 0xba, 0xec, 0xd2, 0x95,
+	0x48, 0xb2, 0xf0, 0x35, 0x24, 0x93, 0x22, 0x86, 0x6c, 0xce, 0x5c, 0xee, 0x9c, 0x99, 0xf3, 0xe3,
+	0xe8, 0x67, 0x09, 0x15, 0xae, 0xa5, 0x64, 0x31, 0xb2, 0x92, 0x8c, 0xa5, 0x92, 0x64, 0x22, 0x07,
+	0x4e, 0xa8, 0x24, 0xcf, 0x4c, 0x72, 0x33, 0x4e, 0x41, 0x80, 0xb1, 0x5b, 0x59, 0x4c, 0x25, 0x8b,
+	0x51, 0xf7, 0xc0, 0x09, 0xfd, 0x08, 0x2c, 0xa5, 0xb5, 0xa1, 0x7b, 0xe8, 0x81, 0x07, 0x6a, 0xb4,
+	0xaa, 0x69, 0xbd, 0x3d, 0x6f, 0x7c, 0x1f, 0x72, 0x8f, 0xb8, 0x81, 0xe3, 0x87, 0xe4, 0xc5, 0x17,
+	0x73, 0x12, 0xa7, 0x00, 0xb3, 0xb5, 0xf1, 0xa4, 0x61, 0x14, 0x32, 0x66, 0x0a, 0xa3, 0xbe, 0xed,
+	0xe7, 0xfa, 0xf1, 0x5d, 0x85, 0xf6, 0x70, 0xff, 0x38, 0xe5, 0x13, 0x79, 0xcb, 0x24, 0xb7, 0x59,
+	0x92, 0x31, 0x2e, 0x8c, 0x6b, 0x7d, 0x43, 0xf1, 0x76, 0x50, 0xef, 0xff, 0x70, 0xe7, 0xea, 0xc8,
+	0xfc, 0x0b, 0x6a, 0x56, 0x0f, 0x6c, 0x36, 0x9b, 0x6c, 0x2f, 0x3f, 0x4f, 0xb5, 0xb7, 0xef, 0xf7,
+	0x0b, 0x64, 0xd7, 0x76, 0x63, 0xa0, 0xef, 0x2b, 0x14, 0x87, 0x06, 0x8c, 0x40, 0x14, 0xc8, 0xce,
+	0xbf, 0x1e, 0x1a, 0x6e, 0xd9, 0x7b, 0xbf, 0xdb, 0x69, 0x14, 0xc8, 0xfe, 0x54, 0xef, 0xb4, 0x93,
+	0x79, 0x0c, 0x11, 0x67, 0xc6, 0xb8, 0x19, 0x6d, 0xb4, 0xa3, 0xdb, 0xb9, 0x93, 0x9b, 0x65, 0x81,
+	0xd1, 0xaa, 0xc0, 0xe8, 0xab, 0xc0, 0xe8, 0xb5, 0xc4, 0xda, 0xaa, 0xc4, 0xda, 0x47, 0x89, 0xb5,
+	0xa7, 0x81, 0xe7, 0x8b, 0x79, 0x46, 0x4d, 0x17, 0x42, 0x8b, 0x0a, 0x37, 0xb9, 0x84, 0xd4, 0xab,
+	0x1b, 0xc9, 0xeb, 0xa3, 0x6a, 0x85, 0xd3, 0x4d, 0x55, 0xc9, 0xf8, 0x27, 0x00, 0x00, 0xff, 0xff,
+	0xb4, 0x4a, 0x96, 0x69, 0xba, 0x01, 0x00, 0x00,
+}
+
+func (m *QueryUTXOsByKeysRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUTXOsByKeysRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUTXOsByKeysRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ClaimableOnly {
+		i--
+		if m.ClaimableOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Utxos) > 0 {
+		for iNdEx := len(m.Utxos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Utxos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQueryUtxosByKeys(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUTXOsByKeysResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUTXOsByKeysResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUTXOsByKeysResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Utxos) > 0 {
+		for iNdEx := len(m.Utxos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Utxos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQueryUtxosByKeys(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQueryUtxosByKeys(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQueryUtxosByKeys(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryUTXOsByKeysRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Utxos) > 0 {
+		for _, e := range m.Utxos {
+			l = e.Size()
+			n += 1 + l + sovQueryUtxosByKeys(uint64(l))
+		}
+	}
+	if m.ClaimableOnly {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryUTXOsByKeysResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Utxos) > 0 {
+		for _, e := range m.Utxos {
+			l = e.Size()
+			n += 1 + l + sovQueryUtxosByKeys(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQueryUtxosByKeys(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQueryUtxosByKeys(x uint64) (n int) {
+	return sovQueryUtxosByKeys(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryUTXOsByKeysRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueryUtxosByKeys
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUTXOsByKeysRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUTXOsByKeysRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Utxos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryUtxosByKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueryUtxosByKeys
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryUtxosByKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Utxos = append(m.Utxos, UTXORef{})
+			if err := m.Utxos[len(m.Utxos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimableOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryUtxosByKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ClaimableOnly = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueryUtxosByKeys(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueryUtxosByKeys
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUTXOsByKeysResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueryUtxosByKeys
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUTXOsByKeysResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUTXOsByKeysResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Utxos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryUtxosByKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueryUtxosByKeys
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryUtxosByKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Utxos = append(m.Utxos, UTXO{})
+			if err := m.Utxos[len(m.Utxos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueryUtxosByKeys(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueryUtxosByKeys
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQueryUtxosByKeys(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQueryUtxosByKeys
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQueryUtxosByKeys
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQueryUtxosByKeys
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQueryUtxosByKeys
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQueryUtxosByKeys
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQueryUtxosByKeys
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQueryUtxosByKeys        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQueryUtxosByKeys          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQueryUtxosByKeys = fmt.Errorf("proto: unexpected end of group")
+)
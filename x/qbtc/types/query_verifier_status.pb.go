@@ -0,0 +1,836 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: qbtc/qbtc/v1/query_verifier_status.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryVerifierStatusRequest is the request type for the Query/VerifierStatus
+// RPC method.
+type QueryVerifierStatusRequest struct {
+}
+
+func (m *QueryVerifierStatusRequest) Reset()         { *m = QueryVerifierStatusRequest{} }
+func (m *QueryVerifierStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVerifierStatusRequest) ProtoMessage()    {}
+func (*QueryVerifierStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f2bf59bdc7666979, []int{0}
+}
+func (m *QueryVerifierStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVerifierStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVerifierStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVerifierStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVerifierStatusRequest.Merge(m, src)
+}
+func (m *QueryVerifierStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVerifierStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVerifierStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVerifierStatusRequest proto.InternalMessageInfo
+
+// QueryVerifierStatusResponse is the response type for the Query/VerifierStatus
+// RPC method.
+type QueryVerifierStatusResponse struct {
+	// initialized reports whether a ZK verifying key is stored in state.
+	Initialized bool `protobuf:"varint,1,opt,name=initialized,proto3" json:"initialized,omitempty"`
+	// vk_fingerprint is the short fingerprint of the stored default verifying
+	// key, the first 8 bytes of its SHA256 in hex as MsgClaimWithProof takes
+	// it, empty when it is not initialized.
+	VkFingerprint string `protobuf:"bytes,2,opt,name=vk_fingerprint,json=vkFingerprint,proto3" json:"vk_fingerprint,omitempty"`
+	// message_version is the claim message version used when a claim does not
+	// name one.
+	MessageVersion string `protobuf:"bytes,3,opt,name=message_version,json=messageVersion,proto3" json:"message_version,omitempty"`
+	// version_vk_fingerprints lists the message versions with a verifying key
+	// of their own, which their claims are verified against instead of the
+	// default key, sorted by version.
+	VersionVkFingerprints []VersionVkFingerprint `protobuf:"bytes,4,rep,name=version_vk_fingerprints,json=versionVkFingerprints,proto3" json:"version_vk_fingerprints"`
+}
+
+func (m *QueryVerifierStatusResponse) Reset()         { *m = QueryVerifierStatusResponse{} }
+func (m *QueryVerifierStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVerifierStatusResponse) ProtoMessage()    {}
+func (*QueryVerifierStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f2bf59bdc7666979, []int{1}
+}
+func (m *QueryVerifierStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVerifierStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVerifierStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVerifierStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVerifierStatusResponse.Merge(m, src)
+}
+func (m *QueryVerifierStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVerifierStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVerifierStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVerifierStatusResponse proto.InternalMessageInfo
+
+func (m *QueryVerifierStatusResponse) GetInitialized() bool {
+	if m != nil {
+		return m.Initialized
+	}
+	return false
+}
+
+func (m *QueryVerifierStatusResponse) GetVkFingerprint() string {
+	if m != nil {
+		return m.VkFingerprint
+	}
+	return ""
+}
+
+func (m *QueryVerifierStatusResponse) GetMessageVersion() string {
+	if m != nil {
+		return m.MessageVersion
+	}
+	return ""
+}
+
+func (m *QueryVerifierStatusResponse) GetVersionVkFingerprints() []VersionVkFingerprint {
+	if m != nil {
+		return m.VersionVkFingerprints
+	}
+	return nil
+}
+
+// VersionVkFingerprint is the short fingerprint of the verifying key claims of
+// a message version are verified against.
+type VersionVkFingerprint struct {
+	MessageVersion string `protobuf:"bytes,1,opt,name=message_version,json=messageVersion,proto3" json:"message_version,omitempty"`
+	VkFingerprint  string `protobuf:"bytes,2,opt,name=vk_fingerprint,json=vkFingerprint,proto3" json:"vk_fingerprint,omitempty"`
+}
+
+func (m *VersionVkFingerprint) Reset()         { *m = VersionVkFingerprint{} }
+func (m *VersionVkFingerprint) String() string { return proto.CompactTextString(m) }
+func (*VersionVkFingerprint) ProtoMessage()    {}
+func (*VersionVkFingerprint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f2bf59bdc7666979, []int{2}
+}
+func (m *VersionVkFingerprint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VersionVkFingerprint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VersionVkFingerprint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VersionVkFingerprint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VersionVkFingerprint.Merge(m, src)
+}
+func (m *VersionVkFingerprint) XXX_Size() int {
+	return m.Size()
+}
+func (m *VersionVkFingerprint) XXX_DiscardUnknown() {
+	xxx_messageInfo_VersionVkFingerprint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VersionVkFingerprint proto.InternalMessageInfo
+
+func (m *VersionVkFingerprint) GetMessageVersion() string {
+	if m != nil {
+		return m.MessageVersion
+	}
+	return ""
+}
+
+func (m *VersionVkFingerprint) GetVkFingerprint() string {
+	if m != nil {
+		return m.VkFingerprint
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*QueryVerifierStatusRequest)(nil), "qbtc.qbtc.v1.QueryVerifierStatusRequest")
+	proto.RegisterType((*QueryVerifierStatusResponse)(nil), "qbtc.qbtc.v1.QueryVerifierStatusResponse")
+	proto.RegisterType((*VersionVkFingerprint)(nil), "qbtc.qbtc.v1.VersionVkFingerprint")
+}
+
+func init() {
+	proto.RegisterFile("qbtc/qbtc/v1/query_verifier_status.proto", fileDescriptor_f2bf59bdc7666979)
+}
+
+var fileDescriptor_f2bf59bdc7666979 = []byte{
+	// 318 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x51, 0x41, 0x4a, 0xc3, 0x40,
+	0x14, 0xcd, 0xd8, 0x22, 0x3a, 0xd5, 0x0a, 0xa1, 0x62, 0xa8, 0x32, 0x86, 0x40, 0x31, 0x1b, 0x13,
+	0xaa, 0x07, 0x10, 0xba, 0x70, 0x6f, 0x84, 0x2e, 0xdc, 0xc4, 0xa6, 0x4e, 0xc7, 0xa1, 0x36, 0x93,
+	0xcc, 0x4c, 0x06, 0xeb, 0x29, 0x3c, 0x56, 0x97, 0x5d, 0xba, 0x12, 0x49, 0x4e, 0xe0, 0x0d, 0x24,
+	0x93, 0x80, 0x11, 0xb2, 0x70, 0xf3, 0x67, 0x78, 0xef, 0xfd, 0xf7, 0xff, 0xe3, 0x43, 0x37, 0x8d,
+	0xe4, 0xdc, 0xd7, 0x45, 0x8d, 0xfd, 0x34, 0xc3, 0x7c, 0x1d, 0x2a, 0xcc, 0xe9, 0x82, 0x62, 0x1e,
+	0x0a, 0x39, 0x93, 0x99, 0xf0, 0x12, 0xce, 0x24, 0x33, 0x0f, 0x4a, 0x91, 0xa7, 0x8b, 0x1a, 0x0f,
+	0x07, 0x84, 0x11, 0xa6, 0x09, 0xbf, 0xfc, 0x55, 0x1a, 0xe7, 0x0c, 0x0e, 0xef, 0x4a, 0x8b, 0x69,
+	0xed, 0x70, 0xaf, 0x0d, 0x02, 0x9c, 0x66, 0x58, 0x48, 0xe7, 0x1b, 0xc0, 0xd3, 0x56, 0x5a, 0x24,
+	0x2c, 0x16, 0xd8, 0xb4, 0x61, 0x8f, 0xc6, 0x54, 0xd2, 0xd9, 0x0b, 0x7d, 0xc3, 0x4f, 0x16, 0xb0,
+	0x81, 0xbb, 0x17, 0x34, 0x21, 0x73, 0x04, 0xfb, 0x6a, 0x19, 0x2e, 0x68, 0x4c, 0x30, 0x4f, 0x38,
+	0x8d, 0xa5, 0xb5, 0x63, 0x03, 0x77, 0x3f, 0x38, 0x54, 0xcb, 0xdb, 0x5f, 0xd0, 0xbc, 0x80, 0x47,
+	0x2b, 0x2c, 0xc4, 0x8c, 0xe0, 0x32, 0x8b, 0xa0, 0x2c, 0xb6, 0x3a, 0x5a, 0xd7, 0xaf, 0xe1, 0x69,
+	0x85, 0x9a, 0x8f, 0xf0, 0xa4, 0x16, 0x84, 0x7f, 0x7d, 0x85, 0xd5, 0xb5, 0x3b, 0x6e, 0xef, 0xca,
+	0xf1, 0x9a, 0xa9, 0xbd, 0xba, 0x6f, 0xda, 0x9c, 0x36, 0xe9, 0x6e, 0x3e, 0xcf, 0x8d, 0xe0, 0x58,
+	0xb5, 0x70, 0xc2, 0x59, 0xc0, 0x41, 0x5b, 0x53, 0xdb, 0x8a, 0xa0, 0x75, 0xc5, 0xff, 0x45, 0x9e,
+	0xdc, 0x6c, 0x72, 0x04, 0xb6, 0x39, 0x02, 0x5f, 0x39, 0x02, 0xef, 0x05, 0x32, 0xb6, 0x05, 0x32,
+	0x3e, 0x0a, 0x64, 0x3c, 0x8c, 0x08, 0x95, 0xcf, 0x59, 0xe4, 0xcd, 0xd9, 0xca, 0x8f, 0xe4, 0x3c,
+	0xbd, 0x64, 0x9c, 0x54, 0x07, 0x7f, 0xad, 0x1e, 0xb9, 0x4e, 0xb0, 0x88, 0x76, 0xf5, 0x05, 0xaf,
+	0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x2b, 0x7e, 0xae, 0xa1, 0x11, 0x02, 0x00, 0x00,
+}
+
+func (m *QueryVerifierStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerifierStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerifierStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVerifierStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerifierStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerifierStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VersionVkFingerprints) > 0 {
+		for iNdEx := len(m.VersionVkFingerprints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VersionVkFingerprints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQueryVerifierStatus(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.MessageVersion) > 0 {
+		i -= len(m.MessageVersion)
+		copy(dAtA[i:], m.MessageVersion)
+		i = encodeVarintQueryVerifierStatus(dAtA, i, uint64(len(m.MessageVersion)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.VkFingerprint) > 0 {
+		i -= len(m.VkFingerprint)
+		copy(dAtA[i:], m.VkFingerprint)
+		i = encodeVarintQueryVerifierStatus(dAtA, i, uint64(len(m.VkFingerprint)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Initialized {
+		i--
+		if m.Initialized {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VersionVkFingerprint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VersionVkFingerprint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VersionVkFingerprint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VkFingerprint) > 0 {
+		i -= len(m.VkFingerprint)
+		copy(dAtA[i:], m.VkFingerprint)
+		i = encodeVarintQueryVerifierStatus(dAtA, i, uint64(len(m.VkFingerprint)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MessageVersion) > 0 {
+		i -= len(m.MessageVersion)
+		copy(dAtA[i:], m.MessageVersion)
+		i = encodeVarintQueryVerifierStatus(dAtA, i, uint64(len(m.MessageVersion)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQueryVerifierStatus(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQueryVerifierStatus(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryVerifierStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryVerifierStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Initialized {
+		n += 2
+	}
+	l = len(m.VkFingerprint)
+	if l > 0 {
+		n += 1 + l + sovQueryVerifierStatus(uint64(l))
+	}
+	l = len(m.MessageVersion)
+	if l > 0 {
+		n += 1 + l + sovQueryVerifierStatus(uint64(l))
+	}
+	if len(m.VersionVkFingerprints) > 0 {
+		for _, e := range m.VersionVkFingerprints {
+			l = e.Size()
+			n += 1 + l + sovQueryVerifierStatus(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *VersionVkFingerprint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MessageVersion)
+	if l > 0 {
+		n += 1 + l + sovQueryVerifierStatus(uint64(l))
+	}
+	l = len(m.VkFingerprint)
+	if l > 0 {
+		n += 1 + l + sovQueryVerifierStatus(uint64(l))
+	}
+	return n
+}
+
+func sovQueryVerifierStatus(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQueryVerifierStatus(x uint64) (n int) {
+	return sovQueryVerifierStatus(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryVerifierStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueryVerifierStatus
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVerifierStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVerifierStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueryVerifierStatus(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueryVerifierStatus
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVerifierStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueryVerifierStatus
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVerifierStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVerifierStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Initialized", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryVerifierStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Initialized = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VkFingerprint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryVerifierStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryVerifierStatus
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryVerifierStatus
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VkFingerprint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryVerifierStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryVerifierStatus
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryVerifierStatus
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionVkFingerprints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryVerifierStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueryVerifierStatus
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryVerifierStatus
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VersionVkFingerprints = append(m.VersionVkFingerprints, VersionVkFingerprint{})
+			if err := m.VersionVkFingerprints[len(m.VersionVkFingerprints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueryVerifierStatus(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueryVerifierStatus
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VersionVkFingerprint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueryVerifierStatus
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VersionVkFingerprint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VersionVkFingerprint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryVerifierStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryVerifierStatus
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryVerifierStatus
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VkFingerprint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryVerifierStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryVerifierStatus
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryVerifierStatus
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VkFingerprint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueryVerifierStatus(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueryVerifierStatus
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQueryVerifierStatus(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQueryVerifierStatus
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQueryVerifierStatus
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQueryVerifierStatus
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQueryVerifierStatus
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQueryVerifierStatus
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQueryVerifierStatus
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQueryVerifierStatus        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQueryVerifierStatus          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQueryVerifierStatus = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,88 @@
+package types
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Satoshi is an amount of bitcoin in satoshis. Amounts reported in BTC must
+// be converted with FromBTCString or FromBTCFloat; multiplying a float by 1e8
+// and truncating loses a satoshi on values like 0.29 BTC.
+type Satoshi uint64
+
+const (
+	// SatoshisPerBTC is the number of satoshis in one bitcoin
+	SatoshisPerBTC = 100_000_000
+	// MaxSatoshi is the largest amount that can exist on Bitcoin, 21 million BTC
+	MaxSatoshi Satoshi = 21_000_000 * SatoshisPerBTC
+
+	btcDecimals = 8
+	// maxBTCExponent bounds the exponent FromBTCString accepts, far beyond
+	// any amount that fits MaxSatoshi
+	maxBTCExponent = 32
+)
+
+// FromBTCString converts a decimal BTC amount to satoshis exactly. It takes
+// the amount as bitcoind renders it in JSON, or as encoding/json renders a
+// float64, which switches to an exponent below 1e-6 BTC (e.g. "5.46e-06").
+// Amounts with a sign, a fraction of a satoshi or above MaxSatoshi fail.
+func FromBTCString(s string) (Satoshi, error) {
+	mantissa, exponent, hasExponent := strings.Cut(strings.ToLower(s), "e")
+	exp := 0
+	if hasExponent {
+		var err error
+		if exp, err = strconv.Atoi(exponent); err != nil || exp < -maxBTCExponent || exp > maxBTCExponent {
+			return 0, fmt.Errorf("invalid BTC amount %q", s)
+		}
+	}
+	whole, frac, hasPoint := strings.Cut(mantissa, ".")
+	if whole == "" && (!hasPoint || frac == "") {
+		return 0, fmt.Errorf("invalid BTC amount %q", s)
+	}
+
+	// the first satoshiDigits digits are the amount in satoshis, padded with
+	// zeros where the digits run out; any digit after them must be zero
+	digits := whole + frac
+	satoshiDigits := len(whole) + exp + btcDecimals
+	var amount uint64
+	for i := 0; i < max(len(digits), satoshiDigits); i++ {
+		c := byte('0')
+		if i < len(digits) {
+			c = digits[i]
+		}
+		if c < '0' || c > '9' {
+			return 0, fmt.Errorf("invalid BTC amount %q", s)
+		}
+		if i >= satoshiDigits {
+			if c != '0' {
+				return 0, fmt.Errorf("invalid BTC amount %q: more than %d decimals", s, btcDecimals)
+			}
+			continue
+		}
+		amount = amount*10 + uint64(c-'0')
+		if amount > uint64(MaxSatoshi) {
+			return 0, fmt.Errorf("BTC amount %q exceeds the bitcoin supply", s)
+		}
+	}
+	return Satoshi(amount), nil
+}
+
+// FromBTCFloat converts a BTC amount to satoshis, rounding to the nearest
+// satoshi. Prefer FromBTCString where the decimal text is available.
+func FromBTCFloat(f float64) (Satoshi, error) {
+	if math.IsNaN(f) || math.IsInf(f, 0) || f < 0 {
+		return 0, fmt.Errorf("invalid BTC amount %v", f)
+	}
+	amount := math.Round(f * SatoshisPerBTC)
+	if amount > float64(MaxSatoshi) {
+		return 0, fmt.Errorf("BTC amount %v exceeds the bitcoin supply", f)
+	}
+	return Satoshi(amount), nil
+}
+
+// Uint64 returns the amount as stored in UTXO amounts.
+func (s Satoshi) Uint64() uint64 {
+	return uint64(s)
+}
@@ -0,0 +1,87 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestFromBTCString(t *testing.T) {
+	valid := map[string]Satoshi{
+		"0":              0,
+		"0.29":           29_000_000,
+		"0.29000000":     29_000_000,
+		"0.00000001":     1,
+		"1.":             SatoshisPerBTC,
+		".5":             50_000_000,
+		"50.00000000":    50 * SatoshisPerBTC,
+		"21000000":       MaxSatoshi,
+		"0000.10000000":  10_000_000,
+		"20999999.99999": MaxSatoshi - 1000,
+		"5.46e-06":       546,
+		"1e-08":          1,
+		"1E-8":           1,
+		"2.1e7":          MaxSatoshi,
+		"0.10e1":         SatoshisPerBTC,
+		"1.000000000":    SatoshisPerBTC,
+	}
+	for s, want := range valid {
+		got, err := FromBTCString(s)
+		if err != nil {
+			t.Fatalf("%q: %v", s, err)
+		}
+		if got != want {
+			t.Fatalf("%q: got %d, want %d", s, got, want)
+		}
+	}
+
+	for _, s := range []string{"", ".", "-1", "+1", "0.000000001", "1e-9", "1.5e-8", "1e", "1e+x", "1e1000", "e5", "1,5", " 1", "21000000.00000001", "99999999999999999999"} {
+		if _, err := FromBTCString(s); err == nil {
+			t.Fatalf("%q: expected an error", s)
+		}
+	}
+}
+
+func TestFromBTCStringEncodedFloat(t *testing.T) {
+	// block reports carry amounts as encoding/json renders the float64 that
+	// btcjson parsed them into
+	for _, want := range []Satoshi{1, 99, 546, 999_999, 29_000_000, 123_456_789, 50 * SatoshisPerBTC, MaxSatoshi - 1} {
+		encoded, err := json.Marshal(float64(want) / SatoshisPerBTC)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := FromBTCString(string(encoded))
+		if err != nil {
+			t.Fatalf("%s: %v", encoded, err)
+		}
+		if got != want {
+			t.Fatalf("%s: got %d, want %d", encoded, got, want)
+		}
+	}
+}
+
+func TestFromBTCFloat(t *testing.T) {
+	// 0.29 * 1e8 is 28999999.999999996 in floating point
+	valid := map[float64]Satoshi{
+		0:          0,
+		0.29:       29_000_000,
+		0.00000001: 1,
+		1.1:        110_000_000,
+		50:         50 * SatoshisPerBTC,
+		21e6:       MaxSatoshi,
+	}
+	for f, want := range valid {
+		got, err := FromBTCFloat(f)
+		if err != nil {
+			t.Fatalf("%v: %v", f, err)
+		}
+		if got != want {
+			t.Fatalf("%v: got %d, want %d", f, got, want)
+		}
+	}
+
+	for _, f := range []float64{-0.1, 21e6 + 1, 1e300} {
+		if _, err := FromBTCFloat(f); err == nil {
+			t.Fatalf("%v: expected an error", f)
+		}
+	}
+}
@@ -0,0 +1,185 @@
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/btcq-org/qbtc/common"
+	"github.com/btcq-org/qbtc/x/qbtc/zk"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+)
+
+// ScriptTypeNullData is the script type bitcoind reports for OP_RETURN outputs.
+const ScriptTypeNullData = "nulldata"
+
+// ScriptPubKeyIdentifier returns the address type of an output and the
+// identifier claims for it are matched against: the Hash160 of the key for
+// P2PKH and P2WPKH, the 32-byte witness program for P2WSH. Outputs without an
+// address or of any other type cannot be claimed and return an error.
+//
+// Nodes render the address of the same output differently, so the identifier
+// is decoded from the script whenever it has one of the claimable forms, and
+// only otherwise from the address. A P2PK output is identified like the P2PKH
+// output of its key, which is the address older nodes report for it.
+//
+// Both the block processor and the claim handler identify outputs through
+// this function, so a UTXO is always matched the way it was stored.
+func ScriptPubKeyIdentifier(spk *ScriptPubKeyResult) (zk.AddressType, []byte, error) {
+	if spk == nil {
+		return zk.AddressTypeUnknown, nil, fmt.Errorf("script pubkey has no address")
+	}
+	if addressType, identifier, ok := scriptIdentifier(spk.Hex); ok {
+		return addressType, identifier, nil
+	}
+	if spk.Address == "" {
+		return zk.AddressTypeUnknown, nil, fmt.Errorf("script pubkey has no address")
+	}
+	return AddressIdentifier(spk.Address)
+}
+
+// AddressIdentifier returns the address type and claim identifier of an
+// address of the Bitcoin network this chain indexes, after
+// NormalizeBitcoinAddress.
+func AddressIdentifier(address string) (zk.AddressType, []byte, error) {
+	address = NormalizeBitcoinAddress(address)
+	params := common.BitcoinNetParams(common.CurrentChainNetwork)
+	addressType, err := zk.DetectAddressTypeForNet(address, params)
+	if err != nil {
+		return zk.AddressTypeUnknown, nil, err
+	}
+	addr, err := btcutil.DecodeAddress(address, params)
+	if err != nil {
+		return zk.AddressTypeUnknown, nil, err
+	}
+	// the Hash160 of the key, or the witness program of a P2WSH address
+	return addressType, addr.ScriptAddress(), nil
+}
+
+// bitcoinNetworks are the networks CheckAddressNetwork names an address of
+// the wrong network by. Signet shares its prefixes with testnet3, and regtest
+// shares the base58 ones.
+var bitcoinNetworks = []*chaincfg.Params{
+	&chaincfg.MainNetParams,
+	&chaincfg.TestNet3Params,
+	&chaincfg.SigNetParams,
+	&chaincfg.RegressionNetParams,
+}
+
+// CheckAddressNetwork returns an error if address, after
+// NormalizeBitcoinAddress, is an address of another Bitcoin network than
+// params. Empty addresses and addresses no network decodes, e.g. of a witness
+// version btcutil does not know, are not a network mismatch and pass.
+func CheckAddressNetwork(address string, params *chaincfg.Params) error {
+	address = NormalizeBitcoinAddress(address)
+	if address == "" {
+		return nil
+	}
+	if addr, err := btcutil.DecodeAddress(address, params); err == nil && addr.IsForNet(params) {
+		return nil
+	}
+	for _, net := range bitcoinNetworks {
+		if net.Net == params.Net {
+			continue
+		}
+		if addr, err := btcutil.DecodeAddress(address, net); err == nil && addr.IsForNet(net) {
+			return fmt.Errorf("address %s is a %s address, expected %s", address, net.Name, params.Name)
+		}
+	}
+	return nil
+}
+
+// CheckScriptAddress returns an error if the address a node reported for an
+// output is not the address its script encodes on params. The script is the
+// same on every network, while the address is rendered by the node, so a
+// mismatch shows a node running on another network. Outputs without an
+// address pass, and so do scripts that encode no single address, such as
+// OP_RETURN outputs or bare multisig.
+func CheckScriptAddress(spk *ScriptPubKeyResult, params *chaincfg.Params) error {
+	address := NormalizeBitcoinAddress(spk.Address)
+	if address == "" {
+		return nil
+	}
+	if err := CheckAddressNetwork(address, params); err != nil {
+		return err
+	}
+	script, err := hex.DecodeString(spk.Hex)
+	if err != nil {
+		return fmt.Errorf("invalid script hex: %w", err)
+	}
+	_, addrs, _, err := txscript.ExtractPkScriptAddrs(script, params)
+	if err != nil || len(addrs) != 1 {
+		return nil
+	}
+	if encoded := addrs[0].EncodeAddress(); encoded != address {
+		return fmt.Errorf("address %s does not match the address %s of its script", address, encoded)
+	}
+	return nil
+}
+
+// bitcoinURIScheme is the BIP21 scheme some wallets prefix addresses with
+const bitcoinURIScheme = "bitcoin:"
+
+// NormalizeBitcoinAddress removes the cosmetic differences between renderings
+// of an address: surrounding space, a "bitcoin:" URI scheme with its query,
+// and upper case bech32, which is valid but has the same checksum in lower
+// case. Base58 addresses are case sensitive and keep their case.
+func NormalizeBitcoinAddress(address string) string {
+	address = strings.TrimSpace(address)
+	if len(address) > len(bitcoinURIScheme) && strings.EqualFold(address[:len(bitcoinURIScheme)], bitcoinURIScheme) {
+		address = address[len(bitcoinURIScheme):]
+		address, _, _ = strings.Cut(address, "?")
+	}
+	if lower := strings.ToLower(address); strings.HasPrefix(lower, "bc1") && address == strings.ToUpper(address) {
+		return lower
+	}
+	return address
+}
+
+// scriptIdentifier decodes the address type and claim identifier of a
+// claimable output script. It reports false for any other script, including
+// P2SH and SegWit versions above 0, whose errors come from the address.
+func scriptIdentifier(scriptHex string) (zk.AddressType, []byte, bool) {
+	script, err := hex.DecodeString(scriptHex)
+	if err != nil {
+		return zk.AddressTypeUnknown, nil, false
+	}
+	switch {
+	case len(script) == 25 && script[0] == txscript.OP_DUP && script[1] == txscript.OP_HASH160 &&
+		script[2] == txscript.OP_DATA_20 && script[23] == txscript.OP_EQUALVERIFY && script[24] == txscript.OP_CHECKSIG:
+		return zk.AddressTypeP2PKH, script[3:23], true
+	case len(script) == 22 && script[0] == txscript.OP_0 && script[1] == txscript.OP_DATA_20:
+		return zk.AddressTypeP2WPKH, script[2:], true
+	case len(script) == 34 && script[0] == txscript.OP_0 && script[1] == txscript.OP_DATA_32:
+		return zk.AddressTypeP2WSH, script[2:], true
+	case len(script) == 35 && script[0] == txscript.OP_DATA_33 && script[34] == txscript.OP_CHECKSIG,
+		len(script) == 67 && script[0] == txscript.OP_DATA_65 && script[66] == txscript.OP_CHECKSIG:
+		// P2PK: the key itself is in the script
+		return zk.AddressTypeP2PKH, btcutil.Hash160(script[1 : len(script)-1]), true
+	}
+	return zk.AddressTypeUnknown, nil, false
+}
+
+// NullDataPayload returns the first data push of an OP_RETURN output.
+func NullDataPayload(spk *ScriptPubKeyResult) ([]byte, error) {
+	if spk == nil || spk.Type != ScriptTypeNullData {
+		return nil, fmt.Errorf("not a nulldata output")
+	}
+	script, err := hex.DecodeString(spk.Hex)
+	if err != nil {
+		return nil, fmt.Errorf("invalid script hex: %w", err)
+	}
+	if len(script) == 0 || script[0] != txscript.OP_RETURN {
+		return nil, fmt.Errorf("script does not start with OP_RETURN")
+	}
+	pushes, err := txscript.PushedData(script[1:])
+	if err != nil {
+		return nil, fmt.Errorf("invalid nulldata script: %w", err)
+	}
+	if len(pushes) == 0 || len(pushes[0]) == 0 {
+		return nil, fmt.Errorf("nulldata output carries no data")
+	}
+	return pushes[0], nil
+}
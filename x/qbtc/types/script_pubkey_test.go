@@ -0,0 +1,194 @@
+package types
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/btcq-org/qbtc/x/qbtc/zk"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScriptPubKeyIdentifier(t *testing.T) {
+	tests := []struct {
+		name       string
+		spk        *ScriptPubKeyResult
+		addrType   zk.AddressType
+		identifier string
+		wantErr    bool
+	}{
+		{
+			name:       "p2pkh",
+			spk:        &ScriptPubKeyResult{Type: "pubkeyhash", Address: "1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa"},
+			addrType:   zk.AddressTypeP2PKH,
+			identifier: "62e907b15cbf27d5425399ebf6f0fb50ebb88f18",
+		},
+		{
+			name:       "p2wpkh",
+			spk:        &ScriptPubKeyResult{Type: "witness_v0_keyhash", Address: "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4"},
+			addrType:   zk.AddressTypeP2WPKH,
+			identifier: "751e76e8199196d454941c45d1b3a323f1433bd6",
+		},
+		{
+			name:       "p2wsh",
+			spk:        &ScriptPubKeyResult{Type: "witness_v0_scripthash", Address: "bc1qrp33g0q5c5txsp9arysrx4k6zdkfs4nce4xj0gdcccefvpysxf3qccfmv3"},
+			addrType:   zk.AddressTypeP2WSH,
+			identifier: "1863143c14c5166804bd19203356da136c985678cd4d27a1b8c6329604903262",
+		},
+		{
+			name:    "p2sh is not claimable",
+			spk:     &ScriptPubKeyResult{Type: "scripthash", Address: "3J98t1WpEZ73CNmQviecrnyiWrnqRhWNLy"},
+			wantErr: true,
+		},
+		{
+			name:    "no address",
+			spk:     &ScriptPubKeyResult{Type: ScriptTypeNullData, Hex: "6a0568656c6c6f"},
+			wantErr: true,
+		},
+		{
+			name:    "nil",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			addrType, identifier, err := ScriptPubKeyIdentifier(tt.spk)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.addrType, addrType)
+			require.Equal(t, tt.identifier, hex.EncodeToString(identifier))
+		})
+	}
+}
+
+func TestNullDataPayload(t *testing.T) {
+	payload, err := NullDataPayload(&ScriptPubKeyResult{Type: ScriptTypeNullData, Hex: "6a0568656c6c6f"})
+	require.NoError(t, err)
+	require.Equal(t, "hello", string(payload))
+
+	_, err = NullDataPayload(&ScriptPubKeyResult{Type: "pubkeyhash", Hex: "6a0568656c6c6f"})
+	require.Error(t, err)
+	_, err = NullDataPayload(&ScriptPubKeyResult{Type: ScriptTypeNullData, Hex: "6a"})
+	require.Error(t, err)
+	_, err = NullDataPayload(&ScriptPubKeyResult{Type: ScriptTypeNullData, Hex: "zz"})
+	require.Error(t, err)
+}
+
+func TestScriptPubKeyIdentifier_AddressVariants(t *testing.T) {
+	// the key of the BIP173 examples, whose Hash160 is 751e76e8...
+	const (
+		pubKey  = "0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798"
+		hash160 = "751e76e8199196d454941c45d1b3a323f1433bd6"
+	)
+	p2pkh := []*ScriptPubKeyResult{
+		{Type: "pubkeyhash", Address: "1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH"},
+		{Type: "pubkeyhash", Hex: "76a914" + hash160 + "88ac"},
+		{Type: "pubkeyhash", Hex: "76a914" + hash160 + "88ac", Address: "1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH"},
+		{Type: "pubkeyhash", Address: " bitcoin:1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH?amount=1 "},
+		// P2PK, reported without an address by current nodes and with the
+		// P2PKH address of the key by older ones
+		{Type: "pubkey", Hex: "21" + pubKey + "ac"},
+		{Type: "pubkey", Hex: "21" + pubKey + "ac", Address: "1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH"},
+	}
+	for _, spk := range p2pkh {
+		addrType, identifier, err := ScriptPubKeyIdentifier(spk)
+		require.NoError(t, err, "%+v", spk)
+		require.Equal(t, zk.AddressTypeP2PKH, addrType, "%+v", spk)
+		require.Equal(t, hash160, hex.EncodeToString(identifier), "%+v", spk)
+	}
+
+	p2wpkh := []*ScriptPubKeyResult{
+		{Type: "witness_v0_keyhash", Address: "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4"},
+		{Type: "witness_v0_keyhash", Address: "BC1QW508D6QEJXTDG4Y5R3ZARVARY0C5XW7KV8F3T4"},
+		{Type: "witness_v0_keyhash", Address: "BITCOIN:BC1QW508D6QEJXTDG4Y5R3ZARVARY0C5XW7KV8F3T4"},
+		{Type: "witness_v0_keyhash", Hex: "0014" + hash160},
+	}
+	for _, spk := range p2wpkh {
+		addrType, identifier, err := ScriptPubKeyIdentifier(spk)
+		require.NoError(t, err, "%+v", spk)
+		require.Equal(t, zk.AddressTypeP2WPKH, addrType, "%+v", spk)
+		require.Equal(t, hash160, hex.EncodeToString(identifier), "%+v", spk)
+	}
+
+	const program = "1863143c14c5166804bd19203356da136c985678cd4d27a1b8c6329604903262"
+	addrType, identifier, err := ScriptPubKeyIdentifier(&ScriptPubKeyResult{Type: "witness_v0_scripthash", Hex: "0020" + program})
+	require.NoError(t, err)
+	require.Equal(t, zk.AddressTypeP2WSH, addrType)
+	require.Equal(t, program, hex.EncodeToString(identifier))
+
+	// scripts that are not claimable still take their error from the address
+	_, _, err = ScriptPubKeyIdentifier(&ScriptPubKeyResult{Type: "scripthash", Hex: "a914" + hash160 + "87", Address: "3J98t1WpEZ73CNmQviecrnyiWrnqRhWNLy"})
+	require.ErrorContains(t, err, "P2SH")
+	_, _, err = ScriptPubKeyIdentifier(&ScriptPubKeyResult{Type: "scripthash", Hex: "a914" + hash160 + "87"})
+	require.ErrorContains(t, err, "no address")
+}
+
+func TestNormalizeBitcoinAddress(t *testing.T) {
+	tests := map[string]string{
+		"1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH":                 "1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH",
+		"\t1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH\n":             "1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH",
+		"bitcoin:1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH?label=x": "1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH",
+		"BC1QW508D6QEJXTDG4Y5R3ZARVARY0C5XW7KV8F3T4":         "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4",
+		// mixed case bech32 is invalid and left for decoding to reject
+		"bc1QW508D6QEJXTDG4Y5R3ZARVARY0C5XW7KV8F3T4": "bc1QW508D6QEJXTDG4Y5R3ZARVARY0C5XW7KV8F3T4",
+		"bitcoin:": "bitcoin:",
+	}
+	for in, want := range tests {
+		require.Equal(t, want, NormalizeBitcoinAddress(in), "%q", in)
+	}
+}
+
+func TestCheckAddressNetwork(t *testing.T) {
+	mainnet := []string{
+		"1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH",
+		"31nJbjcky5Z5ciHgBxNuPvcGapAkeEh2ha",
+		"BC1QW508D6QEJXTDG4Y5R3ZARVARY0C5XW7KV8F3T4",
+		"",
+		// decodes on no network, left to the claim handler to reject
+		"not-an-address",
+	}
+	for _, address := range mainnet {
+		require.NoError(t, CheckAddressNetwork(address, &chaincfg.MainNetParams), "%q", address)
+	}
+
+	wrongNetwork := map[string]string{
+		"mfcEyFDJECfxJf4rnRggoDTfJHUk1e256R":           "testnet3",
+		"2MsLWfUYnaY4RpVvDs5zn1sbXoANvQVzZMs":          "testnet3",
+		"tb1qqyqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqkwz9qq":   "testnet3",
+		"bcrt1qqyqqqqqqqqqqqqqqqqqqqqqqqqqqqqqq58mghf": "regtest",
+	}
+	for address, network := range wrongNetwork {
+		err := CheckAddressNetwork(address, &chaincfg.MainNetParams)
+		require.ErrorContains(t, err, "is a "+network+" address, expected mainnet", "%q", address)
+	}
+
+	// the check follows the network it is given
+	require.NoError(t, CheckAddressNetwork("bcrt1qqyqqqqqqqqqqqqqqqqqqqqqqqqqqqqqq58mghf", &chaincfg.RegressionNetParams))
+	require.NoError(t, CheckAddressNetwork("mfcEyFDJECfxJf4rnRggoDTfJHUk1e256R", &chaincfg.RegressionNetParams))
+	require.ErrorContains(t, CheckAddressNetwork("1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH", &chaincfg.RegressionNetParams), "is a mainnet address")
+}
+
+func TestCheckScriptAddress(t *testing.T) {
+	const p2wpkh = "00140100000000000000000000000000000000000000"
+	pass := []*ScriptPubKeyResult{
+		{Hex: p2wpkh, Address: "bc1qqyqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqugekmn"},
+		{Hex: p2wpkh, Address: "BC1QQYQQQQQQQQQQQQQQQQQQQQQQQQQQQQQQUGEKMN"},
+		{Hex: "76a9141f0dd0b30ae8360683ae0d8f5f9666b56593662488ac", Address: "13qCVr4a2ryEkM8fA3r85QzWFqMNV7p3nB"},
+		// bitcoind reports no address for OP_RETURN outputs
+		{Hex: "6a0474657374"},
+	}
+	for _, spk := range pass {
+		require.NoError(t, CheckScriptAddress(spk, &chaincfg.MainNetParams), "%+v", spk)
+	}
+
+	err := CheckScriptAddress(&ScriptPubKeyResult{Hex: p2wpkh, Address: "tb1qqyqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqkwz9qq"}, &chaincfg.MainNetParams)
+	require.ErrorContains(t, err, "is a testnet3 address, expected mainnet")
+	err = CheckScriptAddress(&ScriptPubKeyResult{Hex: p2wpkh, Address: "13qCVr4a2ryEkM8fA3r85QzWFqMNV7p3nB"}, &chaincfg.MainNetParams)
+	require.ErrorContains(t, err, "does not match the address bc1qqyqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqugekmn of its script")
+
+	// the script renders on the network it is checked against
+	require.NoError(t, CheckScriptAddress(&ScriptPubKeyResult{Hex: p2wpkh, Address: "bcrt1qqyqqqqqqqqqqqqqqqqqqqqqqqqqqqqqq58mghf"}, &chaincfg.RegressionNetParams))
+}
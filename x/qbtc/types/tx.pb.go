@@ -75,33 +75,40 @@ func init() {
 func init() { proto.RegisterFile("qbtc/qbtc/v1/tx.proto", fileDescriptor_7837ce10d5cd1722) }
 
 var fileDescriptor_7837ce10d5cd1722 = []byte{
-	// 412 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0xdf, 0x6a, 0xd4, 0x40,
-	0x14, 0xc6, 0x37, 0x14, 0x45, 0xc6, 0x22, 0x74, 0xf0, 0x0f, 0x0d, 0x1a, 0xc1, 0x52, 0x0a, 0xa2,
-	0x09, 0xd5, 0x07, 0x10, 0xb7, 0x54, 0xaf, 0xa2, 0x6b, 0x6b, 0x51, 0x7a, 0x33, 0xe4, 0xcf, 0x38,
-	0x1b, 0xec, 0xec, 0x99, 0x9d, 0x39, 0x1b, 0x77, 0xef, 0x7c, 0x03, 0x7d, 0x14, 0x1f, 0xc3, 0xcb,
-	0xbd, 0xf4, 0x52, 0x76, 0x2f, 0x7c, 0x0d, 0x99, 0x99, 0x08, 0xbb, 0x0c, 0xc1, 0x9b, 0x93, 0xcc,
-	0xf9, 0x7e, 0xf9, 0x32, 0xdf, 0x39, 0xe4, 0xce, 0xb4, 0xc4, 0x2a, 0x73, 0xa5, 0x3d, 0xce, 0x70,
-	0x9e, 0x2a, 0x0d, 0x08, 0x74, 0xd7, 0x76, 0x52, 0x57, 0xda, 0xe3, 0x78, 0xaf, 0x90, 0xcd, 0x04,
-	0x32, 0x57, 0x3d, 0x10, 0xdf, 0xab, 0xc0, 0x48, 0x30, 0x99, 0x34, 0xc2, 0x7e, 0x28, 0x8d, 0xe8,
-	0x84, 0x7d, 0x2f, 0x30, 0x77, 0xca, 0xfc, 0xa1, 0x93, 0x6e, 0x0b, 0x10, 0xe0, 0xfb, 0xf6, 0xad,
-	0xeb, 0x3e, 0xd9, 0xba, 0x81, 0x34, 0x82, 0x19, 0x8e, 0x6c, 0x02, 0x35, 0x67, 0x8a, 0x73, 0xcd,
-	0x8a, 0xba, 0xd6, 0xdc, 0xfc, 0xf3, 0x38, 0x08, 0x68, 0xcd, 0x15, 0x68, 0x64, 0xe5, 0x15, 0x54,
-	0x9f, 0x3b, 0xe8, 0x30, 0x80, 0x04, 0xb4, 0xac, 0xba, 0x2a, 0x1a, 0xc9, 0x66, 0x38, 0x87, 0x5e,
-	0xaf, 0x99, 0xaa, 0x0b, 0xe4, 0x4c, 0x15, 0xba, 0x90, 0x1d, 0x74, 0x14, 0x40, 0xde, 0xe7, 0x4b,
-	0x83, 0x63, 0x1b, 0x12, 0x3e, 0x79, 0xf0, 0x11, 0x21, 0x37, 0x72, 0x23, 0x4e, 0xa5, 0xc2, 0xc5,
-	0xb3, 0x6f, 0x3b, 0x64, 0x27, 0x37, 0x82, 0xbe, 0x23, 0xf4, 0x9c, 0xe3, 0x1b, 0xa8, 0xf9, 0x88,
-	0x73, 0xfd, 0xd2, 0x27, 0xa1, 0x07, 0xe9, 0xe6, 0x74, 0xd3, 0xdc, 0x88, 0x10, 0x8a, 0xef, 0x06,
-	0x90, 0xb3, 0xa6, 0xaf, 0xc8, 0xde, 0x39, 0xc7, 0xdc, 0x88, 0x33, 0x97, 0x7b, 0x68, 0x63, 0xd3,
-	0xfd, 0x00, 0x1e, 0x62, 0xe5, 0xa4, 0x5e, 0x9f, 0x53, 0xb2, 0xfb, 0x1a, 0xda, 0x13, 0x9b, 0xe5,
-	0xe2, 0xfd, 0xc7, 0xb7, 0xf4, 0x41, 0xc0, 0x6d, 0xca, 0xbd, 0x36, 0x27, 0xe4, 0xe6, 0x85, 0x1b,
-	0xda, 0xc8, 0xce, 0x8c, 0xde, 0x0f, 0xb0, 0x0d, 0xb5, 0xd7, 0xe4, 0x92, 0xdc, 0x72, 0x7f, 0xfa,
-	0xd0, 0xe0, 0x78, 0x64, 0x47, 0x4a, 0x1f, 0x06, 0xe4, 0x36, 0x10, 0x1f, 0xfd, 0x07, 0x38, 0xe3,
-	0x46, 0xc1, 0xc4, 0xf0, 0xf8, 0xda, 0xd7, 0x3f, 0x3f, 0x1e, 0x47, 0xc3, 0x17, 0x3f, 0x57, 0x49,
-	0xb4, 0x5c, 0x25, 0xd1, 0xef, 0x55, 0x12, 0x7d, 0x5f, 0x27, 0x83, 0xe5, 0x3a, 0x19, 0xfc, 0x5a,
-	0x27, 0x83, 0xcb, 0x43, 0xd1, 0xe0, 0x78, 0x56, 0xa6, 0x15, 0xc8, 0xac, 0xc4, 0x6a, 0xfa, 0x14,
-	0xb4, 0xf0, 0xfb, 0x9e, 0xfb, 0x07, 0x2e, 0x14, 0x37, 0xe5, 0x75, 0xb7, 0xe5, 0xe7, 0x7f, 0x03,
-	0x00, 0x00, 0xff, 0xff, 0x05, 0x93, 0xb9, 0xf7, 0x31, 0x03, 0x00, 0x00,
+	// 518 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0xc7, 0x5b, 0xa1, 0x21, 0x64, 0x26, 0xa4, 0x79, 0x03, 0xb4, 0x0a, 0x82, 0xc4, 0xa8, 0x26,
+	0x21, 0x96, 0x68, 0xf0, 0x01, 0x10, 0x1d, 0x83, 0x03, 0x14, 0x4a, 0x4b, 0x01, 0xed, 0x62, 0xa5,
+	0xc9, 0xab, 0x1b, 0xad, 0xce, 0xcb, 0x6c, 0xb7, 0x34, 0x37, 0x3e, 0xc2, 0x3e, 0x0a, 0x1f, 0x83,
+	0xe3, 0x8e, 0x1c, 0x51, 0x7b, 0xe0, 0x6b, 0xa0, 0xd8, 0xa9, 0xd4, 0xc9, 0x89, 0xb8, 0x38, 0xb1,
+	0xdf, 0xef, 0xfd, 0xfd, 0x9e, 0xff, 0x8f, 0xdc, 0xbd, 0x18, 0xe9, 0x28, 0x30, 0xcb, 0xfc, 0x38,
+	0xd0, 0x0b, 0x3f, 0x93, 0xa8, 0x91, 0x6e, 0x17, 0x27, 0xbe, 0x59, 0xe6, 0xc7, 0xad, 0x9d, 0x50,
+	0x24, 0x29, 0x06, 0x66, 0xb5, 0x40, 0xeb, 0x7e, 0x84, 0x4a, 0xa0, 0x0a, 0x84, 0xe2, 0x45, 0xa2,
+	0x50, 0xbc, 0x0c, 0xec, 0xdb, 0x00, 0x33, 0xbb, 0xc0, 0x6e, 0xca, 0xd0, 0x1e, 0x47, 0x8e, 0xf6,
+	0xbc, 0xf8, 0x2b, 0x4f, 0x9f, 0x5d, 0xab, 0x40, 0x28, 0xce, 0x14, 0x68, 0x96, 0x62, 0x0c, 0x2c,
+	0x03, 0x90, 0x2c, 0x8c, 0x63, 0x09, 0x6a, 0xad, 0x71, 0xe0, 0xd0, 0x12, 0x32, 0x94, 0x9a, 0x8d,
+	0xa6, 0x18, 0x9d, 0x97, 0x50, 0xdb, 0x81, 0x38, 0xce, 0x59, 0x34, 0x0d, 0x13, 0xc1, 0x66, 0x7a,
+	0x81, 0xb5, 0x5a, 0xb3, 0x2c, 0x0e, 0x35, 0xb0, 0x2c, 0x94, 0xa1, 0x28, 0xa1, 0x43, 0x07, 0xb2,
+	0x3a, 0xdf, 0x13, 0x3d, 0x29, 0x9a, 0xc4, 0x71, 0x6d, 0x1f, 0x12, 0xb2, 0x69, 0x18, 0x01, 0x9b,
+	0x83, 0x4c, 0xc6, 0x79, 0x92, 0x72, 0x76, 0x0e, 0x79, 0x49, 0xfb, 0x95, 0x5d, 0x5b, 0x69, 0x01,
+	0x02, 0x59, 0x26, 0x61, 0x9c, 0x2c, 0x60, 0xdd, 0xf7, 0x51, 0x5d, 0xad, 0x36, 0x25, 0x86, 0x34,
+	0x67, 0xd3, 0x44, 0x69, 0x8b, 0x3f, 0x26, 0xe4, 0x56, 0x57, 0xf1, 0x53, 0x91, 0xe9, 0xfc, 0xf9,
+	0xe5, 0x16, 0xb9, 0xd1, 0x55, 0x9c, 0x7e, 0x22, 0x74, 0x00, 0xfa, 0x03, 0xc6, 0xd0, 0x03, 0x90,
+	0xaf, 0xec, 0xb3, 0xd2, 0x03, 0x7f, 0xd3, 0x6a, 0xbf, 0xab, 0xb8, 0x0b, 0xb5, 0xee, 0x39, 0x90,
+	0x91, 0xa6, 0x6f, 0xc8, 0xce, 0x00, 0x74, 0x57, 0xf1, 0xbe, 0x31, 0xa1, 0x53, 0x78, 0x40, 0xf7,
+	0x1d, 0xb8, 0xa3, 0x23, 0x13, 0xaa, 0xd5, 0x39, 0x25, 0xdb, 0x6f, 0x71, 0x7e, 0x52, 0xb4, 0x32,
+	0xfc, 0xfc, 0xed, 0x23, 0x7d, 0xe8, 0x70, 0x9b, 0xe1, 0x5a, 0x99, 0x13, 0x72, 0x7b, 0x68, 0x5e,
+	0xa5, 0x57, 0x18, 0x48, 0x1f, 0x38, 0xd8, 0x46, 0xb4, 0x56, 0xe4, 0x8c, 0xdc, 0x31, 0x37, 0x7d,
+	0x4d, 0xf4, 0xa4, 0x57, 0xf8, 0x4b, 0x1f, 0x39, 0xe4, 0x75, 0xa0, 0x75, 0xf8, 0x1f, 0xa0, 0x0f,
+	0x2a, 0xc3, 0x54, 0x01, 0x1d, 0x90, 0xdd, 0xbe, 0x1d, 0x8a, 0x2f, 0xeb, 0x99, 0x78, 0x07, 0x39,
+	0x7d, 0xe2, 0xe4, 0x57, 0x50, 0xb5, 0x05, 0x0f, 0xc9, 0xde, 0x00, 0xb4, 0xb9, 0xb1, 0x0b, 0x02,
+	0x7b, 0xe5, 0xe0, 0xd0, 0x76, 0x95, 0xb3, 0x0e, 0x56, 0x2b, 0x3b, 0x20, 0xbb, 0xf6, 0xb9, 0x4c,
+	0xca, 0x6b, 0x48, 0xf3, 0xf7, 0x89, 0xd2, 0x15, 0xb5, 0x56, 0x50, 0x75, 0xa2, 0xad, 0xad, 0x1f,
+	0x7f, 0x7f, 0x3e, 0x6d, 0x76, 0x5e, 0xfe, 0x5a, 0x7a, 0xcd, 0xab, 0xa5, 0xd7, 0xfc, 0xb3, 0xf4,
+	0x9a, 0x97, 0x2b, 0xaf, 0x71, 0xb5, 0xf2, 0x1a, 0xbf, 0x57, 0x5e, 0xe3, 0xac, 0xcd, 0x13, 0x3d,
+	0x99, 0x8d, 0xfc, 0x08, 0x45, 0x30, 0xd2, 0xd1, 0xc5, 0x11, 0x4a, 0x6e, 0xc7, 0x7e, 0x61, 0x3f,
+	0x3a, 0xcf, 0x40, 0x8d, 0x6e, 0x9a, 0x31, 0x7f, 0xf1, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x0e, 0x5b,
+	0x71, 0x73, 0xbf, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -128,6 +135,15 @@ type MsgClient interface {
 	// ClaimWithProof allows users to claim their airdrop using a ZK proof of
 	// Bitcoin address ownership.
 	ClaimWithProof(ctx context.Context, in *MsgClaimWithProof, opts ...grpc.CallOption) (*MsgClaimWithProofResponse, error)
+	// ReplaceVerifyingKey replaces the ZK verifying key. Only the governance
+	// authority may execute it.
+	ReplaceVerifyingKey(ctx context.Context, in *MsgReplaceVerifyingKey, opts ...grpc.CallOption) (*MsgEmpty, error)
+	// SetClaimMemoPrefixes replaces the accepted claim memo prefixes. Only the
+	// governance authority may execute it.
+	SetClaimMemoPrefixes(ctx context.Context, in *MsgSetClaimMemoPrefixes, opts ...grpc.CallOption) (*MsgEmpty, error)
+	// UpdateClaimDenyList adds and removes addresses on the claim deny list.
+	// Only the governance authority may execute it.
+	UpdateClaimDenyList(ctx context.Context, in *MsgUpdateClaimDenyList, opts ...grpc.CallOption) (*MsgEmpty, error)
 }
 
 type msgClient struct {
@@ -183,6 +199,33 @@ func (c *msgClient) ClaimWithProof(ctx context.Context, in *MsgClaimWithProof, o
 	return out, nil
 }
 
+func (c *msgClient) ReplaceVerifyingKey(ctx context.Context, in *MsgReplaceVerifyingKey, opts ...grpc.CallOption) (*MsgEmpty, error) {
+	out := new(MsgEmpty)
+	err := c.cc.Invoke(ctx, "/qbtc.qbtc.v1.Msg/ReplaceVerifyingKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetClaimMemoPrefixes(ctx context.Context, in *MsgSetClaimMemoPrefixes, opts ...grpc.CallOption) (*MsgEmpty, error) {
+	out := new(MsgEmpty)
+	err := c.cc.Invoke(ctx, "/qbtc.qbtc.v1.Msg/SetClaimMemoPrefixes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateClaimDenyList(ctx context.Context, in *MsgUpdateClaimDenyList, opts ...grpc.CallOption) (*MsgEmpty, error) {
+	out := new(MsgEmpty)
+	err := c.cc.Invoke(ctx, "/qbtc.qbtc.v1.Msg/UpdateClaimDenyList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// SetNodePeerAddress allows authorized validators to update their node peer
@@ -197,6 +240,15 @@ type MsgServer interface {
 	// ClaimWithProof allows users to claim their airdrop using a ZK proof of
 	// Bitcoin address ownership.
 	ClaimWithProof(context.Context, *MsgClaimWithProof) (*MsgClaimWithProofResponse, error)
+	// ReplaceVerifyingKey replaces the ZK verifying key. Only the governance
+	// authority may execute it.
+	ReplaceVerifyingKey(context.Context, *MsgReplaceVerifyingKey) (*MsgEmpty, error)
+	// SetClaimMemoPrefixes replaces the accepted claim memo prefixes. Only the
+	// governance authority may execute it.
+	SetClaimMemoPrefixes(context.Context, *MsgSetClaimMemoPrefixes) (*MsgEmpty, error)
+	// UpdateClaimDenyList adds and removes addresses on the claim deny list.
+	// Only the governance authority may execute it.
+	UpdateClaimDenyList(context.Context, *MsgUpdateClaimDenyList) (*MsgEmpty, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -218,6 +270,15 @@ func (*UnimplementedMsgServer) UpdateParam(ctx context.Context, req *MsgUpdatePa
 func (*UnimplementedMsgServer) ClaimWithProof(ctx context.Context, req *MsgClaimWithProof) (*MsgClaimWithProofResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ClaimWithProof not implemented")
 }
+func (*UnimplementedMsgServer) ReplaceVerifyingKey(ctx context.Context, req *MsgReplaceVerifyingKey) (*MsgEmpty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplaceVerifyingKey not implemented")
+}
+func (*UnimplementedMsgServer) SetClaimMemoPrefixes(ctx context.Context, req *MsgSetClaimMemoPrefixes) (*MsgEmpty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetClaimMemoPrefixes not implemented")
+}
+func (*UnimplementedMsgServer) UpdateClaimDenyList(ctx context.Context, req *MsgUpdateClaimDenyList) (*MsgEmpty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateClaimDenyList not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -313,6 +374,60 @@ func _Msg_ClaimWithProof_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ReplaceVerifyingKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReplaceVerifyingKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReplaceVerifyingKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/qbtc.qbtc.v1.Msg/ReplaceVerifyingKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReplaceVerifyingKey(ctx, req.(*MsgReplaceVerifyingKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetClaimMemoPrefixes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetClaimMemoPrefixes)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetClaimMemoPrefixes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/qbtc.qbtc.v1.Msg/SetClaimMemoPrefixes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetClaimMemoPrefixes(ctx, req.(*MsgSetClaimMemoPrefixes))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateClaimDenyList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateClaimDenyList)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateClaimDenyList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/qbtc.qbtc.v1.Msg/UpdateClaimDenyList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateClaimDenyList(ctx, req.(*MsgUpdateClaimDenyList))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "qbtc.qbtc.v1.Msg",
@@ -338,6 +453,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ClaimWithProof",
 			Handler:    _Msg_ClaimWithProof_Handler,
 		},
+		{
+			MethodName: "ReplaceVerifyingKey",
+			Handler:    _Msg_ReplaceVerifyingKey_Handler,
+		},
+		{
+			MethodName: "SetClaimMemoPrefixes",
+			Handler:    _Msg_SetClaimMemoPrefixes_Handler,
+		},
+		{
+			MethodName: "UpdateClaimDenyList",
+			Handler:    _Msg_UpdateClaimDenyList_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "qbtc/qbtc/v1/tx.proto",
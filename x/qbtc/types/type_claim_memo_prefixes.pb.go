@@ -0,0 +1,323 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: qbtc/qbtc/v1/type_claim_memo_prefixes.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ClaimMemoPrefixes is the governable set of OP_RETURN memo prefixes that mark
+// a Bitcoin transaction as a claim.
+type ClaimMemoPrefixes struct {
+	Prefixes []string `protobuf:"bytes,1,rep,name=prefixes,proto3" json:"prefixes,omitempty"`
+}
+
+func (m *ClaimMemoPrefixes) Reset()         { *m = ClaimMemoPrefixes{} }
+func (m *ClaimMemoPrefixes) String() string { return proto.CompactTextString(m) }
+func (*ClaimMemoPrefixes) ProtoMessage()    {}
+func (*ClaimMemoPrefixes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0bef40a34f612ac5, []int{0}
+}
+func (m *ClaimMemoPrefixes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClaimMemoPrefixes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClaimMemoPrefixes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClaimMemoPrefixes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClaimMemoPrefixes.Merge(m, src)
+}
+func (m *ClaimMemoPrefixes) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClaimMemoPrefixes) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClaimMemoPrefixes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClaimMemoPrefixes proto.InternalMessageInfo
+
+func (m *ClaimMemoPrefixes) GetPrefixes() []string {
+	if m != nil {
+		return m.Prefixes
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ClaimMemoPrefixes)(nil), "qbtc.qbtc.v1.ClaimMemoPrefixes")
+}
+
+func init() {
+	proto.RegisterFile("qbtc/qbtc/v1/type_claim_memo_prefixes.proto", fileDescriptor_0bef40a34f612ac5)
+}
+
+var fileDescriptor_0bef40a34f612ac5 = []byte{
+	// 165 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x2e, 0x4c, 0x2a, 0x49,
+	0xd6, 0x07, 0x13, 0x65, 0x86, 0xfa, 0x25, 0x95, 0x05, 0xa9, 0xf1, 0xc9, 0x39, 0x89, 0x99, 0xb9,
+	0xf1, 0xb9, 0xa9, 0xb9, 0xf9, 0xf1, 0x05, 0x45, 0xa9, 0x69, 0x99, 0x15, 0xa9, 0xc5, 0x7a, 0x05,
+	0x45, 0xf9, 0x25, 0xf9, 0x42, 0x3c, 0x20, 0x75, 0x7a, 0x60, 0xa2, 0xcc, 0x50, 0x49, 0x9f, 0x4b,
+	0xd0, 0x19, 0xa4, 0xd4, 0x37, 0x35, 0x37, 0x3f, 0x00, 0xaa, 0x50, 0x48, 0x8a, 0x8b, 0x03, 0xa6,
+	0x49, 0x82, 0x51, 0x81, 0x59, 0x83, 0x33, 0x08, 0xce, 0x77, 0xb2, 0x3f, 0xf1, 0x48, 0x8e, 0xf1,
+	0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e,
+	0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xd5, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc,
+	0x5c, 0xfd, 0xa4, 0x92, 0xe4, 0x42, 0xdd, 0xfc, 0xa2, 0x74, 0x88, 0xa3, 0x2a, 0x20, 0x14, 0xc8,
+	0x61, 0xc5, 0x49, 0x6c, 0x60, 0x67, 0x18, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0x71, 0xa8, 0x38,
+	0xb0, 0xb5, 0x00, 0x00, 0x00,
+}
+
+func (m *ClaimMemoPrefixes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClaimMemoPrefixes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClaimMemoPrefixes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Prefixes) > 0 {
+		for iNdEx := len(m.Prefixes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Prefixes[iNdEx])
+			copy(dAtA[i:], m.Prefixes[iNdEx])
+			i = encodeVarintTypeClaimMemoPrefixes(dAtA, i, uint64(len(m.Prefixes[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypeClaimMemoPrefixes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypeClaimMemoPrefixes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ClaimMemoPrefixes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Prefixes) > 0 {
+		for _, s := range m.Prefixes {
+			l = len(s)
+			n += 1 + l + sovTypeClaimMemoPrefixes(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovTypeClaimMemoPrefixes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypeClaimMemoPrefixes(x uint64) (n int) {
+	return sovTypeClaimMemoPrefixes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ClaimMemoPrefixes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypeClaimMemoPrefixes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClaimMemoPrefixes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClaimMemoPrefixes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefixes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeClaimMemoPrefixes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeClaimMemoPrefixes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeClaimMemoPrefixes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prefixes = append(m.Prefixes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypeClaimMemoPrefixes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypeClaimMemoPrefixes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTypeClaimMemoPrefixes(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTypeClaimMemoPrefixes
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTypeClaimMemoPrefixes
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTypeClaimMemoPrefixes
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTypeClaimMemoPrefixes
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTypeClaimMemoPrefixes
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTypeClaimMemoPrefixes
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTypeClaimMemoPrefixes        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTypeClaimMemoPrefixes          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTypeClaimMemoPrefixes = fmt.Errorf("proto: unexpected end of group")
+)
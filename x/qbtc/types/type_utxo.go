@@ -5,3 +5,13 @@ import "fmt"
 func (m *UTXO) GetKey() string {
 	return fmt.Sprintf("%s-%d", m.Txid, m.Vout)
 }
+
+// Address returns the Bitcoin address the UTXO pays to, or "" when the UTXO
+// carries no script data. Use it instead of dereferencing ScriptPubKey, which
+// may be nil for UTXOs stored by a malformed block or imported state.
+func (m *UTXO) Address() string {
+	if m == nil || m.ScriptPubKey == nil {
+		return ""
+	}
+	return m.ScriptPubKey.Address
+}
@@ -96,6 +96,13 @@ type UTXO struct {
 	EntitledAmount uint64 `protobuf:"varint,4,opt,name=entitled_amount,json=entitledAmount,proto3" json:"entitled_amount,omitempty"`
 	// The script public key associated with this UTXO
 	ScriptPubKey *ScriptPubKeyResult `protobuf:"bytes,5,opt,name=script_pub_key,json=scriptPubKey,proto3" json:"script_pub_key,omitempty"`
+	// The Bitcoin block height that created this UTXO. Zero for UTXOs loaded
+	// from the genesis snapshot or indexed before the height was recorded.
+	CreatedAtHeight uint64 `protobuf:"varint,6,opt,name=created_at_height,json=createdAtHeight,proto3" json:"created_at_height,omitempty"`
+	// coinbase marks an output of a coinbase transaction. Bitcoin only lets it
+	// be spent after CoinbaseMaturity confirmations, and it is not claimable
+	// before either.
+	Coinbase bool `protobuf:"varint,7,opt,name=coinbase,proto3" json:"coinbase,omitempty"`
 }
 
 func (m *UTXO) Reset()         { *m = UTXO{} }
@@ -166,6 +173,20 @@ func (m *UTXO) GetScriptPubKey() *ScriptPubKeyResult {
 	return nil
 }
 
+func (m *UTXO) GetCreatedAtHeight() uint64 {
+	if m != nil {
+		return m.CreatedAtHeight
+	}
+	return 0
+}
+
+func (m *UTXO) GetCoinbase() bool {
+	if m != nil {
+		return m.Coinbase
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*ScriptPubKeyResult)(nil), "qbtc.qbtc.v1.ScriptPubKeyResult")
 	proto.RegisterType((*UTXO)(nil), "qbtc.qbtc.v1.UTXO")
@@ -174,26 +195,29 @@ func init() {
 func init() { proto.RegisterFile("qbtc/qbtc/v1/type_utxo.proto", fileDescriptor_6f20580ae8da58f8) }
 
 var fileDescriptor_6f20580ae8da58f8 = []byte{
-	// 291 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x29, 0x4c, 0x2a, 0x49,
-	0xd6, 0x07, 0x13, 0x65, 0x86, 0xfa, 0x25, 0x95, 0x05, 0xa9, 0xf1, 0xa5, 0x25, 0x15, 0xf9, 0x7a,
-	0x05, 0x45, 0xf9, 0x25, 0xf9, 0x42, 0x3c, 0x20, 0x09, 0x3d, 0x30, 0x51, 0x66, 0xa8, 0x14, 0xc2,
-	0x25, 0x14, 0x9c, 0x5c, 0x94, 0x59, 0x50, 0x12, 0x50, 0x9a, 0xe4, 0x9d, 0x5a, 0x19, 0x94, 0x5a,
-	0x5c, 0x9a, 0x53, 0x22, 0x24, 0xc0, 0xc5, 0x9c, 0x91, 0x5a, 0x21, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1,
-	0x19, 0x04, 0x62, 0x0a, 0x09, 0x71, 0xb1, 0x80, 0x0c, 0x92, 0x60, 0x02, 0x0b, 0x81, 0xd9, 0x42,
-	0x12, 0x5c, 0xec, 0x89, 0x29, 0x29, 0x45, 0xa9, 0xc5, 0xc5, 0x12, 0xcc, 0x60, 0x61, 0x18, 0x57,
-	0x69, 0x3b, 0x23, 0x17, 0x4b, 0x68, 0x48, 0x84, 0x3f, 0x58, 0x5b, 0x45, 0x66, 0x0a, 0xd4, 0x24,
-	0x30, 0x1b, 0x24, 0x56, 0x96, 0x5f, 0x5a, 0x02, 0x36, 0x8a, 0x37, 0x08, 0xcc, 0x16, 0x12, 0xe3,
-	0x62, 0x4b, 0xcc, 0xcd, 0x2f, 0xcd, 0x2b, 0x01, 0x9b, 0xc4, 0x12, 0x04, 0xe5, 0x09, 0xa9, 0x73,
-	0xf1, 0xa7, 0xe6, 0x95, 0x64, 0x96, 0xe4, 0xa4, 0xa6, 0xc4, 0x43, 0x15, 0xb0, 0x80, 0x15, 0xf0,
-	0xc1, 0x84, 0x1d, 0x21, 0x0a, 0xdd, 0xb8, 0xf8, 0x8a, 0xc1, 0xfe, 0x88, 0x2f, 0x28, 0x4d, 0x8a,
-	0xcf, 0x4e, 0xad, 0x94, 0x60, 0x55, 0x60, 0xd4, 0xe0, 0x36, 0x52, 0xd0, 0x43, 0xf6, 0xae, 0x1e,
-	0xa6, 0x5f, 0x83, 0x78, 0x8a, 0x91, 0xc4, 0x9c, 0xec, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48,
-	0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1,
-	0x58, 0x8e, 0x21, 0x4a, 0x35, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x3f,
-	0xa9, 0x24, 0xb9, 0x50, 0x37, 0xbf, 0x28, 0x1d, 0x12, 0xc8, 0x15, 0x10, 0x0a, 0x14, 0x26, 0xc5,
-	0x49, 0x6c, 0xe0, 0x50, 0x36, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0xba, 0x52, 0x19, 0x57, 0x85,
-	0x01, 0x00, 0x00,
+	// 337 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x91, 0xc1, 0x4a, 0xf3, 0x40,
+	0x10, 0xc7, 0xbb, 0x5f, 0xf3, 0xb5, 0xfd, 0xf6, 0xab, 0xad, 0xee, 0x41, 0x16, 0x91, 0x10, 0x0a,
+	0x62, 0x11, 0x4c, 0xa9, 0x3e, 0x80, 0xd4, 0x83, 0x08, 0x1e, 0x94, 0x58, 0x41, 0xbc, 0x84, 0x6c,
+	0xb2, 0x34, 0xc1, 0xb6, 0x9b, 0x66, 0x67, 0x4b, 0xfa, 0x16, 0x3e, 0x96, 0xc7, 0x1e, 0x3d, 0x4a,
+	0xfb, 0x20, 0x4a, 0xa6, 0xa9, 0x14, 0xbc, 0x4c, 0xfe, 0xf3, 0x9b, 0xc9, 0x7f, 0x98, 0x1d, 0x7a,
+	0x3c, 0x13, 0x10, 0xf6, 0x30, 0xcc, 0xfb, 0x3d, 0x58, 0xa4, 0xd2, 0x37, 0x90, 0x2b, 0x37, 0xcd,
+	0x14, 0x28, 0xd6, 0x2c, 0x0a, 0x2e, 0x86, 0x79, 0xbf, 0x33, 0xa4, 0xec, 0x31, 0xcc, 0x92, 0x14,
+	0x1e, 0x8c, 0xb8, 0x93, 0x0b, 0x4f, 0x6a, 0x33, 0x06, 0xb6, 0x4f, 0xab, 0xb1, 0xcc, 0x39, 0x71,
+	0x48, 0xf7, 0x9f, 0x57, 0x48, 0xc6, 0xa8, 0x55, 0x18, 0xf1, 0x3f, 0x88, 0x50, 0x33, 0x4e, 0xeb,
+	0x41, 0x14, 0x65, 0x52, 0x6b, 0x5e, 0x45, 0xbc, 0x4d, 0x3b, 0x5f, 0x84, 0x5a, 0x4f, 0xc3, 0xe7,
+	0x7b, 0xfc, 0x2d, 0x4f, 0xa2, 0xd2, 0x09, 0x75, 0xc1, 0xe6, 0xca, 0x00, 0x5a, 0xed, 0x79, 0xa8,
+	0xd9, 0x21, 0xad, 0x05, 0x13, 0x65, 0xa6, 0x80, 0x4e, 0x96, 0x57, 0x66, 0xec, 0x94, 0xb6, 0xe5,
+	0x14, 0x12, 0x18, 0xcb, 0xc8, 0x2f, 0x1b, 0x2c, 0x6c, 0x68, 0x6d, 0xf1, 0x60, 0xd3, 0x78, 0x43,
+	0x5b, 0x1a, 0xf7, 0xf0, 0x53, 0x23, 0xfc, 0x57, 0xb9, 0xe0, 0x7f, 0x1d, 0xd2, 0xfd, 0x7f, 0xe1,
+	0xb8, 0xbb, 0xeb, 0xba, 0xbf, 0x77, 0xf5, 0x9a, 0x7a, 0x87, 0xb1, 0x33, 0x7a, 0x10, 0x66, 0x32,
+	0x80, 0x62, 0x1e, 0xf8, 0xb1, 0x4c, 0x46, 0x31, 0xf0, 0x1a, 0x8e, 0x6c, 0x97, 0x85, 0x01, 0xdc,
+	0x22, 0x66, 0x47, 0xb4, 0x11, 0xaa, 0x64, 0x2a, 0x02, 0x2d, 0x79, 0xdd, 0x21, 0xdd, 0x86, 0xf7,
+	0x93, 0x5f, 0x5f, 0xbd, 0xaf, 0x6c, 0xb2, 0x5c, 0xd9, 0xe4, 0x73, 0x65, 0x93, 0xb7, 0xb5, 0x5d,
+	0x59, 0xae, 0xed, 0xca, 0xc7, 0xda, 0xae, 0xbc, 0x9c, 0x8c, 0x12, 0x88, 0x8d, 0x70, 0x43, 0x35,
+	0xe9, 0x09, 0x08, 0x67, 0xe7, 0x2a, 0x1b, 0x6d, 0x8e, 0x95, 0x6f, 0x3e, 0xc5, 0xdb, 0x6a, 0x51,
+	0xc3, 0x6b, 0x5d, 0x7e, 0x07, 0x00, 0x00, 0xff, 0xff, 0x4d, 0x69, 0xe9, 0xeb, 0xcd, 0x01, 0x00,
+	0x00,
 }
 
 func (m *ScriptPubKeyResult) Marshal() (dAtA []byte, err error) {
@@ -260,6 +284,21 @@ func (m *UTXO) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Coinbase {
+		i--
+		if m.Coinbase {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.CreatedAtHeight != 0 {
+		i = encodeVarintTypeUtxo(dAtA, i, uint64(m.CreatedAtHeight))
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.ScriptPubKey != nil {
 		{
 			size, err := m.ScriptPubKey.MarshalToSizedBuffer(dAtA[:i])
@@ -352,6 +391,12 @@ func (m *UTXO) Size() (n int) {
 		l = m.ScriptPubKey.Size()
 		n += 1 + l + sovTypeUtxo(uint64(l))
 	}
+	if m.CreatedAtHeight != 0 {
+		n += 1 + sovTypeUtxo(uint64(m.CreatedAtHeight))
+	}
+	if m.Coinbase {
+		n += 2
+	}
 	return n
 }
 
@@ -661,6 +706,45 @@ func (m *UTXO) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAtHeight", wireType)
+			}
+			m.CreatedAtHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeUtxo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAtHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coinbase", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeUtxo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Coinbase = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypeUtxo(dAtA[iNdEx:])
@@ -0,0 +1,341 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: qbtc/qbtc/v1/type_utxo_stats.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// UTXOStats summarizes the stored UTXO set. It is maintained with every UTXO
+// write, so reading it does not need to walk the set.
+type UTXOStats struct {
+	// The number of stored UTXOs, claimed ones included until they are pruned
+	Count uint64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	// The sum of the entitled amounts of the stored UTXOs, in satoshis
+	UnclaimedAmount uint64 `protobuf:"varint,2,opt,name=unclaimed_amount,json=unclaimedAmount,proto3" json:"unclaimed_amount,omitempty"`
+}
+
+func (m *UTXOStats) Reset()         { *m = UTXOStats{} }
+func (m *UTXOStats) String() string { return proto.CompactTextString(m) }
+func (*UTXOStats) ProtoMessage()    {}
+func (*UTXOStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ecae3fa2e1c22267, []int{0}
+}
+func (m *UTXOStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UTXOStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UTXOStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UTXOStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UTXOStats.Merge(m, src)
+}
+func (m *UTXOStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *UTXOStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_UTXOStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UTXOStats proto.InternalMessageInfo
+
+func (m *UTXOStats) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *UTXOStats) GetUnclaimedAmount() uint64 {
+	if m != nil {
+		return m.UnclaimedAmount
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*UTXOStats)(nil), "qbtc.qbtc.v1.UTXOStats")
+}
+
+func init() {
+	proto.RegisterFile("qbtc/qbtc/v1/type_utxo_stats.proto", fileDescriptor_ecae3fa2e1c22267)
+}
+
+var fileDescriptor_ecae3fa2e1c22267 = []byte{
+	// 179 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2a, 0x4c, 0x2a, 0x49,
+	0xd6, 0x07, 0x13, 0x65, 0x86, 0xfa, 0x25, 0x95, 0x05, 0xa9, 0xf1, 0xa5, 0x25, 0x15, 0xf9, 0xf1,
+	0xc5, 0x25, 0x89, 0x25, 0xc5, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9, 0x42, 0x3c, 0x20, 0x69, 0x3d,
+	0x30, 0x51, 0x66, 0xa8, 0xe4, 0xc3, 0xc5, 0x19, 0x1a, 0x12, 0xe1, 0x1f, 0x0c, 0x52, 0x20, 0x24,
+	0xc2, 0xc5, 0x9a, 0x9c, 0x5f, 0x9a, 0x57, 0x22, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x12, 0x04, 0xe1,
+	0x08, 0x69, 0x72, 0x09, 0x94, 0xe6, 0x25, 0xe7, 0x24, 0x66, 0xe6, 0xa6, 0xa6, 0xc4, 0x27, 0xe6,
+	0x82, 0x15, 0x30, 0x81, 0x15, 0xf0, 0xc3, 0xc5, 0x1d, 0xc1, 0xc2, 0x4e, 0xf6, 0x27, 0x1e, 0xc9,
+	0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e,
+	0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0xa5, 0x9a, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97,
+	0x9c, 0x9f, 0xab, 0x9f, 0x54, 0x92, 0x5c, 0xa8, 0x9b, 0x5f, 0x94, 0x0e, 0x71, 0x68, 0x05, 0x84,
+	0x02, 0x39, 0xb6, 0x38, 0x89, 0x0d, 0xec, 0x46, 0x63, 0xc0, 0x00, 0xf2, 0x32, 0xe6, 0xc6, 0xc9,
+	0x00, 0x00, 0x00,
+}
+
+func (m *UTXOStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UTXOStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UTXOStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UnclaimedAmount != 0 {
+		i = encodeVarintTypeUtxoStats(dAtA, i, uint64(m.UnclaimedAmount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Count != 0 {
+		i = encodeVarintTypeUtxoStats(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypeUtxoStats(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypeUtxoStats(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *UTXOStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Count != 0 {
+		n += 1 + sovTypeUtxoStats(uint64(m.Count))
+	}
+	if m.UnclaimedAmount != 0 {
+		n += 1 + sovTypeUtxoStats(uint64(m.UnclaimedAmount))
+	}
+	return n
+}
+
+func sovTypeUtxoStats(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypeUtxoStats(x uint64) (n int) {
+	return sovTypeUtxoStats(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *UTXOStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypeUtxoStats
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UTXOStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UTXOStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeUtxoStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnclaimedAmount", wireType)
+			}
+			m.UnclaimedAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeUtxoStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnclaimedAmount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypeUtxoStats(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypeUtxoStats
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTypeUtxoStats(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTypeUtxoStats
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTypeUtxoStats
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTypeUtxoStats
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTypeUtxoStats
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTypeUtxoStats
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTypeUtxoStats
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTypeUtxoStats        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTypeUtxoStats          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTypeUtxoStats = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,17 @@
+package types
+
+import "testing"
+
+func TestUTXOAddress(t *testing.T) {
+	var nilUTXO *UTXO
+	if got := nilUTXO.Address(); got != "" {
+		t.Fatalf("nil UTXO: got %q, want empty", got)
+	}
+	if got := (&UTXO{}).Address(); got != "" {
+		t.Fatalf("UTXO without script: got %q, want empty", got)
+	}
+	utxo := UTXO{ScriptPubKey: &ScriptPubKeyResult{Address: "13qCVr4a2ryEkM8fA3r85QzWFqMNV7p3nB"}}
+	if got := utxo.Address(); got != "13qCVr4a2ryEkM8fA3r85QzWFqMNV7p3nB" {
+		t.Fatalf("got %q, want the script address", got)
+	}
+}
@@ -8,8 +8,18 @@ import (
 	"time"
 )
 
+// gzipOSUnknown is the "unknown" operating system value of the gzip header
+// (RFC 1952). compress/gzip already writes it on every platform; it is set
+// explicitly so the header never depends on the defaults of the Go version.
+const gzipOSUnknown = 255
+
 // GzipDeterministic sets a fixed header (zero ModTime, empty Name/Comment)
 // and uses the specified compression level.
+//
+// Validators sign the compressed bytes, so every host must produce the same
+// output for the same input: the header carries no mtime, file name, comment
+// or extra field, and the OS byte is pinned to gzipOSUnknown rather than
+// describing the host.
 func GzipDeterministic(data []byte, level int) ([]byte, error) {
 	var buf bytes.Buffer
 	gw, err := gzip.NewWriterLevel(&buf, level)
@@ -20,6 +30,8 @@ func GzipDeterministic(data []byte, level int) ([]byte, error) {
 	gw.ModTime = time.Time{} // zero value -> fixed mtime
 	gw.Name = ""
 	gw.Comment = ""
+	gw.Extra = nil
+	gw.OS = gzipOSUnknown
 	defer func() {
 		_ = gw.Close()
 	}()
@@ -36,7 +48,8 @@ func GzipDeterministic(data []byte, level int) ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
-// GzipUnzip decompresses gzip-compressed bytes and returns raw bytes.
+// GzipUnzip decompresses gzip-compressed bytes and returns raw bytes. Content
+// that expands past maxDecodedBlockContentSize is rejected.
 func GzipUnzip(data []byte) ([]byte, error) {
 	if len(data) == 0 {
 		return data, nil
@@ -48,9 +61,14 @@ func GzipUnzip(data []byte) ([]byte, error) {
 	defer func() {
 		_ = r.Close()
 	}()
-	out, err := io.ReadAll(r)
+	// read one byte past the limit to tell a stream that ends exactly at it
+	// from one that goes on
+	out, err := io.ReadAll(io.LimitReader(r, maxDecodedBlockContentSize+1))
 	if err != nil {
 		return nil, fmt.Errorf("gzip read: %w", err)
 	}
+	if len(out) > maxDecodedBlockContentSize {
+		return nil, fmt.Errorf("gzip read: decoded content exceeds %d bytes", maxDecodedBlockContentSize)
+	}
 	return out, nil
 }
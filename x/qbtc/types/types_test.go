@@ -3,6 +3,7 @@ package types
 import (
 	"bytes"
 	"compress/gzip"
+	"encoding/hex"
 	"fmt"
 	"testing"
 )
@@ -43,6 +44,43 @@ func TestGzipDeterministic_SameOutputAndRoundTrip(t *testing.T) {
 	}
 }
 
+func TestGzipDeterministic_FixedHeader(t *testing.T) {
+	data := []byte("example data for deterministic gzip")
+	// XFL only reflects the compression level (RFC 1952)
+	levels := map[int]byte{gzip.BestSpeed: 4, gzip.DefaultCompression: 0, gzip.BestCompression: 2}
+
+	for lvl, xfl := range levels {
+		t.Run(fmt.Sprintf("level_%d", lvl), func(t *testing.T) {
+			out, err := GzipDeterministic(data, lvl)
+			if err != nil {
+				t.Fatalf("compress returned error: %v", err)
+			}
+			// ID1 ID2 CM FLG MTIME(4) XFL OS: no optional fields, zero mtime, unknown OS
+			want := []byte{0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, xfl, gzipOSUnknown}
+			if !bytes.Equal(out[:len(want)], want) {
+				t.Fatalf("unexpected gzip header: got %x want %x", out[:len(want)], want)
+			}
+		})
+	}
+}
+
+// TestGzipDeterministic_Golden pins the exact bytes validators sign for a
+// block at the level bifrost uses. A change in the output, e.g. from a new
+// compress/flate implementation, would split attestations between validators
+// running different builds and must be caught before release.
+func TestGzipDeterministic_Golden(t *testing.T) {
+	data := []byte(`{"hash":"00000000000000000001","height":900000,"tx":[]}`)
+	const want = "1f8b08000000000002ffaa56ca482cce50b25232c004864a3a4a19a999e919254a569606060606063a4a25154a56d1b1b500000000ffff0300db2e43c137000000"
+
+	out, err := GzipDeterministic(data, gzip.BestCompression)
+	if err != nil {
+		t.Fatalf("compress returned error: %v", err)
+	}
+	if got := hex.EncodeToString(out); got != want {
+		t.Fatalf("compressed output changed:\ngot  %s\nwant %s", got, want)
+	}
+}
+
 func TestGzipUnzip_EmptyAndInvalid(t *testing.T) {
 	t.Run("empty_input", func(t *testing.T) {
 		out, err := GzipUnzip(nil)
@@ -61,3 +99,40 @@ func TestGzipUnzip_EmptyAndInvalid(t *testing.T) {
 		}
 	})
 }
+
+func TestGzipUnzip_SizeLimit(t *testing.T) {
+	if testing.Short() {
+		t.Skip("decompresses a few hundred MB")
+	}
+
+	// stream zeros through the writer so the input never sits in memory
+	bomb := func(size int) []byte {
+		var buf bytes.Buffer
+		gw, err := gzip.NewWriterLevel(&buf, gzip.BestSpeed)
+		if err != nil {
+			t.Fatal(err)
+		}
+		chunk := make([]byte, 1<<20)
+		for written := 0; written < size; written += len(chunk) {
+			if _, err := gw.Write(chunk[:min(len(chunk), size-written)]); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := gw.Close(); err != nil {
+			t.Fatal(err)
+		}
+		return buf.Bytes()
+	}
+
+	out, err := GzipUnzip(bomb(maxDecodedBlockContentSize))
+	if err != nil {
+		t.Fatalf("content at the limit should decode, got: %v", err)
+	}
+	if len(out) != maxDecodedBlockContentSize {
+		t.Fatalf("decoded %d bytes, want %d", len(out), maxDecodedBlockContentSize)
+	}
+
+	if _, err := GzipUnzip(bomb(maxDecodedBlockContentSize + 1)); err == nil {
+		t.Fatal("expected error for content past the limit")
+	}
+}
@@ -0,0 +1,101 @@
+package zk
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+)
+
+// AddressType identifies the Bitcoin output type a Hash160 commitment belongs
+// to. Its value is the discriminator byte used by claim message versions that
+// bind a proof to a specific address type.
+type AddressType byte
+
+const (
+	// AddressTypeUnknown is used for claim message versions that do not bind
+	// the address type, and for addresses that cannot be claimed.
+	AddressTypeUnknown AddressType = 0x00
+	// AddressTypeP2PKH is a legacy pay-to-pubkey-hash address (1...).
+	AddressTypeP2PKH AddressType = 0x01
+	// AddressTypeP2WPKH is a native SegWit v0 pay-to-witness-pubkey-hash address (bc1q...).
+	AddressTypeP2WPKH AddressType = 0x02
+	// AddressTypeP2WSH is a native SegWit v0 pay-to-witness-script-hash address.
+	// It commits to a 32-byte witness program rather than a Hash160.
+	AddressTypeP2WSH AddressType = 0x03
+	// AddressTypeUnsupportedWitnessVersion is a well-formed native SegWit
+	// address of a witness version (2 and up) that Bitcoin has not assigned
+	// yet. Such outputs cannot be claimed; the value is never bound into a
+	// claim message.
+	AddressTypeUnsupportedWitnessVersion AddressType = 0xff
+)
+
+// String returns the lowercase name of the address type.
+func (t AddressType) String() string {
+	switch t {
+	case AddressTypeP2PKH:
+		return "p2pkh"
+	case AddressTypeP2WPKH:
+		return "p2wpkh"
+	case AddressTypeP2WSH:
+		return "p2wsh"
+	case AddressTypeUnsupportedWitnessVersion:
+		return "unsupported-witness-version"
+	default:
+		return "unknown"
+	}
+}
+
+// ParseAddressType parses the name returned by AddressType.String.
+func ParseAddressType(s string) (AddressType, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "p2pkh":
+		return AddressTypeP2PKH, nil
+	case "p2wpkh":
+		return AddressTypeP2WPKH, nil
+	case "p2wsh":
+		return AddressTypeP2WSH, nil
+	default:
+		return AddressTypeUnknown, fmt.Errorf("unknown address type %q (expected p2pkh, p2wpkh or p2wsh)", s)
+	}
+}
+
+// DetectAddressType returns the type of a mainnet Bitcoin address.
+// Recognized are the Hash160-based single-key types supported by
+// BitcoinAddressToHash160 and P2WSH, whose witness program is extracted with
+// P2WSHAddressToWitnessProgram. A well-formed SegWit address of a future
+// witness version returns AddressTypeUnsupportedWitnessVersion along with an
+// error naming the version. P2SH addresses are rejected rather than assumed to
+// be P2SH-P2WPKH.
+func DetectAddressType(address string) (AddressType, error) {
+	return DetectAddressTypeForNet(address, &chaincfg.MainNetParams)
+}
+
+// DetectAddressTypeForNet is DetectAddressType for an address of the Bitcoin
+// network params.
+func DetectAddressTypeForNet(address string, params *chaincfg.Params) (AddressType, error) {
+	addr, err := btcutil.DecodeAddress(address, params)
+	if err != nil {
+		var witnessVersion btcutil.UnsupportedWitnessVerError
+		if errors.As(err, &witnessVersion) {
+			return AddressTypeUnsupportedWitnessVersion, fmt.Errorf("witness version %d not yet claimable: only P2PKH and SegWit v0 addresses can be claimed", byte(witnessVersion))
+		}
+		return AddressTypeUnknown, fmt.Errorf("invalid Bitcoin address: %w", err)
+	}
+	switch addr.(type) {
+	case *btcutil.AddressPubKeyHash:
+		return AddressTypeP2PKH, nil
+	case *btcutil.AddressWitnessPubKeyHash:
+		return AddressTypeP2WPKH, nil
+	case *btcutil.AddressWitnessScriptHash:
+		return AddressTypeP2WSH, nil
+	case *btcutil.AddressScriptHash:
+		// The address only commits to Hash160(redeemScript), which may wrap
+		// P2WPKH, P2WSH, a multisig or anything else. Never guess which.
+		return AddressTypeUnknown, fmt.Errorf("P2SH addresses are not claimable: the wrapped script cannot be told from the address")
+	default:
+		return AddressTypeUnknown, fmt.Errorf("unsupported address type")
+	}
+}
@@ -0,0 +1,72 @@
+package zk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDetectAddressType(t *testing.T) {
+	tests := []struct {
+		address  string
+		expected AddressType
+		wantErr  bool
+	}{
+		{"1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa", AddressTypeP2PKH, false},
+		{"bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4", AddressTypeP2WPKH, false},
+		{"bc1qrp33g0q5c5txsp9arysrx4k6zdkfs4nce4xj0gdcccefvpysxf3qccfmv3", AddressTypeP2WSH, false},
+		{"3J98t1WpEZ73CNmQviecrnyiWrnqRhWNLy", AddressTypeUnknown, true},
+		{"not-an-address", AddressTypeUnknown, true},
+		{"bc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqzk5jj0", AddressTypeUnknown, true},
+		{"bc1zw508d6qejxtdg4y5r3zarvaryvaxxpcs", AddressTypeUnsupportedWitnessVersion, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.address, func(t *testing.T) {
+			addrType, err := DetectAddressType(tt.address)
+			require.Equal(t, tt.expected, addrType)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, addrType)
+		})
+	}
+}
+
+func TestDetectAddressType_FutureWitnessVersion(t *testing.T) {
+	// BIP-350 test vectors for witness versions 2 and 16
+	for address, version := range map[string]string{
+		"bc1zw508d6qejxtdg4y5r3zarvaryvaxxpcs": "2",
+		"bc1sw50qgdz25j":                       "16",
+	} {
+		addrType, err := DetectAddressType(address)
+		require.Equal(t, AddressTypeUnsupportedWitnessVersion, addrType)
+		require.ErrorContains(t, err, "witness version "+version+" not yet claimable")
+	}
+
+	// A corrupted checksum is still just invalid
+	addrType, err := DetectAddressType("bc1zw508d6qejxtdg4y5r3zarvaryvaxxpct")
+	require.Equal(t, AddressTypeUnknown, addrType)
+	require.ErrorContains(t, err, "invalid Bitcoin address")
+}
+
+func TestDetectAddressType_P2SH(t *testing.T) {
+	// A P2SH address is never routed as P2SH-P2WPKH, whatever it wraps
+	addrType, err := DetectAddressType("3J98t1WpEZ73CNmQviecrnyiWrnqRhWNLy")
+	require.Equal(t, AddressTypeUnknown, addrType)
+	require.ErrorContains(t, err, "P2SH addresses are not claimable")
+
+	_, err = BitcoinAddressToHash160("3J98t1WpEZ73CNmQviecrnyiWrnqRhWNLy")
+	require.ErrorContains(t, err, "P2SH")
+}
+
+func TestParseAddressType(t *testing.T) {
+	for _, addrType := range []AddressType{AddressTypeP2PKH, AddressTypeP2WPKH, AddressTypeP2WSH} {
+		parsed, err := ParseAddressType(addrType.String())
+		require.NoError(t, err)
+		require.Equal(t, addrType, parsed)
+	}
+	_, err := ParseAddressType("p2sh")
+	require.Error(t, err)
+}
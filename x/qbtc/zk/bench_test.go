@@ -0,0 +1,113 @@
+package zk
+
+import (
+	"math/big"
+	"sync"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/frontend/cs/scs"
+	"github.com/stretchr/testify/require"
+)
+
+// circuitConstraintCeilings is the documented upper bound on the number of
+// constraints of each circuit. Proving time grows with the constraint count,
+// so a circuit change that crosses its ceiling fails TestCircuitConstraintCeilings
+// and has to raise the ceiling here deliberately.
+//
+// The ECDSA ceiling is the capacity of the default Hermez SRS (2^DefaultPtauPower):
+// past it the circuit can no longer be set up with the production SRS at all.
+var circuitConstraintCeilings = map[string]struct {
+	circuit func() frontend.Circuit
+	ceiling int
+}{
+	"ECDSA": {
+		circuit: func() frontend.Circuit { return NewBTCSignatureCircuitPlaceholder() },
+		ceiling: 1 << DefaultPtauPower,
+	},
+}
+
+func TestCircuitConstraintCeilings(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping circuit compilation in short mode")
+	}
+	for name, c := range circuitConstraintCeilings {
+		t.Run(name, func(t *testing.T) {
+			cs, err := frontend.Compile(ecc.BN254.ScalarField(), scs.NewBuilder, c.circuit())
+			require.NoError(t, err)
+			n := cs.GetNbConstraints()
+			t.Logf("%s circuit: %d constraints (ceiling %d)", name, n, c.ceiling)
+			require.LessOrEqual(t, n, c.ceiling,
+				"%s circuit has %d constraints, above its ceiling of %d", name, n, c.ceiling)
+		})
+	}
+}
+
+// benchSetup caches the test setup across benchmarks, as compiling the
+// circuit and running the PLONK setup dwarfs a single proof.
+var benchSetup struct {
+	once  sync.Once
+	setup *SetupResult
+	err   error
+}
+
+func cachedTestSetup(tb testing.TB) *SetupResult {
+	tb.Helper()
+	benchSetup.once.Do(func() {
+		benchSetup.setup, benchSetup.err = SetupWithOptions(TestSetupOptions())
+	})
+	require.NoError(tb, benchSetup.err)
+	return benchSetup.setup
+}
+
+// ecdsaBenchParams signs a claim message with a fixed key and returns the
+// proof witness and the matching verification params.
+func ecdsaBenchParams(tb testing.TB) (ProofParams, VerificationParams) {
+	tb.Helper()
+	privKey, _ := btcec.PrivKeyFromBytes(big.NewInt(0x5eed).FillBytes(make([]byte, 32)))
+	compressed := privKey.PubKey().SerializeCompressed()
+	addressHash, err := PublicKeyToAddressHash(compressed)
+	require.NoError(tb, err)
+
+	params := VerificationParams{
+		AddressHash:     addressHash,
+		QBTCAddressHash: HashBTCQAddress("qbtc1benchclaimer"),
+		ChainID:         ComputeChainIDHash("qbtc-bench-1"),
+	}
+	params.MessageHash = ComputeClaimMessage(params.AddressHash, params.QBTCAddressHash, params.ChainID)
+
+	sig := ecdsa.SignCompact(privKey, params.MessageHash[:], true)
+	proofParams, err := ProofParamsFromSignature(sig[1:33], sig[33:65], compressed, params)
+	require.NoError(tb, err)
+	return proofParams, params
+}
+
+func BenchmarkGenerateProof_ECDSA(b *testing.B) {
+	prover := ProverFromSetup(cachedTestSetup(b))
+	proofParams, _ := ecdsaBenchParams(b)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := prover.GenerateProof(proofParams); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkVerifyProof_ECDSA(b *testing.B) {
+	setup := cachedTestSetup(b)
+	proofParams, params := ecdsaBenchParams(b)
+	proof, err := ProverFromSetup(setup).GenerateProof(proofParams)
+	require.NoError(b, err)
+	verifier := NewVerifier(setup.VerifyingKey)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := verifier.VerifyProof(proof, params); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,144 @@
+package zk
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+)
+
+const (
+	// SignatureSchemeRaw means the key signed the 32-byte claim message directly.
+	SignatureSchemeRaw = "raw"
+
+	// SignatureSchemeBIP137 means the key produced a "Bitcoin Signed Message"
+	// (BIP-137) over the hex encoding of the claim message, as most wallets'
+	// sign-message feature does. See BIP137MessageDigest.
+	SignatureSchemeBIP137 = "bip137"
+)
+
+// bitcoinSignedMessageMagic is the prefix wallets hash in front of a signed message.
+const bitcoinSignedMessageMagic = "Bitcoin Signed Message:\n"
+
+// BIP-137 header byte ranges, each covering the four recovery IDs.
+const (
+	bip137HeaderUncompressedP2PKH = 27
+	bip137HeaderCompressedP2PKH   = 31
+	bip137HeaderMax               = 42
+)
+
+// BIP137SignatureLength is the length of a decoded BIP-137 signature:
+// one header byte followed by R and S.
+const BIP137SignatureLength = 65
+
+var supportedSignatureSchemes = map[string]bool{
+	SignatureSchemeRaw:    true,
+	SignatureSchemeBIP137: true,
+}
+
+// NormalizeSignatureScheme maps an empty scheme to SignatureSchemeRaw.
+func NormalizeSignatureScheme(scheme string) string {
+	if scheme == "" {
+		return SignatureSchemeRaw
+	}
+	return scheme
+}
+
+// IsSupportedSignatureScheme reports whether the given signature scheme is
+// accepted. An empty scheme refers to SignatureSchemeRaw.
+func IsSupportedSignatureScheme(scheme string) bool {
+	return supportedSignatureSchemes[NormalizeSignatureScheme(scheme)]
+}
+
+// BIP137MessageText returns the text a wallet signs for a claim message: the
+// lowercase hex encoding of the 32-byte claim message.
+func BIP137MessageText(claimMessage [32]byte) string {
+	return hex.EncodeToString(claimMessage[:])
+}
+
+// BIP137MessageDigest computes the digest a wallet signs for a message:
+//
+//	SHA256(SHA256(varstr("Bitcoin Signed Message:\n") || varstr(message)))
+func BIP137MessageDigest(message string) [32]byte {
+	var buf bytes.Buffer
+	// Writes to a bytes.Buffer cannot fail
+	_ = wire.WriteVarString(&buf, 0, bitcoinSignedMessageMagic)
+	_ = wire.WriteVarString(&buf, 0, message)
+	return chainhash.DoubleHashH(buf.Bytes())
+}
+
+// ComputeSignedMessageForParams returns the digest the key must have signed
+// for the given verification params, i.e. the circuit's MessageHash input.
+// For SignatureSchemeRaw it is the claim message itself; for
+// SignatureSchemeBIP137 it is the BIP-137 digest of the claim message's hex
+// encoding. Both are computed from the same claim message, so the proof is
+// bound to the claimed address, destination and chain either way.
+func ComputeSignedMessageForParams(params VerificationParams) ([32]byte, error) {
+	claimMessage, err := ComputeClaimMessageForParams(params)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	switch NormalizeSignatureScheme(params.SignatureScheme) {
+	case SignatureSchemeRaw:
+		return claimMessage, nil
+	case SignatureSchemeBIP137:
+		return BIP137MessageDigest(BIP137MessageText(claimMessage)), nil
+	default:
+		return [32]byte{}, fmt.Errorf("unsupported signature scheme %q", params.SignatureScheme)
+	}
+}
+
+// DecodeBIP137Signature decodes a base64 BIP-137 signature as produced by
+// wallets. Signatures for uncompressed keys are rejected, since the circuit
+// only hashes compressed public keys. The P2SH-P2WPKH and P2WPKH header
+// ranges are accepted as compressed-key signatures; the address the proof is
+// bound to comes from the claim, not from the header.
+func DecodeBIP137Signature(sigBase64 string) ([]byte, error) {
+	sig, err := base64.StdEncoding.DecodeString(sigBase64)
+	if err != nil {
+		return nil, fmt.Errorf("signature is not valid base64: %w", err)
+	}
+	if len(sig) != BIP137SignatureLength {
+		return nil, fmt.Errorf("invalid BIP-137 signature length: %d (expected %d)", len(sig), BIP137SignatureLength)
+	}
+	header := sig[0]
+	if header < bip137HeaderUncompressedP2PKH || header > bip137HeaderMax {
+		return nil, fmt.Errorf("invalid BIP-137 header byte %d", header)
+	}
+	if header < bip137HeaderCompressedP2PKH {
+		return nil, fmt.Errorf("BIP-137 signatures for uncompressed public keys are not supported")
+	}
+	return sig, nil
+}
+
+// ProofParamsFromBIP137Signature recovers the public key from a base64
+// BIP-137 signature over the claim message of params and builds the proof
+// witness. params.MessageHash must be the BIP-137 digest as returned by
+// ComputeSignedMessageForParams; the recovered key must hash to params.AddressHash.
+func ProofParamsFromBIP137Signature(sigBase64 string, params VerificationParams) (ProofParams, error) {
+	sig, err := DecodeBIP137Signature(sigBase64)
+	if err != nil {
+		return ProofParams{}, err
+	}
+
+	// btcd's compact format uses the compressed P2PKH header range; the
+	// recovery ID is the low two bits of the offset within any range.
+	compact := make([]byte, BIP137SignatureLength)
+	copy(compact, sig)
+	compact[0] = bip137HeaderCompressedP2PKH + (sig[0]-bip137HeaderUncompressedP2PKH)%4
+
+	pubKey, _, err := ecdsa.RecoverCompact(compact, params.MessageHash[:])
+	if err != nil {
+		return ProofParams{}, fmt.Errorf("failed to recover public key from BIP-137 signature: %w", err)
+	}
+
+	proofParams, err := ProofParamsFromSignature(sig[1:33], sig[33:65], pubKey.SerializeCompressed(), params)
+	if err != nil {
+		return ProofParams{}, fmt.Errorf("BIP-137 signature was not made by the claimed address: %w", err)
+	}
+	return proofParams, nil
+}
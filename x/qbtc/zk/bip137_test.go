@@ -0,0 +1,121 @@
+package zk
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBIP137MessageDigest(t *testing.T) {
+	// Expected digests computed independently as
+	// SHA256(SHA256("\x18Bitcoin Signed Message:\n" || len || message))
+	digest := BIP137MessageDigest("hello world")
+	require.Equal(t, "0b6b6ce07bc55ee4aeba0098a5e5d2c8986cab228a54199723f9962316633733", hex.EncodeToString(digest[:]))
+
+	var claimMessage [32]byte
+	for i := range claimMessage {
+		claimMessage[i] = byte(i)
+	}
+	digest = BIP137MessageDigest(BIP137MessageText(claimMessage))
+	require.Equal(t, "ab8815a3e9c1d8a1a275f4ee69cff7e5be3749828167c27486bb48e0e91e20b3", hex.EncodeToString(digest[:]))
+}
+
+func TestIsSupportedSignatureScheme(t *testing.T) {
+	require.True(t, IsSupportedSignatureScheme(""))
+	require.True(t, IsSupportedSignatureScheme(SignatureSchemeRaw))
+	require.True(t, IsSupportedSignatureScheme(SignatureSchemeBIP137))
+	require.False(t, IsSupportedSignatureScheme("bip322"))
+}
+
+// bip137TestParams returns verification params for a fixed key, with
+// MessageHash set to the BIP-137 digest of the claim message.
+func bip137TestParams(t *testing.T) (*btcec.PrivateKey, VerificationParams) {
+	t.Helper()
+	privKey, _ := btcec.PrivKeyFromBytes(big.NewInt(0xb137).FillBytes(make([]byte, 32)))
+	addressHash, err := PublicKeyToAddressHash(privKey.PubKey().SerializeCompressed())
+	require.NoError(t, err)
+
+	params := VerificationParams{
+		AddressHash:     addressHash,
+		QBTCAddressHash: HashBTCQAddress("qbtc1bip137claimer"),
+		ChainID:         ComputeChainIDHash("qbtc-test-1"),
+		SignatureScheme: SignatureSchemeBIP137,
+	}
+	params.MessageHash, err = ComputeSignedMessageForParams(params)
+	require.NoError(t, err)
+	return privKey, params
+}
+
+func TestComputeSignedMessageForParams(t *testing.T) {
+	_, params := bip137TestParams(t)
+
+	claimMessage, err := ComputeClaimMessageForParams(params)
+	require.NoError(t, err)
+	require.Equal(t, BIP137MessageDigest(BIP137MessageText(claimMessage)), params.MessageHash)
+
+	params.SignatureScheme = ""
+	raw, err := ComputeSignedMessageForParams(params)
+	require.NoError(t, err)
+	require.Equal(t, claimMessage, raw)
+
+	params.SignatureScheme = "bip322"
+	_, err = ComputeSignedMessageForParams(params)
+	require.ErrorContains(t, err, "unsupported signature scheme")
+}
+
+func TestProofParamsFromBIP137Signature(t *testing.T) {
+	privKey, params := bip137TestParams(t)
+	compact := ecdsa.SignCompact(privKey, params.MessageHash[:], true)
+	recID := compact[0] - bip137HeaderCompressedP2PKH
+
+	withHeader := func(header byte) string {
+		sig := append([]byte{header}, compact[1:]...)
+		return base64.StdEncoding.EncodeToString(sig)
+	}
+
+	t.Run("compressed P2PKH, P2SH-P2WPKH and P2WPKH headers", func(t *testing.T) {
+		for _, base := range []byte{31, 35, 39} {
+			proofParams, err := ProofParamsFromBIP137Signature(withHeader(base+recID), params)
+			require.NoError(t, err, "header %d", base+recID)
+			require.Equal(t, privKey.PubKey().X(), proofParams.PublicKeyX)
+			require.Equal(t, privKey.PubKey().Y(), proofParams.PublicKeyY)
+			require.Equal(t, params.MessageHash, proofParams.MessageHash)
+		}
+	})
+
+	t.Run("uncompressed key header rejected", func(t *testing.T) {
+		_, err := ProofParamsFromBIP137Signature(withHeader(27+recID), params)
+		require.ErrorContains(t, err, "uncompressed")
+	})
+
+	t.Run("invalid header rejected", func(t *testing.T) {
+		_, err := ProofParamsFromBIP137Signature(withHeader(43), params)
+		require.ErrorContains(t, err, "invalid BIP-137 header")
+	})
+
+	t.Run("invalid base64 rejected", func(t *testing.T) {
+		_, err := ProofParamsFromBIP137Signature("not base64!", params)
+		require.ErrorContains(t, err, "base64")
+	})
+
+	t.Run("wrong length rejected", func(t *testing.T) {
+		_, err := ProofParamsFromBIP137Signature(base64.StdEncoding.EncodeToString(compact[:64]), params)
+		require.ErrorContains(t, err, "invalid BIP-137 signature length")
+	})
+
+	t.Run("signature over another message does not match the address", func(t *testing.T) {
+		other := params
+		other.QBTCAddressHash = HashBTCQAddress("qbtc1someoneelse")
+		var err error
+		other.MessageHash, err = ComputeSignedMessageForParams(other)
+		require.NoError(t, err)
+
+		_, err = ProofParamsFromBIP137Signature(withHeader(31+recID), other)
+		require.ErrorContains(t, err, "not made by the claimed address")
+	})
+}
@@ -1,10 +1,13 @@
 package zk
 
 import (
+	"bytes"
 	"encoding/hex"
 	"fmt"
+	"math/big"
 
 	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/chaincfg"
 )
@@ -32,6 +35,124 @@ func PublicKeyToAddressHash(compressedPubKey []byte) ([20]byte, error) {
 	return result, nil
 }
 
+// DecompressPubKey parses a 33-byte compressed secp256k1 public key and returns
+// its affine coordinates. Unlike go-ethereum's DecompressPubkey it reports why
+// a key was rejected instead of returning nil coordinates.
+func DecompressPubKey(compressed []byte) (x, y *big.Int, err error) {
+	if len(compressed) != btcec.PubKeyBytesLenCompressed {
+		return nil, nil, fmt.Errorf("invalid compressed public key length: %d", len(compressed))
+	}
+	if compressed[0] != 0x02 && compressed[0] != 0x03 {
+		return nil, nil, fmt.Errorf("invalid compressed public key prefix: 0x%02x", compressed[0])
+	}
+
+	// ParsePubKey rejects x >= p and x values with no point on the curve
+	pubKey, err := btcec.ParsePubKey(compressed)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid public key: %w", err)
+	}
+	if !pubKey.IsOnCurve() {
+		return nil, nil, fmt.Errorf("public key is not on the secp256k1 curve")
+	}
+
+	return pubKey.X(), pubKey.Y(), nil
+}
+
+// ProofParamsFromSignature builds ProofParams from the raw ECDSA scalars of a
+// signature over params.MessageHash and the signer's compressed public key.
+// It fails if the public key does not hash to params.AddressHash, since the
+// circuit would reject such a witness anyway.
+func ProofParamsFromSignature(sigR, sigS, compressedPubKey []byte, params VerificationParams) (ProofParams, error) {
+	if len(sigR) == 0 || len(sigR) > 32 {
+		return ProofParams{}, fmt.Errorf("invalid signature R length: %d", len(sigR))
+	}
+	if len(sigS) == 0 || len(sigS) > 32 {
+		return ProofParams{}, fmt.Errorf("invalid signature S length: %d", len(sigS))
+	}
+
+	pubKeyX, pubKeyY, err := DecompressPubKey(compressedPubKey)
+	if err != nil {
+		return ProofParams{}, err
+	}
+
+	addressHash, err := PublicKeyToAddressHash(compressedPubKey)
+	if err != nil {
+		return ProofParams{}, err
+	}
+	if !bytes.Equal(addressHash[:], params.AddressHash[:]) {
+		return ProofParams{}, fmt.Errorf("public key does not match address hash")
+	}
+
+	return ProofParams{
+		SignatureR:      new(big.Int).SetBytes(sigR),
+		SignatureS:      new(big.Int).SetBytes(sigS),
+		PublicKeyX:      pubKeyX,
+		PublicKeyY:      pubKeyY,
+		MessageHash:     params.MessageHash,
+		AddressHash:     params.AddressHash,
+		BTCQAddressHash: params.QBTCAddressHash,
+		ChainID:         params.ChainID,
+		MessageVersion:  params.MessageVersion,
+		AddressType:     params.AddressType,
+	}, nil
+}
+
+// RecoverPublicKey recovers the public key that made the ECDSA signature
+// (r, s) over messageHash. v is the recovery ID, either 0-3 or offset by 27 as
+// signers with an Ethereum-style API return it; a compact signature header
+// (27-34) is accepted too.
+func RecoverPublicKey(r, s *big.Int, v int, messageHash [32]byte) (*btcec.PublicKey, error) {
+	recoveryID, err := normalizeRecoveryID(v)
+	if err != nil {
+		return nil, err
+	}
+	if r == nil || r.Sign() <= 0 || r.BitLen() > 256 {
+		return nil, fmt.Errorf("invalid signature R")
+	}
+	if s == nil || s.Sign() <= 0 || s.BitLen() > 256 {
+		return nil, fmt.Errorf("invalid signature S")
+	}
+
+	// btcd's compact format: header 27 + 4 (compressed) + recovery ID
+	compact := make([]byte, 65)
+	compact[0] = 27 + 4 + recoveryID
+	r.FillBytes(compact[1:33])
+	s.FillBytes(compact[33:65])
+	pubKey, _, err := ecdsa.RecoverCompact(compact, messageHash[:])
+	if err != nil {
+		return nil, fmt.Errorf("failed to recover public key: %w", err)
+	}
+	return pubKey, nil
+}
+
+// normalizeRecoveryID maps the forms of a recovery ID signers return to 0-3.
+func normalizeRecoveryID(v int) (byte, error) {
+	switch {
+	case v >= 0 && v <= 3:
+		return byte(v), nil
+	case v >= 27 && v <= 34:
+		return byte((v - 27) % 4), nil
+	default:
+		return 0, fmt.Errorf("invalid recovery ID %d (expected 0-3 or 27-34)", v)
+	}
+}
+
+// ProofParamsFromRecoverable builds ProofParams from a recoverable ECDSA
+// signature over params.MessageHash, for signers that return (r, s, v) but
+// not their public key. The key is recovered from the signature and must hash
+// to params.AddressHash; a wrong v recovers another key and fails that check.
+func ProofParamsFromRecoverable(r, s *big.Int, v int, params VerificationParams) (ProofParams, error) {
+	pubKey, err := RecoverPublicKey(r, s, v, params.MessageHash)
+	if err != nil {
+		return ProofParams{}, err
+	}
+	proofParams, err := ProofParamsFromSignature(r.Bytes(), s.Bytes(), pubKey.SerializeCompressed(), params)
+	if err != nil {
+		return ProofParams{}, fmt.Errorf("recovered public key does not belong to the claimed address: %w", err)
+	}
+	return proofParams, nil
+}
+
 // AddressHashFromHex parses a hex-encoded address hash
 func AddressHashFromHex(hexStr string) ([20]byte, error) {
 	var result [20]byte
@@ -64,7 +185,8 @@ func Hash160ToP2PKHAddress(hash [20]byte) (string, error) {
 }
 
 // BitcoinAddressToHash160 extracts the Hash160 from various Bitcoin address formats
-// Supports: P2PKH (1...), P2WPKH (bc1q...), P2SH-P2WPKH (3...)
+// Supports: P2PKH (1...), P2WPKH (bc1q...). P2SH (3...) is rejected, since the
+// pubkey hash of a wrapped P2WPKH can't be recovered from the address alone.
 func BitcoinAddressToHash160(address string) ([20]byte, error) {
 	var result [20]byte
 	// only do mainnet
@@ -87,3 +209,19 @@ func BitcoinAddressToHash160(address string) ([20]byte, error) {
 		return result, fmt.Errorf("unsupported address type")
 	}
 }
+
+// P2WSHAddressToWitnessProgram extracts the 32-byte witness program, i.e. the
+// SHA256 of the witness script, from a mainnet P2WSH address (bc1q..., 62 chars).
+func P2WSHAddressToWitnessProgram(address string) ([32]byte, error) {
+	var result [32]byte
+	addr, err := btcutil.DecodeAddress(address, &chaincfg.MainNetParams)
+	if err != nil {
+		return result, fmt.Errorf("invalid Bitcoin address: %w", err)
+	}
+	a, ok := addr.(*btcutil.AddressWitnessScriptHash)
+	if !ok {
+		return result, fmt.Errorf("not a P2WSH address")
+	}
+	copy(result[:], a.WitnessProgram())
+	return result, nil
+}
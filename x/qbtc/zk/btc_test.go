@@ -1,8 +1,13 @@
 package zk
 
 import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
 	"testing"
 
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
 	"github.com/stretchr/testify/require"
 )
 
@@ -20,3 +25,120 @@ func TestHashBTCQAddress(t *testing.T) {
 	// Hash should be 32 bytes (SHA256)
 	require.Len(t, hash1, 32)
 }
+
+func TestDecompressPubKey(t *testing.T) {
+	privKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	compressed := privKey.PubKey().SerializeCompressed()
+
+	x, y, err := DecompressPubKey(compressed)
+	require.NoError(t, err)
+	require.Equal(t, 0, x.Cmp(privKey.PubKey().X()))
+	require.Equal(t, 0, y.Cmp(privKey.PubKey().Y()))
+
+	// Wrong length
+	_, _, err = DecompressPubKey(compressed[:32])
+	require.Error(t, err)
+	_, _, err = DecompressPubKey(privKey.PubKey().SerializeUncompressed())
+	require.Error(t, err)
+
+	// Wrong prefix
+	badPrefix := bytes.Clone(compressed)
+	badPrefix[0] = 0x04
+	_, _, err = DecompressPubKey(badPrefix)
+	require.Error(t, err)
+
+	// x >= field prime is not a valid point
+	notOnCurve := bytes.Repeat([]byte{0xff}, 33)
+	notOnCurve[0] = 0x02
+	_, _, err = DecompressPubKey(notOnCurve)
+	require.Error(t, err)
+}
+
+func TestP2WSHAddressToWitnessProgram(t *testing.T) {
+	// BIP-173 test vector
+	program, err := P2WSHAddressToWitnessProgram("bc1qrp33g0q5c5txsp9arysrx4k6zdkfs4nce4xj0gdcccefvpysxf3qccfmv3")
+	require.NoError(t, err)
+	require.Equal(t, "1863143c14c5166804bd19203356da136c985678cd4d27a1b8c6329604903262", hex.EncodeToString(program[:]))
+
+	// P2WPKH is a witness address but not a script hash
+	_, err = P2WSHAddressToWitnessProgram("bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4")
+	require.Error(t, err)
+
+	_, err = P2WSHAddressToWitnessProgram("not-an-address")
+	require.Error(t, err)
+}
+
+func TestProofParamsFromSignature(t *testing.T) {
+	privKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	compressed := privKey.PubKey().SerializeCompressed()
+
+	addressHash, err := PublicKeyToAddressHash(compressed)
+	require.NoError(t, err)
+
+	params := VerificationParams{
+		AddressHash:     addressHash,
+		QBTCAddressHash: HashBTCQAddress("qbtc1test"),
+		ChainID:         ComputeChainIDHash("qbtc-1"),
+	}
+	params.MessageHash = ComputeClaimMessage(params.AddressHash, params.QBTCAddressHash, params.ChainID)
+
+	r := bytes.Repeat([]byte{0x01}, 32)
+	s := bytes.Repeat([]byte{0x02}, 32)
+
+	proofParams, err := ProofParamsFromSignature(r, s, compressed, params)
+	require.NoError(t, err)
+	require.Equal(t, 0, proofParams.PublicKeyX.Cmp(privKey.PubKey().X()))
+	require.Equal(t, 0, proofParams.PublicKeyY.Cmp(privKey.PubKey().Y()))
+	require.Equal(t, params.MessageHash, proofParams.MessageHash)
+	require.Equal(t, params.QBTCAddressHash, proofParams.BTCQAddressHash)
+
+	// Public key for a different address is rejected
+	params.AddressHash[0] ^= 0xff
+	_, err = ProofParamsFromSignature(r, s, compressed, params)
+	require.Error(t, err)
+
+	// Oversized scalars are rejected
+	_, err = ProofParamsFromSignature(make([]byte, 33), s, compressed, params)
+	require.Error(t, err)
+}
+
+func TestProofParamsFromRecoverable(t *testing.T) {
+	privKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	addressHash, err := PrivateKeyToAddressHash(privKey)
+	require.NoError(t, err)
+
+	params := VerificationParams{
+		AddressHash:     addressHash,
+		QBTCAddressHash: HashBTCQAddress("qbtc1test"),
+		ChainID:         ComputeChainIDHash("qbtc-1"),
+	}
+	params.MessageHash = ComputeClaimMessage(params.AddressHash, params.QBTCAddressHash, params.ChainID)
+
+	// SignCompact returns header || R || S with header 27 + 4 + recovery ID
+	compact := ecdsa.SignCompact(privKey, params.MessageHash[:], true)
+	r := new(big.Int).SetBytes(compact[1:33])
+	s := new(big.Int).SetBytes(compact[33:65])
+	v := int(compact[0]-27) % 4
+
+	for _, form := range []int{v, v + 27, int(compact[0])} {
+		proofParams, err := ProofParamsFromRecoverable(r, s, form, params)
+		require.NoError(t, err, "v=%d", form)
+		require.Equal(t, 0, proofParams.PublicKeyX.Cmp(privKey.PubKey().X()))
+		require.Equal(t, 0, proofParams.PublicKeyY.Cmp(privKey.PubKey().Y()))
+		require.Equal(t, params.MessageHash, proofParams.MessageHash)
+	}
+
+	// The other recovery ID recovers another key
+	_, err = ProofParamsFromRecoverable(r, s, v^1, params)
+	require.ErrorContains(t, err, "does not belong to the claimed address")
+
+	_, err = ProofParamsFromRecoverable(r, s, 2, params)
+	require.Error(t, err)
+	_, err = ProofParamsFromRecoverable(r, s, 35, params)
+	require.ErrorContains(t, err, "invalid recovery ID")
+	_, err = ProofParamsFromRecoverable(big.NewInt(0), s, v, params)
+	require.ErrorContains(t, err, "invalid signature R")
+}
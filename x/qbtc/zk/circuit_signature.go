@@ -9,6 +9,33 @@ import (
 	"github.com/consensys/gnark/std/signature/ecdsa"
 )
 
+// CircuitTypeECDSA names BTCSignatureCircuit, the only circuit claims are
+// verified against.
+const CircuitTypeECDSA = "ecdsa"
+
+// CircuitTypeP2WSH names the planned circuit for P2WSH outputs, which commit
+// to a witness script rather than a key. It does not exist yet.
+const CircuitTypeP2WSH = "p2wsh"
+
+// SupportedCircuitTypes lists the circuit types the chain verifies claims of.
+func SupportedCircuitTypes() []string {
+	return []string{CircuitTypeECDSA}
+}
+
+// CircuitTypeForAddressType returns the circuit a proof claiming an output of
+// the given type must come from, or "" if no circuit can claim it. The
+// Hash160-based types share the ECDSA circuit.
+func CircuitTypeForAddressType(addressType AddressType) string {
+	switch addressType {
+	case AddressTypeP2PKH, AddressTypeP2WPKH:
+		return CircuitTypeECDSA
+	case AddressTypeP2WSH:
+		return CircuitTypeP2WSH
+	default:
+		return ""
+	}
+}
+
 // BTCSignatureCircuit is the ZK circuit that proves ownership of a Bitcoin address
 // using an ECDSA signature. It proves: "I have a valid signature from the key
 // that controls this Bitcoin address" without revealing the signature or public key.
@@ -38,7 +65,8 @@ type BTCSignatureCircuit struct {
 	AddressHash [20]frontend.Variable `gnark:",public"`
 	// BTCQAddressHash is the SHA256 hash of the destination address on qbtc
 	BTCQAddressHash [32]frontend.Variable `gnark:",public"`
-	// ChainID is a hash of the chain identifier (first 8 bytes of SHA256(chain_id))
+	// ChainID is a hash of the chain identifier (first 8 bytes of SHA256(chain_id)).
+	// Message versions binding the full chain ID hash commit to all 32 bytes via MessageHash.
 	ChainID [8]frontend.Variable `gnark:",public"`
 }
 
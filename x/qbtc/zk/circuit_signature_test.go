@@ -325,7 +325,8 @@ func TestSignatureProofSerialization(t *testing.T) {
 	require.NoError(t, err)
 
 	// Serialize
-	protoBytes := proof.ToProtoZKProof()
+	protoBytes, err := proof.ToProtoZKProof()
+	require.NoError(t, err)
 	require.NotEmpty(t, protoBytes)
 
 	// Deserialize
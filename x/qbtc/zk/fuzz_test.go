@@ -1,6 +1,8 @@
 package zk
 
 import (
+	"bytes"
+	"encoding/binary"
 	"testing"
 )
 
@@ -69,3 +71,56 @@ func FuzzDeserializeVerifyingKey(f *testing.F) {
 		_, _ = DeserializeVerifyingKey(data)
 	})
 }
+
+// FuzzProofFromProtoZKProof tests proof envelope parsing with random inputs.
+func FuzzProofFromProtoZKProof(f *testing.F) {
+	envelope := func(fingerprint []byte, proofLen uint32, body int) []byte {
+		var out []byte
+		if fingerprint != nil {
+			out = append([]byte{proofEnvelopeVKFingerprint}, fingerprint...)
+		}
+		out = binary.BigEndian.AppendUint32(out, proofLen)
+		return append(out, bytes.Repeat([]byte{0xab}, body)...)
+	}
+	fingerprint := bytes.Repeat([]byte{0x42}, VKFingerprintSize)
+
+	// Add seed corpus
+
+	// Valid envelopes, with and without fingerprint
+	f.Add(envelope(nil, MinProofDataLen, MinProofDataLen+40))
+	f.Add(envelope(fingerprint, MinProofDataLen, MinProofDataLen+40))
+	f.Add(envelope(nil, MinProofDataLen, MinProofDataLen)) // no public inputs
+
+	// Near-valid envelopes
+	f.Add(envelope(nil, MinProofDataLen, MinProofDataLen-1))              // truncated proof
+	f.Add(envelope(nil, MinProofDataLen-1, MinProofDataLen))              // proof too short
+	f.Add(envelope(nil, MaxProofDataLen+1, 64))                           // proof too long
+	f.Add(envelope(nil, 0xffffffff, 64))                                  // length overflow
+	f.Add(append([]byte{proofEnvelopeVKFingerprint}, fingerprint[1:]...)) // short fingerprint
+	f.Add([]byte{})
+	f.Add([]byte{0x00, 0x00, 0x00})
+	f.Add([]byte{proofEnvelopeVKFingerprint})
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		// Should never panic
+		proof, err := ProofFromProtoZKProof(data)
+		if err != nil {
+			return
+		}
+		// The parsed proof never holds more than the envelope did
+		if len(proof.ProofData)+len(proof.PublicInputs)+len(proof.VKFingerprint) > len(data) {
+			t.Fatalf("parsed proof is larger than its %d byte envelope", len(data))
+		}
+		if len(proof.ProofData) < MinProofDataLen || len(proof.ProofData) > MaxProofDataLen {
+			t.Fatalf("parsed proof data of %d bytes is out of bounds", len(proof.ProofData))
+		}
+		// Whatever parses serializes back to the same envelope
+		out, err := proof.ToProtoZKProof()
+		if err != nil {
+			t.Fatalf("parsed proof does not serialize: %v", err)
+		}
+		if !bytes.Equal(out, data) {
+			t.Fatalf("envelope does not round trip:\ngot  %x\nwant %x", out, data)
+		}
+	})
+}
@@ -104,7 +104,8 @@ func TestFullClaimFlow_Integration(t *testing.T) {
 
 	// Step 4: Serialize proof for transmission (as in tx)
 	t.Log("Step 4: Serializing proof for tx...")
-	protoBytes := proof.ToProtoZKProof()
+	protoBytes, err := proof.ToProtoZKProof()
+	require.NoError(t, err)
 	require.NotEmpty(t, protoBytes)
 
 	// Step 5: Deserialize proof (as done by handler)
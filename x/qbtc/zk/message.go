@@ -1,12 +1,160 @@
 package zk
 
 import (
+	"bytes"
 	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"sort"
 )
 
-// ClaimMessageVersion is the version string included in the claim message
-// to ensure forward compatibility and prevent cross-version replay attacks.
-const ClaimMessageVersion = "qbtc-claim-v1"
+const (
+	// ClaimMessageVersionV1 is the original claim message format.
+	ClaimMessageVersionV1 = "qbtc-claim-v1"
+
+	// ClaimMessageVersionV2 prefixes the message with an AddressType
+	// discriminator byte, so a proof for the P2PKH address of a key cannot be
+	// used for the P2WPKH address of the same key and vice versa.
+	ClaimMessageVersionV2 = "qbtc-claim-v2"
+
+	// ClaimMessageVersionV3 binds the address type like V2 and commits to the
+	// full 32-byte SHA256(chain_id) instead of its first 8 bytes, see
+	// ComputeChainIDHash for why the truncated hash is weak.
+	ClaimMessageVersionV3 = "qbtc-claim-v3"
+
+	// ClaimMessageVersionV4 binds everything V3 does plus a commitment to the
+	// exact set of claimed UTXOs, see ComputeUTXOSetCommitment. It is opt-in:
+	// a V4 proof can only be used for the UTXOs it was signed for.
+	ClaimMessageVersionV4 = "qbtc-claim-v4"
+
+	// ClaimMessageVersionV5 binds everything V4 does plus an expiry height,
+	// the last qbtc block height the claim may be included at. It is opt-in:
+	// a V5 proof stops verifying once the chain passes that height.
+	ClaimMessageVersionV5 = "qbtc-claim-v5"
+
+	// ClaimMessageVersionV6 binds everything V5 does, but its QBTCAddressHash
+	// is a salted commitment to the destination, see CommitBTCQAddress, rather
+	// than the plain address hash. The destination stays hidden until the
+	// claim reveals it together with the salt.
+	ClaimMessageVersionV6 = "qbtc-claim-v6"
+
+	// ClaimMessageVersion is the version string included in the claim message
+	// to ensure forward compatibility and prevent cross-version replay attacks.
+	// It is the version used when none is specified.
+	ClaimMessageVersion = ClaimMessageVersionV1
+)
+
+// supportedClaimMessageVersions lists every claim message version the chain
+// currently accepts. During an upgrade window both the old and the new version
+// are listed so proofs generated against either remain verifiable.
+var supportedClaimMessageVersions = map[string]bool{
+	ClaimMessageVersionV1: true,
+	ClaimMessageVersionV2: true,
+	ClaimMessageVersionV3: true,
+	ClaimMessageVersionV4: true,
+	ClaimMessageVersionV5: true,
+	ClaimMessageVersionV6: true,
+}
+
+// claimMessageVersionsWithAddressType lists the versions that commit to the
+// address type of the claimed output.
+var claimMessageVersionsWithAddressType = map[string]bool{
+	ClaimMessageVersionV2: true,
+	ClaimMessageVersionV3: true,
+	ClaimMessageVersionV4: true,
+	ClaimMessageVersionV5: true,
+	ClaimMessageVersionV6: true,
+}
+
+// claimMessageVersionsWithFullChainID lists the versions that commit to the
+// full SHA256(chain_id) rather than the 8-byte ChainID public input.
+var claimMessageVersionsWithFullChainID = map[string]bool{
+	ClaimMessageVersionV3: true,
+	ClaimMessageVersionV4: true,
+	ClaimMessageVersionV5: true,
+	ClaimMessageVersionV6: true,
+}
+
+// claimMessageVersionsWithUTXOSet lists the versions that commit to the set of
+// claimed UTXOs.
+var claimMessageVersionsWithUTXOSet = map[string]bool{
+	ClaimMessageVersionV4: true,
+	ClaimMessageVersionV5: true,
+	ClaimMessageVersionV6: true,
+}
+
+// claimMessageVersionsWithExpiry lists the versions that commit to the last
+// qbtc block height the claim is valid at.
+var claimMessageVersionsWithExpiry = map[string]bool{
+	ClaimMessageVersionV5: true,
+	ClaimMessageVersionV6: true,
+}
+
+// claimMessageVersionsWithSaltedDestination lists the versions whose
+// QBTCAddressHash is a salted destination commitment.
+var claimMessageVersionsWithSaltedDestination = map[string]bool{
+	ClaimMessageVersionV6: true,
+}
+
+// SupportedClaimMessageVersions returns the claim message versions the chain
+// accepts, sorted.
+func SupportedClaimMessageVersions() []string {
+	versions := make([]string, 0, len(supportedClaimMessageVersions))
+	for version := range supportedClaimMessageVersions {
+		versions = append(versions, version)
+	}
+	sort.Strings(versions)
+	return versions
+}
+
+// NormalizeClaimMessageVersion maps an empty version to the default ClaimMessageVersion.
+func NormalizeClaimMessageVersion(version string) string {
+	if version == "" {
+		return ClaimMessageVersion
+	}
+	return version
+}
+
+// IsSupportedClaimMessageVersion reports whether the given claim message
+// version is accepted. An empty version refers to the default version.
+func IsSupportedClaimMessageVersion(version string) bool {
+	return supportedClaimMessageVersions[NormalizeClaimMessageVersion(version)]
+}
+
+// ClaimMessageBindsAddressType reports whether claim messages of the given
+// version commit to the address type of the claimed output. An empty version
+// refers to the default version.
+func ClaimMessageBindsAddressType(version string) bool {
+	return claimMessageVersionsWithAddressType[NormalizeClaimMessageVersion(version)]
+}
+
+// ClaimMessageBindsFullChainID reports whether claim messages of the given
+// version commit to the full 32-byte chain ID hash. An empty version refers to
+// the default version.
+func ClaimMessageBindsFullChainID(version string) bool {
+	return claimMessageVersionsWithFullChainID[NormalizeClaimMessageVersion(version)]
+}
+
+// ClaimMessageBindsUTXOSet reports whether claim messages of the given version
+// commit to the set of claimed UTXOs. An empty version refers to the default
+// version.
+func ClaimMessageBindsUTXOSet(version string) bool {
+	return claimMessageVersionsWithUTXOSet[NormalizeClaimMessageVersion(version)]
+}
+
+// ClaimMessageBindsExpiry reports whether claim messages of the given version
+// commit to an expiry height. An empty version refers to the default version.
+func ClaimMessageBindsExpiry(version string) bool {
+	return claimMessageVersionsWithExpiry[NormalizeClaimMessageVersion(version)]
+}
+
+// ClaimMessageSaltsDestination reports whether claim messages of the given
+// version bind a salted destination commitment (CommitBTCQAddress) instead of
+// HashBTCQAddress of the destination. An empty version refers to the default
+// version.
+func ClaimMessageSaltsDestination(version string) bool {
+	return claimMessageVersionsWithSaltedDestination[NormalizeClaimMessageVersion(version)]
+}
 
 // ComputeClaimMessage computes the deterministic message hash for a claim.
 // This message is what needs to be signed by the TSS signer.
@@ -21,12 +169,102 @@ const ClaimMessageVersion = "qbtc-claim-v1"
 //   - The chain ID (prevents cross-chain replay)
 //   - A version string (prevents cross-version replay)
 func ComputeClaimMessage(addressHash [20]byte, btcqAddressHash [32]byte, chainID [8]byte) [32]byte {
+	return computeClaimMessage(ClaimMessageVersion, AddressTypeUnknown, addressHash, btcqAddressHash, chainID[:])
+}
+
+// ComputeClaimMessageWithVersion computes the claim message for a specific
+// message version. An empty version selects ClaimMessageVersion; unknown
+// versions are rejected.
+//
+// Versions that bind the address type (see ClaimMessageBindsAddressType)
+// hash as
+//
+//	SHA256(AddressType || AddressHash || BTCQAddressHash || ChainID || version)
+//
+// and require addressType to be P2PKH or P2WPKH. Other versions ignore it.
+//
+// Versions that bind the full chain ID hash (which includes every version
+// binding the UTXO set) cannot be computed from the 8-byte chainID; use
+// ComputeClaimMessageForParams for those.
+func ComputeClaimMessageWithVersion(version string, addressType AddressType, addressHash [20]byte, btcqAddressHash [32]byte, chainID [8]byte) ([32]byte, error) {
+	version = NormalizeClaimMessageVersion(version)
+	if claimMessageVersionsWithFullChainID[version] {
+		return [32]byte{}, fmt.Errorf("claim message version %q binds the full chain ID hash", version)
+	}
+	return claimMessageForVersion(version, addressType, addressHash, btcqAddressHash, chainID[:])
+}
+
+// ComputeClaimMessageForParams computes the claim message expected for the
+// given verification params under params.MessageVersion. Versions that bind
+// the full chain ID hash hash params.FullChainIDHash in place of ChainID as
+//
+//	SHA256(AddressType || AddressHash || BTCQAddressHash || SHA256(chain_id) || version)
+//
+// and require ChainID to be its 8-byte prefix, since ChainID is still the
+// circuit's public input. Versions that bind the UTXO set append
+// params.UTXOSetCommitment after the chain ID hash:
+//
+//	SHA256(AddressType || AddressHash || BTCQAddressHash || SHA256(chain_id) || UTXOSetCommitment || version)
+//
+// Versions that bind an expiry, all of which also bind the UTXO set, append
+// params.ExpiryHeight as 8 bytes big-endian after the commitment:
+//
+//	SHA256(AddressType || AddressHash || BTCQAddressHash || SHA256(chain_id) || UTXOSetCommitment || ExpiryHeight || version)
+func ComputeClaimMessageForParams(params VerificationParams) ([32]byte, error) {
+	version := NormalizeClaimMessageVersion(params.MessageVersion)
+	chainBinding := params.ChainID[:]
+	if claimMessageVersionsWithFullChainID[version] {
+		if !bytes.Equal(params.FullChainIDHash[:len(params.ChainID)], params.ChainID[:]) {
+			return [32]byte{}, fmt.Errorf("claim message version %q requires the full chain ID hash matching the chain ID", version)
+		}
+		chainBinding = params.FullChainIDHash[:]
+	}
+	if claimMessageVersionsWithUTXOSet[version] {
+		if params.UTXOSetCommitment == ([32]byte{}) {
+			return [32]byte{}, fmt.Errorf("claim message version %q requires a UTXO set commitment", version)
+		}
+		chainBinding = append(append([]byte{}, chainBinding...), params.UTXOSetCommitment[:]...)
+	}
+	if claimMessageVersionsWithExpiry[version] {
+		if params.ExpiryHeight == 0 {
+			return [32]byte{}, fmt.Errorf("claim message version %q requires an expiry height", version)
+		}
+		chainBinding = binary.BigEndian.AppendUint64(append([]byte{}, chainBinding...), params.ExpiryHeight)
+	}
+	return claimMessageForVersion(version, params.AddressType, params.AddressHash, params.QBTCAddressHash, chainBinding)
+}
+
+// claimMessageForVersion validates the version and address type and computes
+// the claim message over the given chain binding bytes.
+func claimMessageForVersion(version string, addressType AddressType, addressHash [20]byte, btcqAddressHash [32]byte, chainBinding []byte) ([32]byte, error) {
+	if !supportedClaimMessageVersions[version] {
+		return [32]byte{}, fmt.Errorf("unsupported claim message version %q", version)
+	}
+	if claimMessageVersionsWithAddressType[version] {
+		if addressType != AddressTypeP2PKH && addressType != AddressTypeP2WPKH {
+			return [32]byte{}, fmt.Errorf("claim message version %q requires a P2PKH or P2WPKH address type, got %s", version, addressType)
+		}
+	} else {
+		addressType = AddressTypeUnknown
+	}
+	return computeClaimMessage(version, addressType, addressHash, btcqAddressHash, chainBinding), nil
+}
+
+// computeClaimMessage hashes the claim components with the given version string.
+// The address type byte is only prepended when it is not AddressTypeUnknown.
+// chainBinding is the 8-byte ChainID or, for versions binding it, the full chain
+// ID hash, followed by the UTXO set commitment and the expiry height for
+// versions binding those.
+func computeClaimMessage(version string, addressType AddressType, addressHash [20]byte, btcqAddressHash [32]byte, chainBinding []byte) [32]byte {
 	// Concatenate all components
-	data := make([]byte, 0, 20+32+8+len(ClaimMessageVersion))
+	data := make([]byte, 0, 1+20+32+len(chainBinding)+len(version))
+	if addressType != AddressTypeUnknown {
+		data = append(data, byte(addressType))
+	}
 	data = append(data, addressHash[:]...)
 	data = append(data, btcqAddressHash[:]...)
-	data = append(data, chainID[:]...)
-	data = append(data, []byte(ClaimMessageVersion)...)
+	data = append(data, chainBinding...)
+	data = append(data, []byte(version)...)
 
 	// Hash the concatenation
 	return sha256.Sum256(data)
@@ -58,5 +296,3 @@ func VerifyClaimMessage(messageHash [32]byte, addressHash [20]byte, btcqAddressH
 	expected := ComputeClaimMessage(addressHash, btcqAddressHash, chainID)
 	return messageHash == expected
 }
-
-
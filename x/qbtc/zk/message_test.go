@@ -2,6 +2,7 @@ package zk
 
 import (
 	"crypto/sha256"
+	"encoding/binary"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -121,3 +122,236 @@ func TestClaimMessageVersion(t *testing.T) {
 	hashWithoutVersion := sha256.Sum256(dataWithoutVersion)
 	require.NotEqual(t, msg, hashWithoutVersion, "version string should affect the hash")
 }
+
+func TestComputeClaimMessageWithVersion(t *testing.T) {
+	addressHash := [20]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20}
+	btcqAddressHash := HashBTCQAddress("qbtc1test")
+	chainIDHash := ComputeChainIDHash("qbtc-1")
+
+	defaultMsg := ComputeClaimMessage(addressHash, btcqAddressHash, chainIDHash)
+
+	// Empty version selects the default
+	msg, err := ComputeClaimMessageWithVersion("", AddressTypeUnknown, addressHash, btcqAddressHash, chainIDHash)
+	require.NoError(t, err)
+	require.Equal(t, defaultMsg, msg)
+
+	// Explicit v1 matches the default
+	msg, err = ComputeClaimMessageWithVersion(ClaimMessageVersionV1, AddressTypeP2WPKH, addressHash, btcqAddressHash, chainIDHash)
+	require.NoError(t, err)
+	require.Equal(t, defaultMsg, msg)
+
+	// Unknown versions are rejected
+	_, err = ComputeClaimMessageWithVersion("qbtc-claim-v99", AddressTypeUnknown, addressHash, btcqAddressHash, chainIDHash)
+	require.Error(t, err)
+	require.False(t, IsSupportedClaimMessageVersion("qbtc-claim-v99"))
+	require.True(t, IsSupportedClaimMessageVersion(""))
+}
+
+func TestComputeClaimMessageWithVersion_AddressType(t *testing.T) {
+	addressHash := [20]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20}
+	btcqAddressHash := HashBTCQAddress("qbtc1test")
+	chainIDHash := ComputeChainIDHash("qbtc-1")
+
+	require.False(t, ClaimMessageBindsAddressType(""))
+	require.False(t, ClaimMessageBindsAddressType(ClaimMessageVersionV1))
+	require.True(t, ClaimMessageBindsAddressType(ClaimMessageVersionV2))
+
+	p2pkh, err := ComputeClaimMessageWithVersion(ClaimMessageVersionV2, AddressTypeP2PKH, addressHash, btcqAddressHash, chainIDHash)
+	require.NoError(t, err)
+	p2wpkh, err := ComputeClaimMessageWithVersion(ClaimMessageVersionV2, AddressTypeP2WPKH, addressHash, btcqAddressHash, chainIDHash)
+	require.NoError(t, err)
+	require.NotEqual(t, p2pkh, p2wpkh, "v2 messages must differ per address type")
+	require.NotEqual(t, ComputeClaimMessage(addressHash, btcqAddressHash, chainIDHash), p2pkh)
+
+	expected := []byte{byte(AddressTypeP2PKH)}
+	expected = append(expected, addressHash[:]...)
+	expected = append(expected, btcqAddressHash[:]...)
+	expected = append(expected, chainIDHash[:]...)
+	expected = append(expected, []byte(ClaimMessageVersionV2)...)
+	require.Equal(t, sha256.Sum256(expected), p2pkh)
+
+	// v2 requires a concrete address type
+	_, err = ComputeClaimMessageWithVersion(ClaimMessageVersionV2, AddressTypeUnknown, addressHash, btcqAddressHash, chainIDHash)
+	require.Error(t, err)
+}
+
+func TestComputeClaimMessageForParams_FullChainID(t *testing.T) {
+	params := VerificationParams{
+		AddressHash:     [20]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20},
+		QBTCAddressHash: HashBTCQAddress("qbtc1test"),
+		ChainID:         ComputeChainIDHash("qbtc-1"),
+		FullChainIDHash: ComputeFullChainIDHash("qbtc-1"),
+		MessageVersion:  ClaimMessageVersionV3,
+		AddressType:     AddressTypeP2WPKH,
+	}
+
+	require.False(t, ClaimMessageBindsFullChainID(""))
+	require.False(t, ClaimMessageBindsFullChainID(ClaimMessageVersionV2))
+	require.True(t, ClaimMessageBindsFullChainID(ClaimMessageVersionV3))
+	require.True(t, ClaimMessageBindsAddressType(ClaimMessageVersionV3))
+
+	msg, err := ComputeClaimMessageForParams(params)
+	require.NoError(t, err)
+
+	expected := []byte{byte(AddressTypeP2WPKH)}
+	expected = append(expected, params.AddressHash[:]...)
+	expected = append(expected, params.QBTCAddressHash[:]...)
+	expected = append(expected, params.FullChainIDHash[:]...)
+	expected = append(expected, []byte(ClaimMessageVersionV3)...)
+	require.Equal(t, sha256.Sum256(expected), msg)
+
+	// the full hash must be consistent with the 8-byte ChainID public input
+	mismatched := params
+	mismatched.FullChainIDHash = ComputeFullChainIDHash("qbtc-2")
+	_, err = ComputeClaimMessageForParams(mismatched)
+	require.Error(t, err)
+
+	// the 8-byte API cannot produce v3 messages
+	_, err = ComputeClaimMessageWithVersion(ClaimMessageVersionV3, params.AddressType, params.AddressHash, params.QBTCAddressHash, params.ChainID)
+	require.Error(t, err)
+
+	// earlier versions ignore the full hash and match the 8-byte API
+	params.MessageVersion = ClaimMessageVersionV2
+	v2, err := ComputeClaimMessageForParams(params)
+	require.NoError(t, err)
+	v2Direct, err := ComputeClaimMessageWithVersion(ClaimMessageVersionV2, params.AddressType, params.AddressHash, params.QBTCAddressHash, params.ChainID)
+	require.NoError(t, err)
+	require.Equal(t, v2Direct, v2)
+}
+
+func TestComputeClaimMessageForParams_UTXOSet(t *testing.T) {
+	commitment, err := ComputeUTXOSetCommitment([]Outpoint{{Txid: "aa00000000000000000000000000000000000000000000000000000000000001", Vout: 0}})
+	require.NoError(t, err)
+	params := VerificationParams{
+		AddressHash:       [20]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20},
+		QBTCAddressHash:   HashBTCQAddress("qbtc1test"),
+		ChainID:           ComputeChainIDHash("qbtc-1"),
+		FullChainIDHash:   ComputeFullChainIDHash("qbtc-1"),
+		MessageVersion:    ClaimMessageVersionV4,
+		AddressType:       AddressTypeP2PKH,
+		UTXOSetCommitment: commitment,
+	}
+
+	require.True(t, ClaimMessageBindsUTXOSet(ClaimMessageVersionV4))
+	require.False(t, ClaimMessageBindsUTXOSet(ClaimMessageVersionV3))
+	require.False(t, ClaimMessageBindsUTXOSet(""))
+	require.True(t, ClaimMessageBindsFullChainID(ClaimMessageVersionV4))
+	require.True(t, ClaimMessageBindsAddressType(ClaimMessageVersionV4))
+
+	msg, err := ComputeClaimMessageForParams(params)
+	require.NoError(t, err)
+
+	expected := []byte{byte(AddressTypeP2PKH)}
+	expected = append(expected, params.AddressHash[:]...)
+	expected = append(expected, params.QBTCAddressHash[:]...)
+	expected = append(expected, params.FullChainIDHash[:]...)
+	expected = append(expected, commitment[:]...)
+	expected = append(expected, []byte(ClaimMessageVersionV4)...)
+	require.Equal(t, sha256.Sum256(expected), msg)
+
+	// the commitment is required
+	missing := params
+	missing.UTXOSetCommitment = [32]byte{}
+	_, err = ComputeClaimMessageForParams(missing)
+	require.ErrorContains(t, err, "UTXO set commitment")
+
+	// the 8-byte API cannot produce v4 messages
+	_, err = ComputeClaimMessageWithVersion(ClaimMessageVersionV4, params.AddressType, params.AddressHash, params.QBTCAddressHash, params.ChainID)
+	require.Error(t, err)
+
+	// v3 ignores the commitment
+	params.MessageVersion = ClaimMessageVersionV3
+	withCommitment, err := ComputeClaimMessageForParams(params)
+	require.NoError(t, err)
+	params.UTXOSetCommitment = [32]byte{}
+	withoutCommitment, err := ComputeClaimMessageForParams(params)
+	require.NoError(t, err)
+	require.Equal(t, withoutCommitment, withCommitment)
+}
+
+func TestComputeClaimMessageForParams_Expiry(t *testing.T) {
+	params := VerificationParams{
+		AddressHash:       [20]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20},
+		QBTCAddressHash:   HashBTCQAddress("qbtc1test"),
+		ChainID:           ComputeChainIDHash("qbtc-1"),
+		FullChainIDHash:   ComputeFullChainIDHash("qbtc-1"),
+		MessageVersion:    ClaimMessageVersionV5,
+		AddressType:       AddressTypeP2WPKH,
+		UTXOSetCommitment: [32]byte{9, 9, 9},
+		ExpiryHeight:      123456,
+	}
+
+	require.True(t, IsSupportedClaimMessageVersion(ClaimMessageVersionV5))
+	require.True(t, ClaimMessageBindsExpiry(ClaimMessageVersionV5))
+	require.False(t, ClaimMessageBindsExpiry(ClaimMessageVersionV4))
+	require.False(t, ClaimMessageBindsExpiry(""))
+	require.True(t, ClaimMessageBindsFullChainID(ClaimMessageVersionV5))
+	require.True(t, ClaimMessageBindsAddressType(ClaimMessageVersionV5))
+	require.True(t, ClaimMessageBindsUTXOSet(ClaimMessageVersionV5))
+
+	msg, err := ComputeClaimMessageForParams(params)
+	require.NoError(t, err)
+
+	expected := []byte{byte(AddressTypeP2WPKH)}
+	expected = append(expected, params.AddressHash[:]...)
+	expected = append(expected, params.QBTCAddressHash[:]...)
+	expected = append(expected, params.FullChainIDHash[:]...)
+	expected = append(expected, params.UTXOSetCommitment[:]...)
+	expected = binary.BigEndian.AppendUint64(expected, params.ExpiryHeight)
+	expected = append(expected, []byte(ClaimMessageVersionV5)...)
+	require.Equal(t, sha256.Sum256(expected), msg)
+
+	// another expiry is another message
+	later := params
+	later.ExpiryHeight++
+	laterMsg, err := ComputeClaimMessageForParams(later)
+	require.NoError(t, err)
+	require.NotEqual(t, msg, laterMsg)
+
+	// the expiry is required
+	missing := params
+	missing.ExpiryHeight = 0
+	_, err = ComputeClaimMessageForParams(missing)
+	require.ErrorContains(t, err, "requires an expiry height")
+
+	// v5 binds the UTXO set like v4
+	noSet := params
+	noSet.UTXOSetCommitment = [32]byte{}
+	_, err = ComputeClaimMessageForParams(noSet)
+	require.ErrorContains(t, err, "requires a UTXO set commitment")
+
+	// v3 ignores the expiry
+	params.MessageVersion = ClaimMessageVersionV3
+	withExpiry, err := ComputeClaimMessageForParams(params)
+	require.NoError(t, err)
+	params.ExpiryHeight = 0
+	withoutExpiry, err := ComputeClaimMessageForParams(params)
+	require.NoError(t, err)
+	require.Equal(t, withoutExpiry, withExpiry)
+}
+
+func TestCommitBTCQAddress(t *testing.T) {
+	require.True(t, IsSupportedClaimMessageVersion(ClaimMessageVersionV6))
+	require.True(t, ClaimMessageSaltsDestination(ClaimMessageVersionV6))
+	require.False(t, ClaimMessageSaltsDestination(ClaimMessageVersionV3))
+	require.False(t, ClaimMessageSaltsDestination(""))
+	require.True(t, ClaimMessageBindsFullChainID(ClaimMessageVersionV6))
+	require.True(t, ClaimMessageBindsAddressType(ClaimMessageVersionV6))
+	require.True(t, ClaimMessageBindsUTXOSet(ClaimMessageVersionV6))
+	require.True(t, ClaimMessageBindsExpiry(ClaimMessageVersionV6))
+
+	salt := [DestinationSaltSize]byte{1, 2, 3}
+	commitment := CommitBTCQAddress("qbtc1test", salt)
+
+	expected := append([]byte("qbtc-destination-commitment"), salt[:]...)
+	expected = append(expected, []byte("qbtc1test")...)
+	require.Equal(t, sha256.Sum256(expected), commitment)
+
+	// the salt hides the address: the commitment is not its plain hash and
+	// changes with the salt
+	require.NotEqual(t, HashBTCQAddress("qbtc1test"), commitment)
+	otherSalt := salt
+	otherSalt[0]++
+	require.NotEqual(t, commitment, CommitBTCQAddress("qbtc1test", otherSalt))
+	require.NotEqual(t, commitment, CommitBTCQAddress("qbtc1other", salt))
+}
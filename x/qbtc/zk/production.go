@@ -0,0 +1,23 @@
+package zk
+
+import (
+	"errors"
+	"os"
+	"strconv"
+)
+
+// ProductionEnv is the environment variable that marks a production
+// deployment. When it holds a true value (see strconv.ParseBool), the unsafe
+// test SRS is refused.
+const ProductionEnv = "QBTC_PRODUCTION"
+
+// ErrTestSRSInProduction is returned by SetupWithOptions for SetupModeTest
+// when the process runs in production mode.
+var ErrTestSRSInProduction = errors.New("unsafe test SRS is disabled in production (" + ProductionEnv + " is set)")
+
+// IsProduction reports whether ProductionEnv marks this process as a
+// production deployment.
+func IsProduction() bool {
+	production, err := strconv.ParseBool(os.Getenv(ProductionEnv))
+	return err == nil && production
+}
@@ -0,0 +1,145 @@
+package zk
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/consensys/gnark/backend/witness"
+)
+
+// proofLengthHeaderSize is the size of the big-endian proof length prefix
+// used by the serialized proof envelope.
+const proofLengthHeaderSize = 4
+
+// proofEnvelopeVKFingerprint marks an envelope that starts with the short
+// fingerprint of the verifying key. A legacy envelope starts with the proof
+// length, whose first byte is always zero since MaxProofDataLen < 1<<24.
+const proofEnvelopeVKFingerprint byte = 0x01
+
+// publicInputCount is the number of public field elements exposed by
+// BTCSignatureCircuit: MessageHash(32) + AddressHash(20) + BTCQAddressHash(32) + ChainID(8).
+const publicInputCount = 32 + 20 + 32 + 8
+
+// Proof bundles a serialized PLONK proof together with the serialized public
+// witness it was generated against.
+type Proof struct {
+	ProofData    []byte // serialized plonk.Proof
+	PublicInputs []byte // serialized public witness
+	// VKFingerprint optionally names the verifying key the proof was generated
+	// against (ShortVerifyingKeyFingerprint), so a proof made with a stale
+	// setup is rejected with ErrVerifyingKeyMismatch.
+	VKFingerprint []byte
+}
+
+// ToProtoZKProof serializes the proof into its wire envelope:
+//
+//	uint32_be(len(ProofData)) || ProofData || PublicInputs
+//
+// or, when VKFingerprint is set:
+//
+//	0x01 || VKFingerprint || uint32_be(len(ProofData)) || ProofData || PublicInputs
+//
+// The proof length is bounded like in ProofFromProtoZKProof, which also keeps
+// it well within the uint32 length header.
+func (p *Proof) ToProtoZKProof() ([]byte, error) {
+	if len(p.ProofData) < MinProofDataLen {
+		return nil, fmt.Errorf("proof data too short: %d bytes (min %d)", len(p.ProofData), MinProofDataLen)
+	}
+	if len(p.ProofData) > MaxProofDataLen {
+		return nil, fmt.Errorf("proof data too long: %d bytes (max %d)", len(p.ProofData), MaxProofDataLen)
+	}
+	if len(p.VKFingerprint) != 0 && len(p.VKFingerprint) != VKFingerprintSize {
+		return nil, fmt.Errorf("verifying key fingerprint must be %d bytes, got %d", VKFingerprintSize, len(p.VKFingerprint))
+	}
+
+	var prefix []byte
+	if len(p.VKFingerprint) > 0 {
+		prefix = append([]byte{proofEnvelopeVKFingerprint}, p.VKFingerprint...)
+	}
+	out := make([]byte, len(prefix)+proofLengthHeaderSize+len(p.ProofData)+len(p.PublicInputs))
+	n := copy(out, prefix)
+	binary.BigEndian.PutUint32(out[n:n+proofLengthHeaderSize], uint32(len(p.ProofData)))
+	n += proofLengthHeaderSize
+	n += copy(out[n:], p.ProofData)
+	copy(out[n:], p.PublicInputs)
+	return out, nil
+}
+
+// ProofFromProtoZKProof parses a proof envelope produced by ToProtoZKProof.
+func ProofFromProtoZKProof(data []byte) (*Proof, error) {
+	var fingerprint []byte
+	if len(data) > 0 && data[0] == proofEnvelopeVKFingerprint {
+		if len(data) < 1+VKFingerprintSize {
+			return nil, fmt.Errorf("proof envelope too short for verifying key fingerprint: %d bytes", len(data))
+		}
+		fingerprint = make([]byte, VKFingerprintSize)
+		copy(fingerprint, data[1:1+VKFingerprintSize])
+		data = data[1+VKFingerprintSize:]
+	}
+	if len(data) < proofLengthHeaderSize {
+		return nil, fmt.Errorf("proof envelope too short: %d bytes", len(data))
+	}
+
+	proofLen := binary.BigEndian.Uint32(data[:proofLengthHeaderSize])
+	if proofLen < MinProofDataLen {
+		return nil, fmt.Errorf("proof data too short: %d bytes (min %d)", proofLen, MinProofDataLen)
+	}
+	if proofLen > MaxProofDataLen {
+		return nil, fmt.Errorf("proof data too long: %d bytes (max %d)", proofLen, MaxProofDataLen)
+	}
+
+	rest := data[proofLengthHeaderSize:]
+	if uint64(proofLen) > uint64(len(rest)) {
+		return nil, fmt.Errorf("proof envelope truncated: header declares %d bytes, %d available", proofLen, len(rest))
+	}
+
+	proof := &Proof{
+		ProofData:     make([]byte, proofLen),
+		PublicInputs:  make([]byte, len(rest)-int(proofLen)),
+		VKFingerprint: fingerprint,
+	}
+	copy(proof.ProofData, rest[:proofLen])
+	copy(proof.PublicInputs, rest[proofLen:])
+	return proof, nil
+}
+
+// DecodePublicInputs deserializes a public witness of BTCSignatureCircuit back
+// into the values it commits to. Every public input is a single byte, so any
+// element that does not fit in a byte is rejected.
+func DecodePublicInputs(publicInputs []byte) (VerificationParams, error) {
+	var params VerificationParams
+
+	w, err := witness.New(ecc.BN254.ScalarField())
+	if err != nil {
+		return params, fmt.Errorf("failed to create witness: %w", err)
+	}
+	if err := w.UnmarshalBinary(publicInputs); err != nil {
+		return params, fmt.Errorf("failed to deserialize public witness: %w", err)
+	}
+
+	vec, ok := w.Vector().(fr.Vector)
+	if !ok {
+		return params, fmt.Errorf("unexpected public witness vector type %T", w.Vector())
+	}
+	if len(vec) != publicInputCount {
+		return params, fmt.Errorf("unexpected public input count: got %d, want %d", len(vec), publicInputCount)
+	}
+
+	values := make([]byte, publicInputCount)
+	for i := range vec {
+		if !vec[i].IsUint64() || vec[i].Uint64() > 0xff {
+			return params, fmt.Errorf("public input %d is not a byte", i)
+		}
+		values[i] = byte(vec[i].Uint64())
+	}
+
+	offset := 0
+	offset += copy(params.MessageHash[:], values[offset:])
+	offset += copy(params.AddressHash[:], values[offset:])
+	offset += copy(params.QBTCAddressHash[:], values[offset:])
+	copy(params.ChainID[:], values[offset:])
+
+	return params, nil
+}
@@ -0,0 +1,139 @@
+package zk
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/frontend"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProofEnvelope_RoundTrip(t *testing.T) {
+	proof := &Proof{
+		ProofData:    make([]byte, MinProofDataLen+10),
+		PublicInputs: []byte{1, 2, 3, 4},
+	}
+	for i := range proof.ProofData {
+		proof.ProofData[i] = byte(i)
+	}
+
+	encoded, err := proof.ToProtoZKProof()
+	require.NoError(t, err)
+	require.Equal(t, uint32(len(proof.ProofData)), binary.BigEndian.Uint32(encoded[:4]))
+
+	decoded, err := ProofFromProtoZKProof(encoded)
+	require.NoError(t, err)
+	require.Equal(t, proof.ProofData, decoded.ProofData)
+	require.Equal(t, proof.PublicInputs, decoded.PublicInputs)
+}
+
+func TestProofEnvelope_VKFingerprint(t *testing.T) {
+	fingerprint := ShortVerifyingKeyFingerprint([]byte("verifying key"))
+	proof := &Proof{
+		ProofData:     make([]byte, MinProofDataLen),
+		PublicInputs:  []byte{1, 2, 3, 4},
+		VKFingerprint: fingerprint[:],
+	}
+
+	encoded, err := proof.ToProtoZKProof()
+	require.NoError(t, err)
+	require.Equal(t, proofEnvelopeVKFingerprint, encoded[0])
+	require.Equal(t, fingerprint[:], encoded[1:1+VKFingerprintSize])
+
+	decoded, err := ProofFromProtoZKProof(encoded)
+	require.NoError(t, err)
+	require.Equal(t, proof, decoded)
+
+	// legacy envelopes decode without a fingerprint
+	proof.VKFingerprint = nil
+	legacy, err := proof.ToProtoZKProof()
+	require.NoError(t, err)
+	require.Len(t, legacy, len(encoded)-1-VKFingerprintSize)
+	decoded, err = ProofFromProtoZKProof(legacy)
+	require.NoError(t, err)
+	require.Nil(t, decoded.VKFingerprint)
+
+	// the fingerprint has a fixed size
+	proof.VKFingerprint = fingerprint[:4]
+	_, err = proof.ToProtoZKProof()
+	require.ErrorContains(t, err, "fingerprint must be 8 bytes")
+	_, err = ProofFromProtoZKProof(encoded[:1+VKFingerprintSize-1])
+	require.ErrorContains(t, err, "too short for verifying key fingerprint")
+}
+
+func TestToProtoZKProof_Bounds(t *testing.T) {
+	_, err := (&Proof{ProofData: make([]byte, MinProofDataLen-1)}).ToProtoZKProof()
+	require.Error(t, err)
+
+	_, err = (&Proof{ProofData: make([]byte, MaxProofDataLen+1)}).ToProtoZKProof()
+	require.Error(t, err)
+
+	// the bounds match ProofFromProtoZKProof, so anything encoded decodes
+	for _, n := range []int{MinProofDataLen, MaxProofDataLen} {
+		encoded, err := (&Proof{ProofData: make([]byte, n)}).ToProtoZKProof()
+		require.NoError(t, err)
+		_, err = ProofFromProtoZKProof(encoded)
+		require.NoError(t, err)
+	}
+}
+
+func TestProofFromProtoZKProof_Invalid(t *testing.T) {
+	header := func(n uint32) []byte {
+		b := make([]byte, 4)
+		binary.BigEndian.PutUint32(b, n)
+		return b
+	}
+
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{"empty", nil},
+		{"short header", []byte{0, 0, 1}},
+		{"proof too short", append(header(MinProofDataLen-1), make([]byte, MinProofDataLen)...)},
+		{"proof too long", header(MaxProofDataLen + 1)},
+		{"truncated", append(header(MinProofDataLen+1), make([]byte, MinProofDataLen)...)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ProofFromProtoZKProof(tt.data)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestDecodePublicInputs(t *testing.T) {
+	addressHash := [20]byte{0xAA, 0xBB, 0xCC, 0xDD, 0xEE, 0xFF, 0x11, 0x22, 0x33, 0x44, 0x55, 0x66, 0x77, 0x88, 0x99, 0x00, 0x12, 0x34, 0x56, 0x78}
+	btcqAddressHash := HashBTCQAddress("qbtc1test")
+	chainIDHash := ComputeChainIDHash("qbtc-1")
+	messageHash := ComputeClaimMessage(addressHash, btcqAddressHash, chainIDHash)
+
+	assignment := &BTCSignatureCircuit{}
+	for i := range 32 {
+		assignment.MessageHash[i] = messageHash[i]
+		assignment.BTCQAddressHash[i] = btcqAddressHash[i]
+	}
+	for i := range 20 {
+		assignment.AddressHash[i] = addressHash[i]
+	}
+	for i := range 8 {
+		assignment.ChainID[i] = chainIDHash[i]
+	}
+
+	w, err := frontend.NewWitness(assignment, ecc.BN254.ScalarField(), frontend.PublicOnly())
+	require.NoError(t, err)
+	publicInputs, err := w.MarshalBinary()
+	require.NoError(t, err)
+
+	params, err := DecodePublicInputs(publicInputs)
+	require.NoError(t, err)
+	require.Equal(t, messageHash, params.MessageHash)
+	require.Equal(t, addressHash, params.AddressHash)
+	require.Equal(t, btcqAddressHash, params.QBTCAddressHash)
+	require.Equal(t, chainIDHash, params.ChainID)
+
+	_, err = DecodePublicInputs([]byte{1, 2, 3})
+	require.Error(t, err)
+}
@@ -0,0 +1,41 @@
+package zk
+
+import (
+	"sync/atomic"
+
+	"github.com/consensys/gnark/backend"
+	"github.com/consensys/gnark/constraint/solver"
+)
+
+// proverConcurrency is the NbTasks limit passed to gnark for every proof.
+// Zero leaves gnark's default of one task per CPU.
+var proverConcurrency atomic.Int64
+
+// SetProverConcurrency limits the number of parallel tasks gnark uses to
+// solve the circuit for each proof generated afterwards, through its NbTasks
+// option. Zero or a negative value restores gnark's default of one task per CPU.
+//
+// The setting applies to all provers in the process. gnark sizes the FFT and
+// multi-exponentiation stages of PLONK proving by GOMAXPROCS, so operators that
+// need a hard bound on the cores a proof can use should lower that as well.
+func SetProverConcurrency(n int) {
+	if n < 0 {
+		n = 0
+	}
+	proverConcurrency.Store(int64(n))
+}
+
+// ProverConcurrency returns the limit set by SetProverConcurrency, or zero if
+// proofs use gnark's default.
+func ProverConcurrency() int {
+	return int(proverConcurrency.Load())
+}
+
+// proverOptions returns the gnark prover options for the current settings.
+func proverOptions() []backend.ProverOption {
+	n := ProverConcurrency()
+	if n == 0 {
+		return nil
+	}
+	return []backend.ProverOption{backend.WithSolverOptions(solver.WithNbTasks(n))}
+}
@@ -0,0 +1,36 @@
+package zk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetProverConcurrency(t *testing.T) {
+	t.Cleanup(func() { SetProverConcurrency(0) })
+
+	require.Zero(t, ProverConcurrency())
+	require.Empty(t, proverOptions())
+
+	SetProverConcurrency(2)
+	require.Equal(t, 2, ProverConcurrency())
+	require.Len(t, proverOptions(), 1)
+
+	SetProverConcurrency(-1)
+	require.Zero(t, ProverConcurrency())
+	require.Empty(t, proverOptions())
+}
+
+func TestGenerateProof_LimitedConcurrency(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping proof generation in short mode")
+	}
+	t.Cleanup(func() { SetProverConcurrency(0) })
+	SetProverConcurrency(1)
+
+	setup := cachedTestSetup(t)
+	proofParams, params := ecdsaBenchParams(t)
+	proof, err := ProverFromSetup(setup).GenerateProof(proofParams)
+	require.NoError(t, err)
+	require.NoError(t, NewVerifier(setup.VerifyingKey).VerifyProof(proof, params))
+}
@@ -10,6 +10,7 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/consensys/gnark-crypto/ecc"
@@ -114,7 +115,8 @@ func DefaultSetupOptions() SetupOptions {
 }
 
 // TestSetupOptions returns setup options for testing.
-// WARNING: Uses unsafe test SRS - DO NOT use in production!
+// WARNING: Uses unsafe test SRS - DO NOT use in production! SetupWithOptions
+// refuses these options when ProductionEnv is set.
 func TestSetupOptions() SetupOptions {
 	return SetupOptions{
 		Mode: SetupModeTest,
@@ -124,18 +126,18 @@ func TestSetupOptions() SetupOptions {
 // SetupWithOptions performs PLONK setup for the BTCSignatureCircuit.
 // This circuit is compatible with TSS/MPC signers.
 // For production, use SetupModeDownload to use the Hermez/Polygon Powers of Tau.
+// SetupModeTest fails with ErrTestSRSInProduction when IsProduction is true,
+// since anyone can forge proofs against keys from the test SRS.
 func SetupWithOptions(opts SetupOptions) (*SetupResult, error) {
-	// Create a placeholder circuit for compilation
-	circuit := NewBTCSignatureCircuitPlaceholder()
+	if opts.Mode == SetupModeTest && IsProduction() {
+		return nil, ErrTestSRSInProduction
+	}
 
-	// Compile the circuit to SCS (Sparse Constraint System for PLONK)
-	cs, err := frontend.Compile(ecc.BN254.ScalarField(), scs.NewBuilder, circuit)
+	cs, err := compileCircuit()
 	if err != nil {
-		return nil, fmt.Errorf("failed to compile circuit: %w", err)
+		return nil, err
 	}
 
-	fmt.Printf("Circuit compiled: %d constraints\n", cs.GetNbConstraints())
-
 	var srs, srsLagrange *kzg.SRS
 
 	switch opts.Mode {
@@ -159,6 +161,9 @@ func SetupWithOptions(opts SetupOptions) (*SetupResult, error) {
 		if err != nil {
 			return nil, fmt.Errorf("failed to load SRS Lagrange from %s: %w", opts.SRSLagrangePath, err)
 		}
+		if err := checkLagrangeSRSSize(srsLagrange, cs.GetNbConstraints()); err != nil {
+			return nil, fmt.Errorf("SRS Lagrange from %s does not fit the circuit: %w", opts.SRSLagrangePath, err)
+		}
 
 	case SetupModeDownload:
 		// Download and cache the Hermez Powers of Tau
@@ -188,6 +193,22 @@ func SetupWithOptions(opts SetupOptions) (*SetupResult, error) {
 	}, nil
 }
 
+// compileCircuit compiles BTCSignatureCircuit to the sparse constraint system
+// used by PLONK. Every setup path compiles the circuit the same way.
+func compileCircuit() (constraint.ConstraintSystem, error) {
+	// Create a placeholder circuit for compilation
+	circuit := NewBTCSignatureCircuitPlaceholder()
+
+	// Compile the circuit to SCS (Sparse Constraint System for PLONK)
+	cs, err := frontend.Compile(ecc.BN254.ScalarField(), scs.NewBuilder, circuit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compile circuit: %w", err)
+	}
+
+	fmt.Printf("Circuit compiled: %d constraints\n", cs.GetNbConstraints())
+	return cs, nil
+}
+
 // LoadBN254SRSFromFile loads a BN254 KZG SRS from a gnark-formatted file
 func LoadBN254SRSFromFile(path string) (*kzg.SRS, error) {
 	f, err := os.Open(path)
@@ -251,18 +272,46 @@ func LoadOrDownloadHermezSRS(cacheDir string, power int, minConstraints int) (*k
 	srsPath := filepath.Join(cacheDir, fmt.Sprintf("srs_bn254_%d.dat", power))
 	srsLagrangePath := filepath.Join(cacheDir, fmt.Sprintf("srs_lagrange_bn254_%d_%d.dat", power, minConstraints))
 	rawSRSLagrangePath := filepath.Join(cacheDir, fmt.Sprintf("raw_srs_lagrange_bn254_%d_%d.dat", power, minConstraints))
-	// Check if cached files exist
+	// Check if cached files exist; a cache that fails its checksum (e.g. a
+	// partial write from a crashed run) is discarded and regenerated below
 	if fileExists(srsPath) && fileExists(srsLagrangePath) {
 		fmt.Printf("Loading cached SRS from %s\n", cacheDir)
-		srs, err := LoadBN254SRSFromFile(srsPath)
-		if err != nil {
-			return nil, nil, fmt.Errorf("failed to load cached SRS: %w", err)
+		srs, err := loadCachedBN254SRS(srsPath)
+		if err == nil {
+			var srsLagrange *kzg.SRS
+			srsLagrange, err = loadCachedBN254SRS(srsLagrangePath)
+			if err == nil {
+				err = checkLagrangeSRSSize(srsLagrange, minConstraints)
+			}
+			if err == nil {
+				return srs, srsLagrange, nil
+			}
+			// the canonical SRS does not depend on the circuit, only its
+			// Lagrange form has to be redone
+			fmt.Printf("Warning: discarding cached SRS Lagrange (%v), regenerating it\n", err)
+			removeCachedBN254SRS(srsLagrangePath)
+			srsLagrange, err = lagrangeSRS(srs, minConstraints)
+			if err != nil {
+				return nil, nil, err
+			}
+			if err := saveBN254SRSToFile(srsLagrange, srsLagrangePath); err != nil {
+				fmt.Printf("Warning: failed to cache SRS Lagrange: %v\n", err)
+			}
+			return srs, srsLagrange, nil
 		}
-		srsLagrange, err := LoadBN254SRSFromFile(srsLagrangePath)
-		if err != nil {
-			return nil, nil, fmt.Errorf("failed to load cached SRS Lagrange: %w", err)
+		fmt.Printf("Warning: discarding invalid SRS cache: %v\n", err)
+		removeCachedBN254SRS(srsPath)
+		removeCachedBN254SRS(srsLagrangePath)
+	}
+
+	// A previously downloaded PTAU file may be truncated as well
+	if fileExists(rawSRSLagrangePath) {
+		if expectedHash, ok := ptauBlake2bHashes[power]; ok {
+			if err := verifyFileBlake2b(rawSRSLagrangePath, expectedHash); err != nil {
+				fmt.Printf("Warning: discarding invalid cached PTAU file: %v\n", err)
+				os.Remove(rawSRSLagrangePath)
+			}
 		}
-		return srs, srsLagrange, nil
 	}
 
 	if !fileExists(rawSRSLagrangePath) {
@@ -293,21 +342,9 @@ func LoadOrDownloadHermezSRS(cacheDir string, power int, minConstraints int) (*k
 		return nil, nil, fmt.Errorf("failed to convert PTAU to gnark SRS: %w", err)
 	}
 	// Generate Lagrange form for PLONK
-	// We need the next power of 2 that fits the constraint count
-	lagrangeSize := nextPowerOfTwo(minConstraints)
-	fmt.Printf("Generating Lagrange SRS for size %d...\n", lagrangeSize)
-
-	srsLagrange, err := kzg.ToLagrangeG1(srs.Pk.G1[:lagrangeSize])
+	srsLagrangeResult, err := lagrangeSRS(srs, minConstraints)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to compute Lagrange SRS: %w", err)
-	}
-
-	// Build the Lagrange SRS structure
-	srsLagrangeResult := &kzg.SRS{
-		Pk: kzg.ProvingKey{
-			G1: srsLagrange,
-		},
-		Vk: srs.Vk,
+		return nil, nil, err
 	}
 
 	// Cache the converted SRS
@@ -322,6 +359,38 @@ func LoadOrDownloadHermezSRS(cacheDir string, power int, minConstraints int) (*k
 	return srs, srsLagrangeResult, nil
 }
 
+// lagrangeSRS computes the Lagrange form of srs that PLONK needs for a circuit
+// with nbConstraints constraints: the next power of 2 that fits them.
+func lagrangeSRS(srs *kzg.SRS, nbConstraints int) (*kzg.SRS, error) {
+	lagrangeSize := nextPowerOfTwo(nbConstraints)
+	if lagrangeSize > len(srs.Pk.G1) {
+		return nil, fmt.Errorf("SRS has %d points, Lagrange SRS needs %d", len(srs.Pk.G1), lagrangeSize)
+	}
+	fmt.Printf("Generating Lagrange SRS for size %d...\n", lagrangeSize)
+
+	srsLagrange, err := kzg.ToLagrangeG1(srs.Pk.G1[:lagrangeSize])
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute Lagrange SRS: %w", err)
+	}
+	return &kzg.SRS{
+		Pk: kzg.ProvingKey{
+			G1: srsLagrange,
+		},
+		Vk: srs.Vk,
+	}, nil
+}
+
+// checkLagrangeSRSSize checks that a Lagrange SRS was computed for a circuit
+// of nbConstraints constraints. One left over from before a circuit change
+// would otherwise only fail deep inside the PLONK setup.
+func checkLagrangeSRSSize(srsLagrange *kzg.SRS, nbConstraints int) error {
+	if want := nextPowerOfTwo(nbConstraints); len(srsLagrange.Pk.G1) != want {
+		return fmt.Errorf("got %d Lagrange SRS points, a circuit of %d constraints needs %d",
+			len(srsLagrange.Pk.G1), nbConstraints, want)
+	}
+	return nil
+}
+
 // nextPowerOfTwo returns the smallest power of 2 >= n
 func nextPowerOfTwo(n int) int {
 	p := 1
@@ -360,15 +429,77 @@ func verifyFileBlake2b(filePath, expectedHash string) error {
 	return nil
 }
 
+// srsChecksumSuffix is appended to a cached SRS file path to name the sidecar
+// file holding its hex-encoded SHA-256 checksum.
+const srsChecksumSuffix = ".sha256"
+
+// saveBN254SRSToFile caches an SRS together with a sidecar checksum. The SRS
+// is written to a temporary file and renamed into place, and the checksum is
+// written last, so an interrupted save never leaves a cache that verifies.
 func saveBN254SRSToFile(srs *kzg.SRS, path string) error {
-	f, err := os.Create(path)
+	// drop any stale checksum first so a failed save cannot pair with it
+	if err := os.Remove(path + srsChecksumSuffix); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	tmpPath := path + ".tmp"
+	f, err := os.Create(tmpPath)
 	if err != nil {
 		return err
 	}
+	hash := sha256.New()
+	if _, err := srs.WriteTo(io.MultiWriter(f, hash)); err != nil {
+		f.Close()
+		os.Remove(tmpPath)
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		os.Remove(tmpPath)
+		return err
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(tmpPath)
+		return err
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		os.Remove(tmpPath)
+		return err
+	}
+
+	return os.WriteFile(path+srsChecksumSuffix, []byte(fmt.Sprintf("%x\n", hash.Sum(nil))), 0644)
+}
+
+// loadCachedBN254SRS loads an SRS cached by saveBN254SRSToFile after checking
+// it against its sidecar checksum. Caches without a checksum are rejected.
+func loadCachedBN254SRS(path string) (*kzg.SRS, error) {
+	expected, err := os.ReadFile(path + srsChecksumSuffix)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read SRS checksum: %w", err)
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open SRS file: %w", err)
+	}
 	defer f.Close()
 
-	_, err = srs.WriteTo(f)
-	return err
+	hash := sha256.New()
+	if _, err := io.Copy(hash, f); err != nil {
+		return nil, fmt.Errorf("failed to hash SRS file: %w", err)
+	}
+	actual := fmt.Sprintf("%x", hash.Sum(nil))
+	if actual != strings.TrimSpace(string(expected)) {
+		return nil, fmt.Errorf("SRS checksum mismatch for %s: expected %s, got %s", path, strings.TrimSpace(string(expected)), actual)
+	}
+
+	return LoadBN254SRSFromFile(path)
+}
+
+// removeCachedBN254SRS deletes a cached SRS file and its checksum.
+func removeCachedBN254SRS(path string) {
+	os.Remove(path)
+	os.Remove(path + srsChecksumSuffix)
 }
 
 // SerializeVerifyingKey serializes the verifying key to bytes
@@ -463,12 +594,75 @@ type ProofParams struct {
 	AddressHash     [20]byte // Hash160 of the public key
 	BTCQAddressHash [32]byte // H(claimer_address)
 	ChainID         [8]byte  // First 8 bytes of H(chain_id)
+
+	// MessageVersion is the claim message version MessageHash was computed
+	// with. Empty means ClaimMessageVersion.
+	MessageVersion string
+
+	// AddressType is the address type MessageHash commits to, for message
+	// versions that bind it. Ignored otherwise.
+	AddressType AddressType
 }
 
 // GenerateProof generates a PLONK proof that proves ownership of a Bitcoin address
 // using an ECDSA signature. The signature and public key are private inputs.
 func (p *Prover) GenerateProof(params ProofParams) ([]byte, error) {
-	// Create witness assignment
+	proof, err := p.GenerateProofWithPublicInputs(params)
+	if err != nil {
+		return nil, err
+	}
+	return proof.ProofData, nil
+}
+
+// GenerateProofWithPublicInputs generates a PLONK proof like GenerateProof and
+// additionally returns the serialized public witness alongside it.
+func (p *Prover) GenerateProofWithPublicInputs(params ProofParams) (*Proof, error) {
+	if !IsSupportedClaimMessageVersion(params.MessageVersion) {
+		return nil, fmt.Errorf("unsupported claim message version %q", params.MessageVersion)
+	}
+
+	// Create the full witness
+	assignment := newSignatureAssignment(params)
+	witness, err := frontend.NewWitness(assignment, ecc.BN254.ScalarField())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create witness: %w", err)
+	}
+
+	// Generate the PLONK proof, with the concurrency set by SetProverConcurrency
+	proof, err := plonk.Prove(p.cs, p.pk, witness, proverOptions()...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate proof: %w", err)
+	}
+
+	// Serialize the proof
+	var proofBuf bytes.Buffer
+	_, err = proof.WriteTo(&proofBuf)
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize proof: %w", err)
+	}
+
+	// Get public witness
+	publicWitness, err := witness.Public()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get public witness: %w", err)
+	}
+
+	// Serialize public inputs
+	var publicBuf bytes.Buffer
+	_, err = publicWitness.WriteTo(&publicBuf)
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize public inputs: %w", err)
+	}
+
+	return &Proof{
+		ProofData:    proofBuf.Bytes(),
+		PublicInputs: publicBuf.Bytes(),
+	}, nil
+}
+
+// newSignatureAssignment builds the BTCSignatureCircuit witness assignment
+// for params.
+func newSignatureAssignment(params ProofParams) *BTCSignatureCircuit {
 	assignment := &BTCSignatureCircuit{}
 
 	// Set signature R scalar (the 'r' value in ECDSA, x-coord of k·G mod n)
@@ -503,38 +697,7 @@ func (p *Prover) GenerateProof(params ProofParams) ([]byte, error) {
 		assignment.ChainID[i] = params.ChainID[i]
 	}
 
-	// Create the full witness
-	witness, err := frontend.NewWitness(assignment, ecc.BN254.ScalarField())
-	if err != nil {
-		return nil, fmt.Errorf("failed to create witness: %w", err)
-	}
-
-	// Generate the PLONK proof
-	proof, err := plonk.Prove(p.cs, p.pk, witness)
-	if err != nil {
-		return nil, fmt.Errorf("failed to generate proof: %w", err)
-	}
-
-	// Serialize the proof
-	var proofBuf bytes.Buffer
-	_, err = proof.WriteTo(&proofBuf)
-	if err != nil {
-		return nil, fmt.Errorf("failed to serialize proof: %w", err)
-	}
-
-	// Get public witness
-	publicWitness, err := witness.Public()
-	if err != nil {
-		return nil, fmt.Errorf("failed to get public witness: %w", err)
-	}
-
-	// Serialize public inputs
-	var publicBuf bytes.Buffer
-	_, err = publicWitness.WriteTo(&publicBuf)
-	if err != nil {
-		return nil, fmt.Errorf("failed to serialize public inputs: %w", err)
-	}
-	return proofBuf.Bytes(), nil
+	return assignment
 }
 
 // bigIntToLimbs converts a big.Int to 4 limbs of 64 bits each for emulated field elements
@@ -564,21 +727,81 @@ func bigIntToLimbs(n *big.Int) []frontend.Variable {
 	return limbs
 }
 
+// limbsToBigInt is the inverse of bigIntToLimbs: it reassembles the value
+// from 4 limbs of 64 bits in little-endian limb order.
+func limbsToBigInt(limbs []frontend.Variable) (*big.Int, error) {
+	if len(limbs) != 4 {
+		return nil, fmt.Errorf("expected 4 limbs, got %d", len(limbs))
+	}
+	n := new(big.Int)
+	for i := 3; i >= 0; i-- {
+		limb, ok := limbs[i].(*big.Int)
+		if !ok {
+			return nil, fmt.Errorf("limb %d is %T, not *big.Int", i, limbs[i])
+		}
+		if limb.Sign() < 0 || limb.BitLen() > 64 {
+			return nil, fmt.Errorf("limb %d does not fit in 64 bits", i)
+		}
+		n.Lsh(n, 64)
+		n.Or(n, limb)
+	}
+	return n, nil
+}
+
 // HashBTCQAddress hashes a BTCQ address string to get the binding commitment
 func HashBTCQAddress(btcqAddress string) [32]byte {
 	return sha256.Sum256([]byte(btcqAddress))
 }
 
+// destinationCommitmentDomain separates salted destination commitments from
+// HashBTCQAddress hashes.
+const destinationCommitmentDomain = "qbtc-destination-commitment"
+
+// DestinationSaltSize is the size of the salt of a destination commitment.
+const DestinationSaltSize = 32
+
+// CommitBTCQAddress computes the salted destination commitment that message
+// versions binding one use as QBTCAddressHash:
+//
+//	SHA256("qbtc-destination-commitment" || salt || btcqAddress)
+//
+// A plain HashBTCQAddress can be matched against candidate addresses by anyone
+// who sees the proof or claim message. With a random salt, the destination is
+// only known once the claim reveals the salt.
+func CommitBTCQAddress(btcqAddress string, salt [DestinationSaltSize]byte) [32]byte {
+	h := sha256.New()
+	h.Write([]byte(destinationCommitmentDomain))
+	h.Write(salt[:])
+	h.Write([]byte(btcqAddress))
+	var commitment [32]byte
+	h.Sum(commitment[:0])
+	return commitment
+}
+
 // VerificationParams contains parameters needed for proof verification
 type VerificationParams struct {
-	MessageHash     [32]byte // The message that was signed
-	AddressHash     [20]byte // Hash160 of BTC pubkey
-	QBTCAddressHash [32]byte // H(claimer_address)
-	ChainID         [8]byte  // First 8 bytes of H(chain_id)
+	MessageHash       [32]byte    // The message that was signed
+	AddressHash       [20]byte    // Hash160 of BTC pubkey
+	QBTCAddressHash   [32]byte    // H(claimer_address), or CommitBTCQAddress for versions salting it
+	ChainID           [8]byte     // First 8 bytes of H(chain_id)
+	MessageVersion    string      // Claim message version; empty means ClaimMessageVersion
+	AddressType       AddressType // Address type bound by the message version, if any
+	FullChainIDHash   [32]byte    // H(chain_id); only used by versions binding the full chain ID hash
+	SignatureScheme   string      // How the claim message was signed; empty means SignatureSchemeRaw
+	UTXOSetCommitment [32]byte    // Commitment to the claimed UTXOs; only used by versions binding the UTXO set
+	ExpiryHeight      uint64      // Last qbtc block height the claim is valid at; only used by versions binding an expiry
 }
 
 // ComputeChainIDHash computes the chain ID hash from a chain ID string.
 // Returns the first 8 bytes of SHA256(chain_id).
+//
+// Threat model: with claim message versions up to qbtc-claim-v2 the claim is
+// bound to the chain by these 64 bits only. That rules out accidental replay
+// between chains, but an attacker who can pick a chain ID (e.g. launch a
+// fork or test network) can find one whose hash shares the 8-byte prefix of a
+// target chain with about 2^32 work for any pair, or 2^64 for a given target,
+// and replay claims signed for one chain on the other. qbtc-claim-v3 commits
+// to the full hash (ComputeFullChainIDHash) and is not affected.
 func ComputeChainIDHash(chainID string) [8]byte {
 	hash := sha256.Sum256([]byte(chainID))
 	var result [8]byte
@@ -586,6 +809,12 @@ func ComputeChainIDHash(chainID string) [8]byte {
 	return result
 }
 
+// ComputeFullChainIDHash returns SHA256(chain_id), the chain binding used by
+// claim message versions that commit to the full chain ID hash.
+func ComputeFullChainIDHash(chainID string) [32]byte {
+	return sha256.Sum256([]byte(chainID))
+}
+
 // SaveSetupToWriter writes the setup result to a writer
 func SaveSetupToWriter(setup *SetupResult, w io.Writer) error {
 	// Write constraint system
@@ -0,0 +1,88 @@
+package zk
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bn254/kzg"
+	"github.com/consensys/gnark/backend/plonk"
+)
+
+// setupSeedDomain separates the SRS secret derived by SetupWithSeed from other
+// uses of the same seed.
+const setupSeedDomain = "qbtc-zk-setup-seed-v1"
+
+// ErrSeededSetupInProduction is returned by SetupWithSeed when the process
+// runs in production mode.
+var ErrSeededSetupInProduction = errors.New("seeded setup is disabled in production (" + ProductionEnv + " is set)")
+
+// SetupWithSeed performs the PLONK setup for the same circuit as
+// SetupWithOptions with an SRS derived deterministically from seed. The KZG
+// secret is
+//
+//	tau = SHA256("qbtc-zk-setup-seed-v1" || seed) mod r
+//
+// where r is the BN254 scalar field order. The canonical SRS is
+// [tau^i]G1 sized with plonk.SRSSize, and the Lagrange SRS is its
+// transform over the circuit's evaluation domain. Two calls with the same seed
+// return byte-identical keys.
+//
+// WARNING: the seed is the toxic waste of this setup. Anyone who learns it can
+// forge proofs for the resulting verifying key, so it must never be published
+// or reconstructible by any party. Like the test SRS, it is refused when
+// IsProduction is true.
+func SetupWithSeed(seed []byte) (*SetupResult, error) {
+	if IsProduction() {
+		return nil, ErrSeededSetupInProduction
+	}
+	if len(seed) == 0 {
+		return nil, fmt.Errorf("setup seed is empty")
+	}
+
+	tau := seedToTau(seed)
+	if tau.Sign() == 0 {
+		return nil, fmt.Errorf("setup seed maps to a zero secret")
+	}
+
+	cs, err := compileCircuit()
+	if err != nil {
+		return nil, err
+	}
+
+	sizeCanonical, sizeLagrange := plonk.SRSSize(cs)
+	srs, err := kzg.NewSRS(uint64(sizeCanonical), tau)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate SRS from seed: %w", err)
+	}
+	lagrangeG1, err := kzg.ToLagrangeG1(srs.Pk.G1[:sizeLagrange])
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute Lagrange SRS: %w", err)
+	}
+	srsLagrange := &kzg.SRS{
+		Pk: kzg.ProvingKey{G1: lagrangeG1},
+		Vk: srs.Vk,
+	}
+
+	pk, vk, err := plonk.Setup(cs, srs, srsLagrange)
+	if err != nil {
+		return nil, fmt.Errorf("failed to run PLONK setup: %w", err)
+	}
+
+	return &SetupResult{
+		ConstraintSystem: cs,
+		ProvingKey:       pk,
+		VerifyingKey:     vk,
+	}, nil
+}
+
+// seedToTau derives the KZG secret for SetupWithSeed.
+func seedToTau(seed []byte) *big.Int {
+	h := sha256.New()
+	h.Write([]byte(setupSeedDomain))
+	h.Write(seed)
+	tau := new(big.Int).SetBytes(h.Sum(nil))
+	return tau.Mod(tau, ecc.BN254.ScalarField())
+}
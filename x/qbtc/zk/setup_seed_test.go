@@ -0,0 +1,57 @@
+package zk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetupWithSeed_Deterministic(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping seeded setup in short mode")
+	}
+	seed := []byte("qbtc seeded setup test")
+
+	first, err := SetupWithSeed(seed)
+	require.NoError(t, err)
+	second, err := SetupWithSeed(seed)
+	require.NoError(t, err)
+
+	firstVK, err := SerializeVerifyingKey(first.VerifyingKey)
+	require.NoError(t, err)
+	secondVK, err := SerializeVerifyingKey(second.VerifyingKey)
+	require.NoError(t, err)
+	require.Equal(t, firstVK, secondVK)
+
+	// Same circuit as SetupWithOptions
+	reference := cachedTestSetup(t).ConstraintSystem
+	require.Equal(t, reference.GetNbConstraints(), first.ConstraintSystem.GetNbConstraints())
+	require.Equal(t, reference.GetNbPublicVariables(), first.ConstraintSystem.GetNbPublicVariables())
+
+	// Proofs from the seeded keys verify
+	proofParams, params := ecdsaBenchParams(t)
+	proof, err := ProverFromSetup(first).GenerateProof(proofParams)
+	require.NoError(t, err)
+	require.NoError(t, NewVerifier(second.VerifyingKey).VerifyProof(proof, params))
+
+	// Another seed gives another key
+	other, err := SetupWithSeed([]byte("another seed"))
+	require.NoError(t, err)
+	otherVK, err := SerializeVerifyingKey(other.VerifyingKey)
+	require.NoError(t, err)
+	require.NotEqual(t, firstVK, otherVK)
+}
+
+func TestSetupWithSeed_Invalid(t *testing.T) {
+	_, err := SetupWithSeed(nil)
+	require.ErrorContains(t, err, "empty")
+
+	t.Setenv(ProductionEnv, "true")
+	_, err = SetupWithSeed([]byte("seed"))
+	require.ErrorIs(t, err, ErrSeededSetupInProduction)
+}
+
+func TestSeedToTau(t *testing.T) {
+	require.Equal(t, seedToTau([]byte("a")), seedToTau([]byte("a")))
+	require.NotEqual(t, seedToTau([]byte("a")), seedToTau([]byte("b")))
+}
@@ -0,0 +1,159 @@
+package zk
+
+import (
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/consensys/gnark-crypto/ecc/bn254/kzg"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSRSCacheChecksum(t *testing.T) {
+	srs, err := kzg.NewSRS(16, big.NewInt(42))
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "srs_bn254_test.dat")
+	require.NoError(t, saveBN254SRSToFile(srs, path))
+	require.FileExists(t, path+srsChecksumSuffix)
+	require.NoFileExists(t, path+".tmp")
+
+	loaded, err := loadCachedBN254SRS(path)
+	require.NoError(t, err)
+	require.Equal(t, len(srs.Pk.G1), len(loaded.Pk.G1))
+
+	// a truncated cache file no longer matches its checksum
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, data[:len(data)/2], 0644))
+	_, err = loadCachedBN254SRS(path)
+	require.ErrorContains(t, err, "checksum mismatch")
+
+	// caches without a checksum are not trusted
+	removeCachedBN254SRS(path)
+	require.NoFileExists(t, path+srsChecksumSuffix)
+	require.NoError(t, os.WriteFile(path, data, 0644))
+	_, err = loadCachedBN254SRS(path)
+	require.Error(t, err)
+}
+
+func TestLoadOrDownloadHermezSRS_StaleLagrangeCache(t *testing.T) {
+	const power, nbConstraints = 5, 20
+	cacheDir := t.TempDir()
+	srsPath := filepath.Join(cacheDir, fmt.Sprintf("srs_bn254_%d.dat", power))
+	srsLagrangePath := filepath.Join(cacheDir, fmt.Sprintf("srs_lagrange_bn254_%d_%d.dat", power, nbConstraints))
+
+	srs, err := kzg.NewSRS(1<<power, big.NewInt(42))
+	require.NoError(t, err)
+	require.NoError(t, saveBN254SRSToFile(srs, srsPath))
+
+	// a Lagrange SRS computed for a smaller circuit under the same name
+	stale, err := lagrangeSRS(srs, 16)
+	require.NoError(t, err)
+	require.Error(t, checkLagrangeSRSSize(stale, nbConstraints))
+	require.NoError(t, saveBN254SRSToFile(stale, srsLagrangePath))
+
+	// the stale file is regenerated from the cached SRS, nothing is downloaded
+	_, srsLagrange, err := LoadOrDownloadHermezSRS(cacheDir, power, nbConstraints)
+	require.NoError(t, err)
+	require.Len(t, srsLagrange.Pk.G1, 32)
+
+	cached, err := loadCachedBN254SRS(srsLagrangePath)
+	require.NoError(t, err)
+	require.Len(t, cached.Pk.G1, 32)
+}
+
+func TestSetupWithOptions_RefusesTestSRSInProduction(t *testing.T) {
+	for _, value := range []string{"true", "1"} {
+		t.Setenv(ProductionEnv, value)
+		require.True(t, IsProduction())
+		_, err := SetupWithOptions(TestSetupOptions())
+		require.ErrorIs(t, err, ErrTestSRSInProduction)
+	}
+
+	for _, value := range []string{"", "false", "0", "yes please"} {
+		t.Setenv(ProductionEnv, value)
+		require.False(t, IsProduction(), value)
+	}
+}
+
+func TestBigIntToLimbs(t *testing.T) {
+	hexInt := func(s string) *big.Int {
+		n, ok := new(big.Int).SetString(s, 16)
+		require.True(t, ok, s)
+		return n
+	}
+	nMinusOne := new(big.Int).Sub(btcec.S256().N, big.NewInt(1))
+
+	tests := []struct {
+		name string
+		n    *big.Int
+		want [4]uint64
+	}{
+		{name: "nil", n: nil, want: [4]uint64{0, 0, 0, 0}},
+		{name: "zero", n: big.NewInt(0), want: [4]uint64{0, 0, 0, 0}},
+		{name: "one", n: big.NewInt(1), want: [4]uint64{1, 0, 0, 0}},
+		{
+			name: "group order minus one",
+			n:    nMinusOne,
+			want: [4]uint64{0xbfd25e8cd0364140, 0xbaaedce6af48a03b, 0xfffffffffffffffe, 0xffffffffffffffff},
+		},
+		{
+			name: "high bit of the top limb",
+			n:    new(big.Int).Lsh(big.NewInt(1), 255),
+			want: [4]uint64{0, 0, 0, 0x8000000000000000},
+		},
+		{
+			name: "shorter than 32 bytes",
+			n:    hexInt("0102030405060708090a"),
+			want: [4]uint64{0x030405060708090a, 0x0102, 0, 0},
+		},
+		{
+			name: "limb boundary",
+			n:    hexInt("ffffffffffffffff"),
+			want: [4]uint64{0xffffffffffffffff, 0, 0, 0},
+		},
+		{
+			name: "one past a limb boundary",
+			n:    hexInt("10000000000000000"),
+			want: [4]uint64{0, 1, 0, 0},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			limbs := bigIntToLimbs(tc.n)
+			require.Len(t, limbs, 4)
+			for i, want := range tc.want {
+				limb, ok := limbs[i].(*big.Int)
+				require.True(t, ok, "limb %d is %T", i, limbs[i])
+				require.True(t, limb.IsUint64(), "limb %d overflows 64 bits", i)
+				require.Equal(t, want, limb.Uint64(), "limb %d", i)
+			}
+
+			back, err := limbsToBigInt(limbs)
+			require.NoError(t, err)
+			want := tc.n
+			if want == nil {
+				want = big.NewInt(0)
+			}
+			require.Zero(t, want.Cmp(back), "round trip: got %x, want %x", back, want)
+		})
+	}
+}
+
+func TestLimbsToBigInt_Rejects(t *testing.T) {
+	_, err := limbsToBigInt(bigIntToLimbs(big.NewInt(1))[:3])
+	require.ErrorContains(t, err, "expected 4 limbs")
+
+	limbs := bigIntToLimbs(big.NewInt(1))
+	limbs[2] = new(big.Int).Lsh(big.NewInt(1), 64)
+	_, err = limbsToBigInt(limbs)
+	require.ErrorContains(t, err, "does not fit in 64 bits")
+
+	limbs[2] = uint64(1)
+	_, err = limbsToBigInt(limbs)
+	require.ErrorContains(t, err, "not *big.Int")
+}
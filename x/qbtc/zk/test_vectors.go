@@ -0,0 +1,316 @@
+package zk
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/bech32"
+	"github.com/btcsuite/btcd/chaincfg"
+)
+
+// TestVectorsFormatVersion is the version of the test vector file layout.
+// Bump it on any change to the JSON format or to the vector cases.
+const TestVectorsFormatVersion = 1
+
+const (
+	// testVectorCircuitECDSA names BTCSignatureCircuit in test vectors.
+	testVectorCircuitECDSA = "ecdsa"
+	// testVectorChainID is the chain ID all test vectors are bound to.
+	testVectorChainID = "qbtc-testvectors-1"
+	// testVectorSeedPrefix prefixes the case name to derive its private key.
+	testVectorSeedPrefix = "qbtc-test-vector/"
+	// testVectorBTCQPrefix is the bech32 prefix of the destination addresses.
+	testVectorBTCQPrefix = "qbtc"
+)
+
+// testVectorCase describes one golden vector. Every value in the generated
+// vector is derived from these fields, so adding, removing or reordering a
+// case changes the file and requires bumping TestVectorsFormatVersion.
+type testVectorCase struct {
+	name            string
+	addressType     AddressType
+	messageVersion  string
+	signatureScheme string
+}
+
+var testVectorCases = []testVectorCase{
+	{"p2pkh-v1-raw", AddressTypeP2PKH, ClaimMessageVersionV1, SignatureSchemeRaw},
+	{"p2wpkh-v2-raw", AddressTypeP2WPKH, ClaimMessageVersionV2, SignatureSchemeRaw},
+	{"p2wpkh-v3-raw", AddressTypeP2WPKH, ClaimMessageVersionV3, SignatureSchemeRaw},
+	{"p2pkh-v1-bip137", AddressTypeP2PKH, ClaimMessageVersionV1, SignatureSchemeBIP137},
+	{"p2wpkh-v3-bip137", AddressTypeP2WPKH, ClaimMessageVersionV3, SignatureSchemeBIP137},
+}
+
+// TestVectorFile is the JSON document written by 'zkprover test-vectors'.
+// It is the contract other implementations of the prover test against.
+type TestVectorFile struct {
+	FormatVersion int `json:"format_version"`
+	// VerifyingKey is the hex verifying key the proofs verify against. It is
+	// empty when the file was generated without proofs.
+	VerifyingKey string       `json:"verifying_key,omitempty"`
+	Vectors      []TestVector `json:"vectors"`
+}
+
+// TestVector is a single golden vector. All fields except Proof are
+// deterministic: signatures use RFC 6979 nonces. PLONK proofs are randomized,
+// so Proof differs between generations but always verifies against the
+// file's verifying key.
+type TestVector struct {
+	Name        string `json:"name"`
+	CircuitType string `json:"circuit_type"`
+	// PrivateKey is the hex secp256k1 key, SHA256("qbtc-test-vector/" || name)
+	PrivateKey string `json:"private_key"`
+	// PublicKey is the hex compressed public key
+	PublicKey   string `json:"public_key"`
+	BTCAddress  string `json:"btc_address"`
+	AddressType string `json:"address_type"`
+	// AddressHash is the hex Hash160 of PublicKey, the address identifier
+	AddressHash     string `json:"address_hash"`
+	BTCQAddress     string `json:"btcq_address"`
+	ChainID         string `json:"chain_id"`
+	MessageVersion  string `json:"message_version"`
+	SignatureScheme string `json:"signature_scheme"`
+	// ClaimMessage is the hex claim message (ComputeClaimMessageForParams)
+	ClaimMessage string `json:"claim_message"`
+	// SignedMessage is the hex digest the key signed (ComputeSignedMessageForParams)
+	SignedMessage string `json:"signed_message"`
+	// Signature is the hex r || s signature over SignedMessage
+	Signature string `json:"signature"`
+	// BIP137Signature is the base64 signature a wallet would return, for the bip137 scheme
+	BIP137Signature string                 `json:"bip137_signature,omitempty"`
+	PublicInputs    TestVectorPublicInputs `json:"public_inputs"`
+	// Proof is the hex proof envelope (Proof.ToProtoZKProof)
+	Proof string `json:"proof,omitempty"`
+}
+
+// TestVectorPublicInputs holds the hex public inputs of BTCSignatureCircuit.
+type TestVectorPublicInputs struct {
+	MessageHash     string `json:"message_hash"`
+	AddressHash     string `json:"address_hash"`
+	BTCQAddressHash string `json:"btcq_address_hash"`
+	ChainID         string `json:"chain_id"`
+}
+
+// GenerateTestVectors builds the golden test vectors. When prover is nil the
+// vectors carry no proofs and vkBytes is ignored; otherwise every vector is
+// proven with prover, and vkBytes must be the matching verifying key.
+func GenerateTestVectors(prover *Prover, vkBytes []byte) (*TestVectorFile, error) {
+	file := &TestVectorFile{FormatVersion: TestVectorsFormatVersion}
+	if prover != nil {
+		file.VerifyingKey = hex.EncodeToString(vkBytes)
+	}
+	for _, c := range testVectorCases {
+		vector, proofParams, err := buildTestVector(c)
+		if err != nil {
+			return nil, fmt.Errorf("test vector %s: %w", c.name, err)
+		}
+		if prover != nil {
+			proof, err := prover.GenerateProofWithPublicInputs(proofParams)
+			if err != nil {
+				return nil, fmt.Errorf("test vector %s: failed to generate proof: %w", c.name, err)
+			}
+			envelope, err := proof.ToProtoZKProof()
+			if err != nil {
+				return nil, fmt.Errorf("test vector %s: %w", c.name, err)
+			}
+			vector.Proof = hex.EncodeToString(envelope)
+		}
+		file.Vectors = append(file.Vectors, vector)
+	}
+	return file, nil
+}
+
+// VerifyTestVectors checks a test vector file against this implementation:
+// every deterministic field must match what GenerateTestVectors derives, and
+// when the file has a verifying key every proof must verify against it with
+// the vector's public inputs.
+func VerifyTestVectors(file *TestVectorFile) error {
+	if file.FormatVersion != TestVectorsFormatVersion {
+		return fmt.Errorf("unsupported test vector format version %d (expected %d)", file.FormatVersion, TestVectorsFormatVersion)
+	}
+	if len(file.Vectors) != len(testVectorCases) {
+		return fmt.Errorf("expected %d test vectors, got %d", len(testVectorCases), len(file.Vectors))
+	}
+
+	var verifier *Verifier
+	if file.VerifyingKey != "" {
+		vkBytes, err := hex.DecodeString(file.VerifyingKey)
+		if err != nil {
+			return fmt.Errorf("verifying key is not valid hex: %w", err)
+		}
+		verifier, err = NewVerifierFromBytes(vkBytes)
+		if err != nil {
+			return err
+		}
+	}
+
+	for i, c := range testVectorCases {
+		got := file.Vectors[i]
+		want, _, err := buildTestVector(c)
+		if err != nil {
+			return fmt.Errorf("test vector %s: %w", c.name, err)
+		}
+		proofHex := got.Proof
+		got.Proof = ""
+		if got != want {
+			return fmt.Errorf("test vector %d does not match:\ngot:  %+v\nwant: %+v", i, got, want)
+		}
+		if verifier == nil {
+			continue
+		}
+		if err := verifyTestVectorProof(verifier, want, proofHex); err != nil {
+			return fmt.Errorf("test vector %s: %w", c.name, err)
+		}
+	}
+	return nil
+}
+
+// verifyTestVectorProof checks that the proof envelope commits to the
+// vector's public inputs and verifies.
+func verifyTestVectorProof(verifier *Verifier, vector TestVector, proofHex string) error {
+	envelope, err := hex.DecodeString(proofHex)
+	if err != nil {
+		return fmt.Errorf("proof is not valid hex: %w", err)
+	}
+	proof, err := ProofFromProtoZKProof(envelope)
+	if err != nil {
+		return err
+	}
+	params, err := testVectorVerificationParams(vector)
+	if err != nil {
+		return err
+	}
+	return verifier.VerifyProofWithPublicInputs(proof, params)
+}
+
+// testVectorVerificationParams rebuilds the verification params a chain
+// would use for the vector.
+func testVectorVerificationParams(vector TestVector) (VerificationParams, error) {
+	addressHash, err := AddressHashFromHex(vector.AddressHash)
+	if err != nil {
+		return VerificationParams{}, err
+	}
+	addressType, err := ParseAddressType(vector.AddressType)
+	if err != nil {
+		return VerificationParams{}, err
+	}
+	params := VerificationParams{
+		AddressHash:     addressHash,
+		QBTCAddressHash: HashBTCQAddress(vector.BTCQAddress),
+		ChainID:         ComputeChainIDHash(vector.ChainID),
+		FullChainIDHash: ComputeFullChainIDHash(vector.ChainID),
+		MessageVersion:  vector.MessageVersion,
+		AddressType:     addressType,
+		SignatureScheme: vector.SignatureScheme,
+	}
+	params.MessageHash, err = ComputeSignedMessageForParams(params)
+	return params, err
+}
+
+// buildTestVector derives the deterministic part of a vector and the proof
+// witness for it.
+func buildTestVector(c testVectorCase) (TestVector, ProofParams, error) {
+	seed := sha256.Sum256([]byte(testVectorSeedPrefix + c.name))
+	privKey, pubKey := btcec.PrivKeyFromBytes(seed[:])
+	compressed := pubKey.SerializeCompressed()
+
+	addressHash, err := PublicKeyToAddressHash(compressed)
+	if err != nil {
+		return TestVector{}, ProofParams{}, err
+	}
+	btcAddress, err := testVectorBTCAddress(c.addressType, addressHash)
+	if err != nil {
+		return TestVector{}, ProofParams{}, err
+	}
+	// The destination is derived from the same seed so that each vector binds
+	// a different qbtc address
+	btcqAddress, err := testVectorBTCQAddress(seed)
+	if err != nil {
+		return TestVector{}, ProofParams{}, err
+	}
+
+	vector := TestVector{
+		Name:            c.name,
+		CircuitType:     testVectorCircuitECDSA,
+		PrivateKey:      hex.EncodeToString(seed[:]),
+		PublicKey:       hex.EncodeToString(compressed),
+		BTCAddress:      btcAddress,
+		AddressType:     c.addressType.String(),
+		AddressHash:     AddressHashToHex(addressHash),
+		BTCQAddress:     btcqAddress,
+		ChainID:         testVectorChainID,
+		MessageVersion:  c.messageVersion,
+		SignatureScheme: c.signatureScheme,
+	}
+	params, err := testVectorVerificationParams(vector)
+	if err != nil {
+		return TestVector{}, ProofParams{}, err
+	}
+	claimMessage, err := ComputeClaimMessageForParams(params)
+	if err != nil {
+		return TestVector{}, ProofParams{}, err
+	}
+
+	// SignCompact uses RFC 6979 nonces, so the signature is deterministic
+	compact := ecdsa.SignCompact(privKey, params.MessageHash[:], true)
+	sigR, sigS := compact[1:33], compact[33:65]
+
+	var proofParams ProofParams
+	if c.signatureScheme == SignatureSchemeBIP137 {
+		// Wallets mark P2WPKH signatures with the bech32 header range
+		bip137Sig := append([]byte{}, compact...)
+		if c.addressType == AddressTypeP2WPKH {
+			bip137Sig[0] += 8
+		}
+		vector.BIP137Signature = base64.StdEncoding.EncodeToString(bip137Sig)
+		proofParams, err = ProofParamsFromBIP137Signature(vector.BIP137Signature, params)
+	} else {
+		proofParams, err = ProofParamsFromSignature(sigR, sigS, compressed, params)
+	}
+	if err != nil {
+		return TestVector{}, ProofParams{}, err
+	}
+
+	vector.ClaimMessage = hex.EncodeToString(claimMessage[:])
+	vector.SignedMessage = hex.EncodeToString(params.MessageHash[:])
+	vector.Signature = hex.EncodeToString(compact[1:])
+	vector.PublicInputs = TestVectorPublicInputs{
+		MessageHash:     hex.EncodeToString(params.MessageHash[:]),
+		AddressHash:     hex.EncodeToString(params.AddressHash[:]),
+		BTCQAddressHash: hex.EncodeToString(params.QBTCAddressHash[:]),
+		ChainID:         hex.EncodeToString(params.ChainID[:]),
+	}
+	return vector, proofParams, nil
+}
+
+// testVectorBTCAddress encodes the mainnet address of the given type.
+func testVectorBTCAddress(addressType AddressType, addressHash [20]byte) (string, error) {
+	switch addressType {
+	case AddressTypeP2PKH:
+		return Hash160ToP2PKHAddress(addressHash)
+	case AddressTypeP2WPKH:
+		addr, err := btcutil.NewAddressWitnessPubKeyHash(addressHash[:], &chaincfg.MainNetParams)
+		if err != nil {
+			return "", err
+		}
+		return addr.EncodeAddress(), nil
+	default:
+		return "", fmt.Errorf("no test vector address encoding for %s", addressType)
+	}
+}
+
+// testVectorBTCQAddress returns a bech32 qbtc address over the first 20 bytes
+// of SHA256(seed).
+func testVectorBTCQAddress(seed [32]byte) (string, error) {
+	h := sha256.Sum256(seed[:])
+	data, err := bech32.ConvertBits(h[:20], 8, 5, true)
+	if err != nil {
+		return "", err
+	}
+	return bech32.Encode(testVectorBTCQPrefix, data)
+}
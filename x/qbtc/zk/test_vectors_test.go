@@ -0,0 +1,140 @@
+package zk
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	"github.com/stretchr/testify/require"
+)
+
+// testVectorsPath is the committed golden file. Regenerate it with
+//
+//	zkprover test-vectors --no-proofs --out x/qbtc/zk/testdata/test_vectors.json
+//
+// after bumping TestVectorsFormatVersion.
+var testVectorsPath = filepath.Join("testdata", "test_vectors.json")
+
+func loadTestVectors(t *testing.T, path string) *TestVectorFile {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	var file TestVectorFile
+	require.NoError(t, json.Unmarshal(data, &file))
+	return &file
+}
+
+func TestGoldenTestVectors(t *testing.T) {
+	require.NoError(t, VerifyTestVectors(loadTestVectors(t, testVectorsPath)))
+}
+
+func TestGenerateTestVectors_Deterministic(t *testing.T) {
+	first, err := GenerateTestVectors(nil, nil)
+	require.NoError(t, err)
+	second, err := GenerateTestVectors(nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, first, second)
+	require.Empty(t, first.VerifyingKey)
+
+	names := make(map[string]bool)
+	for _, v := range first.Vectors {
+		require.False(t, names[v.Name], "duplicate vector name %s", v.Name)
+		names[v.Name] = true
+		require.Empty(t, v.Proof)
+
+		// The signature verifies under the public key over the signed message
+		pubKeyBytes, err := hex.DecodeString(v.PublicKey)
+		require.NoError(t, err)
+		pubKey, err := btcec.ParsePubKey(pubKeyBytes)
+		require.NoError(t, err)
+		sigBytes, err := hex.DecodeString(v.Signature)
+		require.NoError(t, err)
+		require.Len(t, sigBytes, 64)
+		var r, s btcec.ModNScalar
+		r.SetByteSlice(sigBytes[:32])
+		s.SetByteSlice(sigBytes[32:])
+		digest, err := hex.DecodeString(v.SignedMessage)
+		require.NoError(t, err)
+		require.True(t, ecdsa.NewSignature(&r, &s).Verify(digest, pubKey), v.Name)
+
+		require.Equal(t, v.SignedMessage, v.PublicInputs.MessageHash)
+		require.Equal(t, v.AddressHash, v.PublicInputs.AddressHash)
+		if v.SignatureScheme == SignatureSchemeBIP137 {
+			require.NotEmpty(t, v.BIP137Signature)
+			require.NotEqual(t, v.ClaimMessage, v.SignedMessage)
+		} else {
+			require.Empty(t, v.BIP137Signature)
+			require.Equal(t, v.ClaimMessage, v.SignedMessage)
+		}
+	}
+}
+
+func TestVerifyTestVectors_RejectsMismatch(t *testing.T) {
+	tests := []struct {
+		name    string
+		tamper  func(f *TestVectorFile)
+		wantErr string
+	}{
+		{
+			name:    "format version",
+			tamper:  func(f *TestVectorFile) { f.FormatVersion++ },
+			wantErr: "format version",
+		},
+		{
+			name:    "missing vector",
+			tamper:  func(f *TestVectorFile) { f.Vectors = f.Vectors[1:] },
+			wantErr: "expected",
+		},
+		{
+			name:    "claim message",
+			tamper:  func(f *TestVectorFile) { f.Vectors[0].ClaimMessage = f.Vectors[1].ClaimMessage },
+			wantErr: "does not match",
+		},
+		{
+			name:    "signature",
+			tamper:  func(f *TestVectorFile) { f.Vectors[2].Signature = f.Vectors[1].Signature },
+			wantErr: "does not match",
+		},
+		{
+			name:    "public input",
+			tamper:  func(f *TestVectorFile) { f.Vectors[3].PublicInputs.ChainID = "0000000000000000" },
+			wantErr: "does not match",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			file, err := GenerateTestVectors(nil, nil)
+			require.NoError(t, err)
+			tc.tamper(file)
+			require.ErrorContains(t, VerifyTestVectors(file), tc.wantErr)
+		})
+	}
+}
+
+func TestGenerateTestVectors_Proofs(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping proof generation in short mode")
+	}
+	setup := cachedTestSetup(t)
+	vkBytes, err := SerializeVerifyingKey(setup.VerifyingKey)
+	require.NoError(t, err)
+
+	file, err := GenerateTestVectors(ProverFromSetup(setup), vkBytes)
+	require.NoError(t, err)
+
+	// Round-trip through JSON like an external consumer would
+	path := filepath.Join(t.TempDir(), "vectors.json")
+	data, err := json.MarshalIndent(file, "", "  ")
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, data, 0o644))
+	loaded := loadTestVectors(t, path)
+	require.NoError(t, VerifyTestVectors(loaded))
+
+	// A proof is only valid for its own vector
+	loaded.Vectors[0].Proof, loaded.Vectors[1].Proof = loaded.Vectors[1].Proof, loaded.Vectors[0].Proof
+	require.ErrorContains(t, VerifyTestVectors(loaded), "public inputs do not match")
+}
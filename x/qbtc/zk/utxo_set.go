@@ -0,0 +1,63 @@
+package zk
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"sort"
+)
+
+// Outpoint identifies a Bitcoin transaction output by its txid, as displayed
+// by Bitcoin Core, and output index.
+type Outpoint struct {
+	Txid string
+	Vout uint32
+}
+
+// ComputeUTXOSetCommitment returns the commitment to a set of outpoints that
+// claim message versions binding the UTXO set sign over. The outpoints are
+// sorted by txid bytes and output index, so the commitment does not depend on
+// the order they are listed in:
+//
+//	SHA256(txid_1 || vout_1 || ... || txid_n || vout_n)
+//
+// where txid is the 32 bytes of the hex txid and vout is 4 bytes big-endian.
+// Empty sets, invalid txids and duplicate outpoints are rejected.
+func ComputeUTXOSetCommitment(outpoints []Outpoint) ([32]byte, error) {
+	if len(outpoints) == 0 {
+		return [32]byte{}, fmt.Errorf("UTXO set is empty")
+	}
+
+	type entry struct {
+		txid []byte
+		vout uint32
+	}
+	entries := make([]entry, len(outpoints))
+	for i, op := range outpoints {
+		txid, err := hex.DecodeString(op.Txid)
+		if err != nil || len(txid) != sha256.Size {
+			return [32]byte{}, fmt.Errorf("invalid txid %q", op.Txid)
+		}
+		entries[i] = entry{txid: txid, vout: op.Vout}
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		if c := bytes.Compare(entries[i].txid, entries[j].txid); c != 0 {
+			return c < 0
+		}
+		return entries[i].vout < entries[j].vout
+	})
+
+	h := sha256.New()
+	for i, e := range entries {
+		if i > 0 && bytes.Equal(e.txid, entries[i-1].txid) && e.vout == entries[i-1].vout {
+			return [32]byte{}, fmt.Errorf("duplicate outpoint %x:%d", e.txid, e.vout)
+		}
+		h.Write(e.txid)
+		h.Write(binary.BigEndian.AppendUint32(nil, e.vout))
+	}
+	var commitment [32]byte
+	copy(commitment[:], h.Sum(nil))
+	return commitment, nil
+}
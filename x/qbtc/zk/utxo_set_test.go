@@ -0,0 +1,46 @@
+package zk
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestComputeUTXOSetCommitment(t *testing.T) {
+	txidA := strings.Repeat("0a", 32)
+	txidB := strings.Repeat("0b", 32)
+
+	commitment, err := ComputeUTXOSetCommitment([]Outpoint{{txidB, 0}, {txidA, 2}, {txidA, 1}})
+	require.NoError(t, err)
+
+	// Sorted by txid bytes, then output index
+	var expected []byte
+	for _, op := range []Outpoint{{txidA, 1}, {txidA, 2}, {txidB, 0}} {
+		txid, err := hex.DecodeString(op.Txid)
+		require.NoError(t, err)
+		expected = append(expected, txid...)
+		expected = binary.BigEndian.AppendUint32(expected, op.Vout)
+	}
+	require.Equal(t, sha256.Sum256(expected), commitment)
+
+	// Order and hex case do not matter
+	reordered, err := ComputeUTXOSetCommitment([]Outpoint{{txidA, 1}, {strings.ToUpper(txidB), 0}, {txidA, 2}})
+	require.NoError(t, err)
+	require.Equal(t, commitment, reordered)
+
+	// Any change to the set does
+	subset, err := ComputeUTXOSetCommitment([]Outpoint{{txidA, 1}, {txidA, 2}})
+	require.NoError(t, err)
+	require.NotEqual(t, commitment, subset)
+
+	_, err = ComputeUTXOSetCommitment(nil)
+	require.ErrorContains(t, err, "empty")
+	_, err = ComputeUTXOSetCommitment([]Outpoint{{txidA, 1}, {txidA, 1}})
+	require.ErrorContains(t, err, "duplicate")
+	_, err = ComputeUTXOSetCommitment([]Outpoint{{"abcd", 0}})
+	require.ErrorContains(t, err, "invalid txid")
+}
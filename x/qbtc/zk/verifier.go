@@ -2,16 +2,23 @@ package zk
 
 import (
 	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"sync"
 
 	"github.com/consensys/gnark-crypto/ecc"
 	"github.com/consensys/gnark/backend/plonk"
+	"github.com/consensys/gnark/backend/witness"
 	"github.com/consensys/gnark/frontend"
 )
 
 // Verifier handles ZK proof verification for signature-based proofs using PLONK.
 // This verifier is TSS/MPC compatible - it verifies proofs of ECDSA signature validity.
+//
+// A Verifier is never modified after construction and may be used from any
+// number of goroutines. Replacing a verifier swaps the reference, so a
+// verifier obtained before keeps verifying against its own key.
 type Verifier struct {
 	vk plonk.VerifyingKey
 }
@@ -39,20 +46,99 @@ func (v *Verifier) VerifyProof(proof []byte, params VerificationParams) error {
 		return fmt.Errorf("proof cannot be nil")
 	}
 
-	// Verify the message hash matches expected
-	expectedMessage := ComputeClaimMessage(params.AddressHash, params.QBTCAddressHash, params.ChainID)
+	// Verify the message hash matches expected for the declared message
+	// version and signature scheme
+	expectedMessage, err := ComputeSignedMessageForParams(params)
+	if err != nil {
+		return err
+	}
 	if expectedMessage != params.MessageHash {
 		return fmt.Errorf("message hash mismatch: proof was signed for different parameters")
 	}
 
-	// Deserialize the proof
+	// Deserialize the proof. Bytes after the encoded proof would let one proof
+	// be submitted under many encodings, so they are rejected.
 	plonkProof := plonk.NewProof(ecc.BN254)
-	_, err := plonkProof.ReadFrom(bytes.NewReader(proof))
+	n, err := plonkProof.ReadFrom(bytes.NewReader(proof))
 	if err != nil {
 		return fmt.Errorf("failed to deserialize proof: %w", err)
 	}
+	if n != int64(len(proof)) {
+		return fmt.Errorf("proof has %d trailing bytes", int64(len(proof))-n)
+	}
+
+	// The public witness is derived from params; public inputs shipped with
+	// the proof are never used for verification
+	publicInputs, err := publicWitness(params)
+	if err != nil {
+		return err
+	}
+
+	// Verify the proof
+	err = plonk.Verify(plonkProof, v.vk, publicInputs)
+	if err != nil {
+		return fmt.Errorf("proof verification failed: %w", err)
+	}
+
+	return nil
+}
+
+// VerifyProofWithPublicInputs verifies a proof bundle like VerifyProof and
+// additionally requires its serialized public inputs to be exactly the
+// canonical encoding of the public inputs derived from params. This rejects
+// bundles whose inputs decode to the expected values through a non-canonical
+// or padded encoding.
+func (v *Verifier) VerifyProofWithPublicInputs(proof *Proof, params VerificationParams) error {
+	if proof == nil {
+		return fmt.Errorf("proof cannot be nil")
+	}
+	expected, err := ExpectedPublicInputs(params)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(proof.PublicInputs, expected) {
+		return fmt.Errorf("public inputs do not match the verification params")
+	}
+	if err := v.CheckVKFingerprint(proof.VKFingerprint); err != nil {
+		return err
+	}
+	return v.VerifyProof(proof.ProofData, params)
+}
+
+// CheckVKFingerprint returns ErrVerifyingKeyMismatch unless fingerprint is the
+// short fingerprint of the verifier's key. An empty fingerprint is accepted,
+// since bundles do not have to carry one.
+func (v *Verifier) CheckVKFingerprint(fingerprint []byte) error {
+	if len(fingerprint) == 0 {
+		return nil
+	}
+	vkBytes, err := v.GetVerifyingKeyBytes()
+	if err != nil {
+		return err
+	}
+	active := ShortVerifyingKeyFingerprint(vkBytes)
+	if !bytes.Equal(fingerprint, active[:]) {
+		return fmt.Errorf("%w: proof has %x, verifier has %x", ErrVerifyingKeyMismatch, fingerprint, active)
+	}
+	return nil
+}
 
-	// Create the public witness with the expected values
+// ExpectedPublicInputs returns the canonical serialized public witness of
+// BTCSignatureCircuit for params, as produced by the prover.
+func ExpectedPublicInputs(params VerificationParams) ([]byte, error) {
+	w, err := publicWitness(params)
+	if err != nil {
+		return nil, err
+	}
+	data, err := w.MarshalBinary()
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize public witness: %w", err)
+	}
+	return data, nil
+}
+
+// publicWitness builds the public witness of BTCSignatureCircuit from params.
+func publicWitness(params VerificationParams) (witness.Witness, error) {
 	assignment := &BTCSignatureCircuit{}
 
 	// Set message hash
@@ -76,18 +162,11 @@ func (v *Verifier) VerifyProof(proof []byte, params VerificationParams) error {
 	}
 
 	// Create witness from assignment (public only)
-	witness, err := frontend.NewWitness(assignment, ecc.BN254.ScalarField(), frontend.PublicOnly())
-	if err != nil {
-		return fmt.Errorf("failed to create public witness: %w", err)
-	}
-
-	// Verify the proof
-	err = plonk.Verify(plonkProof, v.vk, witness)
+	w, err := frontend.NewWitness(assignment, ecc.BN254.ScalarField(), frontend.PublicOnly())
 	if err != nil {
-		return fmt.Errorf("proof verification failed: %w", err)
+		return nil, fmt.Errorf("failed to create public witness: %w", err)
 	}
-
-	return nil
+	return w, nil
 }
 
 // GetVerifyingKey returns the verifying key
@@ -100,6 +179,31 @@ func (v *Verifier) GetVerifyingKeyBytes() ([]byte, error) {
 	return SerializeVerifyingKey(v.vk)
 }
 
+// VerifyingKeyFingerprint returns the hex SHA256 of a serialized verifying
+// key. Nodes holding the same key report the same fingerprint.
+func VerifyingKeyFingerprint(vkBytes []byte) string {
+	hash := sha256.Sum256(vkBytes)
+	return hex.EncodeToString(hash[:])
+}
+
+// VKFingerprintSize is the size of the short verifying key fingerprint
+// carried by proof bundles.
+const VKFingerprintSize = 8
+
+// ShortVerifyingKeyFingerprint returns the first VKFingerprintSize bytes of
+// the SHA256 of a serialized verifying key, i.e. the prefix of
+// VerifyingKeyFingerprint. It is enough to tell keys apart across rotations.
+func ShortVerifyingKeyFingerprint(vkBytes []byte) [VKFingerprintSize]byte {
+	var fingerprint [VKFingerprintSize]byte
+	hash := sha256.Sum256(vkBytes)
+	copy(fingerprint[:], hash[:])
+	return fingerprint
+}
+
+// ErrVerifyingKeyMismatch is returned when a proof declares that it was
+// generated against a different verifying key than the one verifying it.
+var ErrVerifyingKeyMismatch = fmt.Errorf("proof was generated against a different verifying key")
+
 // globalVerifierState holds the global verifier state with thread-safe access.
 // SECURITY: Once initialized, the verifier is immutable to prevent VK replacement attacks.
 type globalVerifierState struct {
@@ -158,6 +262,16 @@ func RegisterVerifierFromVK(vk plonk.VerifyingKey) error {
 	return nil
 }
 
+// ClearVerifierForTesting drops the global verifier, so tests can exercise a
+// node that has not loaded a verifying key yet. A node never calls it.
+func ClearVerifierForTesting() {
+	globalState.mu.Lock()
+	defer globalState.mu.Unlock()
+
+	globalState.verifier = nil
+	globalState.initialized = false
+}
+
 // GetVerifier returns the global verifier.
 // Thread-safe: uses read lock for concurrent access.
 func GetVerifier() (*Verifier, error) {
@@ -0,0 +1,81 @@
+package zk
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpectedPublicInputs(t *testing.T) {
+	_, params := ecdsaBenchParams(t)
+
+	publicInputs, err := ExpectedPublicInputs(params)
+	require.NoError(t, err)
+	decoded, err := DecodePublicInputs(publicInputs)
+	require.NoError(t, err)
+	require.Equal(t, params.MessageHash, decoded.MessageHash)
+	require.Equal(t, params.AddressHash, decoded.AddressHash)
+	require.Equal(t, params.QBTCAddressHash, decoded.QBTCAddressHash)
+	require.Equal(t, params.ChainID, decoded.ChainID)
+}
+
+func TestVerifyProofWithPublicInputs(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping proof generation in short mode")
+	}
+	setup := cachedTestSetup(t)
+	proofParams, params := ecdsaBenchParams(t)
+	proof, err := ProverFromSetup(setup).GenerateProofWithPublicInputs(proofParams)
+	require.NoError(t, err)
+	verifier := NewVerifier(setup.VerifyingKey)
+	require.NoError(t, verifier.VerifyProofWithPublicInputs(proof, params))
+
+	withInputs := func(publicInputs []byte) *Proof {
+		return &Proof{ProofData: proof.ProofData, PublicInputs: publicInputs}
+	}
+
+	t.Run("inputs for other params", func(t *testing.T) {
+		other := params
+		other.ChainID = ComputeChainIDHash("qbtc-other")
+		otherInputs, err := ExpectedPublicInputs(other)
+		require.NoError(t, err)
+		require.ErrorContains(t, verifier.VerifyProofWithPublicInputs(withInputs(otherInputs), params), "public inputs do not match")
+	})
+
+	t.Run("padded inputs", func(t *testing.T) {
+		padded := append(append([]byte{}, proof.PublicInputs...), 0)
+		require.ErrorContains(t, verifier.VerifyProofWithPublicInputs(withInputs(padded), params), "public inputs do not match")
+	})
+
+	t.Run("non-canonical field element", func(t *testing.T) {
+		// The last element is the final ChainID byte; adding the field modulus
+		// keeps its value modulo r but changes the encoding
+		nonCanonical := append([]byte{}, proof.PublicInputs...)
+		last := nonCanonical[len(nonCanonical)-32:]
+		value := new(big.Int).SetBytes(last)
+		value.Add(value, ecc.BN254.ScalarField())
+		value.FillBytes(last)
+		require.ErrorContains(t, verifier.VerifyProofWithPublicInputs(withInputs(nonCanonical), params), "public inputs do not match")
+	})
+
+	t.Run("verifying key fingerprint", func(t *testing.T) {
+		vkBytes, err := SerializeVerifyingKey(setup.VerifyingKey)
+		require.NoError(t, err)
+		active := ShortVerifyingKeyFingerprint(vkBytes)
+
+		withFingerprint := withInputs(proof.PublicInputs)
+		withFingerprint.VKFingerprint = active[:]
+		require.NoError(t, verifier.VerifyProofWithPublicInputs(withFingerprint, params))
+
+		stale := ShortVerifyingKeyFingerprint([]byte("rotated verifying key"))
+		withFingerprint.VKFingerprint = stale[:]
+		require.ErrorIs(t, verifier.VerifyProofWithPublicInputs(withFingerprint, params), ErrVerifyingKeyMismatch)
+	})
+
+	t.Run("trailing proof bytes", func(t *testing.T) {
+		padded := append(append([]byte{}, proof.ProofData...), 0)
+		require.ErrorContains(t, verifier.VerifyProof(padded, params), "trailing bytes")
+	})
+}
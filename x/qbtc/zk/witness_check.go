@@ -0,0 +1,90 @@
+package zk
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/test"
+)
+
+// CheckWitness reports why params would not satisfy BTCSignatureCircuit, or
+// nil if they do. plonk.Prove only says that the witness is unsatisfied, so
+// this checks each constraint the circuit enforces natively first and names
+// the one that fails, e.g. the Hash160 check for a public key of another
+// address. Inputs passing those checks are run through gnark's test solver,
+// whose error points at the failing constraint in the circuit source.
+//
+// Solving takes about as long as compiling the circuit, but needs no setup.
+func CheckWitness(params ProofParams) error {
+	if !IsSupportedClaimMessageVersion(params.MessageVersion) {
+		return fmt.Errorf("unsupported claim message version %q", params.MessageVersion)
+	}
+	if params.SignatureR == nil || params.SignatureS == nil {
+		return fmt.Errorf("witness has no signature")
+	}
+	if params.PublicKeyX == nil || params.PublicKeyY == nil {
+		return fmt.Errorf("witness has no public key")
+	}
+
+	// Public key: the circuit works on the affine point and fails the ECDSA
+	// gadget for anything off the curve
+	pubKey, err := witnessPublicKey(params.PublicKeyX, params.PublicKeyY)
+	if err != nil {
+		return fmt.Errorf("invalid public key at ECDSA check: %w", err)
+	}
+
+	// Step 1 of the circuit: the signature verifies for MessageHash
+	var r, s btcec.ModNScalar
+	if !scalarInRange(params.SignatureR, &r) {
+		return fmt.Errorf("signature R out of range [1, n-1] at ECDSA check")
+	}
+	if !scalarInRange(params.SignatureS, &s) {
+		return fmt.Errorf("signature S out of range [1, n-1] at ECDSA check")
+	}
+	if !ecdsa.NewSignature(&r, &s).Verify(params.MessageHash[:], pubKey) {
+		return fmt.Errorf("signature does not verify for message hash %x at ECDSA check", params.MessageHash)
+	}
+
+	// Step 2 of the circuit: the compressed public key hashes to AddressHash
+	addressHash, err := PublicKeyToAddressHash(pubKey.SerializeCompressed())
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(addressHash[:], params.AddressHash[:]) {
+		return fmt.Errorf("address hash mismatch at Hash160 check: public key hashes to %x, witness claims %x", addressHash, params.AddressHash)
+	}
+
+	if err := test.IsSolved(&BTCSignatureCircuit{}, newSignatureAssignment(params), ecc.BN254.ScalarField()); err != nil {
+		return fmt.Errorf("circuit not satisfied: %w", err)
+	}
+	return nil
+}
+
+// witnessPublicKey parses affine coordinates as a secp256k1 public key,
+// rejecting coordinates that are not field elements or not on the curve.
+func witnessPublicKey(x, y *big.Int) (*btcec.PublicKey, error) {
+	if x.Sign() < 0 || x.BitLen() > 256 || y.Sign() < 0 || y.BitLen() > 256 {
+		return nil, fmt.Errorf("coordinates do not fit in 32 bytes")
+	}
+	uncompressed := make([]byte, 65)
+	uncompressed[0] = 0x04
+	x.FillBytes(uncompressed[1:33])
+	y.FillBytes(uncompressed[33:])
+	return btcec.ParsePubKey(uncompressed)
+}
+
+// scalarInRange sets scalar to v and reports whether v is a valid signature
+// scalar, i.e. in [1, n-1] for the curve order n.
+func scalarInRange(v *big.Int, scalar *btcec.ModNScalar) bool {
+	if v.Sign() <= 0 || v.BitLen() > 256 {
+		return false
+	}
+	if overflow := scalar.SetByteSlice(v.Bytes()); overflow {
+		return false
+	}
+	return !scalar.IsZero()
+}
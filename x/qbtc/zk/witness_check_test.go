@@ -0,0 +1,87 @@
+package zk
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	btcecdsa "github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	"github.com/stretchr/testify/require"
+)
+
+// witnessCheckParams signs a claim with a fresh key and returns its proof
+// inputs.
+func witnessCheckParams(t *testing.T) ProofParams {
+	t.Helper()
+	privKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	compressed := privKey.PubKey().SerializeCompressed()
+
+	addressHash, err := PublicKeyToAddressHash(compressed)
+	require.NoError(t, err)
+	params := VerificationParams{
+		AddressHash:     addressHash,
+		QBTCAddressHash: HashBTCQAddress("qbtc1test"),
+		ChainID:         ComputeChainIDHash("qbtc-1"),
+	}
+	params.MessageHash = ComputeClaimMessage(params.AddressHash, params.QBTCAddressHash, params.ChainID)
+
+	compact := btcecdsa.SignCompact(privKey, params.MessageHash[:], true)
+	proofParams, err := ProofParamsFromSignature(compact[1:33], compact[33:65], compressed, params)
+	require.NoError(t, err)
+	return proofParams
+}
+
+func TestCheckWitness_NativeChecks(t *testing.T) {
+	t.Run("address hash of another key", func(t *testing.T) {
+		params := witnessCheckParams(t)
+		params.AddressHash[0] ^= 0xff
+		err := CheckWitness(params)
+		require.ErrorContains(t, err, "address hash mismatch at Hash160 check")
+	})
+
+	t.Run("signature over another message", func(t *testing.T) {
+		params := witnessCheckParams(t)
+		params.MessageHash[0] ^= 0xff
+		err := CheckWitness(params)
+		require.ErrorContains(t, err, "signature does not verify")
+		require.ErrorContains(t, err, "at ECDSA check")
+	})
+
+	t.Run("zero signature scalar", func(t *testing.T) {
+		params := witnessCheckParams(t)
+		params.SignatureS = big.NewInt(0)
+		require.ErrorContains(t, CheckWitness(params), "signature S out of range")
+	})
+
+	t.Run("scalar above the curve order", func(t *testing.T) {
+		params := witnessCheckParams(t)
+		params.SignatureR = new(big.Int).Add(btcec.S256().N, big.NewInt(1))
+		require.ErrorContains(t, CheckWitness(params), "signature R out of range")
+	})
+
+	t.Run("public key off the curve", func(t *testing.T) {
+		params := witnessCheckParams(t)
+		params.PublicKeyY = new(big.Int).Add(params.PublicKeyY, big.NewInt(1))
+		require.ErrorContains(t, CheckWitness(params), "invalid public key at ECDSA check")
+	})
+
+	t.Run("missing signature", func(t *testing.T) {
+		params := witnessCheckParams(t)
+		params.SignatureR = nil
+		require.ErrorContains(t, CheckWitness(params), "witness has no signature")
+	})
+
+	t.Run("unsupported message version", func(t *testing.T) {
+		params := witnessCheckParams(t)
+		params.MessageVersion = "qbtc-claim-v0"
+		require.ErrorContains(t, CheckWitness(params), "unsupported claim message version")
+	})
+}
+
+func TestCheckWitness_Solves(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping circuit solver test in short mode")
+	}
+	require.NoError(t, CheckWitness(witnessCheckParams(t)))
+}